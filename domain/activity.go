@@ -13,4 +13,15 @@ var (
 	ActivityTaskTeamArray      = ActivityType(6)
 	ActivityTaskWasDeleted     = ActivityType(8)
 	ActivityTaskFileWasDeleted = ActivityType(9)
+
+	ActivityTaskCommentWasChanged  = ActivityType(10)
+	ActivityTaskCommentWasDeleted  = ActivityType(11)
+	ActivityTaskCommentWasHidden   = ActivityType(12)
+	ActivityTaskCommentWasRestored = ActivityType(13)
+
+	ActivityTaskSmsWasSent = ActivityType(14)
+
+	ActivityDealStageChanged = ActivityType(15)
+
+	ActivityAgentMerged = ActivityType(16)
 )
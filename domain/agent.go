@@ -1,11 +1,25 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Типы контактов агента. Телефоны и номера мессенджеров хранятся только цифрами,
+// как значения полей типа Phone
+const (
+	AgentContactPhone    = "phone"
+	AgentContactEmail    = "email"
+	AgentContactTelegram = "telegram"
+	AgentContactWhatsApp = "whatsapp"
+	AgentContactViber    = "viber"
+)
+
 type Agent struct {
 	UUID           uuid.UUID
 	FederationUUID uuid.UUID
@@ -17,6 +31,10 @@ type Agent struct {
 	Name     string
 	Contacts []AgentContacts
 
+	// LegalEntities и TaskUUIDs заполняются только для карточки агента
+	LegalEntities []AgentLegalEntity
+	TaskUUIDs     []uuid.UUID
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
@@ -33,6 +51,189 @@ type AgentFilter struct {
 	Offset         *int       `json:"offset"`
 	Limit          *int       `json:"limit"`
 	Name           *string    `json:"name"`
+
+	// Phone ищет по началу номера без учета форматирования, Email - по началу адреса без учета регистра
+	Phone *string `json:"phone"`
+	Email *string `json:"email"`
+}
+
+// AgentLegalEntity - юрлицо или ИП, с которым работает агент
+type AgentLegalEntity struct {
+	UUID      uuid.UUID
+	AgentUUID uuid.UUID
+
+	Name    string
+	INN     string
+	KPP     string
+	OGRN    string
+	Address string
+}
+
+// Validate проверяет реквизиты: контрольные суммы ИНН и ОГРН, формат КПП
+func (e *AgentLegalEntity) Validate() error {
+	e.Name = strings.TrimSpace(e.Name)
+	e.INN = strings.TrimSpace(e.INN)
+	e.KPP = strings.TrimSpace(e.KPP)
+	e.OGRN = strings.TrimSpace(e.OGRN)
+	e.Address = strings.TrimSpace(e.Address)
+
+	if e.Name == "" {
+		return errors.New("название юрлица не может быть пустым")
+	}
+
+	if !validINN(e.INN) {
+		return fmt.Errorf("ИНН %q указан неверно", e.INN)
+	}
+
+	if e.KPP != "" && (len(e.KPP) != 9 || !isDigits(e.KPP[:4]) || !isDigits(e.KPP[6:])) {
+		return fmt.Errorf("КПП %q указан неверно", e.KPP)
+	}
+
+	if e.OGRN != "" && !validOGRN(e.OGRN) {
+		return fmt.Errorf("ОГРН %q указан неверно", e.OGRN)
+	}
+
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+func innChecksum(inn string, weights []int) byte {
+	sum := 0
+	for i, w := range weights {
+		sum += int(inn[i]-'0') * w
+	}
+
+	return byte(sum%11%10) + '0'
+}
+
+// validINN - ИНН организации (10 цифр) или физлица (12 цифр) с верными контрольными цифрами
+func validINN(inn string) bool {
+	if !isDigits(inn) {
+		return false
+	}
+
+	switch len(inn) {
+	case 10:
+		return inn[9] == innChecksum(inn, []int{2, 4, 10, 3, 5, 9, 4, 6, 8})
+	case 12:
+		return inn[10] == innChecksum(inn, []int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}) &&
+			inn[11] == innChecksum(inn, []int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8})
+	}
+
+	return false
+}
+
+// validOGRN - ОГРН (13 цифр) или ОГРНИП (15 цифр) с верной контрольной цифрой
+func validOGRN(ogrn string) bool {
+	if !isDigits(ogrn) || (len(ogrn) != 13 && len(ogrn) != 15) {
+		return false
+	}
+
+	n := len(ogrn) - 1
+	mod := uint64(11)
+	if n == 14 {
+		mod = 13
+	}
+
+	var num uint64
+	for _, r := range ogrn[:n] {
+		num = num*10 + uint64(r-'0')
+	}
+
+	return byte(num%mod%10)+'0' == ogrn[n]
+}
+
+// AgentComment - заметка сотрудника в карточке агента
+type AgentComment struct {
+	UUID          uuid.UUID
+	AgentUUID     uuid.UUID
+	CreatedBy     string
+	CreatedByUUID uuid.UUID
+
+	Text string
+
+	CreatedAt time.Time
+}
+
+// AgentDuplicate - агент Duplicate похож на агента Agent, Matches - совпавшие признаки
+type AgentDuplicate struct {
+	Agent     Agent
+	Duplicate Agent
+	Score     int
+	Matches   []DuplicateMatch
+}
+
+// AgentMerge - что перенесено с дубля на агента при слиянии. Смс отдельно не переносятся:
+// они привязаны к телефонам, которые переходят к агенту вместе с контактами
+type AgentMerge struct {
+	SurvivorUUID  uuid.UUID
+	DuplicateUUID uuid.UUID
+	DuplicateName string
+
+	// Contacts - контакты дубля, которых не было у агента
+	Contacts []AgentContacts
+
+	LegalEntities int64
+	Tasks         int64
+	Deals         int64
+	Comments      int64
+	Files         int64
+	Emails        int64
+}
+
+// Типы событий ленты агента
+const (
+	AgentTimelineTask    = "task"
+	AgentTimelineDeal    = "deal"
+	AgentTimelineSms     = "sms"
+	AgentTimelineComment = "comment"
+	AgentTimelineFile    = "file"
+)
+
+// AgentTimelineItem - событие ленты агента: связанная задача, сделка, смс, комментарий или файл.
+// Title - название задачи, сделки или файла, Text - текст смс или комментария, Status - статус источника
+type AgentTimelineItem struct {
+	Type      string
+	UUID      uuid.UUID
+	At        time.Time
+	CreatedBy string
+
+	Title  string
+	Text   string
+	Status string
+}
+
+// MergeAgentTimeline сводит события источников в одну ленту от новых к старым и возвращает
+// страницу [offset, offset+limit). Каждый источник должен отдать не меньше offset+limit последних событий
+func MergeAgentTimeline(offset, limit int, sources ...[]AgentTimelineItem) []AgentTimelineItem {
+	items := []AgentTimelineItem{}
+	for _, src := range sources {
+		items = append(items, src...)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].At.After(items[j].At)
+	})
+
+	if offset >= len(items) {
+		return []AgentTimelineItem{}
+	}
+
+	items = items[offset:]
+	if limit < len(items) {
+		items = items[:limit]
+	}
+
+	return items
 }
 
 func NewAgent(federationUUID uuid.UUID, companyUUID *uuid.UUID, me Me, name string, contacts []AgentContacts) *Agent {
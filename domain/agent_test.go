@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAgentLegalEntityValidate(t *testing.T) {
+	valid := []AgentLegalEntity{
+		{Name: " ПАО Сбербанк ", INN: "7707083893", KPP: "773601001", OGRN: "1027700132195"},
+		{Name: "ИП Иванов", INN: "500100732259"},
+	}
+
+	for _, e := range valid {
+		if err := e.Validate(); err != nil {
+			t.Errorf("%s: %s", e.Name, err)
+		}
+	}
+
+	invalid := []AgentLegalEntity{
+		{INN: "7707083893"},
+		{Name: "A", INN: "7707083894"},
+		{Name: "A", INN: "500100732258"},
+		{Name: "A", INN: "77070838"},
+		{Name: "A", INN: "7707083893", KPP: "77360100"},
+		{Name: "A", INN: "7707083893", OGRN: "1027700132194"},
+	}
+
+	for _, e := range invalid {
+		if err := e.Validate(); err == nil {
+			t.Errorf("%+v: should fail", e)
+		}
+	}
+}
+
+func TestMergeAgentTimeline(t *testing.T) {
+	now := time.Now()
+	item := func(typ string, ago int) AgentTimelineItem {
+		return AgentTimelineItem{Type: typ, UUID: uuid.New(), At: now.Add(-time.Duration(ago) * time.Hour)}
+	}
+
+	tasks := []AgentTimelineItem{item(AgentTimelineTask, 1), item(AgentTimelineTask, 5)}
+	sms := []AgentTimelineItem{item(AgentTimelineSms, 2), item(AgentTimelineSms, 3)}
+	comments := []AgentTimelineItem{item(AgentTimelineComment, 4)}
+
+	got := MergeAgentTimeline(1, 3, tasks, sms, comments)
+	if len(got) != 3 || got[0] != sms[0] || got[1] != sms[1] || got[2] != comments[0] {
+		t.Errorf("unexpected page %+v", got)
+	}
+
+	if got := MergeAgentTimeline(10, 3, tasks, sms); len(got) != 0 {
+		t.Errorf("offset beyond end: %+v", got)
+	}
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"errors"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -69,9 +70,18 @@ type CatalogFiled struct {
 	Hash string
 	Name string `validate:"lte=30,gte=1"  ru:"название"`
 
-	DataType        FieldDataType `validate:"lte=10,gte=0"  ru:"тип данных"`
+	DataType        FieldDataType `validate:"lte=18,gte=0"  ru:"тип данных"`
 	DataCatalogUUID *uuid.UUID
 
+	// Formula - выражение вычисляемого поля, значение пересчитывается при каждой записи строки
+	Formula string `validate:"lte=1000"  ru:"формула"`
+
+	// Options - варианты поля select/multi_select
+	Options FieldOptions
+
+	// OnDelete - что делать со строками, которые ссылаются через это поле на удаляемую строку
+	OnDelete string
+
 	CatalogUUID uuid.UUID `validate:"uuid"  ru:"project uuid"`
 
 	CreatedBy string
@@ -115,6 +125,52 @@ func NewCatalogFiled(name, hash string, dataType FieldDataType, dataCatalogUUID
 	return p
 }
 
+const (
+	OnDeleteBlock   = "block"
+	OnDeleteCascade = "cascade"
+	OnDeleteSetNull = "set_null"
+)
+
+// SetOnDelete задает политику удаления для полей data и data_array, пустая - block
+func (pf *CatalogFiled) SetOnDelete(policy string) error {
+	switch policy {
+	case "":
+		policy = OnDeleteBlock
+	case OnDeleteBlock, OnDeleteCascade, OnDeleteSetNull:
+	default:
+		return errors.New("политика удаления: block, cascade или set_null")
+	}
+
+	if policy != OnDeleteBlock && pf.DataType != Data && pf.DataType != DataArray {
+		return errors.New("политика удаления задается только для полей data и data_array")
+	}
+
+	pf.OnDelete = policy
+
+	return nil
+}
+
+// SetOptions задает варианты поля select/multi_select
+func (pf *CatalogFiled) SetOptions(options FieldOptions) (err error) {
+	pf.Options, err = options.Normalize(pf.DataType)
+
+	return err
+}
+
+// SetFormula задает выражение вычисляемого поля, типы проверяются в сервисе по полям справочника
+func (pf *CatalogFiled) SetFormula(formula string) error {
+	formula = strings.TrimSpace(formula)
+
+	err := validateFormula(pf.DataType, formula)
+	if err != nil {
+		return err
+	}
+
+	pf.Formula = formula
+
+	return nil
+}
+
 type CatalogData struct {
 	UUID           uuid.UUID
 	FederationUUID uuid.UUID
@@ -135,6 +191,42 @@ type CatalogData struct {
 	DeletedAt *time.Time
 }
 
+const (
+	CatalogDataCreated = "create"
+	CatalogDataUpdated = "update"
+	CatalogDataDeleted = "delete"
+	CatalogDataMerged  = "merge"
+)
+
+// CatalogDataDuplicate - строка Duplicate похожа на строку Data, Title - значения поля с именем строки
+type CatalogDataDuplicate struct {
+	Data           CatalogData
+	Duplicate      CatalogData
+	Title          string
+	DuplicateTitle string
+	Score          int
+	Matches        []DuplicateMatch
+}
+
+// CatalogDataHistory - запись в истории изменений строки справочника,
+// Fields - состояние строки после изменения, Changes - hash поля -> {old, new}
+type CatalogDataHistory struct {
+	UUID        uuid.UUID
+	DataUUID    uuid.UUID
+	CatalogUUID uuid.UUID
+
+	Action  string
+	Fields  map[string]interface{}
+	Changes map[string]interface{}
+
+	// MergedUUID - для слияния: у оставшейся строки - слитый дубль, у дубля - строка, в которую он слит
+	MergedUUID *uuid.UUID
+
+	CreatedBy     string
+	CreatedByUUID uuid.UUID
+	CreatedAt     time.Time
+}
+
 func (p *Catalog) AddFiled(name string, dataType FieldDataType) {
 	f := &CatalogFiled{
 		Name:     name,
@@ -164,6 +256,14 @@ func (pf *CatalogFiled) FieldTypeDesc() string {
 		return "data"
 	case DataArray:
 		return "data_array"
+	case Formula:
+		return "formula"
+	case Select:
+		return "select"
+	case MultiSelect:
+		return "multi_select"
+	case Money:
+		return "money"
 	}
 
 	return "unknown"
@@ -14,6 +14,8 @@ type Comment struct {
 	Comment   string `validate:"required,trim,gte=2,lte=5000"  ru:"комментарий"`
 	CreatedBy string `validate:"required,email,lte=100,gte=3"  ru:"автор (email)"`
 
+	CommentHTML string
+
 	ReplyUUID    *uuid.UUID `validate:"uuid"  ru:"комментарий (uuid)"`
 	ReplyComment *string
 
@@ -22,6 +24,10 @@ type Comment struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
+	DeletedBy string
+
+	HiddenAt *time.Time
+	HiddenBy string
 
 	Files []File
 
@@ -62,3 +68,45 @@ func NewComment(createdBy string, taskUUID, replyUUID uuid.UUID, emails []string
 
 	return comment
 }
+
+type CommentRevision struct {
+	UUID        uuid.UUID
+	CommentUUID uuid.UUID
+	TaskUUID    uuid.UUID
+
+	Comment string
+	People  map[string]int64
+
+	EditedBy  string
+	CreatedAt time.Time
+}
+
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+func (c *Comment) IsHidden() bool {
+	return c.HiddenAt != nil
+}
+
+// Placeholder убирает текст и упоминания удаленного или скрытого комментария: в ленте задачи
+// от него остается только заглушка. Восстановленный комментарий показывается целиком
+func (c *Comment) Placeholder() {
+	if c.IsDeleted() || c.IsHidden() {
+		c.Comment = ""
+		c.CommentHTML = ""
+		c.People = map[string]int64{}
+	}
+}
+
+// Revision - запись истории с текстом комментария до правки пользователем editedBy
+func (c *Comment) Revision(editedBy string) CommentRevision {
+	return CommentRevision{
+		UUID:        uuid.New(),
+		CommentUUID: c.UUID,
+		TaskUUID:    c.TaskUUID,
+		Comment:     c.Comment,
+		People:      c.People,
+		EditedBy:    editedBy,
+	}
+}
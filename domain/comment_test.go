@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCommentPlaceholder(t *testing.T) {
+	now := time.Now()
+	c := Comment{Comment: "текст", CommentHTML: "<p>текст</p>", People: map[string]int64{"a@b.ru": 1}}
+
+	visible := c
+	visible.Placeholder()
+	if visible.Comment != "текст" || len(visible.People) != 1 {
+		t.Errorf("visible comment changed: %+v", visible)
+	}
+
+	hidden := c
+	hidden.HiddenAt, hidden.HiddenBy = &now, "mod@b.ru"
+	hidden.Placeholder()
+	if hidden.Comment != "" || hidden.CommentHTML != "" || len(hidden.People) != 0 {
+		t.Errorf("hidden comment keeps text: %+v", hidden)
+	}
+
+	restored := c
+	restored.HiddenAt, restored.HiddenBy = nil, ""
+	restored.Placeholder()
+	if restored.Comment != "текст" {
+		t.Errorf("restored comment lost text: %+v", restored)
+	}
+
+	deleted := c
+	deleted.DeletedAt = &now
+	deleted.Placeholder()
+	if deleted.Comment != "" {
+		t.Errorf("deleted comment keeps text: %+v", deleted)
+	}
+}
+
+func TestCommentRevision(t *testing.T) {
+	c := Comment{UUID: uuid.New(), TaskUUID: uuid.New(), Comment: "до правки", People: map[string]int64{"a@b.ru": 1}}
+
+	rev := c.Revision("editor@b.ru")
+	if rev.UUID == uuid.Nil || rev.CommentUUID != c.UUID || rev.TaskUUID != c.TaskUUID {
+		t.Errorf("wrong refs: %+v", rev)
+	}
+	if rev.Comment != "до правки" || rev.EditedBy != "editor@b.ru" || rev.People["a@b.ru"] != 1 {
+		t.Errorf("wrong revision: %+v", rev)
+	}
+}
@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// Статус сделки, совпадает со статусом этапа, на котором она находится
+const (
+	DealOpen = 0
+	DealWon  = 1
+	DealLost = 2
+)
+
+const maxDealStages = 30
+
+var stageColorRe = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// DealPipeline - воронка продаж компании с упорядоченными этапами
+type DealPipeline struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	Name   string
+	Sort   int
+	Stages []DealStage
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// DealStage - этап воронки. Probability - вероятность закрытия сделки на этапе в процентах,
+// Status - DealOpen для рабочих этапов, DealWon или DealLost для закрывающих
+type DealStage struct {
+	UUID         uuid.UUID
+	PipelineUUID uuid.UUID
+
+	Name        string
+	Color       string
+	Probability int
+	Status      int
+	Sort        int
+}
+
+// Validate проверяет этапы и проставляет им uuid и порядок. Нужен хотя бы один открытый этап
+func (p *DealPipeline) Validate() error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return errors.New("название воронки не может быть пустым")
+	}
+
+	if len(p.Stages) == 0 || len(p.Stages) > maxDealStages {
+		return fmt.Errorf("в воронке должно быть от 1 до %d этапов", maxDealStages)
+	}
+
+	names := map[string]bool{}
+
+	for i := range p.Stages {
+		stage := &p.Stages[i]
+
+		stage.Name = strings.TrimSpace(stage.Name)
+		if stage.Name == "" {
+			return errors.New("название этапа не может быть пустым")
+		}
+
+		if names[strings.ToLower(stage.Name)] {
+			return fmt.Errorf("этап %q повторяется", stage.Name)
+		}
+		names[strings.ToLower(stage.Name)] = true
+
+		if stage.Probability < 0 || stage.Probability > 100 {
+			return fmt.Errorf("этап %q: вероятность должна быть от 0 до 100", stage.Name)
+		}
+
+		if stage.Status != DealOpen && stage.Status != DealWon && stage.Status != DealLost {
+			return fmt.Errorf("этап %q: неизвестный статус %d", stage.Name, stage.Status)
+		}
+
+		if stage.Color != "" && !stageColorRe.MatchString(stage.Color) {
+			return fmt.Errorf("этап %q: цвет должен быть в формате #rrggbb", stage.Name)
+		}
+
+		if stage.UUID == uuid.Nil {
+			stage.UUID = uuid.New()
+		}
+
+		stage.PipelineUUID = p.UUID
+		stage.Sort = i
+	}
+
+	if _, ok := p.FirstStage(); !ok {
+		return errors.New("в воронке должен быть хотя бы один открытый этап")
+	}
+
+	return nil
+}
+
+// Stage - этап воронки по uuid
+func (p DealPipeline) Stage(uid uuid.UUID) (DealStage, bool) {
+	return lo.Find(p.Stages, func(s DealStage) bool { return s.UUID == uid })
+}
+
+// FirstStage - первый открытый этап, на него попадают новые сделки
+func (p DealPipeline) FirstStage() (DealStage, bool) {
+	return lo.Find(p.Stages, func(s DealStage) bool { return s.Status == DealOpen })
+}
+
+// Deal - сделка компании в воронке. Amount - сумма сделки, Probability переопределяет
+// вероятность этапа, если задана
+type Deal struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	Name        string
+	Description string
+	Tags        []string
+	Priority    int
+
+	PipelineUUID uuid.UUID
+	StageUUID    uuid.UUID
+	Status       int
+
+	Amount      MoneyValue
+	Probability *int
+
+	AgentUUID *uuid.UUID
+	TaskUUIDs []uuid.UUID
+
+	// CloseAt - ожидаемая дата закрытия, по ней сделка попадает в месяц прогноза
+	CloseAt        *time.Time
+	FinishedAt     *time.Time
+	StageChangedAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// Validate проверяет сделку без учета воронки
+func (d *Deal) Validate() error {
+	d.Name = strings.TrimSpace(d.Name)
+	if d.Name == "" || utf8.RuneCountInString(d.Name) > 50 {
+		return errors.New("название сделки от 1 до 50 символов")
+	}
+
+	if d.Probability != nil && (*d.Probability < 0 || *d.Probability > 100) {
+		return errors.New("вероятность должна быть от 0 до 100")
+	}
+
+	if d.Amount.Amount < 0 {
+		return errors.New("сумма сделки не может быть отрицательной")
+	}
+
+	d.Amount.Currency = strings.ToUpper(strings.TrimSpace(d.Amount.Currency))
+	if _, ok := CurrencyExponent(d.Amount.Currency); !ok {
+		return fmt.Errorf("валюта %q не поддерживается", d.Amount.Currency)
+	}
+
+	d.TaskUUIDs = lo.Uniq(d.TaskUUIDs)
+
+	return nil
+}
+
+// SetStage переводит сделку на этап и выставляет статус. Закрытые сделки получают FinishedAt,
+// при возврате на открытый этап он сбрасывается
+func (d *Deal) SetStage(stage DealStage, now time.Time) {
+	d.StageUUID = stage.UUID
+	d.Status = stage.Status
+	d.StageChangedAt = now
+
+	switch {
+	case stage.Status == DealOpen:
+		d.FinishedAt = nil
+	case d.FinishedAt == nil:
+		d.FinishedAt = &now
+	}
+}
+
+// DealProbability - вероятность сделки: своя, иначе этапа
+func DealProbability(deal Deal, stage DealStage) int {
+	if deal.Probability != nil {
+		return *deal.Probability
+	}
+
+	return stage.Probability
+}
+
+// DealFilter - фильтр списка сделок компании
+type DealFilter struct {
+	CompanyUUID  uuid.UUID
+	PipelineUUID *uuid.UUID
+	StageUUID    *uuid.UUID
+	AgentUUID    *uuid.UUID
+	TaskUUID     *uuid.UUID
+	Status       *int
+	Name         *string
+
+	Offset int
+	Limit  int
+}
+
+// DealForecast - взвешенная сумма открытых сделок этапа за месяц ожидаемого закрытия.
+// Month - YYYY-MM, пустой для сделок без даты закрытия. Суммы в минорных единицах валюты
+type DealForecast struct {
+	StageUUID uuid.UUID
+	Month     string
+	Currency  string
+	Count     int
+	Amount    int64
+	Weighted  int64
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestDealPipelineValidate(t *testing.T) {
+	p := DealPipeline{
+		UUID: uuid.New(),
+		Name: " Продажи ",
+		Stages: []DealStage{
+			{Name: "Выиграна", Probability: 100, Status: DealWon},
+			{Name: "Переговоры", Probability: 40, Color: "#aabbcc"},
+			{Name: "Проиграна", Status: DealLost},
+		},
+	}
+
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Name != "Продажи" {
+		t.Errorf("name not trimmed: %q", p.Name)
+	}
+
+	for i, stage := range p.Stages {
+		if stage.UUID == uuid.Nil || stage.PipelineUUID != p.UUID || stage.Sort != i {
+			t.Errorf("stage %d not prepared: %+v", i, stage)
+		}
+	}
+
+	first, ok := p.FirstStage()
+	if !ok || first.Name != "Переговоры" {
+		t.Errorf("first stage %+v", first)
+	}
+
+	tests := []struct {
+		name   string
+		stages []DealStage
+	}{
+		{name: "no stages"},
+		{name: "duplicate", stages: []DealStage{{Name: "A"}, {Name: "a"}}},
+		{name: "probability", stages: []DealStage{{Name: "A", Probability: 101}}},
+		{name: "status", stages: []DealStage{{Name: "A", Status: 3}}},
+		{name: "color", stages: []DealStage{{Name: "A", Color: "red"}}},
+		{name: "no open", stages: []DealStage{{Name: "A", Status: DealWon}}},
+	}
+
+	for _, tt := range tests {
+		p := DealPipeline{Name: "P", Stages: tt.stages}
+		if err := p.Validate(); err == nil {
+			t.Errorf("%s: should fail", tt.name)
+		}
+	}
+}
+
+func TestDealValidate(t *testing.T) {
+	task := uuid.New()
+	d := Deal{Name: "Поставка", Amount: MoneyValue{Amount: 100, Currency: "rub"}, TaskUUIDs: []uuid.UUID{task, task}}
+
+	if err := d.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Amount.Currency != "RUB" || len(d.TaskUUIDs) != 1 {
+		t.Errorf("unexpected %+v", d)
+	}
+
+	for name, d := range map[string]Deal{
+		"name":        {Amount: MoneyValue{Currency: "RUB"}},
+		"long name":   {Name: strings.Repeat("я", 51), Amount: MoneyValue{Currency: "RUB"}},
+		"amount":      {Name: "A", Amount: MoneyValue{Amount: -1, Currency: "RUB"}},
+		"currency":    {Name: "A", Amount: MoneyValue{Currency: "XXX"}},
+		"probability": {Name: "A", Amount: MoneyValue{Currency: "RUB"}, Probability: lo.ToPtr(120)},
+	} {
+		if err := d.Validate(); err == nil {
+			t.Errorf("%s: should fail", name)
+		}
+	}
+}
+
+func TestDealSetStage(t *testing.T) {
+	now := time.Now()
+	d := Deal{}
+
+	d.SetStage(DealStage{UUID: uuid.New(), Status: DealWon}, now)
+	if d.Status != DealWon || d.FinishedAt == nil || !d.FinishedAt.Equal(now) {
+		t.Errorf("won: %+v", d)
+	}
+
+	d.SetStage(DealStage{UUID: uuid.New(), Status: DealLost}, now.Add(time.Hour))
+	if !d.FinishedAt.Equal(now) {
+		t.Errorf("finished at should be kept: %v", d.FinishedAt)
+	}
+
+	open := DealStage{UUID: uuid.New(), Probability: 30}
+	d.SetStage(open, now)
+	if d.Status != DealOpen || d.FinishedAt != nil || d.StageUUID != open.UUID {
+		t.Errorf("open: %+v", d)
+	}
+
+	if p := DealProbability(d, open); p != 30 {
+		t.Errorf("stage probability %d", p)
+	}
+
+	d.Probability = lo.ToPtr(75)
+	if p := DealProbability(d, open); p != 75 {
+		t.Errorf("deal probability %d", p)
+	}
+}
@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+// Признаки, по которым записи считаются дублями, и их вес в оценке
+const (
+	DuplicateByINN         = "inn"
+	DuplicateByPhone       = "phone"
+	DuplicateByEmail       = "email"
+	DuplicateByName        = "name"
+	DuplicateBySimilarName = "similar_name"
+)
+
+var duplicateWeights = map[string]int{
+	DuplicateByINN:         50,
+	DuplicateByPhone:       40,
+	DuplicateByEmail:       40,
+	DuplicateByName:        30,
+	DuplicateBySimilarName: 15,
+}
+
+const (
+	DuplicateMaxScore = 100
+	// DuplicateMinScore - оценка по умолчанию, с которой запись предлагается как дубль
+	DuplicateMinScore = 30
+	// DuplicateMaxGroup - значение, общее для большего числа записей (частое слово имени, общий телефон),
+	// не отличает записи и при поиске всех пар не используется, иначе сравнение становится квадратичным
+	DuplicateMaxGroup = 200
+)
+
+// Организационно-правовые формы не различают названия и при сравнении отбрасываются
+var legalForms = []string{"ooo", "oao", "zao", "pao", "ao", "ip", "nko", "llc", "ltd", "inc"}
+
+// DuplicateKeys - нормализованные значения записи, по которым ищутся дубли.
+// Телефоны, email и ИНН нормализует вызывающий, имя - DuplicateNameKey
+type DuplicateKeys struct {
+	Name   string
+	Phones []string
+	Emails []string
+	INNs   []string
+}
+
+type DuplicateMatch struct {
+	By    string
+	Value string
+}
+
+// DuplicatePair - запись DuplicateUUID похожа на запись UUID с оценкой Score от 0 до 100
+type DuplicatePair struct {
+	UUID          uuid.UUID
+	DuplicateUUID uuid.UUID
+	Score         int
+	Matches       []DuplicateMatch
+}
+
+// DuplicateNameKey приводит имя к виду для сравнения: транслит ИКАО в нижнем регистре,
+// без знаков препинания и организационно-правовой формы, слова по алфавиту.
+// "ООО «Ромашка»" и "Romashka LLC" дают одинаковый ключ
+func DuplicateNameKey(name string) string {
+	latin := helpers.ICAO(strings.ToLower(name))
+
+	words := strings.FieldsFunc(latin, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z') && !unicode.IsDigit(r)
+	})
+
+	words = lo.Uniq(lo.Filter(words, func(w string, _ int) bool {
+		return !lo.Contains(legalForms, w)
+	}))
+
+	sort.Strings(words)
+
+	return strings.Join(words, " ")
+}
+
+// ScoreDuplicate сравнивает две записи: каждый совпавший признак добавляет свой вес один раз,
+// похожее имя - когда все слова одного имени есть в другом. Оценка не больше DuplicateMaxScore
+func ScoreDuplicate(a, b DuplicateKeys) (score int, matches []DuplicateMatch) {
+	add := func(by string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+
+		score += duplicateWeights[by]
+		for _, v := range values {
+			matches = append(matches, DuplicateMatch{By: by, Value: v})
+		}
+	}
+
+	add(DuplicateByINN, lo.Intersect(a.INNs, b.INNs))
+	add(DuplicateByPhone, lo.Intersect(a.Phones, b.Phones))
+	add(DuplicateByEmail, lo.Intersect(a.Emails, b.Emails))
+
+	switch {
+	case a.Name == "" || b.Name == "":
+	case a.Name == b.Name:
+		add(DuplicateByName, []string{a.Name})
+	case similarName(a.Name, b.Name):
+		add(DuplicateBySimilarName, []string{lo.Ternary(len(a.Name) < len(b.Name), a.Name, b.Name)})
+	}
+
+	return lo.Min([]int{score, DuplicateMaxScore}), matches
+}
+
+func similarName(a, b string) bool {
+	aw, bw := strings.Fields(a), strings.Fields(b)
+	if len(aw) > len(bw) {
+		aw, bw = bw, aw
+	}
+
+	return lo.Every(bw, aw)
+}
+
+// FindDuplicates ищет среди candidates записи, похожие на uid, с оценкой не ниже minScore.
+// Результат отсортирован по убыванию оценки
+func FindDuplicates(uid uuid.UUID, keys DuplicateKeys, candidates map[uuid.UUID]DuplicateKeys, minScore int) []DuplicatePair {
+	res := []DuplicatePair{}
+
+	for cuid, ckeys := range candidates {
+		if cuid == uid {
+			continue
+		}
+
+		score, matches := ScoreDuplicate(keys, ckeys)
+		if score == 0 || score < minScore {
+			continue
+		}
+
+		res = append(res, DuplicatePair{UUID: uid, DuplicateUUID: cuid, Score: score, Matches: matches})
+	}
+
+	sortDuplicates(res)
+
+	return res
+}
+
+// DuplicatePairs находит все пары похожих записей. Сравниваются только записи с общим
+// телефоном, email, ИНН или словом имени, которое есть не больше чем у DuplicateMaxGroup записей.
+// Каждая пара попадает в результат один раз
+func DuplicatePairs(records map[uuid.UUID]DuplicateKeys, minScore int) []DuplicatePair {
+	index := map[string][]uuid.UUID{}
+	for uid, keys := range records {
+		for _, k := range duplicateIndexKeys(keys) {
+			index[k] = append(index[k], uid)
+		}
+	}
+
+	seen := map[[2]uuid.UUID]bool{}
+	res := []DuplicatePair{}
+
+	for _, uids := range index {
+		if len(uids) > DuplicateMaxGroup {
+			continue
+		}
+
+		for i := range uids {
+			for j := i + 1; j < len(uids); j++ {
+				a, b := uids[i], uids[j]
+				if a == b {
+					continue
+				}
+				if b.String() < a.String() {
+					a, b = b, a
+				}
+				if seen[[2]uuid.UUID{a, b}] {
+					continue
+				}
+				seen[[2]uuid.UUID{a, b}] = true
+
+				score, matches := ScoreDuplicate(records[a], records[b])
+				if score == 0 || score < minScore {
+					continue
+				}
+
+				res = append(res, DuplicatePair{UUID: a, DuplicateUUID: b, Score: score, Matches: matches})
+			}
+		}
+	}
+
+	sortDuplicates(res)
+
+	return res
+}
+
+func duplicateIndexKeys(keys DuplicateKeys) (res []string) {
+	for _, v := range keys.INNs {
+		res = append(res, DuplicateByINN+":"+v)
+	}
+	for _, v := range keys.Phones {
+		res = append(res, DuplicateByPhone+":"+v)
+	}
+	for _, v := range keys.Emails {
+		res = append(res, DuplicateByEmail+":"+v)
+	}
+	for _, w := range strings.Fields(keys.Name) {
+		res = append(res, DuplicateByName+":"+w)
+	}
+
+	return lo.Uniq(res)
+}
+
+func sortDuplicates(res []DuplicatePair) {
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		if res[i].UUID != res[j].UUID {
+			return res[i].UUID.String() < res[j].UUID.String()
+		}
+
+		return res[i].DuplicateUUID.String() < res[j].DuplicateUUID.String()
+	})
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDuplicateNameKey(t *testing.T) {
+	cases := map[string]string{
+		"ООО «Ромашка»":         "romashka",
+		"Romashka LLC":          "romashka",
+		"Петров Иван":           "ivan petrov",
+		"иван  петров, ИП":      "ivan petrov",
+		"Ёлкин Юрий":            "elkin iurii",
+		"ЗАО \"Альфа-2\" Альфа": "2 alfa",
+		"":                      "",
+	}
+
+	for name, want := range cases {
+		if got := DuplicateNameKey(name); got != want {
+			t.Errorf("%q: got %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestScoreDuplicate(t *testing.T) {
+	a := DuplicateKeys{
+		Name:   DuplicateNameKey("Иван Петров"),
+		Phones: []string{"79001234567"},
+		Emails: []string{"ivan@example.com"},
+		INNs:   []string{"500100732259"},
+	}
+
+	score, matches := ScoreDuplicate(a, DuplicateKeys{Name: DuplicateNameKey("Petrov Ivan"), Phones: []string{"79001234567"}})
+	if score != 70 || len(matches) != 2 {
+		t.Errorf("phone and name: got %d %+v", score, matches)
+	}
+
+	score, _ = ScoreDuplicate(a, a)
+	if score != DuplicateMaxScore {
+		t.Errorf("same record: got %d", score)
+	}
+
+	score, matches = ScoreDuplicate(a, DuplicateKeys{Name: DuplicateNameKey("Петров")})
+	if score != 15 || matches[0].By != DuplicateBySimilarName || matches[0].Value != "petrov" {
+		t.Errorf("similar name: got %d %+v", score, matches)
+	}
+
+	score, _ = ScoreDuplicate(a, DuplicateKeys{Name: DuplicateNameKey("Сидоров"), Emails: []string{"other@example.com"}})
+	if score != 0 {
+		t.Errorf("different: got %d", score)
+	}
+}
+
+func TestDuplicatePairs(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	records := map[uuid.UUID]DuplicateKeys{
+		a: {Name: DuplicateNameKey("ООО Ромашка"), INNs: []string{"7707083893"}},
+		b: {Name: DuplicateNameKey("Romashka"), INNs: []string{"7707083893"}},
+		c: {Name: DuplicateNameKey("Ромашка Плюс")},
+		d: {Name: DuplicateNameKey("Лютик"), Phones: []string{"79001234567"}},
+	}
+
+	pairs := DuplicatePairs(records, DuplicateMinScore)
+	if len(pairs) != 1 || pairs[0].Score != 80 {
+		t.Fatalf("got %+v", pairs)
+	}
+	if !(pairs[0].UUID == a && pairs[0].DuplicateUUID == b) && !(pairs[0].UUID == b && pairs[0].DuplicateUUID == a) {
+		t.Errorf("wrong pair %+v", pairs[0])
+	}
+
+	if pairs := DuplicatePairs(records, 1); len(pairs) != 3 {
+		t.Errorf("with similar names: got %d pairs", len(pairs))
+	}
+
+	common := map[uuid.UUID]DuplicateKeys{}
+	for i := 0; i <= DuplicateMaxGroup; i++ {
+		common[uuid.New()] = DuplicateKeys{Name: "romashka"}
+	}
+	if pairs := DuplicatePairs(common, 1); len(pairs) != 0 {
+		t.Errorf("common name: got %d pairs", len(pairs))
+	}
+
+	found := FindDuplicates(c, records[c], records, 1)
+	if len(found) != 2 || found[0].UUID != c || found[0].Score != 15 {
+		t.Errorf("find: got %+v", found)
+	}
+}
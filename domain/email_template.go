@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailTemplate - шаблон письма на языке Locale. Subject - text/template, Body - html/template
+// с содержимым письма, который вставляется в общий макет с оформлением федерации.
+// Custom - шаблон переопределен федерацией, иначе это встроенный шаблон.
+type EmailTemplate struct {
+	FederationUUID uuid.UUID
+
+	Name    string
+	Locale  string
+	Subject string
+	Body    string
+
+	Custom    bool
+	UpdatedBy string
+	UpdatedAt *time.Time
+}
+
+// EmailBranding - оформление писем федерации: логотип, цвета, подпись и язык по умолчанию
+type EmailBranding struct {
+	FederationUUID uuid.UUID
+
+	LogoURL         string
+	PrimaryColor    string
+	BackgroundColor string
+	Footer          string
+	Locale          string
+
+	UpdatedBy string
+	UpdatedAt *time.Time
+}
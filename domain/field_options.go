@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MaxFieldOptions - максимальное количество вариантов у поля select/multi_select
+const MaxFieldOptions = 500
+
+var colorRgxp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// FieldOption - вариант поля select/multi_select. В данных задач и справочников хранится Name,
+// ID не меняется при переименовании и по нему находятся переименованные варианты
+type FieldOption struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Color    string `json:"color,omitempty"`
+	Archived bool   `json:"archived,omitempty"`
+}
+
+// FieldOptions - варианты поля в порядке отображения
+type FieldOptions []FieldOption
+
+// IsSelect - поле с управляемым списком вариантов
+func IsSelect(dataType FieldDataType) bool {
+	return dataType == Select || dataType == MultiSelect
+}
+
+// Normalize проверяет варианты поля типа dataType и выдает ID новым вариантам
+func (o FieldOptions) Normalize(dataType FieldDataType) (FieldOptions, error) {
+	if !IsSelect(dataType) {
+		if len(o) > 0 {
+			return nil, errors.New("варианты задаются только для полей select и multi_select")
+		}
+
+		return nil, nil
+	}
+
+	if len(o) == 0 {
+		return nil, errors.New("для поля select нужен хотя бы один вариант")
+	}
+
+	if len(o) > MaxFieldOptions {
+		return nil, fmt.Errorf("вариантов не больше %d", MaxFieldOptions)
+	}
+
+	res := make(FieldOptions, 0, len(o))
+	ids := map[string]bool{}
+	names := map[string]bool{}
+
+	for _, opt := range o {
+		opt.Name = strings.TrimSpace(opt.Name)
+		if opt.Name == "" || utf8.RuneCountInString(opt.Name) > 100 {
+			return nil, errors.New("название варианта от 1 до 100 символов")
+		}
+
+		if names[opt.Name] {
+			return nil, fmt.Errorf("вариант %q повторяется", opt.Name)
+		}
+
+		if opt.Color != "" && !colorRgxp.MatchString(opt.Color) {
+			return nil, fmt.Errorf("цвет варианта %q должен быть в формате #rrggbb", opt.Name)
+		}
+
+		if opt.ID == "" {
+			opt.ID = uuid.NewString()
+		}
+
+		if ids[opt.ID] {
+			return nil, fmt.Errorf("id варианта %s повторяется", opt.ID)
+		}
+
+		ids[opt.ID] = true
+		names[opt.Name] = true
+
+		res = append(res, opt)
+	}
+
+	return res, nil
+}
+
+// Renames сравнивает варианты до (o) и после (next) изменения и возвращает старое название -> новое.
+// Удалять варианты нельзя, чтобы значения в данных оставались в списке: их нужно архивировать
+func (o FieldOptions) Renames(next FieldOptions) (map[string]string, error) {
+	renames := map[string]string{}
+
+	byID := lo.KeyBy(next, func(opt FieldOption) string { return opt.ID })
+
+	for _, prev := range o {
+		opt, ok := byID[prev.ID]
+		if !ok {
+			return nil, fmt.Errorf("вариант %q нельзя удалить, его можно перенести в архив", prev.Name)
+		}
+
+		if opt.Name != prev.Name {
+			renames[prev.Name] = opt.Name
+		}
+	}
+
+	return renames, nil
+}
+
+// CheckValue проверяет, что value есть среди вариантов и не в архиве. Вариант из архива
+// допустим, если он уже был в значении поля current
+func (o FieldOptions) CheckValue(value string, current interface{}) error {
+	opt, ok := lo.Find(o, func(opt FieldOption) bool { return opt.Name == value })
+	if !ok {
+		return fmt.Errorf("нет варианта %q", value)
+	}
+
+	if opt.Archived && !hasOption(current, value) {
+		return fmt.Errorf("вариант %q в архиве", value)
+	}
+
+	return nil
+}
+
+// SelectValue проверяет значение поля select (строка) или multi_select (массив строк)
+// и возвращает его в виде для хранения. current - текущее значение поля
+func (o FieldOptions) SelectValue(dataType FieldDataType, value, current interface{}) (interface{}, error) {
+	if dataType == Select {
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("значение должно быть строкой")
+		}
+
+		return v, o.CheckValue(v, current)
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.New("значение должно быть массивом строк")
+	}
+
+	res := make([]string, 0, len(values))
+	for _, item := range values {
+		v, ok := item.(string)
+		if !ok {
+			return nil, errors.New("значение должно быть массивом строк")
+		}
+
+		err := o.CheckValue(v, current)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, v)
+	}
+
+	return lo.Uniq(res), nil
+}
+
+func hasOption(current interface{}, value string) bool {
+	switch v := current.(type) {
+	case string:
+		return v == value
+	case []string:
+		return lo.Contains(v, value)
+	case []interface{}:
+		return lo.Contains(v, interface{}(value))
+	}
+
+	return false
+}
+
+// Scan scan value into FieldOptions, implements sql.Scanner interface.
+func (o *FieldOptions) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := FieldOptions{}
+	err := json.Unmarshal(bytes, &result)
+	*o = result
+	return err
+}
+
+// Value return json value, implement driver.Valuer interface.
+func (o FieldOptions) Value() (driver.Value, error) {
+	if o == nil {
+		o = FieldOptions{}
+	}
+
+	return json.Marshal(o)
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldOptionsNormalize(t *testing.T) {
+	opts, err := FieldOptions{{Name: " VIP ", Color: "#ff0000"}, {ID: "b", Name: "Обычный"}}.Normalize(Select)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if opts[0].ID == "" || opts[0].Name != "VIP" || opts[1].ID != "b" {
+		t.Errorf("unexpected options %+v", opts)
+	}
+
+	tests := []struct {
+		name     string
+		options  FieldOptions
+		dataType FieldDataType
+	}{
+		{name: "empty select", options: FieldOptions{}, dataType: Select},
+		{name: "options on string", options: FieldOptions{{Name: "a"}}, dataType: String},
+		{name: "duplicate name", options: FieldOptions{{Name: "a"}, {Name: "a"}}, dataType: MultiSelect},
+		{name: "bad color", options: FieldOptions{{Name: "a", Color: "red"}}, dataType: Select},
+	}
+
+	for _, tt := range tests {
+		if _, err := tt.options.Normalize(tt.dataType); err == nil {
+			t.Errorf("%s: should fail", tt.name)
+		}
+	}
+}
+
+func TestFieldOptionsRenames(t *testing.T) {
+	prev := FieldOptions{{ID: "1", Name: "a"}, {ID: "2", Name: "b"}}
+
+	renames, err := prev.Renames(FieldOptions{{ID: "1", Name: "b"}, {ID: "2", Name: "a", Archived: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := map[string]string{"a": "b", "b": "a"}; !reflect.DeepEqual(renames, want) {
+		t.Errorf("want %v, got %v", want, renames)
+	}
+
+	if _, err := prev.Renames(FieldOptions{{ID: "1", Name: "a"}}); err == nil {
+		t.Error("removing an option should fail")
+	}
+}
+
+func TestFieldOptionsSelectValue(t *testing.T) {
+	opts := FieldOptions{{ID: "1", Name: "a"}, {ID: "2", Name: "old", Archived: true}}
+
+	v, err := opts.SelectValue(MultiSelect, []interface{}{"a", "a"}, nil)
+	if err != nil || !reflect.DeepEqual(v, []string{"a"}) {
+		t.Errorf("unexpected %v (%v)", v, err)
+	}
+
+	if _, err := opts.SelectValue(Select, "c", nil); err == nil {
+		t.Error("unknown option should fail")
+	}
+
+	if _, err := opts.SelectValue(Select, "old", nil); err == nil {
+		t.Error("archived option should fail for a new value")
+	}
+
+	if _, err := opts.SelectValue(MultiSelect, []interface{}{"a", "old"}, []interface{}{"old"}); err != nil {
+		t.Errorf("archived option already in value should pass: %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+)
+
+const (
+	RuleType     = "type"
+	RuleRequired = "required"
+	RulePattern  = "pattern"
+	RuleMin      = "min"
+	RuleMax      = "max"
+	RuleValues   = "values"
+	RuleUnique   = "unique"
+)
+
+// FieldRules - правила проверки значения кастомного поля в проекте
+type FieldRules struct {
+	// Pattern - регулярное выражение для строковых полей
+	Pattern string `json:"pattern,omitempty"`
+	// Min, Max - границы числа, длины строки или количества элементов массива
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	// Values - допустимые значения, для массивов проверяется каждый элемент
+	Values []string `json:"values,omitempty"`
+	// Unique - значение не должно повторяться в других задачах проекта
+	Unique bool `json:"unique,omitempty"`
+	// RequiredFromStatus - поле обязательно на этом статусе и всех следующих, кроме отмены
+	RequiredFromStatus *int `json:"required_from_status,omitempty"`
+}
+
+// Validate проверяет сами правила для поля типа dataType
+func (r FieldRules) Validate(dataType FieldDataType) error {
+	if r.Pattern != "" {
+		if dataType != String && dataType != Text && dataType != Link && dataType != Email {
+			return errors.New("регулярное выражение задается только для строковых полей")
+		}
+
+		if len(r.Pattern) > 500 {
+			return errors.New("регулярное выражение до 500 символов")
+		}
+
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			return fmt.Errorf("неверное регулярное выражение: %w", err)
+		}
+	}
+
+	if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return errors.New("min больше max")
+	}
+
+	if len(r.Values) > 500 {
+		return errors.New("допустимых значений не больше 500")
+	}
+
+	if r.Unique && (dataType == Array || dataType == People || dataType == Formula || dataType == MultiSelect) {
+		return errors.New("уникальность не проверяется для массивов и формул")
+	}
+
+	if r.RequiredFromStatus != nil && (*r.RequiredFromStatus < 0 || *r.RequiredFromStatus > 20) {
+		return errors.New("статус должен быть в диапазоне 0..20")
+	}
+
+	return nil
+}
+
+// RequiredOn - поле обязательно на статусе status по правилам или по списку requiredOnStatuses
+func (r FieldRules) RequiredOn(status int, requiredOnStatuses []int) bool {
+	if lo.Contains(requiredOnStatuses, status) {
+		return true
+	}
+
+	return r.RequiredFromStatus != nil && status >= *r.RequiredFromStatus && status != StatusCancel
+}
+
+// Check проверяет значение поля, уникальность проверяется в сервисе. rule - нарушенное правило
+func (r FieldRules) Check(value interface{}) (rule string, err error) {
+	switch v := value.(type) {
+	case string:
+		if r.Pattern != "" {
+			// правила проверены при сохранении, ошибка компиляции здесь невозможна
+			if rgxp, err := regexp.Compile(r.Pattern); err == nil && !rgxp.MatchString(v) {
+				return RulePattern, errors.New("значение не соответствует формату")
+			}
+		}
+
+		if rule, err := r.checkRange(float64(utf8.RuneCountInString(v)), "длина"); err != nil {
+			return rule, err
+		}
+
+		return r.checkValues(v)
+
+	case int:
+		return r.checkNumber(float64(v))
+	case float64:
+		return r.checkNumber(v)
+	case MoneyValue:
+		return r.checkRange(v.Major(), "сумма")
+
+	case []string:
+		if rule, err := r.checkRange(float64(len(v)), "количество значений"); err != nil {
+			return rule, err
+		}
+
+		for _, item := range v {
+			if rule, err := r.checkValues(item); err != nil {
+				return rule, err
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func (r FieldRules) checkNumber(v float64) (rule string, err error) {
+	if rule, err := r.checkRange(v, "значение"); err != nil {
+		return rule, err
+	}
+
+	return r.checkValues(fmt.Sprint(v))
+}
+
+func (r FieldRules) checkRange(v float64, what string) (rule string, err error) {
+	if r.Min != nil && v < *r.Min {
+		return RuleMin, fmt.Errorf("%s меньше %v", what, *r.Min)
+	}
+
+	if r.Max != nil && v > *r.Max {
+		return RuleMax, fmt.Errorf("%s больше %v", what, *r.Max)
+	}
+
+	return "", nil
+}
+
+func (r FieldRules) checkValues(v string) (rule string, err error) {
+	if len(r.Values) > 0 && !lo.Contains(r.Values, v) {
+		return RuleValues, fmt.Errorf("допустимые значения: %s", strings.Join(r.Values, ", "))
+	}
+
+	return "", nil
+}
+
+// Scan scan value into FieldRules, implements sql.Scanner interface.
+func (r *FieldRules) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := FieldRules{}
+	err := json.Unmarshal(bytes, &result)
+	*r = result
+	return err
+}
+
+// Value return json value, implement driver.Valuer interface.
+func (r FieldRules) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+)
+
+func TestFieldRulesValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    FieldRules
+		dataType FieldDataType
+		wantErr  bool
+	}{
+		{name: "pattern on string", rules: FieldRules{Pattern: `^\d{10}$`}, dataType: String},
+		{name: "pattern on integer", rules: FieldRules{Pattern: `^\d+$`}, dataType: Integer, wantErr: true},
+		{name: "broken pattern", rules: FieldRules{Pattern: `(`}, dataType: String, wantErr: true},
+		{name: "min > max", rules: FieldRules{Min: lo.ToPtr(10.0), Max: lo.ToPtr(1.0)}, dataType: Float, wantErr: true},
+		{name: "unique array", rules: FieldRules{Unique: true}, dataType: Array, wantErr: true},
+		{name: "status out of range", rules: FieldRules{RequiredFromStatus: lo.ToPtr(99)}, dataType: String, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.rules.Validate(tt.dataType); (err != nil) != tt.wantErr {
+			t.Errorf("%s: wantErr %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestFieldRulesCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules FieldRules
+		value interface{}
+		want  string
+	}{
+		{name: "pattern ok", rules: FieldRules{Pattern: `^\d{3}$`}, value: "123"},
+		{name: "pattern", rules: FieldRules{Pattern: `^\d{3}$`}, value: "12a", want: RulePattern},
+		{name: "string length", rules: FieldRules{Max: lo.ToPtr(3.0)}, value: "абвг", want: RuleMax},
+		{name: "number min", rules: FieldRules{Min: lo.ToPtr(1.0)}, value: 0, want: RuleMin},
+		{name: "number values", rules: FieldRules{Values: []string{"1", "2.5"}}, value: 2.5},
+		{name: "array values", rules: FieldRules{Values: []string{"a", "b"}}, value: []string{"a", "c"}, want: RuleValues},
+		{name: "array count", rules: FieldRules{Min: lo.ToPtr(2.0)}, value: []string{"a"}, want: RuleMin},
+	}
+
+	for _, tt := range tests {
+		rule, err := tt.rules.Check(tt.value)
+		if rule != tt.want || (err != nil) != (tt.want != "") {
+			t.Errorf("%s: want %q, got %q (%v)", tt.name, tt.want, rule, err)
+		}
+	}
+}
+
+func TestFieldRulesRequiredOn(t *testing.T) {
+	r := FieldRules{RequiredFromStatus: lo.ToPtr(StatusDone)}
+
+	if r.RequiredOn(StatusDone-1, nil) {
+		t.Error("field should not be required before status")
+	}
+
+	if !r.RequiredOn(StatusDone, nil) {
+		t.Error("field should be required from status")
+	}
+
+	if r.RequiredOn(StatusCancel, nil) {
+		t.Error("field should not be required on cancel")
+	}
+
+	if !(FieldRules{}).RequiredOn(5, []int{5}) {
+		t.Error("required_on_statuses should be respected")
+	}
+}
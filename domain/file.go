@@ -13,6 +13,31 @@ type File struct {
 	UUID uuid.UUID `json:"uuid"`
 	URL  string    `json:"url"`
 
+	Mime       string `json:"mime"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	Pages      int    `json:"pages"`
+	PreviewURL string `json:"preview_url"`
+	Version    int    `json:"version"`
+	ScanStatus string `json:"scan_status"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy uuid.UUID `json:"created_by"`
+}
+
+type FileVersion struct {
+	UUID     uuid.UUID `json:"uuid"`
+	FileUUID uuid.UUID `json:"file_uuid"`
+	Version  int       `json:"version"`
+	Name     string    `json:"name"`
+	Ext      string    `json:"ext"`
+	Size     int64     `json:"size"`
+	Mime     string    `json:"mime"`
+	URL      string    `json:"url"`
+	IsLatest bool      `json:"is_latest"`
+
+	ScanStatus string `json:"scan_status"`
+
 	CreatedAt time.Time `json:"created_at"`
 	CreatedBy uuid.UUID `json:"created_by"`
 }
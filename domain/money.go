@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// currencyExponents - поддерживаемые валюты ISO 4217 и количество знаков после запятой
+var currencyExponents = map[string]int{
+	"RUB": 2, "USD": 2, "EUR": 2, "GBP": 2, "CHF": 2, "CNY": 2, "KZT": 2, "BYN": 2, "UAH": 2,
+	"AMD": 2, "GEL": 2, "AZN": 2, "UZS": 2, "KGS": 2, "TRY": 2, "AED": 2, "INR": 2,
+	"JPY": 0, "KRW": 0, "KWD": 3, "BHD": 3,
+}
+
+// maxMoneyAmount - больше float64 из json не передает без потери точности
+const maxMoneyAmount = 1 << 53
+
+// MoneyValue - значение поля money: сумма в минорных единицах валюты (копейках, центах) и код валюты ISO 4217
+type MoneyValue struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// CurrencyExponent - количество знаков после запятой у валюты, ok=false - валюта не поддерживается
+func CurrencyExponent(currency string) (exp int, ok bool) {
+	exp, ok = currencyExponents[currency]
+	return exp, ok
+}
+
+// NewMoney приводит значение поля money из запроса или базы: {"amount": 123450, "currency": "RUB"}
+func NewMoney(value interface{}) (m MoneyValue, err error) {
+	switch v := value.(type) {
+	case MoneyValue:
+		m = v
+	case map[string]interface{}:
+		amount, ok := moneyAmount(v["amount"])
+		if !ok {
+			return m, errors.New("amount должен быть целым числом в минорных единицах валюты")
+		}
+
+		currency, _ := v["currency"].(string)
+
+		m = MoneyValue{Amount: amount, Currency: strings.ToUpper(currency)}
+	default:
+		return m, errors.New(`значение должно быть объектом {"amount", "currency"}`)
+	}
+
+	if _, ok := CurrencyExponent(m.Currency); !ok {
+		return m, fmt.Errorf("валюта %q не поддерживается", m.Currency)
+	}
+
+	return m, nil
+}
+
+func moneyAmount(value interface{}) (int64, bool) {
+	var f float64
+
+	switch v := value.(type) {
+	case int:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case float64:
+		f = v
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return 0, false
+		}
+		f = float64(n)
+	default:
+		return 0, false
+	}
+
+	if f != math.Trunc(f) || math.Abs(f) > maxMoneyAmount {
+		return 0, false
+	}
+
+	return int64(f), true
+}
+
+// ParseMoney разбирает сумму из текста: "1 234,56 RUB", "USD 99.90". Валюта обязательна
+func ParseMoney(s string) (m MoneyValue, err error) {
+	number := strings.Builder{}
+
+	for _, part := range strings.FieldsFunc(s, unicode.IsSpace) {
+		if len(part) == 3 && strings.IndexFunc(part, func(r rune) bool { return !unicode.IsLetter(r) }) == -1 {
+			m.Currency = strings.ToUpper(part)
+			continue
+		}
+
+		number.WriteString(part)
+	}
+
+	exp, ok := CurrencyExponent(m.Currency)
+	if !ok {
+		return m, fmt.Errorf("%q: не указана или не поддерживается валюта", s)
+	}
+
+	whole, frac, _ := strings.Cut(strings.ReplaceAll(number.String(), ",", "."), ".")
+
+	negative := strings.HasPrefix(whole, "-")
+	whole = strings.TrimPrefix(whole, "-")
+
+	if whole == "" || len(frac) > exp {
+		return m, fmt.Errorf("%q не сумма в %s", s, m.Currency)
+	}
+
+	amount, err := strconv.ParseInt(whole+frac+strings.Repeat("0", exp-len(frac)), 10, 64)
+	if err != nil || amount > maxMoneyAmount {
+		return m, fmt.Errorf("%q не сумма в %s", s, m.Currency)
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	m.Amount = amount
+
+	return m, nil
+}
+
+// Major - сумма в основных единицах валюты (рублях, долларах)
+func (m MoneyValue) Major() float64 {
+	exp, _ := CurrencyExponent(m.Currency)
+	return float64(m.Amount) / math.Pow10(exp)
+}
+
+// String форматирует сумму без потери точности: "-1234.50 RUB"
+func (m MoneyValue) String() string {
+	exp, _ := CurrencyExponent(m.Currency)
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	if exp == 0 {
+		return fmt.Sprintf("%s%d %s", sign, amount, m.Currency)
+	}
+
+	div := int64(math.Pow10(exp))
+
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/div, exp, amount%div, m.Currency)
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "testing"
+
+func TestNewMoney(t *testing.T) {
+	m, err := NewMoney(map[string]interface{}{"amount": float64(123450), "currency": "rub"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m != (MoneyValue{Amount: 123450, Currency: "RUB"}) {
+		t.Errorf("unexpected %+v", m)
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "fraction", value: map[string]interface{}{"amount": 10.5, "currency": "RUB"}},
+		{name: "unknown currency", value: map[string]interface{}{"amount": float64(1), "currency": "XXX"}},
+		{name: "no amount", value: map[string]interface{}{"currency": "RUB"}},
+		{name: "number", value: float64(100)},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewMoney(tt.value); err == nil {
+			t.Errorf("%s: should fail", tt.name)
+		}
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in   string
+		want MoneyValue
+	}{
+		{in: "1 234,56 RUB", want: MoneyValue{Amount: 123456, Currency: "RUB"}},
+		{in: "USD 99.9", want: MoneyValue{Amount: 9990, Currency: "USD"}},
+		{in: "-5 eur", want: MoneyValue{Amount: -500, Currency: "EUR"}},
+		{in: "1500 JPY", want: MoneyValue{Amount: 1500, Currency: "JPY"}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMoney(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("%q: got %+v, %v", tt.in, got, err)
+		}
+	}
+
+	for _, in := range []string{"100", "1.234 RUB", "10.5 JPY", "abc RUB"} {
+		if _, err := ParseMoney(in); err == nil {
+			t.Errorf("%q: should fail", in)
+		}
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := map[MoneyValue]string{
+		{Amount: 123405, Currency: "RUB"}: "1234.05 RUB",
+		{Amount: -50, Currency: "USD"}:    "-0.50 USD",
+		{Amount: 1500, Currency: "JPY"}:   "1500 JPY",
+		{Amount: 1234, Currency: "KWD"}:   "1.234 KWD",
+	}
+
+	for m, want := range tests {
+		if got := m.String(); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+}
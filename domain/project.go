@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -140,6 +141,12 @@ const (
 	Time      FieldDataType = 12
 	DateTime  FieldDataType = 13
 	People    FieldDataType = 14
+	Formula   FieldDataType = 15
+	// Select, MultiSelect - один или несколько вариантов из списка поля
+	Select      FieldDataType = 16
+	MultiSelect FieldDataType = 17
+	// Money - сумма в минорных единицах и код валюты, см. MoneyValue
+	Money FieldDataType = 18
 )
 
 type ProjectCatalogType string
@@ -172,6 +179,10 @@ type CompanyField struct {
 	CompanyUUID        uuid.UUID     `validate:"uuid" ru:"компания uuid"`
 	RequiredOnStatuses []int         `validate:"lte=50" ru:"необходимо на статусе"`
 	Style              string        `validate:"lte=20" ru:"стиль"`
+	Formula            string        `validate:"lte=1000" ru:"формула"`
+	Rules              FieldRules
+	Options            FieldOptions
+	OnDelete           string
 	CreatedBy          string
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
@@ -227,11 +238,72 @@ func (pf *CompanyField) FieldTypeDesc() string {
 		return "datetime"
 	case People:
 		return "people"
+	case Formula:
+		return "formula"
+	case Select:
+		return "select"
+	case MultiSelect:
+		return "multi_select"
+	case Money:
+		return "money"
 	}
 
 	return "unknown"
 }
 
+// SetOptions задает варианты поля select/multi_select
+func (pf *CompanyField) SetOptions(options FieldOptions) (err error) {
+	pf.Options, err = options.Normalize(pf.DataType)
+
+	return err
+}
+
+// SetOnDelete задает, что делать с задачей при удалении строки справочника, на которую ссылается поле:
+// block - запретить удаление, set_null - убрать ссылку. Задачи вместе со строкой не удаляются
+func (pf *CompanyField) SetOnDelete(policy string) error {
+	switch policy {
+	case "":
+		policy = OnDeleteBlock
+	case OnDeleteBlock, OnDeleteSetNull:
+	default:
+		return errors.New("политика удаления для поля задачи: block или set_null")
+	}
+
+	if policy != OnDeleteBlock && pf.DataType != Data && pf.DataType != DataArray {
+		return errors.New("политика удаления задается только для полей data и data_array")
+	}
+
+	pf.OnDelete = policy
+
+	return nil
+}
+
+// SetFormula задает выражение вычисляемого поля, типы проверяются в сервисе по остальным полям компании
+func (pf *CompanyField) SetFormula(formula string) error {
+	formula = strings.TrimSpace(formula)
+
+	err := validateFormula(pf.DataType, formula)
+	if err != nil {
+		return err
+	}
+
+	pf.Formula = formula
+
+	return nil
+}
+
+func validateFormula(dataType FieldDataType, formula string) error {
+	if dataType == Formula && formula == "" {
+		return errors.New("для поля типа formula обязательно указывать формулу")
+	}
+
+	if dataType != Formula && formula != "" {
+		return errors.New("формула задается только для полей типа formula")
+	}
+
+	return nil
+}
+
 type ProjectUser struct {
 	UUID           uuid.UUID `validate:"uuid"`
 	User           User
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SearchTypeTask    = "task"
+	SearchTypeComment = "comment"
+	SearchTypeFile    = "file"
+	SearchTypeCatalog = "catalog"
+)
+
+func GetSearchTypes() []string {
+	return []string{SearchTypeTask, SearchTypeComment, SearchTypeFile, SearchTypeCatalog}
+}
+
+// SearchDocument - единица поискового индекса
+type SearchDocument struct {
+	EntityType string
+	EntityUUID uuid.UUID
+
+	// TaskUUID - задача, к которой относится комментарий или файл
+	TaskUUID *uuid.UUID
+
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    *uuid.UUID
+
+	Title string
+	Body  string
+
+	UpdatedAt time.Time
+}
+
+type SearchQuery struct {
+	Query string
+
+	// CompanyUUIDs - компании, доступные пользователю
+	CompanyUUIDs []uuid.UUID
+
+	FederationUUID *uuid.UUID
+	ProjectUUID    *uuid.UUID
+	Types          []string
+
+	Limit  int
+	Offset int
+}
+
+type SearchHit struct {
+	EntityType string
+	EntityUUID uuid.UUID
+	TaskUUID   *uuid.UUID
+
+	FederationUUID uuid.UUID
+	ProjectUUID    *uuid.UUID
+
+	Title string
+
+	// Highlight - фрагменты текста, совпадения обёрнуты в <mark>
+	Highlight string
+	Rank      float64
+
+	UpdatedAt time.Time
+}
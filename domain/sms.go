@@ -22,6 +22,23 @@ type Sms struct {
 	Test      bool              `json:"test"`
 	PartnerID int               `json:"partner_id"`
 
+	Provider   string
+	ProviderID string
+	// ProviderIDs - все id провайдера: при отправке на несколько номеров у каждого номера свой id,
+	// ProviderID - первый из них
+	ProviderIDs []string
+
+	// TaskUUID и TemplateUUID заполняются для сообщений, отправленных по задаче
+	TaskUUID     *uuid.UUID
+	TemplateUUID *uuid.UUID
+
+	// Status - sent, delivered или failed; StatusCode - последний код провайдера (коды sms.ru),
+	// ErrorCode - код ошибки доставки, если сообщение не доставлено
+	Status     string
+	StatusCode int
+	ErrorCode  int
+	StatusAt   *time.Time
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SmsCampaign - массовая рассылка по шаблону с {{плейсхолдерами}}
+type SmsCampaign struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	Name     string
+	Template string
+
+	// Source - agents, catalog или csv; SourceUUID - uuid справочника для catalog
+	Source     string
+	SourceUUID *uuid.UUID
+
+	// Status - draft, scheduled, sending, done или canceled
+	Status     string
+	SendAt     *time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+
+	Report SmsCampaignReport
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// SmsCampaignReport - отчет по рассылке: получатели по статусам отправки и доставки
+type SmsCampaignReport struct {
+	Total     int
+	Pending   int
+	Excluded  int
+	Sent      int
+	Delivered int
+	Failed    int
+	Cost      float64
+}
+
+type SmsRecipient struct {
+	UUID         uuid.UUID
+	CampaignUUID uuid.UUID
+
+	Phone string
+	Name  string
+	Vars  map[string]string
+	Text  string
+
+	// Status - pending, excluded, sent или failed (ошибка отправки)
+	Status string
+	Error  string
+	Cost   float64
+
+	SmsUUID *uuid.UUID
+	// Delivery - статус доставки отправленного сообщения: sent, delivered или failed
+	Delivery string
+	SentAt   *time.Time
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SmsTemplate - шаблон sms компании с {{плейсхолдерами}}, который можно отправить по задаче.
+// Если задан OnStatus, шаблон отправляется автоматически при переходе задачи в этот статус
+// (в проекте ProjectUUID или в любом проекте компании).
+type SmsTemplate struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	Name string
+	Text string
+
+	// PhoneField - имя или hash поля задачи с телефоном получателя
+	PhoneField string
+
+	ProjectUUID *uuid.UUID
+	OnStatus    *int
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
@@ -43,6 +43,8 @@ type Task struct {
 	CompanyUUID    uuid.UUID `validate:"uuid"  ru:"компания (uuid)"`
 	ProjectUUID    uuid.UUID `validate:"uuid"  ru:"проект (uuid)"`
 
+	DescriptionHTML string
+
 	IsEpic bool `ru:"эпик"`
 
 	ResponsibleBy string `ru:"ответственный (uuid)"`
@@ -60,6 +60,44 @@ type ActivityTaskFileWasDeletedDTO struct {
 	Size int64  `json:"size"`
 }
 
+type ActivityTaskCommentDTO struct {
+	UUID   uuid.UUID `json:"uuid"`
+	Author string    `json:"author"`
+	Old    string    `json:"old,omitempty"`
+	New    string    `json:"new,omitempty"`
+}
+
+type ActivityTaskSmsDTO struct {
+	UUID         uuid.UUID  `json:"uuid"`
+	Phone        string     `json:"phone"`
+	Text         string     `json:"text"`
+	TemplateUUID *uuid.UUID `json:"template_uuid,omitempty"`
+	Template     string     `json:"template,omitempty"`
+}
+
+// ActivityDealStageDTO - переход сделки между этапами воронки, Old* пустые при создании сделки
+type ActivityDealStageDTO struct {
+	OldStageUUID *uuid.UUID `json:"old_stage_uuid,omitempty"`
+	OldStage     string     `json:"old_stage,omitempty"`
+	OldStatus    *int       `json:"old_status,omitempty"`
+	NewStageUUID uuid.UUID  `json:"new_stage_uuid"`
+	NewStage     string     `json:"new_stage"`
+	NewStatus    int        `json:"new_status"`
+}
+
+// ActivityAgentMergeDTO - слияние дубля в агента: добавленные контакты и число перенесенных записей
+type ActivityAgentMergeDTO struct {
+	DuplicateUUID uuid.UUID          `json:"duplicate_uuid"`
+	DuplicateName string             `json:"duplicate_name"`
+	Contacts      []AgentContactsDTO `json:"contacts"`
+	LegalEntities int64              `json:"legal_entities"`
+	Tasks         int64              `json:"tasks"`
+	Deals         int64              `json:"deals"`
+	Comments      int64              `json:"comments"`
+	Files         int64              `json:"files"`
+	Emails        int64              `json:"emails"`
+}
+
 func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 	var status map[string]interface{}
 
@@ -153,6 +191,81 @@ func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 		}
 	}
 
+	if dm.Type == int(domain.ActivityTaskCommentWasChanged) ||
+		dm.Type == int(domain.ActivityTaskCommentWasDeleted) ||
+		dm.Type == int(domain.ActivityTaskCommentWasHidden) ||
+		dm.Type == int(domain.ActivityTaskCommentWasRestored) {
+		var p ActivityTaskCommentDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
+	if dm.Type == int(domain.ActivityDealStageChanged) {
+		var p ActivityDealStageDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
+	if dm.Type == int(domain.ActivityAgentMerged) {
+		var p ActivityAgentMergeDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
+	if dm.Type == int(domain.ActivityTaskSmsWasSent) {
+		var p ActivityTaskSmsDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
 	return &ActivityDTO{
 		UUID:      dm.UUID,
 		CreatedBy: user,
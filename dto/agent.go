@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
 )
 
 type AgentDTO struct {
@@ -17,6 +19,9 @@ type AgentDTO struct {
 
 	Contacts []AgentContactsDTO `json:"contacts"`
 
+	LegalEntities []AgentLegalEntityDTO `json:"legal_entities,omitempty"`
+	TaskUUIDs     []uuid.UUID           `json:"task_uuids,omitempty"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -25,3 +30,84 @@ type AgentContactsDTO struct {
 	Type string `json:"type"`
 	Val  string `json:"val"`
 }
+
+type AgentLegalEntityDTO struct {
+	UUID    uuid.UUID `json:"uuid"`
+	Name    string    `json:"name"`
+	INN     string    `json:"inn"`
+	KPP     string    `json:"kpp"`
+	OGRN    string    `json:"ogrn"`
+	Address string    `json:"address"`
+}
+
+// NewAgentDTO - карточка агента с юрлицами и связанными задачами
+func NewAgentDTO(dm domain.Agent) AgentDTO {
+	return AgentDTO{
+		UUID:           dm.UUID,
+		Name:           dm.Name,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		ProjectUUID:    dm.ProjectUUID,
+
+		Contacts: lo.Map(dm.Contacts, func(c domain.AgentContacts, _ int) AgentContactsDTO {
+			return AgentContactsDTO{
+				Type: c.Type,
+				Val:  c.Val,
+			}
+		}),
+
+		LegalEntities: lo.Map(dm.LegalEntities, func(e domain.AgentLegalEntity, _ int) AgentLegalEntityDTO {
+			return AgentLegalEntityDTO{
+				UUID:    e.UUID,
+				Name:    e.Name,
+				INN:     e.INN,
+				KPP:     e.KPP,
+				OGRN:    e.OGRN,
+				Address: e.Address,
+			}
+		}),
+		TaskUUIDs: dm.TaskUUIDs,
+
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+type AgentCommentDTO struct {
+	UUID      uuid.UUID `json:"uuid"`
+	Text      string    `json:"text"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewAgentCommentDTO(dm domain.AgentComment) AgentCommentDTO {
+	return AgentCommentDTO{
+		UUID:      dm.UUID,
+		Text:      dm.Text,
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+	}
+}
+
+// AgentTimelineItemDTO - событие ленты агента, Type - task, deal, sms, comment или file
+type AgentTimelineItemDTO struct {
+	Type      string    `json:"type"`
+	UUID      uuid.UUID `json:"uuid"`
+	At        time.Time `json:"at"`
+	CreatedBy string    `json:"created_by"`
+	Title     string    `json:"title,omitempty"`
+	Text      string    `json:"text,omitempty"`
+	Status    string    `json:"status,omitempty"`
+}
+
+func NewAgentTimelineItemDTO(dm domain.AgentTimelineItem) AgentTimelineItemDTO {
+	return AgentTimelineItemDTO{
+		Type:      dm.Type,
+		UUID:      dm.UUID,
+		At:        dm.At,
+		CreatedBy: dm.CreatedBy,
+		Title:     dm.Title,
+		Text:      dm.Text,
+		Status:    dm.Status,
+	}
+}
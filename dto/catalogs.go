@@ -31,12 +31,15 @@ type CatalogDTOs struct {
 }
 
 type CatalogFieldDTO struct {
-	UUID            uuid.UUID  `json:"uuid"`
-	Name            string     `json:"name"`
-	Hash            string     `json:"hash"`
-	DataType        int        `json:"data_type"`
-	DataCatalogUUID *uuid.UUID `json:"data_catalog_uuid,omitempty"`
-	DataDesc        string     `json:"data_desc"`
+	UUID            uuid.UUID           `json:"uuid"`
+	Name            string              `json:"name"`
+	Hash            string              `json:"hash"`
+	DataType        int                 `json:"data_type"`
+	DataCatalogUUID *uuid.UUID          `json:"data_catalog_uuid,omitempty"`
+	DataDesc        string              `json:"data_desc"`
+	OnDelete        string              `json:"on_delete"`
+	Formula         string              `json:"formula,omitempty"`
+	Options         domain.FieldOptions `json:"options,omitempty"`
 }
 
 type CatalogDataDTO struct {
@@ -84,6 +87,84 @@ func NewCatalogDataDTO(dm domain.CatalogData, dict IDict) CatalogDataDTO {
 	}
 }
 
+type CatalogDataHistoryDTO struct {
+	UUID   uuid.UUID `json:"uuid"`
+	Action string    `json:"action"`
+
+	Fields  map[string]interface{} `json:"fields"`
+	Changes map[string]interface{} `json:"changes"`
+
+	MergedUUID *uuid.UUID `json:"merged_uuid,omitempty"`
+
+	CreatedBy     string    `json:"created_by"`
+	CreatedByUUID uuid.UUID `json:"created_by_uuid"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func NewCatalogDataHistoryDTO(dm domain.CatalogDataHistory) CatalogDataHistoryDTO {
+	return CatalogDataHistoryDTO{
+		UUID:          dm.UUID,
+		Action:        dm.Action,
+		Fields:        dm.Fields,
+		Changes:       dm.Changes,
+		MergedUUID:    dm.MergedUUID,
+		CreatedBy:     dm.CreatedBy,
+		CreatedByUUID: dm.CreatedByUUID,
+		CreatedAt:     dm.CreatedAt,
+	}
+}
+
+type CatalogDataUsagesDTO struct {
+	Catalogs []CatalogDataUsageDTO `json:"catalogs"`
+	Tasks    []TaskEntityUsageDTO  `json:"tasks"`
+}
+
+type CatalogDataUsageDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	CatalogUUID uuid.UUID `json:"catalog_uuid"`
+	CatalogName string    `json:"catalog_name"`
+	FieldHash   string    `json:"field_hash"`
+	FieldName   string    `json:"field_name"`
+	OnDelete    string    `json:"on_delete"`
+}
+
+type TaskEntityUsageDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	Name        string    `json:"name"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	Fields      []string  `json:"fields"`
+}
+
+type CatalogImportReportDTO struct {
+	DryRun  bool `json:"dry_run"`
+	Total   int  `json:"total"`
+	Valid   int  `json:"valid"`
+	Created int  `json:"created"`
+
+	Columns map[string]string       `json:"columns"`
+	Ignored []string                `json:"ignored"`
+	Errors  []CatalogImportErrorDTO `json:"errors"`
+}
+
+type CatalogImportErrorDTO struct {
+	Row    int    `json:"row"`
+	Column string `json:"column,omitempty"`
+	Error  string `json:"error"`
+}
+
+// XLSXColumn - колонка, которая известна только во время выгрузки, раскрывается в отдельные колонки файла
+type XLSXColumn struct {
+	Name  string
+	Value interface{}
+}
+
+type CatalogDataExportDTO struct {
+	UUID      uuid.UUID    `json:"uuid" xlsx:"A" ru:"UUID"`
+	Fields    []XLSXColumn `json:"fields" xlsx:"B"`
+	CreatedAt string       `json:"created_at" xlsx:"C" ru:"Создано"`
+	UpdatedAt string       `json:"updated_at" xlsx:"D" ru:"Обновлено"`
+}
+
 type CatalogSearchDTO struct {
 	FederationUUID uuid.UUID `json:"federation_uuid"`
 	CompanyUUID    uuid.UUID `json:"company_uuid"`
@@ -12,6 +12,7 @@ type CommentDTO struct {
 	UUID uuid.UUID `json:"uuid"`
 
 	Comment      string     `json:"comment"`
+	CommentHTML  string     `json:"comment_html"`
 	ReplyUUID    *uuid.UUID `json:"reply_uuid,omitempty"`
 	ReplyComment *string    `json:"reply_comment,omitempty"`
 
@@ -25,6 +26,23 @@ type CommentDTO struct {
 	Uploads []UploadDTO `json:"files,omitempty"`
 
 	Pin bool `json:"pin"`
+
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy *UserDTO   `json:"deleted_by,omitempty"`
+
+	HiddenAt *time.Time `json:"hidden_at,omitempty"`
+	HiddenBy *UserDTO   `json:"hidden_by,omitempty"`
+}
+
+type CommentRevisionDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	CommentUUID uuid.UUID `json:"comment_uuid"`
+
+	Comment string    `json:"comment"`
+	People  []UserDTO `json:"people,omitempty"`
+
+	EditedBy  *UserDTO  `json:"edited_by"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 func NewCommentDTO(dm domain.Comment, dict IDict, s3 IStorage) CommentDTO {
@@ -48,9 +66,18 @@ func NewCommentDTO(dm domain.Comment, dict IDict, s3 IStorage) CommentDTO {
 		}
 	})
 
+	var deletedBy, hiddenBy *UserDTO
+	if dm.DeletedBy != "" {
+		deletedBy, _ = dict.FindUser(dm.DeletedBy)
+	}
+	if dm.HiddenBy != "" {
+		hiddenBy, _ = dict.FindUser(dm.HiddenBy)
+	}
+
 	return CommentDTO{
 		UUID:         dm.UUID,
 		Comment:      dm.Comment,
+		CommentHTML:  renderedHTML(dm.CommentHTML, dm.Comment),
 		CreatedBy:    createdBy,
 		ReplyUUID:    dm.ReplyUUID,
 		ReplyComment: dm.ReplyComment,
@@ -65,6 +92,27 @@ func NewCommentDTO(dm domain.Comment, dict IDict, s3 IStorage) CommentDTO {
 		People: people,
 
 		Pin: dm.Pin,
+
+		DeletedAt: dm.DeletedAt,
+		DeletedBy: deletedBy,
+
+		HiddenAt: dm.HiddenAt,
+		HiddenBy: hiddenBy,
+	}
+}
+
+func NewCommentRevisionDTO(dm domain.CommentRevision, dict IDict) CommentRevisionDTO {
+	editedBy, _ := dict.FindUser(dm.EditedBy)
+
+	people, _ := dict.FindUsers(lo.Keys(dm.People))
+
+	return CommentRevisionDTO{
+		UUID:        dm.UUID,
+		CommentUUID: dm.CommentUUID,
+		Comment:     dm.Comment,
+		People:      people,
+		EditedBy:    editedBy,
+		CreatedAt:   dm.CreatedAt,
 	}
 }
 
@@ -0,0 +1,117 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type DealPipelineDTO struct {
+	UUID        uuid.UUID      `json:"uuid"`
+	CompanyUUID uuid.UUID      `json:"company_uuid"`
+	Name        string         `json:"name"`
+	Sort        int            `json:"sort"`
+	Stages      []DealStageDTO `json:"stages"`
+	CreatedBy   string         `json:"created_by"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type DealStageDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color"`
+	Probability int       `json:"probability"`
+	Status      int       `json:"status"`
+}
+
+func NewDealPipelineDTO(dm domain.DealPipeline) DealPipelineDTO {
+	return DealPipelineDTO{
+		UUID:        dm.UUID,
+		CompanyUUID: dm.CompanyUUID,
+		Name:        dm.Name,
+		Sort:        dm.Sort,
+		Stages: lo.Map(dm.Stages, func(s domain.DealStage, _ int) DealStageDTO {
+			return DealStageDTO{
+				UUID:        s.UUID,
+				Name:        s.Name,
+				Color:       s.Color,
+				Probability: s.Probability,
+				Status:      s.Status,
+			}
+		}),
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+type DealDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	CompanyUUID uuid.UUID `json:"company_uuid"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Tags        []string  `json:"tags"`
+	Priority    int       `json:"priority"`
+
+	PipelineUUID uuid.UUID `json:"pipeline_uuid"`
+	StageUUID    uuid.UUID `json:"stage_uuid"`
+	Status       int       `json:"status"`
+
+	Amount      domain.MoneyValue `json:"amount"`
+	Probability *int              `json:"probability,omitempty"`
+
+	AgentUUID *uuid.UUID  `json:"agent_uuid,omitempty"`
+	TaskUUIDs []uuid.UUID `json:"task_uuids"`
+
+	CloseAt        *time.Time `json:"close_at,omitempty"`
+	FinishedAt     *time.Time `json:"finished_at,omitempty"`
+	StageChangedAt time.Time  `json:"stage_changed_at"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewDealDTO(dm domain.Deal) DealDTO {
+	return DealDTO{
+		UUID:        dm.UUID,
+		CompanyUUID: dm.CompanyUUID,
+		Name:        dm.Name,
+		Description: dm.Description,
+		Tags:        lo.Ternary(dm.Tags == nil, []string{}, dm.Tags),
+		Priority:    dm.Priority,
+
+		PipelineUUID: dm.PipelineUUID,
+		StageUUID:    dm.StageUUID,
+		Status:       dm.Status,
+
+		Amount:      dm.Amount,
+		Probability: dm.Probability,
+
+		AgentUUID: dm.AgentUUID,
+		TaskUUIDs: lo.Ternary(dm.TaskUUIDs == nil, []uuid.UUID{}, dm.TaskUUIDs),
+
+		CloseAt:        dm.CloseAt,
+		FinishedAt:     dm.FinishedAt,
+		StageChangedAt: dm.StageChangedAt,
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+// DealForecastDTO - открытые сделки этапа с ожидаемым закрытием в месяце Month (YYYY-MM,
+// пустой - без даты закрытия). Amount и Weighted в минорных единицах Currency
+type DealForecastDTO struct {
+	StageUUID uuid.UUID `json:"stage_uuid"`
+	Stage     string    `json:"stage"`
+	Month     string    `json:"month"`
+	Currency  string    `json:"currency"`
+	Count     int       `json:"count"`
+	Amount    int64     `json:"amount"`
+	Weighted  int64     `json:"weighted"`
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type DuplicateMatchDTO struct {
+	By    string `json:"by"`
+	Value string `json:"value"`
+}
+
+type AgentDuplicateDTO struct {
+	Agent     AgentDTO            `json:"agent"`
+	Duplicate AgentDTO            `json:"duplicate"`
+	Score     int                 `json:"score"`
+	Matches   []DuplicateMatchDTO `json:"matches"`
+}
+
+type CatalogDataDuplicateDTO struct {
+	UUID            uuid.UUID              `json:"uuid"`
+	Title           string                 `json:"title"`
+	Fields          map[string]interface{} `json:"fields"`
+	DuplicateUUID   uuid.UUID              `json:"duplicate_uuid"`
+	DuplicateTitle  string                 `json:"duplicate_title"`
+	DuplicateFields map[string]interface{} `json:"duplicate_fields"`
+	Score           int                    `json:"score"`
+	Matches         []DuplicateMatchDTO    `json:"matches"`
+}
+
+func newDuplicateMatchesDTO(matches []domain.DuplicateMatch) []DuplicateMatchDTO {
+	return lo.Map(matches, func(m domain.DuplicateMatch, _ int) DuplicateMatchDTO {
+		return DuplicateMatchDTO{By: m.By, Value: m.Value}
+	})
+}
+
+// NewAgentDuplicateDTO - пара похожих агентов, юрлица в карточках не отдаются: для поиска дублей загружаются только ИНН
+func NewAgentDuplicateDTO(dm domain.AgentDuplicate) AgentDuplicateDTO {
+	agent, duplicate := NewAgentDTO(dm.Agent), NewAgentDTO(dm.Duplicate)
+	agent.LegalEntities, duplicate.LegalEntities = nil, nil
+
+	return AgentDuplicateDTO{
+		Agent:     agent,
+		Duplicate: duplicate,
+		Score:     dm.Score,
+		Matches:   newDuplicateMatchesDTO(dm.Matches),
+	}
+}
+
+func NewCatalogDataDuplicateDTO(dm domain.CatalogDataDuplicate) CatalogDataDuplicateDTO {
+	return CatalogDataDuplicateDTO{
+		UUID:            dm.Data.UUID,
+		Title:           dm.Title,
+		Fields:          dm.Data.Fields,
+		DuplicateUUID:   dm.Duplicate.UUID,
+		DuplicateTitle:  dm.DuplicateTitle,
+		DuplicateFields: dm.Duplicate.Fields,
+		Score:           dm.Score,
+		Matches:         newDuplicateMatchesDTO(dm.Matches),
+	}
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+type EmailTemplateDTO struct {
+	Name      string     `json:"name"`
+	Locale    string     `json:"locale"`
+	Subject   string     `json:"subject"`
+	Body      string     `json:"body"`
+	Custom    bool       `json:"custom"`
+	UpdatedBy string     `json:"updated_by,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewEmailTemplateDTO(dm domain.EmailTemplate) EmailTemplateDTO {
+	return EmailTemplateDTO{
+		Name:      dm.Name,
+		Locale:    dm.Locale,
+		Subject:   dm.Subject,
+		Body:      dm.Body,
+		Custom:    dm.Custom,
+		UpdatedBy: dm.UpdatedBy,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+type EmailBrandingDTO struct {
+	LogoURL         string     `json:"logo_url"`
+	PrimaryColor    string     `json:"primary_color"`
+	BackgroundColor string     `json:"background_color"`
+	Footer          string     `json:"footer"`
+	Locale          string     `json:"locale"`
+	UpdatedBy       string     `json:"updated_by,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewEmailBrandingDTO(dm domain.EmailBranding) EmailBrandingDTO {
+	return EmailBrandingDTO{
+		LogoURL:         dm.LogoURL,
+		PrimaryColor:    dm.PrimaryColor,
+		BackgroundColor: dm.BackgroundColor,
+		Footer:          dm.Footer,
+		Locale:          dm.Locale,
+		UpdatedBy:       dm.UpdatedBy,
+		UpdatedAt:       dm.UpdatedAt,
+	}
+}
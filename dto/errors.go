@@ -3,6 +3,9 @@ package dto
 import (
 	"errors"
 	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
 )
 
 type NotFoundError struct {
@@ -22,3 +25,37 @@ func NotFoundErr(msg string) NotFoundError {
 func NotFoundErrf(msg string, a ...interface{}) NotFoundError {
 	return NotFoundError{Err: fmt.Errorf(msg, a...)}
 }
+
+// ForbiddenError - действие запрещено для объекта в его текущем состоянии или для пользователя
+type ForbiddenError struct {
+	Err error
+}
+
+func (e ForbiddenError) Error() string {
+	return e.Err.Error()
+}
+
+func (e ForbiddenError) Unwrap() error { return e.Err }
+
+func ForbiddenErr(msg string) ForbiddenError {
+	return ForbiddenError{Err: errors.New(msg)}
+}
+
+// FieldError - нарушение правила кастомного поля, UI показывает Message у поля Hash
+type FieldError struct {
+	Hash    string `json:"hash"`
+	Name    string `json:"name"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// FieldsError - ошибки проверки кастомных полей, возвращаются клиенту списком
+type FieldsError struct {
+	Fields []FieldError
+}
+
+func (e FieldsError) Error() string {
+	return strings.Join(lo.Map(e.Fields, func(f FieldError, _ int) string {
+		return fmt.Sprintf("field %s (%s): %s", f.Name, f.Hash, f.Message)
+	}), "; ")
+}
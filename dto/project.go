@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type ProjectDTO struct {
@@ -56,6 +57,18 @@ type FieldStatistics struct {
 	Filled float64 `json:"filled"`
 	Count  int     `json:"count"`
 	Total  int     `json:"total"`
+
+	Money []MoneyStatistics `json:"money,omitempty"`
+}
+
+// MoneyStatistics - сумма, минимум, максимум и среднее поля money по валюте, в минорных единицах
+type MoneyStatistics struct {
+	Currency string  `json:"currency"`
+	Count    int     `json:"count"`
+	Sum      int64   `json:"sum"`
+	Min      int64   `json:"min"`
+	Max      int64   `json:"max"`
+	Avg      float64 `json:"avg"`
 }
 
 type ProjectOptionsDTO struct {
@@ -92,13 +105,16 @@ func NewProjectDTOs(dto *ProjectDTO) ProjectDTOs {
 }
 
 type CompanyFieldDTO struct {
-	UUID        uuid.UUID `json:"uuid"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Icon        string    `json:"icon"`
-	Hash        string    `json:"hash"`
-	DataType    int       `json:"data_type"`
-	DataDesc    string    `json:"data_desc"`
+	UUID        uuid.UUID           `json:"uuid"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Icon        string              `json:"icon"`
+	Hash        string              `json:"hash"`
+	DataType    int                 `json:"data_type"`
+	DataDesc    string              `json:"data_desc"`
+	Formula     string              `json:"formula,omitempty"`
+	Options     domain.FieldOptions `json:"options,omitempty"`
+	OnDelete    string              `json:"on_delete"`
 
 	ProjectsUUID      []uuid.UUID `json:"project_uuids"`
 	TasksTotal        int         `json:"tasks_total"`
@@ -108,14 +124,17 @@ type CompanyFieldDTO struct {
 }
 
 type ProjectFieldDTO struct {
-	UUID               uuid.UUID `json:"uuid"`
-	Name               string    `json:"name"`
-	Description        string    `json:"description"`
-	Hash               string    `json:"hash"`
-	DataType           int       `json:"data_type"`
-	DataDesc           string    `json:"data_desc"`
-	RequiredOnStatuses []int     `json:"required_on_statuses"`
-	Style              string    `json:"style"`
+	UUID               uuid.UUID           `json:"uuid"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	Hash               string              `json:"hash"`
+	DataType           int                 `json:"data_type"`
+	DataDesc           string              `json:"data_desc"`
+	RequiredOnStatuses []int               `json:"required_on_statuses"`
+	Style              string              `json:"style"`
+	Formula            string              `json:"formula,omitempty"`
+	Rules              domain.FieldRules   `json:"rules"`
+	Options            domain.FieldOptions `json:"options,omitempty"`
 
 	ProjectUUID uuid.UUID `json:"project_uuid"`
 }
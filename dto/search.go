@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type SearchResultDTO struct {
+	Type string    `json:"type"`
+	UUID uuid.UUID `json:"uuid"`
+
+	TaskUUID       *uuid.UUID `json:"task_uuid,omitempty"`
+	FederationUUID uuid.UUID  `json:"federation_uuid"`
+	ProjectUUID    *uuid.UUID `json:"project_uuid,omitempty"`
+
+	Title     string  `json:"title"`
+	Highlight string  `json:"highlight"`
+	Rank      float64 `json:"rank"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewSearchResultDTO(dm domain.SearchHit) SearchResultDTO {
+	return SearchResultDTO{
+		Type:           dm.EntityType,
+		UUID:           dm.EntityUUID,
+		TaskUUID:       dm.TaskUUID,
+		FederationUUID: dm.FederationUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		Title:          dm.Title,
+		Highlight:      dm.Highlight,
+		Rank:           dm.Rank,
+		UpdatedAt:      dm.UpdatedAt,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type SmsDTO struct {
@@ -16,6 +17,12 @@ type SmsDTO struct {
 	Text   string
 	Status string
 
+	StatusCode int
+	ErrorCode  int
+	StatusAt   *time.Time
+
+	TaskUUID *uuid.UUID
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
@@ -27,5 +34,119 @@ type SmsFilterDTO struct {
 	Limit       *int       `json:"limit"`
 	IsMy        *bool      `json:"is_my"`
 	Status      *int       `json:"status"`
+	State       *string    `json:"state"`
 	MyEmail     *string    `json:"my_email"`
+
+	// Phones - сообщения на любой из номеров, номера в формате sms.NormalizePhone
+	Phones []string `json:"phones"`
+}
+
+type SmsCampaignDTO struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	CompanyUUID uuid.UUID  `json:"company_uuid"`
+	Name        string     `json:"name"`
+	Template    string     `json:"template"`
+	Source      string     `json:"source"`
+	SourceUUID  *uuid.UUID `json:"source_uuid,omitempty"`
+	Status      string     `json:"status"`
+	SendAt      *time.Time `json:"send_at,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+
+	Report SmsCampaignReportDTO `json:"report"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SmsCampaignReportDTO struct {
+	Total     int     `json:"total"`
+	Pending   int     `json:"pending"`
+	Excluded  int     `json:"excluded"`
+	Sent      int     `json:"sent"`
+	Delivered int     `json:"delivered"`
+	Failed    int     `json:"failed"`
+	Cost      float64 `json:"cost"`
+}
+
+func NewSmsCampaignDTO(dm domain.SmsCampaign) SmsCampaignDTO {
+	return SmsCampaignDTO{
+		UUID:        dm.UUID,
+		CompanyUUID: dm.CompanyUUID,
+		Name:        dm.Name,
+		Template:    dm.Template,
+		Source:      dm.Source,
+		SourceUUID:  dm.SourceUUID,
+		Status:      dm.Status,
+		SendAt:      dm.SendAt,
+		StartedAt:   dm.StartedAt,
+		FinishedAt:  dm.FinishedAt,
+
+		Report: SmsCampaignReportDTO{
+			Total:     dm.Report.Total,
+			Pending:   dm.Report.Pending,
+			Excluded:  dm.Report.Excluded,
+			Sent:      dm.Report.Sent,
+			Delivered: dm.Report.Delivered,
+			Failed:    dm.Report.Failed,
+			Cost:      dm.Report.Cost,
+		},
+
+		CreatedBy: dm.CreatedBy,
+		CreatedAt: dm.CreatedAt,
+	}
+}
+
+type SmsRecipientDTO struct {
+	UUID     uuid.UUID  `json:"uuid"`
+	Phone    string     `json:"phone"`
+	Name     string     `json:"name"`
+	Text     string     `json:"text"`
+	Status   string     `json:"status"`
+	Delivery string     `json:"delivery"`
+	Error    string     `json:"error"`
+	Cost     float64    `json:"cost"`
+	SentAt   *time.Time `json:"sent_at,omitempty"`
+}
+
+func NewSmsRecipientDTO(dm domain.SmsRecipient) SmsRecipientDTO {
+	return SmsRecipientDTO{
+		UUID:     dm.UUID,
+		Phone:    dm.Phone,
+		Name:     dm.Name,
+		Text:     dm.Text,
+		Status:   dm.Status,
+		Delivery: dm.Delivery,
+		Error:    dm.Error,
+		Cost:     dm.Cost,
+		SentAt:   dm.SentAt,
+	}
+}
+
+type SmsTemplateDTO struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	CompanyUUID uuid.UUID  `json:"company_uuid"`
+	Name        string     `json:"name"`
+	Text        string     `json:"text"`
+	PhoneField  string     `json:"phone_field"`
+	ProjectUUID *uuid.UUID `json:"project_uuid,omitempty"`
+	OnStatus    *int       `json:"on_status,omitempty"`
+	CreatedBy   string     `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func NewSmsTemplateDTO(dm domain.SmsTemplate) SmsTemplateDTO {
+	return SmsTemplateDTO{
+		UUID:        dm.UUID,
+		CompanyUUID: dm.CompanyUUID,
+		Name:        dm.Name,
+		Text:        dm.Text,
+		PhoneField:  dm.PhoneField,
+		ProjectUUID: dm.ProjectUUID,
+		OnStatus:    dm.OnStatus,
+		CreatedBy:   dm.CreatedBy,
+		CreatedAt:   dm.CreatedAt,
+		UpdatedAt:   dm.UpdatedAt,
+	}
 }
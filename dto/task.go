@@ -4,11 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/markdown"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
@@ -32,14 +34,15 @@ type OpenByDTO struct {
 }
 
 type TaskDTO struct {
-	UUID          uuid.UUID `json:"uuid"`
-	ID            int       `json:"id"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description"`
-	CreatedBy     UserDTO   `json:"created_by"`
-	ResponsibleBy *UserDTO  `json:"responsible_by,omitempty"`
-	ImplementBy   *UserDTO  `json:"implement_by,omitempty"`
-	ManagedBy     *UserDTO  `json:"managed_by,omitempty"`
+	UUID            uuid.UUID `json:"uuid"`
+	ID              int       `json:"id"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+	DescriptionHTML string    `json:"description_html"`
+	CreatedBy       UserDTO   `json:"created_by"`
+	ResponsibleBy   *UserDTO  `json:"responsible_by,omitempty"`
+	ImplementBy     *UserDTO  `json:"implement_by,omitempty"`
+	ManagedBy       *UserDTO  `json:"managed_by,omitempty"`
 
 	IsEpic bool `json:"is_epic"`
 
@@ -140,6 +143,8 @@ type TaskFieldDTO struct {
 	Name     string      `json:"name"`
 	DataType int         `json:"data_type"`
 	Value    interface{} `json:"value"`
+	// Formula - выражение вычисляемого поля, значение только для чтения
+	Formula string `json:"formula,omitempty"`
 }
 
 func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File, reminders []domain.Reminder, linkedFieldsData map[uuid.UUID]interface{}, dict IDict, s3 IStorage) TaskDTO {
@@ -174,6 +179,7 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 					Name:     pf.Name,
 					DataType: pf.DataType,
 					Value:    fi[pf.Hash],
+					Formula:  pf.Formula,
 				})
 			}
 		}
@@ -231,11 +237,20 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 		}
 
 		return FileDTOs{
-			UUID:      dm.UUID,
-			Name:      dm.Name,
-			Ext:       dm.Ext,
-			Size:      dm.Size,
-			URL:       dm.URL,
+			UUID: dm.UUID,
+			Name: dm.Name,
+			Ext:  dm.Ext,
+			Size: dm.Size,
+			URL:  dm.URL,
+
+			Mime:       dm.Mime,
+			Width:      dm.Width,
+			Height:     dm.Height,
+			Pages:      dm.Pages,
+			PreviewURL: dm.PreviewURL,
+			Version:    dm.Version,
+			ScanStatus: dm.ScanStatus,
+
 			CreatedAt: dm.CreatedAt,
 			CreatedBy: *createdBy,
 		}
@@ -288,7 +303,8 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 		Project:    NewProjectDTOs(projectDTO),
 		Federation: NewFederationDTOs(federationDTO),
 
-		Description: dm.Description,
+		Description:     dm.Description,
+		DescriptionHTML: renderedHTML(dm.DescriptionHTML, dm.Description),
 
 		Priority:        dm.Priority,
 		CompanyPriority: companyPriority,
@@ -404,6 +420,56 @@ type FilterDTO struct {
 	Value    interface{}
 }
 
+// FilterRange - оператор фильтра по диапазону, значение фильтра RangeDTO
+const FilterRange = "range"
+
+// RangeDTO - диапазон для числовых полей и полей money, границы включительно. Для money границы
+// в минорных единицах, Currency ограничивает валюту
+type RangeDTO struct {
+	From     *float64 `json:"from"`
+	To       *float64 `json:"to"`
+	Currency string   `json:"currency"`
+}
+
+func newRangeDTO(name string, v map[string]interface{}) (r RangeDTO, err error) {
+	bound := func(key string) (*float64, error) {
+		value, ok := v[key]
+		if !ok || value == nil {
+			return nil, nil
+		}
+
+		f, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("фильтр %s: %s должен быть числом", name, key)
+		}
+
+		return &f, nil
+	}
+
+	if r.From, err = bound("from"); err != nil {
+		return r, err
+	}
+
+	if r.To, err = bound("to"); err != nil {
+		return r, err
+	}
+
+	if r.From == nil && r.To == nil {
+		return r, fmt.Errorf("фильтр %s: нужно указать from или to", name)
+	}
+
+	if currency, ok := v["currency"]; ok {
+		s, ok := currency.(string)
+		if !ok {
+			return r, fmt.Errorf("фильтр %s: currency должен быть строкой", name)
+		}
+
+		r.Currency = strings.ToUpper(s)
+	}
+
+	return r, nil
+}
+
 func NewFilterDTO(filter *string) (dtos []FilterDTO, err error) {
 	if filter == nil {
 		return dtos, err
@@ -416,6 +482,21 @@ func NewFilterDTO(filter *string) (dtos []FilterDTO, err error) {
 	}
 
 	for k, v := range jsonMap {
+		if m, ok := v.(map[string]interface{}); ok {
+			r, err := newRangeDTO(k, m)
+			if err != nil {
+				return dtos, err
+			}
+
+			dtos = append(dtos, FilterDTO{
+				Name:     k,
+				Operator: FilterRange,
+				Value:    r,
+			})
+
+			continue
+		}
+
 		dtos = append(dtos, FilterDTO{
 			Name:     k,
 			Operator: "=",
@@ -458,3 +539,12 @@ func (d *TaskSearchDTO) Validate() error {
 
 	return nil
 }
+
+// renderedHTML отдаёт сохранённый html, для старых записей рендерит без ссылок на задачи и упоминаний
+func renderedHTML(html, raw string) string {
+	if html == "" && raw != "" {
+		return markdown.Render(raw, markdown.Options{})
+	}
+
+	return html
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type UploadDTO struct {
@@ -12,6 +13,14 @@ type UploadDTO struct {
 	EXT  string    `json:"ext"`
 	Size int64     `json:"size"`
 	URL  string    `json:"url"`
+
+	Mime       string `json:"mime"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	Pages      int    `json:"pages"`
+	PreviewURL string `json:"preview_url"`
+	Version    int    `json:"version"`
+	ScanStatus string `json:"scan_status"`
 }
 
 func NewUploadDTO(uid uuid.UUID, name, ext string, size int64, url string) UploadDTO {
@@ -24,6 +33,30 @@ func NewUploadDTO(uid uuid.UUID, name, ext string, size int64, url string) Uploa
 	}
 }
 
+// UploadSessionDTO - составная загрузка: части грузятся напрямую в хранилище по ссылкам url
+type UploadSessionDTO struct {
+	UUID       uuid.UUID  `json:"uuid"`
+	Name       string     `json:"name"`
+	Size       int64      `json:"size"`
+	Mime       string     `json:"mime"`
+	PartSize   int64      `json:"part_size"`
+	PartsCount int        `json:"parts_count"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	FileUUID   *uuid.UUID `json:"file_uuid,omitempty"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+
+	Parts []UploadPartDTO `json:"parts"`
+}
+
+type UploadPartDTO struct {
+	Number   int    `json:"number"`
+	Size     int64  `json:"size"`
+	Uploaded bool   `json:"uploaded"`
+	ETag     string `json:"etag,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
 type FileDTO struct {
 	UUID       uuid.UUID `json:"uuid"`
 	ObjectName string    `json:"object_name"`
@@ -43,10 +76,54 @@ type FileDTOs struct {
 	Size int64     `json:"size"`
 	URL  string    `json:"url"`
 
+	Mime       string `json:"mime"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	Pages      int    `json:"pages"`
+	PreviewURL string `json:"preview_url"`
+	Version    int    `json:"version"`
+	ScanStatus string `json:"scan_status"`
+
 	CreatedAt time.Time `json:"created_at"`
 	CreatedBy UserDTO   `json:"created_by"`
 }
 
+type FileVersionDTO struct {
+	UUID     uuid.UUID `json:"uuid"`
+	Version  int       `json:"version"`
+	Name     string    `json:"name"`
+	Ext      string    `json:"ext"`
+	Size     int64     `json:"size"`
+	Mime     string    `json:"mime"`
+	URL      string    `json:"url"`
+	IsLatest bool      `json:"is_latest"`
+
+	ScanStatus string `json:"scan_status"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy *UserDTO  `json:"created_by"`
+}
+
+func NewFileVersionDTO(dm domain.FileVersion, dict IDict) FileVersionDTO {
+	createdBy, _ := dict.FindUserByUUID(dm.CreatedBy)
+
+	return FileVersionDTO{
+		UUID:     dm.UUID,
+		Version:  dm.Version,
+		Name:     dm.Name,
+		Ext:      dm.Ext,
+		Size:     dm.Size,
+		Mime:     dm.Mime,
+		URL:      dm.URL,
+		IsLatest: dm.IsLatest,
+
+		ScanStatus: dm.ScanStatus,
+
+		CreatedAt: dm.CreatedAt,
+		CreatedBy: createdBy,
+	}
+}
+
 type ImageDTO struct {
 	UUID       uuid.UUID `json:"uuid"`
 	ObjectName string    `json:"object_name"`
@@ -0,0 +1,51 @@
+package activities
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+// AgentMerged пишет в активность агента, какой дубль в него слит и что с дубля перенесено
+func (s *Service) AgentMerged(creator domain.Creator, merge domain.AgentMerge) (*Activity, error) {
+	ActivityMeta := dto.ActivityAgentMergeDTO{
+		DuplicateUUID: merge.DuplicateUUID,
+		DuplicateName: merge.DuplicateName,
+		Contacts: lo.Map(merge.Contacts, func(c domain.AgentContacts, _ int) dto.AgentContactsDTO {
+			return dto.AgentContactsDTO{Type: c.Type, Val: c.Val}
+		}),
+		LegalEntities: merge.LegalEntities,
+		Tasks:         merge.Tasks,
+		Deals:         merge.Deals,
+		Comments:      merge.Comments,
+		Files:         merge.Files,
+		Emails:        merge.Emails,
+	}
+
+	mp, err := helpers.StructToMap(ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	act := &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    merge.SurvivorUUID,
+		EntityType:    "agent",
+		Description:   fmt.Sprint(domain.ActivityAgentMerged),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          domain.ActivityAgentMerged,
+		Meta:          mp,
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
@@ -0,0 +1,111 @@
+package activities
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+func (s *Service) TaskCommentWasChanged(creator domain.Creator, comment domain.Comment, oldVal, newVal string) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskCommentDTO{
+		UUID:   comment.UUID,
+		Author: comment.CreatedBy,
+		Old:    oldVal,
+		New:    newVal,
+	}
+
+	act, err := s.taskCommentActivity(creator, comment.TaskUUID, domain.ActivityTaskCommentWasChanged, ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	if oldVal == newVal {
+		return act, nil
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
+
+func (s *Service) TaskCommentWasDeleted(creator domain.Creator, comment domain.Comment) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskCommentDTO{
+		UUID:   comment.UUID,
+		Author: comment.CreatedBy,
+		Old:    comment.Comment,
+	}
+
+	act, err := s.taskCommentActivity(creator, comment.TaskUUID, domain.ActivityTaskCommentWasDeleted, ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
+
+func (s *Service) TaskCommentWasHidden(creator domain.Creator, comment domain.Comment) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskCommentDTO{
+		UUID:   comment.UUID,
+		Author: comment.CreatedBy,
+	}
+
+	act, err := s.taskCommentActivity(creator, comment.TaskUUID, domain.ActivityTaskCommentWasHidden, ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
+
+func (s *Service) TaskCommentWasRestored(creator domain.Creator, comment domain.Comment) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskCommentDTO{
+		UUID:   comment.UUID,
+		Author: comment.CreatedBy,
+	}
+
+	act, err := s.taskCommentActivity(creator, comment.TaskUUID, domain.ActivityTaskCommentWasRestored, ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
+
+func (s *Service) taskCommentActivity(creator domain.Creator, taskUUID uuid.UUID, tp domain.ActivityType, meta dto.ActivityTaskCommentDTO) (*Activity, error) {
+	mp, err := helpers.StructToMap(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    taskUUID,
+		EntityType:    "task",
+		Description:   fmt.Sprint(tp),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          tp,
+		Meta:          mp,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package activities
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+// DealStageChanged пишет переход сделки между этапами, при создании сделки from - nil
+func (s *Service) DealStageChanged(creator domain.Creator, dealUUID uuid.UUID, from *domain.DealStage, to domain.DealStage) (*Activity, error) {
+	ActivityMeta := dto.ActivityDealStageDTO{
+		NewStageUUID: to.UUID,
+		NewStage:     to.Name,
+		NewStatus:    to.Status,
+	}
+
+	if from != nil {
+		ActivityMeta.OldStageUUID = &from.UUID
+		ActivityMeta.OldStage = from.Name
+		ActivityMeta.OldStatus = &from.Status
+	}
+
+	mp, err := helpers.StructToMap(ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	act := &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    dealUUID,
+		EntityType:    "deal",
+		Description:   fmt.Sprint(domain.ActivityDealStageChanged),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          domain.ActivityDealStageChanged,
+		Meta:          mp,
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
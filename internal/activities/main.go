@@ -41,6 +41,28 @@ func (s *Service) GetTaskActivities(taskUID uuid.UUID, limit, offset int) ([]dom
 		return nil, 0, err
 	}
 
+	return toDomain(orms), total, nil
+}
+
+func (s *Service) GetDealActivities(dealUID uuid.UUID, limit, offset int) ([]domain.Activity, int64, error) {
+	orms, total, err := s.repo.GetDealActivities(dealUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return toDomain(orms), total, nil
+}
+
+func (s *Service) GetAgentActivities(agentUID uuid.UUID, limit, offset int) ([]domain.Activity, int64, error) {
+	orms, total, err := s.repo.GetAgentActivities(agentUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return toDomain(orms), total, nil
+}
+
+func toDomain(orms []Activity) []domain.Activity {
 	return lo.Map(orms, func(orm Activity, _ int) domain.Activity {
 		return domain.Activity{
 			UUID:        orm.UUID,
@@ -55,5 +77,5 @@ func (s *Service) GetTaskActivities(taskUID uuid.UUID, limit, offset int) ([]dom
 			Meta:      orm.Meta,
 			Type:      int(orm.Type),
 		}
-	}), total, nil
+	})
 }
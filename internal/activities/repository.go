@@ -20,10 +20,22 @@ func (r *Repository) CreateActivity(activity *Activity) error {
 }
 
 func (r *Repository) GetTaskActivities(taskUID uuid.UUID, limit, offset int) (orms []Activity, total int64, err error) {
+	return r.getActivities("task", taskUID, limit, offset)
+}
+
+func (r *Repository) GetDealActivities(dealUID uuid.UUID, limit, offset int) (orms []Activity, total int64, err error) {
+	return r.getActivities("deal", dealUID, limit, offset)
+}
+
+func (r *Repository) GetAgentActivities(agentUID uuid.UUID, limit, offset int) (orms []Activity, total int64, err error) {
+	return r.getActivities("agent", agentUID, limit, offset)
+}
+
+func (r *Repository) getActivities(entityType string, uid uuid.UUID, limit, offset int) (orms []Activity, total int64, err error) {
 	err = r.gorm.DB.
 		Select("*, count(*) OVER() AS total").
-		Where("entity_uuid = ?", taskUID).
-		Where("entity_type = ?", "task").
+		Where("entity_uuid = ?", uid).
+		Where("entity_type = ?", entityType).
 		Order("created_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -0,0 +1,43 @@
+package activities
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+func (s *Service) TaskSmsWasSent(creator domain.Creator, taskUUID uuid.UUID, sms domain.Sms, template string) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskSmsDTO{
+		UUID:         sms.UUID,
+		Phone:        sms.To,
+		Text:         sms.Text,
+		TemplateUUID: sms.TemplateUUID,
+		Template:     template,
+	}
+
+	mp, err := helpers.StructToMap(ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	act := &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    taskUUID,
+		EntityType:    "task",
+		Description:   fmt.Sprint(domain.ActivityTaskSmsWasSent),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          domain.ActivityTaskSmsWasSent,
+		Meta:          mp,
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
@@ -0,0 +1,64 @@
+package agents
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/samber/lo"
+)
+
+var telegramRe = regexp.MustCompile(`^[A-Za-z0-9_]{5,32}$`)
+
+// NormalizeContacts проверяет контакты агента и приводит их к виду, по которому работает поиск:
+// телефоны и номера WhatsApp и Viber - только цифры, email - в нижнем регистре, telegram - логин без @.
+// Контакты других типов сохраняются как есть, повторы убираются
+func NormalizeContacts(contacts []domain.AgentContacts) ([]domain.AgentContacts, error) {
+	res := make([]domain.AgentContacts, 0, len(contacts))
+
+	for _, c := range contacts {
+		typ := strings.ToLower(strings.TrimSpace(c.Type))
+		val := strings.TrimSpace(c.Val)
+
+		if typ == "" || val == "" {
+			return nil, errors.New("тип и значение контакта не могут быть пустыми")
+		}
+
+		if sms.IsPhoneKey(typ) {
+			typ = domain.AgentContactPhone
+		}
+
+		switch typ {
+		case domain.AgentContactPhone, domain.AgentContactWhatsApp, domain.AgentContactViber:
+			phone, ok := sms.NormalizePhone(val)
+			if !ok {
+				return nil, fmt.Errorf("номер %q указан неверно", val)
+			}
+			val = phone
+		case domain.AgentContactEmail:
+			addr, err := mail.ParseAddress(val)
+			if err != nil {
+				return nil, fmt.Errorf("email %q указан неверно", val)
+			}
+			val = strings.ToLower(addr.Address)
+		case domain.AgentContactTelegram:
+			login := strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(val, "https://"), "t.me/"), "@")
+			if phone, ok := sms.NormalizePhone(login); ok && strings.HasPrefix(login, "+") {
+				val = phone
+				break
+			}
+			if !telegramRe.MatchString(login) {
+				return nil, fmt.Errorf("telegram %q указан неверно", val)
+			}
+			val = login
+		}
+
+		res = append(res, domain.AgentContacts{Type: typ, Val: val})
+	}
+
+	return lo.Uniq(res), nil
+}
@@ -0,0 +1,45 @@
+package agents
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestNormalizeContacts(t *testing.T) {
+	got, err := NormalizeContacts([]domain.AgentContacts{
+		{Type: "Телефон", Val: "8 (999) 123-45-67"},
+		{Type: "phone", Val: "+7 999 123 45 67"},
+		{Type: "email", Val: "Ivan Petrov <Ivan@Example.com>"},
+		{Type: "telegram", Val: "https://t.me/ivan_petrov"},
+		{Type: "whatsapp", Val: "+7 999 765-43-21"},
+		{Type: "site", Val: " example.com "},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []domain.AgentContacts{
+		{Type: "phone", Val: "79991234567"},
+		{Type: "email", Val: "ivan@example.com"},
+		{Type: "telegram", Val: "ivan_petrov"},
+		{Type: "whatsapp", Val: "79997654321"},
+		{Type: "site", Val: "example.com"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	for _, c := range []domain.AgentContacts{
+		{Type: "phone", Val: "12345"},
+		{Type: "email", Val: "not an email"},
+		{Type: "telegram", Val: "@a b"},
+		{Type: "", Val: "x"},
+	} {
+		if _, err := NormalizeContacts([]domain.AgentContacts{c}); err == nil {
+			t.Errorf("%+v: should fail", c)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package agents
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/samber/lo"
+)
+
+// DuplicateKeys собирает признаки агента для поиска дублей: телефоны из контактов phone,
+// whatsapp и viber, email в нижнем регистре, ИНН юрлиц и имя в транслите
+func DuplicateKeys(dm domain.Agent) domain.DuplicateKeys {
+	keys := domain.DuplicateKeys{
+		Name: domain.DuplicateNameKey(dm.Name),
+	}
+
+	for _, c := range dm.Contacts {
+		switch {
+		case sms.IsPhoneKey(c.Type), c.Type == domain.AgentContactWhatsApp, c.Type == domain.AgentContactViber:
+			if phone, ok := sms.NormalizePhone(c.Val); ok {
+				keys.Phones = append(keys.Phones, phone)
+			}
+		case c.Type == domain.AgentContactEmail:
+			keys.Emails = append(keys.Emails, strings.ToLower(strings.TrimSpace(c.Val)))
+		}
+	}
+
+	for _, e := range dm.LegalEntities {
+		if e.INN != "" {
+			keys.INNs = append(keys.INNs, e.INN)
+		}
+	}
+
+	keys.Phones = lo.Uniq(keys.Phones)
+	keys.Emails = lo.Uniq(keys.Emails)
+	keys.INNs = lo.Uniq(keys.INNs)
+
+	return keys
+}
+
+func duplicateKeys(dms []domain.Agent) map[uuid.UUID]domain.DuplicateKeys {
+	return lo.SliceToMap(dms, func(dm domain.Agent) (uuid.UUID, domain.DuplicateKeys) {
+		return dm.UUID, DuplicateKeys(dm)
+	})
+}
+
+// MergeContacts добавляет к контактам survivor контакты дубля, которых у него нет
+func MergeContacts(survivor, duplicate []domain.AgentContacts) []domain.AgentContacts {
+	return lo.Uniq(append(append([]domain.AgentContacts{}, survivor...), duplicate...))
+}
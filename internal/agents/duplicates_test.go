@@ -0,0 +1,35 @@
+package agents
+
+import (
+	"testing"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestDuplicateKeys(t *testing.T) {
+	keys := DuplicateKeys(domain.Agent{
+		Name: "Иван Петров",
+		Contacts: []domain.AgentContacts{
+			{Type: domain.AgentContactPhone, Val: "89001234567"},
+			{Type: domain.AgentContactWhatsApp, Val: "79001234567"},
+			{Type: domain.AgentContactEmail, Val: "Ivan@Example.com"},
+			{Type: domain.AgentContactTelegram, Val: "ivan_petrov"},
+		},
+		LegalEntities: []domain.AgentLegalEntity{{INN: "500100732259"}},
+	})
+
+	if keys.Name != "ivan petrov" || len(keys.Phones) != 1 || keys.Phones[0] != "79001234567" ||
+		len(keys.Emails) != 1 || keys.Emails[0] != "ivan@example.com" || len(keys.INNs) != 1 {
+		t.Errorf("unexpected keys: %+v", keys)
+	}
+}
+
+func TestMergeContacts(t *testing.T) {
+	phone := domain.AgentContacts{Type: domain.AgentContactPhone, Val: "79001234567"}
+	email := domain.AgentContacts{Type: domain.AgentContactEmail, Val: "ivan@example.com"}
+
+	merged := MergeContacts([]domain.AgentContacts{phone}, []domain.AgentContacts{email, phone})
+	if len(merged) != 2 || merged[0] != phone || merged[1] != email {
+		t.Errorf("unexpected contacts: %+v", merged)
+	}
+}
@@ -2,20 +2,36 @@ package agents
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/activities"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
 )
 
-func New(repo *Repository) *Service {
+const maxCommentLength = 5000
+
+func New(repo *Repository, as *activities.Service) *Service {
 	c := &Service{
 		repo: repo,
+		as:   as,
 	}
 
 	return c
 }
 
 func (s *Service) Create(_ context.Context, a *domain.Agent) error {
+	contacts, err := NormalizeContacts(a.Contacts)
+	if err != nil {
+		return err
+	}
+	a.Contacts = contacts
+
 	return s.repo.Create(a)
 }
 
@@ -23,10 +39,172 @@ func (s *Service) Get(ctx context.Context, filter domain.AgentFilter) ([]domain.
 	return s.repo.Get(ctx, filter)
 }
 
+func (s *Service) FindByContact(_ context.Context, federationUUID, companyUUID uuid.UUID, contact domain.AgentContacts) (domain.Agent, error) {
+	return s.repo.FindByContact(federationUUID, companyUUID, contact)
+}
+
 func (s *Service) Delete(_ context.Context, uid uuid.UUID) error {
 	return s.repo.Delete(uid)
 }
 
 func (s *Service) Update(_ context.Context, a *domain.Agent) error {
+	contacts, err := NormalizeContacts(a.Contacts)
+	if err != nil {
+		return err
+	}
+	a.Contacts = contacts
+
 	return s.repo.Update(a)
 }
+
+// GetByUUID возвращает карточку агента, если он принадлежит федерации
+func (s *Service) GetByUUID(_ context.Context, federationUUID, uid uuid.UUID) (domain.Agent, error) {
+	dm, err := s.repo.GetByUUID(uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.FederationUUID != federationUUID {
+		return dm, dto.NotFoundErr("агент не найден")
+	}
+
+	return dm, nil
+}
+
+func (s *Service) SetLegalEntities(_ context.Context, agent domain.Agent, dms []domain.AgentLegalEntity) error {
+	for i := range dms {
+		if err := dms[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.SetLegalEntities(agent.UUID, dms)
+}
+
+// SetTasks связывает агента с задачами его федерации и компании
+func (s *Service) SetTasks(_ context.Context, agent domain.Agent, taskUUIDs []uuid.UUID) error {
+	taskUUIDs = lo.Uniq(taskUUIDs)
+
+	found, err := s.repo.ScopeTasks(agent.FederationUUID, agent.CompanyUUID, taskUUIDs)
+	if err != nil {
+		return err
+	}
+
+	missing, _ := lo.Difference(taskUUIDs, found)
+	if len(missing) > 0 {
+		return dto.NotFoundErrf("задачи не найдены: %s", strings.Join(lo.Map(missing, func(uid uuid.UUID, _ int) string { return uid.String() }), ", "))
+	}
+
+	return s.repo.SetTasks(agent.UUID, taskUUIDs)
+}
+
+func (s *Service) TimelineTasks(_ context.Context, uid uuid.UUID, limit int) ([]TimelineTask, int64, error) {
+	return s.repo.TimelineTasks(uid, limit)
+}
+
+func (s *Service) CreateComment(_ context.Context, dm *domain.AgentComment) error {
+	dm.Text = strings.TrimSpace(dm.Text)
+	if dm.Text == "" {
+		return errors.New("комментарий не может быть пустым")
+	}
+
+	if utf8.RuneCountInString(dm.Text) > maxCommentLength {
+		return errors.New("комментарий слишком длинный")
+	}
+
+	if dm.UUID == uuid.Nil {
+		dm.UUID = uuid.New()
+	}
+
+	return s.repo.CreateComment(*dm)
+}
+
+func (s *Service) GetComments(_ context.Context, uid uuid.UUID, offset, limit int) ([]domain.AgentComment, int64, error) {
+	return s.repo.GetComments(uid, offset, limit)
+}
+
+func (s *Service) DeleteComment(_ context.Context, agentUUID, uid uuid.UUID) error {
+	return s.repo.DeleteComment(agentUUID, uid)
+}
+
+// Duplicates ищет дубли агента среди агентов его компании, агента без компании - среди агентов федерации
+// без компании: объединить можно только их
+func (s *Service) Duplicates(_ context.Context, agent domain.Agent, minScore int) ([]domain.AgentDuplicate, error) {
+	candidates, err := s.repo.DuplicateCandidates(agent.FederationUUID, agent.CompanyUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates = lo.Filter(candidates, func(item domain.Agent, _ int) bool { return sameCompany(item, agent) })
+
+	pairs := domain.FindDuplicates(agent.UUID, DuplicateKeys(agent), duplicateKeys(candidates), minScore)
+
+	byUUID := lo.KeyBy(candidates, func(item domain.Agent) uuid.UUID { return item.UUID })
+	byUUID[agent.UUID] = agent
+
+	return toDuplicates(pairs, byUUID), nil
+}
+
+// AllDuplicates находит пары похожих агентов федерации или одной ее компании. Пары агентов
+// разных компаний пропускаются - объединить их нельзя
+func (s *Service) AllDuplicates(_ context.Context, federationUUID uuid.UUID, companyUUID *uuid.UUID, minScore int) ([]domain.AgentDuplicate, error) {
+	candidates, err := s.repo.DuplicateCandidates(federationUUID, companyUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	byUUID := lo.KeyBy(candidates, func(item domain.Agent) uuid.UUID { return item.UUID })
+
+	pairs := lo.Filter(domain.DuplicatePairs(duplicateKeys(candidates), minScore), func(p domain.DuplicatePair, _ int) bool {
+		return sameCompany(byUUID[p.UUID], byUUID[p.DuplicateUUID])
+	})
+
+	return toDuplicates(pairs, byUUID), nil
+}
+
+// sameCompany - агенты одной компании или оба без компании, как требует Merge
+func sameCompany(a, b domain.Agent) bool {
+	return lo.FromPtr(a.CompanyUUID) == lo.FromPtr(b.CompanyUUID)
+}
+
+func toDuplicates(pairs []domain.DuplicatePair, agents map[uuid.UUID]domain.Agent) []domain.AgentDuplicate {
+	return lo.Map(pairs, func(p domain.DuplicatePair, _ int) domain.AgentDuplicate {
+		return domain.AgentDuplicate{
+			Agent:     agents[p.UUID],
+			Duplicate: agents[p.DuplicateUUID],
+			Score:     p.Score,
+			Matches:   p.Matches,
+		}
+	})
+}
+
+// Merge сливает дубль в агента survivor: переносит связанные данные, добавляет контакты дубля,
+// удаляет дубль и пишет в активность survivor, что и откуда перенесено
+func (s *Service) Merge(_ context.Context, crtr domain.Creator, survivor, duplicate domain.Agent) (domain.AgentMerge, error) {
+	if survivor.UUID == duplicate.UUID {
+		return domain.AgentMerge{}, errors.New("нельзя объединить агента с самим собой")
+	}
+
+	if survivor.FederationUUID != duplicate.FederationUUID || !sameCompany(survivor, duplicate) {
+		return domain.AgentMerge{}, errors.New("объединить можно только агентов одной компании")
+	}
+
+	contacts := MergeContacts(survivor.Contacts, duplicate.Contacts)
+
+	res, err := s.repo.Merge(survivor, duplicate, contacts)
+	if err != nil {
+		return res, err
+	}
+
+	res.Contacts, _ = lo.Difference(contacts, survivor.Contacts)
+
+	if _, err := s.as.AgentMerged(crtr, res); err != nil {
+		logrus.Error("agent activity error: ", err)
+	}
+
+	return res, nil
+}
+
+func (s *Service) GetActivities(_ context.Context, uid uuid.UUID, limit, offset int) ([]domain.Activity, int64, error) {
+	return s.as.GetAgentActivities(uid, limit, offset)
+}
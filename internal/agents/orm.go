@@ -55,3 +55,62 @@ func (j *ContactsArray) Scan(value interface{}) error {
 func (j ContactsArray) Value() (driver.Value, error) {
 	return json.Marshal(j)
 }
+
+type LegalEntity struct {
+	UUID      uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	AgentUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Name    string `gorm:"type:varchar(250);default:'';not null;"`
+	INN     string `gorm:"column:inn;type:varchar(12);default:'';not null;"`
+	KPP     string `gorm:"column:kpp;type:varchar(9);default:'';not null;"`
+	OGRN    string `gorm:"column:ogrn;type:varchar(15);default:'';not null;"`
+	Address string `gorm:"type:varchar(500);default:'';not null;"`
+	Sort    int    `gorm:"type:int;default:0;not null;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (LegalEntity) TableName() string {
+	return "agent_legal_entities"
+}
+
+type AgentTask struct {
+	AgentUUID uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	TaskUUID  uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (AgentTask) TableName() string {
+	return "agent_tasks"
+}
+
+type Comment struct {
+	UUID      uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	AgentUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Text string `gorm:"type:text;default:'';not null;"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	Total int64 `gorm:"->"`
+}
+
+func (Comment) TableName() string {
+	return "agent_comments"
+}
+
+// TimelineTask - связанная задача для ленты агента
+type TimelineTask struct {
+	UUID      uuid.UUID
+	Name      string
+	Status    int
+	CreatedBy string
+	CreatedAt time.Time
+
+	Total int64
+}
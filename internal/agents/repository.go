@@ -2,7 +2,10 @@ package agents
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
+	"unicode"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -63,6 +66,29 @@ func (r *Repository) Get(_ context.Context, filter domain.AgentFilter) (dms []do
 		query = query.Where("name ilike ?", *filter.Name+"%")
 	}
 
+	if filter.Phone != nil {
+		phone := strings.Map(func(r rune) rune {
+			if unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, *filter.Phone)
+
+		if phone == "" {
+			return nil, -1, errors.New("телефон для поиска должен содержать цифры")
+		}
+
+		query = query.Where("case when jsonb_typeof(contacts) = 'array' then exists (select 1 from jsonb_array_elements(contacts) c "+
+			"where c->>'type' in ? and regexp_replace(c->>'val', '\\D', '', 'g') like ?) else false end",
+			[]string{domain.AgentContactPhone, domain.AgentContactWhatsApp, domain.AgentContactViber}, phone+"%")
+	}
+
+	if filter.Email != nil {
+		query = query.Where("case when jsonb_typeof(contacts) = 'array' then exists (select 1 from jsonb_array_elements(contacts) c "+
+			"where c->>'type' = ? and lower(c->>'val') like ?) else false end",
+			domain.AgentContactEmail, strings.ToLower(strings.TrimSpace(*filter.Email))+"%")
+	}
+
 	if filter.Limit != nil {
 		query = query.Limit(*filter.Limit)
 	} else {
@@ -96,29 +122,79 @@ func (r *Repository) Get(_ context.Context, filter domain.AgentFilter) (dms []do
 	}
 
 	dms = helpers.Map(orms, func(item Agent, i int) domain.Agent {
-		return domain.Agent{
-			UUID:           item.UUID,
-			FederationUUID: item.FederationUUID,
-			CompanyUUID:    item.CompanyUUID,
+		return toDomain(item)
+	})
 
-			CreatedBy:     item.CreatedBy,
-			CreatedByUUID: item.CreatedByUUID,
+	return dms, total, nil
+}
 
-			Name: item.Name,
-			Contacts: lo.Map(item.Contacts, func(c Contacts, _ int) domain.AgentContacts {
-				return domain.AgentContacts{
-					Type: c.Type,
-					Val:  c.Val,
-				}
-			}),
+// FindByContact ищет агента компании по контакту, например по email отправителя письма
+func (r *Repository) FindByContact(federationUUID, companyUUID uuid.UUID, contact domain.AgentContacts) (dm domain.Agent, err error) {
+	orm := Agent{}
+
+	filter, err := json.Marshal([]Contacts{{Type: contact.Type, Val: contact.Val}})
+	if err != nil {
+		return dm, err
+	}
 
-			CreatedAt: item.CreatedAt,
-			UpdatedAt: item.UpdatedAt,
-			DeletedAt: item.DeletedAt,
+	err = r.gorm.DB.
+		Where("federation_uuid = ?", federationUUID).
+		Where("company_uuid = ?", companyUUID).
+		Where("contacts @> ?", string(filter)).
+		Where("deleted_at is null").
+		Order("created_at").
+		First(&orm).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("агент не найден")
+	}
+	if err != nil {
+		return dm, err
+	}
+
+	return toDomain(orm), nil
+}
+
+// GetByUUID возвращает агента с юрлицами и связанными задачами
+func (r *Repository) GetByUUID(uid uuid.UUID) (dm domain.Agent, err error) {
+	orm := Agent{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("агент не найден")
+	}
+	if err != nil {
+		return dm, err
+	}
+
+	dm = toDomain(orm)
+
+	entities := []LegalEntity{}
+	err = r.gorm.DB.Where("agent_uuid = ?", uid).Order("sort").Find(&entities).Error
+	if err != nil {
+		return dm, err
+	}
+
+	dm.LegalEntities = lo.Map(entities, func(item LegalEntity, _ int) domain.AgentLegalEntity {
+		return domain.AgentLegalEntity{
+			UUID:      item.UUID,
+			AgentUUID: item.AgentUUID,
+			Name:      item.Name,
+			INN:       item.INN,
+			KPP:       item.KPP,
+			OGRN:      item.OGRN,
+			Address:   item.Address,
 		}
 	})
 
-	return dms, total, nil
+	err = r.gorm.DB.Model(&AgentTask{}).
+		Where("agent_uuid = ?", uid).
+		Order("created_at").
+		Pluck("task_uuid", &dm.TaskUUIDs).Error
+
+	return dm, err
 }
 
 func (r *Repository) Update(s *domain.Agent) error {
@@ -136,6 +212,290 @@ func (r *Repository) Update(s *domain.Agent) error {
 		}).Error
 }
 
+// SetLegalEntities заменяет юрлица агента
+func (r *Repository) SetLegalEntities(agentUUID uuid.UUID, dms []domain.AgentLegalEntity) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("agent_uuid = ?", agentUUID).Delete(&LegalEntity{}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(dms) == 0 {
+			return nil
+		}
+
+		return tx.Create(lo.Map(dms, func(item domain.AgentLegalEntity, i int) LegalEntity {
+			return LegalEntity{
+				UUID:      lo.Ternary(item.UUID == uuid.Nil, uuid.New(), item.UUID),
+				AgentUUID: agentUUID,
+				Name:      item.Name,
+				INN:       item.INN,
+				KPP:       item.KPP,
+				OGRN:      item.OGRN,
+				Address:   item.Address,
+				Sort:      i,
+			}
+		})).Error
+	})
+}
+
+// ScopeTasks - какие из задач uids есть у федерации агента и его компании, если она задана
+func (r *Repository) ScopeTasks(federationUUID uuid.UUID, companyUUID *uuid.UUID, uids []uuid.UUID) (found []uuid.UUID, err error) {
+	if len(uids) == 0 {
+		return found, nil
+	}
+
+	query := r.gorm.DB.Table("tasks").
+		Where("uuid in ?", uids).
+		Where("federation_uuid = ?", federationUUID).
+		Where("deleted_at is null")
+
+	if companyUUID != nil {
+		query = query.Where("company_uuid = ?", *companyUUID)
+	}
+
+	err = query.Pluck("uuid", &found).Error
+
+	return found, err
+}
+
+// SetTasks заменяет связанные с агентом задачи
+func (r *Repository) SetTasks(agentUUID uuid.UUID, taskUUIDs []uuid.UUID) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("agent_uuid = ?", agentUUID).Delete(&AgentTask{}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(taskUUIDs) == 0 {
+			return nil
+		}
+
+		return tx.Create(lo.Map(taskUUIDs, func(uid uuid.UUID, _ int) AgentTask {
+			return AgentTask{
+				AgentUUID: agentUUID,
+				TaskUUID:  uid,
+			}
+		})).Error
+	})
+}
+
+// TimelineTasks - последние limit связанных задач для ленты агента и их общее число
+func (r *Repository) TimelineTasks(agentUUID uuid.UUID, limit int) (orms []TimelineTask, total int64, err error) {
+	err = r.gorm.DB.Table("agent_tasks at").
+		Select("t.uuid, t.name, t.status, t.created_by, t.created_at, count(*) OVER() AS total").
+		Joins("join tasks t on t.uuid = at.task_uuid").
+		Where("at.agent_uuid = ?", agentUUID).
+		Where("t.deleted_at is null").
+		Order("t.created_at desc").
+		Limit(limit).
+		Scan(&orms).Error
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	return orms, total, err
+}
+
+func (r *Repository) CreateComment(dm domain.AgentComment) error {
+	return r.gorm.DB.Create(&Comment{
+		UUID:          dm.UUID,
+		AgentUUID:     dm.AgentUUID,
+		CreatedBy:     dm.CreatedBy,
+		CreatedByUUID: dm.CreatedByUUID,
+		Text:          dm.Text,
+	}).Error
+}
+
+func (r *Repository) GetComments(agentUUID uuid.UUID, offset, limit int) (dms []domain.AgentComment, total int64, err error) {
+	orms := []Comment{}
+
+	err = r.gorm.DB.
+		Select("*, count(*) OVER() AS total").
+		Where("agent_uuid = ?", agentUUID).
+		Where("deleted_at is null").
+		Order("created_at desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&orms).Error
+	if err != nil {
+		return dms, -1, err
+	}
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	return lo.Map(orms, func(item Comment, _ int) domain.AgentComment {
+		return domain.AgentComment{
+			UUID:          item.UUID,
+			AgentUUID:     item.AgentUUID,
+			CreatedBy:     item.CreatedBy,
+			CreatedByUUID: item.CreatedByUUID,
+			Text:          item.Text,
+			CreatedAt:     item.CreatedAt,
+		}
+	}), total, nil
+}
+
+func (r *Repository) DeleteComment(agentUUID, uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&Comment{}).
+		Where("uuid = ?", uid).
+		Where("agent_uuid = ?", agentUUID).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("комментарий не найден")
+	}
+
+	return res.Error
+}
+
+// DuplicateCandidates - живые агенты федерации, а если задана компания - только ее, с ИНН юрлиц
+func (r *Repository) DuplicateCandidates(federationUUID uuid.UUID, companyUUID *uuid.UUID) (dms []domain.Agent, err error) {
+	orms := []Agent{}
+
+	query := r.gorm.DB.
+		Where("federation_uuid = ?", federationUUID).
+		Where("deleted_at is null")
+
+	if companyUUID != nil {
+		query = query.Where("company_uuid = ?", *companyUUID)
+	}
+
+	err = query.Find(&orms).Error
+	if err != nil || len(orms) == 0 {
+		return dms, err
+	}
+
+	entities := []LegalEntity{}
+	err = r.gorm.DB.
+		Select("agent_uuid, inn").
+		Where("agent_uuid in ?", lo.Map(orms, func(item Agent, _ int) uuid.UUID { return item.UUID })).
+		Where("inn <> ''").
+		Find(&entities).Error
+	if err != nil {
+		return dms, err
+	}
+
+	inns := lo.GroupBy(entities, func(item LegalEntity) uuid.UUID { return item.AgentUUID })
+
+	dms = lo.Map(orms, func(item Agent, _ int) domain.Agent {
+		dm := toDomain(item)
+		dm.LegalEntities = lo.Map(inns[item.UUID], func(e LegalEntity, _ int) domain.AgentLegalEntity {
+			return domain.AgentLegalEntity{AgentUUID: e.AgentUUID, INN: e.INN}
+		})
+
+		return dm
+	})
+
+	return dms, nil
+}
+
+// Merge переносит на survivor задачи, сделки, комментарии, файлы, входящие письма и юрлица
+// с новыми ИНН от duplicate, записывает survivor контакты contacts и удаляет duplicate
+func (r *Repository) Merge(survivor, duplicate domain.Agent, contacts []domain.AgentContacts) (res domain.AgentMerge, err error) {
+	res = domain.AgentMerge{
+		SurvivorUUID:  survivor.UUID,
+		DuplicateUUID: duplicate.UUID,
+		DuplicateName: duplicate.Name,
+	}
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		del := tx.Model(&Agent{}).
+			Where("uuid = ?", duplicate.UUID).
+			Where("deleted_at is null").
+			Update("deleted_at", gorm.Expr("now()"))
+		if del.Error != nil {
+			return del.Error
+		}
+		if del.RowsAffected == 0 {
+			return dto.NotFoundErr("агент не найден")
+		}
+
+		upd := tx.Model(&Agent{}).
+			Where("uuid = ?", survivor.UUID).
+			Where("deleted_at is null").
+			Updates(map[string]interface{}{
+				"contacts": ContactsArray(lo.Map(contacts, func(c domain.AgentContacts, _ int) Contacts {
+					return Contacts{Type: c.Type, Val: c.Val}
+				})),
+				"updated_at": gorm.Expr("now()"),
+			})
+		if upd.Error != nil {
+			return upd.Error
+		}
+		if upd.RowsAffected == 0 {
+			return dto.NotFoundErr("агент не найден")
+		}
+
+		query := tx.Model(&LegalEntity{}).Where("agent_uuid = ?", duplicate.UUID)
+		if inns := lo.Map(survivor.LegalEntities, func(e domain.AgentLegalEntity, _ int) string { return e.INN }); len(inns) > 0 {
+			query = query.Where("inn not in ?", inns)
+		}
+
+		moved := query.Updates(map[string]interface{}{
+			"agent_uuid": survivor.UUID,
+			"sort":       gorm.Expr("sort + ?", len(survivor.LegalEntities)),
+		})
+		if moved.Error != nil {
+			return moved.Error
+		}
+		res.LegalEntities = moved.RowsAffected
+
+		moved = tx.Exec(`insert into agent_tasks (agent_uuid, task_uuid, created_at)
+			select ?, task_uuid, created_at from agent_tasks where agent_uuid = ?
+			on conflict do nothing`, survivor.UUID, duplicate.UUID)
+		if moved.Error != nil {
+			return moved.Error
+		}
+		res.Tasks = moved.RowsAffected
+
+		err := tx.Where("agent_uuid = ?", duplicate.UUID).Delete(&AgentTask{}).Error
+		if err != nil {
+			return err
+		}
+
+		move := func(count *int64, query *gorm.DB) error {
+			*count = query.RowsAffected
+			return query.Error
+		}
+
+		err = move(&res.Comments, tx.Model(&Comment{}).
+			Where("agent_uuid = ?", duplicate.UUID).
+			Where("deleted_at is null").
+			Update("agent_uuid", survivor.UUID))
+		if err != nil {
+			return err
+		}
+
+		err = move(&res.Deals, tx.Table("deals").
+			Where("agent_uuid = ?", duplicate.UUID).
+			Updates(map[string]interface{}{"agent_uuid": survivor.UUID, "updated_at": gorm.Expr("now()")}))
+		if err != nil {
+			return err
+		}
+
+		err = move(&res.Files, tx.Table("files").
+			Where("type = ?", "agent").
+			Where("type_uuid = ?", duplicate.UUID).
+			Where("deleted_at is null").
+			Update("type_uuid", survivor.UUID))
+		if err != nil {
+			return err
+		}
+
+		return move(&res.Emails, tx.Table("inbound_emails").
+			Where("agent_uuid = ?", duplicate.UUID).
+			Update("agent_uuid", survivor.UUID))
+	})
+
+	return res, err
+}
+
 func (r *Repository) Delete(uid uuid.UUID) (err error) {
 	res := r.gorm.DB.
 		Model(&Agent{}).
@@ -149,3 +509,27 @@ func (r *Repository) Delete(uid uuid.UUID) (err error) {
 
 	return res.Error
 }
+
+func toDomain(item Agent) domain.Agent {
+	return domain.Agent{
+		UUID:           item.UUID,
+		FederationUUID: item.FederationUUID,
+		CompanyUUID:    item.CompanyUUID,
+		ProjectUUID:    item.ProjectUUID,
+
+		CreatedBy:     item.CreatedBy,
+		CreatedByUUID: item.CreatedByUUID,
+
+		Name: item.Name,
+		Contacts: lo.Map(item.Contacts, func(c Contacts, _ int) domain.AgentContacts {
+			return domain.AgentContacts{
+				Type: c.Type,
+				Val:  c.Val,
+			}
+		}),
+
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+		DeletedAt: item.DeletedAt,
+	}
+}
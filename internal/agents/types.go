@@ -1,5 +1,8 @@
 package agents
 
+import "github.com/krisch/crm-backend/internal/activities"
+
 type Service struct {
 	repo *Repository
+	as   *activities.Service
 }
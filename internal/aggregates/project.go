@@ -81,6 +81,8 @@ func (s *Service) GetProject(_ context.Context, uid uuid.UUID) (dto.ProjectDTO,
 				DataType:           int(item.DataType),
 				RequiredOnStatuses: item.RequiredOnStatuses,
 				Style:              item.Style,
+				Rules:              item.Rules,
+				Options:            item.Options,
 				DataDesc:           item.FieldTypeDesc(),
 			}
 		}),
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/agents"
+	"github.com/samber/lo"
+)
+
+// AgentTimeline сводит в ленту агента связанные задачи, его сделки, смс на его телефоны,
+// комментарии и файлы. Каждый источник отдает offset+limit последних событий, total - сумма по источникам
+func (a *App) AgentTimeline(ctx context.Context, agent domain.Agent, offset, limit int) ([]domain.AgentTimelineItem, int64, error) {
+	n := offset + limit
+	var total int64
+
+	tasks, cnt, err := a.AgentsService.TimelineTasks(ctx, agent.UUID, n)
+	if err != nil {
+		return nil, -1, err
+	}
+	total += cnt
+
+	taskItems := lo.Map(tasks, func(item agents.TimelineTask, _ int) domain.AgentTimelineItem {
+		return domain.AgentTimelineItem{
+			Type:      domain.AgentTimelineTask,
+			UUID:      item.UUID,
+			At:        item.CreatedAt,
+			CreatedBy: item.CreatedBy,
+			Title:     item.Name,
+			Status:    strconv.Itoa(item.Status),
+		}
+	})
+
+	dealItems := []domain.AgentTimelineItem{}
+	smsItems := []domain.AgentTimelineItem{}
+
+	if agent.CompanyUUID != nil {
+		deals, cnt, err := a.DealsService.GetDeals(ctx, domain.DealFilter{
+			CompanyUUID: *agent.CompanyUUID,
+			AgentUUID:   &agent.UUID,
+			Limit:       n,
+		})
+		if err != nil {
+			return nil, -1, err
+		}
+		total += cnt
+
+		dealItems = lo.Map(deals, func(item domain.Deal, _ int) domain.AgentTimelineItem {
+			return domain.AgentTimelineItem{
+				Type:      domain.AgentTimelineDeal,
+				UUID:      item.UUID,
+				At:        item.UpdatedAt,
+				CreatedBy: item.CreatedBy,
+				Title:     item.Name,
+				Status:    strconv.Itoa(item.Status),
+			}
+		})
+
+		phones := lo.FilterMap(agent.Contacts, func(c domain.AgentContacts, _ int) (string, bool) {
+			return c.Val, c.Type == domain.AgentContactPhone
+		})
+
+		if len(phones) > 0 {
+			msgs, cnt, err := a.SMSService.GetSms(ctx, dto.SmsFilterDTO{
+				CompanyUUID: agent.CompanyUUID,
+				Phones:      phones,
+				Limit:       &n,
+			})
+			if err != nil {
+				return nil, -1, err
+			}
+			total += cnt
+
+			smsItems = lo.Map(msgs, func(item domain.Sms, _ int) domain.AgentTimelineItem {
+				return domain.AgentTimelineItem{
+					Type:      domain.AgentTimelineSms,
+					UUID:      item.UUID,
+					At:        item.CreatedAt,
+					CreatedBy: item.CreatedBy,
+					Title:     item.To,
+					Text:      item.Text,
+					Status:    item.Status,
+				}
+			})
+		}
+	}
+
+	comments, cnt, err := a.AgentsService.GetComments(ctx, agent.UUID, 0, n)
+	if err != nil {
+		return nil, -1, err
+	}
+	total += cnt
+
+	commentItems := lo.Map(comments, func(item domain.AgentComment, _ int) domain.AgentTimelineItem {
+		return domain.AgentTimelineItem{
+			Type:      domain.AgentTimelineComment,
+			UUID:      item.UUID,
+			At:        item.CreatedAt,
+			CreatedBy: item.CreatedBy,
+			Text:      item.Text,
+		}
+	})
+
+	files, err := a.S3PrivateService.GetAgentFiles(agent.FederationUUID, agent.UUID)
+	if err != nil {
+		return nil, -1, err
+	}
+	total += int64(len(files))
+
+	fileItems := lo.Map(files, func(item domain.File, _ int) domain.AgentTimelineItem {
+		createdBy := ""
+		if user, ok := a.DictionaryService.FindUserByUUID(item.CreatedBy); ok {
+			createdBy = user.Email
+		}
+
+		return domain.AgentTimelineItem{
+			Type:      domain.AgentTimelineFile,
+			UUID:      item.UUID,
+			At:        item.CreatedAt,
+			CreatedBy: createdBy,
+			Title:     item.Name,
+			Status:    item.ScanStatus,
+		}
+	})
+
+	return domain.MergeAgentTimeline(offset, limit, taskItems, dealItems, smsItems, commentItems, fileItems), total, nil
+}
@@ -0,0 +1,287 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/inbound"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	inboundContactType = "email"
+	inboundMaxText     = 5000
+)
+
+// inboundSender - от чьего имени создается задача или комментарий из письма.
+// Письма от пользователей с подтвержденным адресом создаются от них самих, остальные - от ответственного
+// за проект с пометкой об отправителе, а сам отправитель заводится агентом компании.
+type inboundSender struct {
+	Email string
+	UUID  uuid.UUID
+	Note  string
+
+	Result inbound.Result
+}
+
+// RunInbound принимает входящую почту: smtp-сервер или опрос maildir
+func (a *App) RunInbound(ctx context.Context) {
+	if !a.InboundService.Enabled() {
+		return
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(time.Second * 5)
+				a.RunInbound(ctx)
+			}
+		}()
+
+		err := a.InboundService.Serve(ctx)
+		if err != nil {
+			logrus.Error("inbound email error: ", err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second * 5):
+			}
+
+			a.RunInbound(ctx)
+		}
+	}()
+}
+
+// InboundTask создает задачу из письма на адрес проекта, вложения сохраняются в комментарий к ней
+func (a *App) InboundTask(ctx context.Context, m inbound.Message, addr inbound.Address) (inbound.Result, error) {
+	project, found := a.DictionaryService.FindProject(addr.UUID)
+	if !found {
+		return inbound.Result{}, domain.ErrProjectNotFound
+	}
+
+	responsible := ""
+	if project.ResponsibleBy != nil {
+		responsible = project.ResponsibleBy.Email
+	}
+
+	sender, err := a.inboundSender(ctx, m, project.FederationUUID, project.CompanyUUID, responsible)
+	if err != nil {
+		return inbound.Result{}, err
+	}
+
+	res := sender.Result
+
+	description := m.Text
+	if sender.Note != "" {
+		description = sender.Note + "\n\n" + description
+	}
+
+	task, err := domain.NewTask(
+		inboundTaskName(m.Subject),
+		project.FederationUUID,
+		project.CompanyUUID,
+		project.UUID,
+		sender.Email,
+		nil,
+		[]string{},
+
+		truncate(description, inboundMaxText),
+		[]string{},
+		[]string{},
+		"",
+		responsible,
+
+		0,
+
+		nil,
+		"",
+		"",
+
+		map[uuid.UUID][]string{},
+	)
+	if err != nil {
+		return res, err
+	}
+
+	id, err := a.TaskService.CreateTask(task)
+	if err != nil {
+		return res, err
+	}
+
+	res.TaskUUID = &task.UUID
+
+	if len(m.Attachments) > 0 {
+		comment := domain.NewComment(sender.Email, task.UUID, uuid.Nil, []string{}, "Вложения из письма")
+
+		err = a.TaskService.CreateComment(ctx, task.UUID, *comment)
+		if err != nil {
+			return res, err
+		}
+
+		res.CommentUUID = &comment.UUID
+
+		err = a.inboundAttachments(task, comment.UUID, sender.UUID, m.Attachments)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	if !m.Auto {
+		a.inboundAck(m, task, id)
+	}
+
+	return res, nil
+}
+
+// InboundReply добавляет ответ на уведомление комментарием к задаче, цитата исходного письма отрезается
+func (a *App) InboundReply(ctx context.Context, m inbound.Message, addr inbound.Address) (inbound.Result, error) {
+	task, err := a.TaskService.GetTask(ctx, addr.UUID, []string{})
+	if err != nil {
+		return inbound.Result{}, err
+	}
+
+	sender, err := a.inboundSender(ctx, m, task.FederationUUID, task.CompanyUUID, task.ResponsibleBy)
+	if err != nil {
+		return inbound.Result{}, err
+	}
+
+	res := sender.Result
+	res.TaskUUID = &task.UUID
+
+	text := inbound.StripQuoted(m.Text)
+	if utf8.RuneCountInString(text) < 2 {
+		if len(m.Attachments) == 0 {
+			return res, errors.New("пустой ответ")
+		}
+		text = "Вложения из письма"
+	}
+
+	if sender.Note != "" {
+		text = sender.Note + "\n\n" + text
+	}
+
+	comment := domain.NewComment(sender.Email, task.UUID, uuid.Nil, []string{}, truncate(text, inboundMaxText))
+
+	err = a.TaskService.CreateComment(ctx, task.UUID, *comment)
+	if err != nil {
+		return res, err
+	}
+
+	res.CommentUUID = &comment.UUID
+
+	return res, a.inboundAttachments(task, comment.UUID, sender.UUID, m.Attachments)
+}
+
+func (a *App) inboundSender(ctx context.Context, m inbound.Message, federationUUID, companyUUID uuid.UUID, responsible string) (inboundSender, error) {
+	// From легко подделать, поэтому пользователем отправитель считается, только если адрес подтвержден
+	if user, ok := a.DictionaryService.FindUser(m.From); ok && m.SenderVerified {
+		return inboundSender{
+			Email:  user.Email,
+			UUID:   user.UUID,
+			Result: inbound.Result{UserEmail: user.Email},
+		}, nil
+	}
+
+	owner, ok := a.DictionaryService.FindUser(responsible)
+	if responsible == "" || !ok {
+		return inboundSender{}, errors.New("письмо от неизвестного отправителя, а у проекта не задан ответственный")
+	}
+
+	contact := domain.AgentContacts{Type: inboundContactType, Val: m.From}
+
+	agent, err := a.AgentsService.FindByContact(ctx, federationUUID, companyUUID, contact)
+	if errors.As(err, &dto.NotFoundError{}) {
+		name := m.FromName
+		if name == "" {
+			name = m.From
+		}
+
+		agent = *domain.NewAgent(federationUUID, &companyUUID, domain.Me{UUID: owner.UUID, Email: owner.Email}, truncate(name, 100), []domain.AgentContacts{contact})
+		err = a.AgentsService.Create(ctx, &agent)
+	}
+	if err != nil {
+		return inboundSender{}, err
+	}
+
+	return inboundSender{
+		Email: owner.Email,
+		UUID:  owner.UUID,
+		Note:  fmt.Sprintf("Письмо от %s <%s>", agent.Name, m.From),
+
+		Result: inbound.Result{AgentUUID: &agent.UUID},
+	}, nil
+}
+
+// inboundAttachments сохраняет вложения письма файлами комментария
+func (a *App) inboundAttachments(task domain.Task, commentUUID, userUUID uuid.UUID, attachments []inbound.Attachment) error {
+	for _, att := range attachments {
+		name := filepath.Base(att.Name)
+		if name == "." || name == "/" {
+			name = "attachment"
+		}
+
+		storeFilePath := "/tmp/" + helpers.FakeString(10) + "-" + name
+
+		err := os.WriteFile(storeFilePath, att.Data, 0o600)
+		if err != nil {
+			return err
+		}
+
+		_, err = a.S3PrivateService.UploadTaskCommentFile(task.FederationUUID, task.UUID, commentUUID, name, storeFilePath, userUUID)
+		os.Remove(storeFilePath)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inboundAck отвечает отправителю номером задачи, ответ на это письмо станет комментарием
+func (a *App) inboundAck(m inbound.Message, task domain.Task, id int) {
+	message, err := a.EmailTemplates.Render(task.FederationUUID, "", emails.TemplateTaskAck, emails.TaskAckData{ID: id, Name: task.Name})
+	if err == nil {
+		err = a.EmailService.SendEmail([]string{m.From}, message.WithReplyTo(a.InboundService.Addresses.ReplyAddress(task.UUID, m.From)))
+	}
+
+	if err != nil {
+		logrus.WithField("task", task.UUID).Warn("inbound email ack: ", err)
+	}
+}
+
+func inboundTaskName(subject string) string {
+	name := strings.Join(strings.Fields(subject), " ")
+	if utf8.RuneCountInString(name) < 3 {
+		name = "Письмо без темы"
+	}
+
+	return truncate(name, 100)
+}
+
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+
+	// не разрезаем многобайтовый символ
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+
+	return s[:limit]
+}
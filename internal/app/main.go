@@ -2,10 +2,13 @@ package app
 
 import (
 	"context"
+	"errors"
 	"runtime/debug"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/agents"
 	"github.com/krisch/crm-backend/internal/aggregates"
 	"github.com/krisch/crm-backend/internal/cache"
@@ -13,12 +16,14 @@ import (
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/company"
 	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/deals"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/inbound"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/logs"
 	"github.com/krisch/crm-backend/internal/notifications"
@@ -26,6 +31,7 @@ import (
 	"github.com/krisch/crm-backend/internal/profile"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/search"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
 	"github.com/krisch/crm-backend/pkg/redis"
@@ -44,6 +50,7 @@ type App struct {
 	NotificationsService *notifications.Service
 	ProfileService       *profile.Service
 	EmailService         emails.IEmailsService
+	EmailTemplates       *emails.Templates
 	HealthService        *health.Service
 	FederationService    *federation.Service
 	TaskService          *task.Service
@@ -61,6 +68,9 @@ type App struct {
 	JWT                  jwt.IJWT
 	AgentsService        *agents.Service
 	PermissionsService   *permissions.Service
+	SearchService        *search.Service
+	InboundService       *inbound.Service
+	DealsService         *deals.Service
 
 	MetricsCounters *helpers.MetricsCounters
 }
@@ -154,15 +164,218 @@ func (a *App) Work(ctx context.Context, rds *redis.RDS) {
 	a.RedisSubscribe(ctx, rds, "update")
 	a.SyncDictionariesByTimeout()
 	a.SyncDictionariesByHook()
+	a.PollSmsStatuses(ctx)
+	a.RunSmsCampaigns(ctx)
+	a.RunEmailOutbox(ctx)
+	a.RunInbound(ctx)
+	a.RunUploadsCleanup(ctx)
+	a.RunPendingScans(ctx)
+}
+
+// RunEmailOutbox отправляет письма из очереди, повторы по расписанию backoff
+func (a *App) RunEmailOutbox(ctx context.Context) {
+	interval := time.Second * time.Duration(a.Options.EMAIL_OUTBOX_INTERVAL)
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(interval)
+				a.RunEmailOutbox(ctx)
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+
+			n, err := a.EmailService.ProcessOutbox(ctx)
+			if err != nil {
+				logrus.Error("email outbox error: ", err)
+				continue
+			}
+
+			if n > 0 {
+				logrus.Infof("email outbox: %d sent", n)
+			}
+		}
+	}()
+}
+
+// RunUploadsCleanup отменяет брошенные составные загрузки и удаляет их части из хранилища,
+// а также закрывает загрузки, застрявшие в завершении
+func (a *App) RunUploadsCleanup(ctx context.Context) {
+	interval := time.Hour
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(interval)
+				a.RunUploadsCleanup(ctx)
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+
+			n, err := a.S3PrivateService.CleanupUploads()
+			if err != nil {
+				logrus.Error("uploads cleanup error: ", err)
+				continue
+			}
+
+			if n > 0 {
+				logrus.Infof("uploads cleanup: %d closed", n)
+			}
+		}
+	}()
+}
+
+// RunPendingScans возвращает в обработку файлы, которые застряли без проверки антивирусом
+func (a *App) RunPendingScans(ctx context.Context) {
+	interval := time.Second * time.Duration(a.Options.AV_RESCAN_INTERVAL)
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(interval)
+				a.RunPendingScans(ctx)
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+
+			n, err := a.S3PrivateService.RequeuePendingScans(2 * interval)
+			if err != nil {
+				logrus.Error("pending scans error: ", err)
+				continue
+			}
+
+			if n > 0 {
+				logrus.Infof("pending scans: %d requeued", n)
+			}
+		}
+	}()
+}
+
+// PollSmsStatuses периодически запрашивает статусы доставки sms, для которых не пришел callback
+func (a *App) PollSmsStatuses(ctx context.Context) {
+	interval := time.Second * time.Duration(a.Options.SMS_STATUS_POLL_INTERVAL)
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(interval)
+				a.PollSmsStatuses(ctx)
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+
+			n, err := a.SMSService.PollStatuses(ctx, interval)
+			if err != nil {
+				logrus.Error("sms status poll error: ", err)
+				continue
+			}
+
+			if n > 0 {
+				logrus.Infof("sms status poll: %d updated", n)
+			}
+		}
+	}()
+}
+
+// SmsOptions - настройки sms провайдера компании
+func (a *App) SmsOptions(companyUUID uuid.UUID) (sms.Options, error) {
+	so, err := a.CompanyService.GetSmsOptions(companyUUID)
+	if err != nil {
+		return sms.Options{}, err
+	}
+
+	return sms.Options{
+		Provider: so.Provider,
+		API:      so.API,
+		From:     so.From,
+		Params:   so.Params,
+
+		RateLimit: so.RateLimit,
+	}, nil
 }
 
 func (a *App) Subscribe(_ context.Context) {
 	a.TaskService.OnTaskUpdatedOrCreated(func(uid uuid.UUID, people []string) error {
 		logrus.Info("task updated or created")
+
+		go func() {
+			if err := a.SearchService.IndexTask(context.Background(), uid); err != nil {
+				logrus.Error("search index task error: ", err)
+			}
+		}()
+
 		err := a.NotificationsService.CreateTaskState(uid, people)
 		return err
 	})
 
+	a.CatalogService.OnDataChanged(func(uid uuid.UUID) error {
+		return a.SearchService.IndexCatalogData(context.Background(), uid)
+	})
+
+	// задачи, поля которых изменились вместе со справочником или полем компании
+	taskChanged := func(uid uuid.UUID) error {
+		return a.TaskService.TaskWasUpdatedOrCreated(uid, []string{})
+	}
+	a.CatalogService.OnTaskChanged(taskChanged)
+	a.FederationService.OnTaskChanged(taskChanged)
+
+	a.S3PrivateService.OnFileProcessed(func(uid uuid.UUID) error {
+		// файлы агентов не относятся к задачам и в поиск не попадают
+		err := a.SearchService.IndexFile(context.Background(), uid)
+		if errors.As(err, &dto.NotFoundError{}) {
+			return nil
+		}
+
+		return err
+	})
+
+	a.SMSService.OnResolveOptions(a.SmsOptions)
+
+	a.InboundService.OnNewTask(a.InboundTask)
+	a.InboundService.OnReply(a.InboundReply)
+
+	a.TaskService.OnTaskStatusChanged(func(crt domain.Creator, task domain.Task) error {
+		go a.SendStatusSms(context.Background(), crt, task)
+		return nil
+	})
+
 	a.TaskService.OnOpenTask(func(uid uuid.UUID, email string) error {
 		logrus.Info("task was open")
 		err := a.NotificationsService.RemoveNotification(email, "task", uid)
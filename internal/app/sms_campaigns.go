@@ -0,0 +1,163 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxCampaignRecipients - сколько записей контрагентов или справочника берется в одну рассылку
+const MaxCampaignRecipients = 10000
+
+// SmsCampaignRecipients собирает получателей рассылки из контрагентов компании или записей справочника.
+// Для справочника номер берется из поля phoneField (имя или hash), иначе из первого поля, похожего на телефон.
+func (a *App) SmsCampaignRecipients(ctx context.Context, federationUUID, companyUUID uuid.UUID, source string, catalogUUID *uuid.UUID, phoneField string) ([]domain.SmsRecipient, error) {
+	switch source {
+	case sms.SourceAgents:
+		return a.agentRecipients(ctx, federationUUID, companyUUID)
+	case sms.SourceCatalog:
+		if catalogUUID == nil {
+			return nil, errors.New("не указан справочник")
+		}
+		return a.catalogRecipients(federationUUID, companyUUID, *catalogUUID, phoneField)
+	case sms.SourceCSV:
+		// получатели загружаются отдельно файлом
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("неизвестный источник получателей: %s", source)
+}
+
+func (a *App) agentRecipients(ctx context.Context, federationUUID, companyUUID uuid.UUID) ([]domain.SmsRecipient, error) {
+	limit := MaxCampaignRecipients
+
+	agents, _, err := a.AgentsService.Get(ctx, domain.AgentFilter{
+		FederationUUID: federationUUID,
+		CompanyUUID:    &companyUUID,
+		Limit:          &limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res []domain.SmsRecipient
+
+	for _, agent := range agents {
+		vars := map[string]string{"name": agent.Name}
+		for _, c := range agent.Contacts {
+			key := strings.ToLower(c.Type)
+			if _, ok := vars[key]; !ok {
+				vars[key] = c.Val
+			}
+		}
+
+		for _, c := range agent.Contacts {
+			if sms.IsPhoneKey(c.Type) {
+				res = append(res, domain.SmsRecipient{Phone: c.Val, Name: agent.Name, Vars: vars})
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (a *App) catalogRecipients(federationUUID, companyUUID, catalogUUID uuid.UUID, phoneField string) ([]domain.SmsRecipient, error) {
+	catalog, err := a.CatalogService.GetCatalog(catalogUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if catalog.CompanyUUID != companyUUID {
+		return nil, dto.NotFoundErr("справочник не найден")
+	}
+
+	fields, err := a.CatalogService.GetCatalogFields(catalogUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	phoneHash := ""
+	for _, f := range fields {
+		if phoneField != "" && (f.Name == phoneField || f.Hash == phoneField) {
+			phoneHash = f.Hash
+			break
+		}
+
+		if phoneField == "" && phoneHash == "" && sms.IsPhoneKey(f.Name) {
+			phoneHash = f.Hash
+		}
+	}
+
+	if phoneHash == "" {
+		return nil, errors.New("в справочнике нет поля с телефоном")
+	}
+
+	limit := MaxCampaignRecipients
+
+	data, _, err := a.CatalogService.GetData(dto.CatalogSearchDTO{
+		FederationUUID: federationUUID,
+		CompanyUUID:    companyUUID,
+		CatalogUUID:    catalogUUID,
+		Limit:          &limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(data, func(item domain.CatalogData, _ int) (domain.SmsRecipient, bool) {
+		phone, ok := item.Fields[phoneHash]
+		if !ok || phone == nil {
+			return domain.SmsRecipient{}, false
+		}
+
+		vars := map[string]string{}
+		for _, f := range fields {
+			if v, ok := item.Fields[f.Hash]; ok && v != nil {
+				vars[strings.ToLower(f.Name)] = fmt.Sprint(v)
+			}
+		}
+
+		return domain.SmsRecipient{Phone: fmt.Sprint(phone), Name: vars["name"], Vars: vars}, true
+	}), nil
+}
+
+// RunSmsCampaigns раз в sms.CampaignTick отправляет очередные порции запущенных рассылок
+func (a *App) RunSmsCampaigns(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(sms.CampaignTick)
+				a.RunSmsCampaigns(ctx)
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(sms.CampaignTick):
+			}
+
+			n, err := a.SMSService.ProcessCampaigns(ctx)
+			if err != nil {
+				logrus.Error("sms campaigns error: ", err)
+				continue
+			}
+
+			if n > 0 {
+				logrus.Infof("sms campaigns: %d sent", n)
+			}
+		}
+	}()
+}
@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// TaskSmsVars - значения плейсхолдеров задачи, статус подставляется названием из проекта
+func (a *App) TaskSmsVars(ctx context.Context, task domain.Task) (map[string]string, []dto.ProjectFieldDTO) {
+	status := domain.GetTaskStatuses()[task.Status]
+
+	project, err := a.AgregateService.GetProject(ctx, task.ProjectUUID)
+	if err == nil && project.Statuses != nil {
+		if st, ok := lo.Find(*project.Statuses, func(item dto.ProjectStatusDTO) bool {
+			return item.Number == task.Status
+		}); ok {
+			status = st.Name
+		}
+	}
+
+	fields, _ := a.DictionaryService.FindProjectFields(task.ProjectUUID)
+
+	return sms.TaskVars(task, status, fields), fields
+}
+
+// SendTaskSms отправляет по задаче шаблон tpl или текст text через провайдера компании
+// и записывает сообщение в ленту активности задачи. Без phone номер берется из полей задачи.
+func (a *App) SendTaskSms(ctx context.Context, crt domain.Creator, task domain.Task, tpl *domain.SmsTemplate, text, phone string) (domain.Sms, error) {
+	vars, fields := a.TaskSmsVars(ctx, task)
+
+	phoneField := ""
+	templateName := ""
+
+	var templateUUID *uuid.UUID
+
+	if tpl != nil {
+		text = tpl.Text
+		phoneField = tpl.PhoneField
+		templateName = tpl.Name
+		templateUUID = &tpl.UUID
+	}
+
+	text = strings.TrimSpace(sms.RenderTemplate(text, vars))
+	if text == "" {
+		return domain.Sms{}, errors.New("пустой текст sms")
+	}
+
+	if phone == "" {
+		phone = sms.TaskPhone(task, fields, phoneField)
+	}
+
+	phone, ok := sms.NormalizePhone(phone)
+	if !ok {
+		return domain.Sms{}, errors.New("не указан телефон получателя")
+	}
+
+	cmpny, f := a.DictionaryService.FindCompany(task.CompanyUUID)
+	if !f {
+		return domain.Sms{}, dto.NotFoundErr("компания не найдена")
+	}
+
+	opts, err := a.SmsOptions(cmpny.UUID)
+	if err != nil {
+		return domain.Sms{}, err
+	}
+
+	s := sms.NewCompanySms(phone, text, opts.From, crt.UUID, crt.Email, cmpny)
+	s.TaskUUID = &task.UUID
+	s.TemplateUUID = templateUUID
+
+	_, err = a.SMSService.Send(ctx, opts, s)
+	if err != nil {
+		return *s, err
+	}
+
+	return *s, a.TaskService.SmsWasSent(crt, task.UUID, *s, templateName)
+}
+
+// SendStatusSms отправляет шаблоны, привязанные к новому статусу задачи.
+// Ошибки только логируются: смена статуса не должна зависеть от отправки sms.
+func (a *App) SendStatusSms(ctx context.Context, crt domain.Creator, task domain.Task) {
+	tpls, err := a.SMSService.StatusTemplates(ctx, task, task.Status)
+	if err != nil {
+		logrus.Error("sms status templates error: ", err)
+		return
+	}
+
+	for i := range tpls {
+		_, err = a.SendTaskSms(ctx, crt, task, &tpls[i], "", "")
+		if err != nil {
+			logrus.WithField("task", task.UUID).WithField("template", tpls[i].UUID).Warn("sms by status: ", err)
+		}
+	}
+}
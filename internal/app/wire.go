@@ -13,12 +13,14 @@ import (
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/company"
 	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/deals"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/inbound"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/logs"
 	"github.com/krisch/crm-backend/internal/notifications"
@@ -26,6 +28,7 @@ import (
 	"github.com/krisch/crm-backend/internal/profile"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/search"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
 	"github.com/krisch/crm-backend/pkg/postgres"
@@ -41,6 +44,10 @@ func s3Conf(conf *configs.Configs) s3.Conf {
 		Location:        conf.CDN_PUBLIC_REGION,
 		UseSSL:          conf.CDN_PUBLIC_SSL,
 		PublicURL:       conf.CDN_PUBLIC_URL,
+		Backend:         conf.CDN_BACKEND,
+		Root:            conf.CDN_PUBLIC_FS_ROOT,
+		Secret:          conf.CDN_PUBLIC_FS_SECRET,
+		BackendURL:      conf.URL_BACKEND,
 	}
 }
 
@@ -54,6 +61,12 @@ func s3PrivateConf(conf *configs.Configs) s3.ConfPrivate {
 		UseSSL:          conf.CDN_PRIVATE_SSL,
 		PublicURL:       conf.CDN_PRIVATE_URL,
 		BackendURL:      conf.URL_BACKEND,
+		Backend:         conf.CDN_BACKEND,
+		Root:            conf.CDN_PRIVATE_FS_ROOT,
+		Secret:          conf.CDN_PRIVATE_FS_SECRET,
+		MaxUploadSize:   int64(conf.CDN_MAX_UPLOAD_SIZE) << 20,
+		Scanner:         conf.AV_SCANNER,
+		ClamdAddr:       conf.CLAMD_ADDR,
 	}
 }
 
@@ -113,6 +126,7 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 
 		emails.NewRepository,
 		emails.NewFromCreds,
+		emails.NewTemplates,
 
 		helpers.NewMetricsCounters,
 
@@ -130,6 +144,17 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 		catalogs.NewRepository,
 		catalogs.New,
 
+		search.NewRepository,
+		search.NewPostgresBackend,
+		wire.Bind(new(search.IBackend), new(*search.PostgresBackend)),
+		search.New,
+
+		inbound.NewRepository,
+		inbound.New,
+
+		deals.NewRepository,
+		deals.New,
+
 		NewApp,
 	)
 
@@ -146,6 +171,7 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	logService logs.ILogService,
 	profileService *profile.Service,
 	emailService emails.IEmailsService,
+	emailTemplates *emails.Templates,
 	federationService *federation.Service,
 	taskService *task.Service,
 	commentService *comments.Service,
@@ -162,6 +188,9 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	smsService *sms.Service,
 	agentsService *agents.Service,
 	permissionsService *permissions.Service,
+	searchService *search.Service,
+	inboundService *inbound.Service,
+	dealsService *deals.Service,
 ) *App {
 	w := &App{
 		Env:  conf.ENV,
@@ -178,6 +207,7 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.NotificationsService = notificationsService
 	w.LogService = logService
 	w.EmailService = emailService
+	w.EmailTemplates = emailTemplates
 
 	// JWT service
 	jwtService := jwt.New(conf.SOLT)
@@ -198,6 +228,7 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.CacheService = cacheService
 	w.FederationService = federationService
 	w.TaskService = taskService
+	w.TaskService.SetLinkHosts(conf.MARKDOWN_LINK_HOSTS)
 	w.CommentService = commentService
 	w.RemindersService = remindersService
 	w.CatalogService = catalogService
@@ -206,6 +237,9 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.SMSService = smsService
 	w.AgentsService = agentsService
 	w.PermissionsService = permissionsService
+	w.SearchService = searchService
+	w.InboundService = inboundService
+	w.DealsService = dealsService
 
 	return w
 }
@@ -15,12 +15,14 @@ import (
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/company"
 	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/deals"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/inbound"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/logs"
 	"github.com/krisch/crm-backend/internal/notifications"
@@ -28,6 +30,7 @@ import (
 	"github.com/krisch/crm-backend/internal/profile"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/search"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
 	"github.com/krisch/crm-backend/pkg/postgres"
@@ -52,7 +55,10 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	dictionaryRepository := dictionary.NewRepository(gdb, rds, metricsCounters)
 	conf := s3Conf(configsConfigs)
 	s3Repository := s3.NewRepository(gdb)
-	s3Service := s3.New(conf, s3Repository)
+	s3Service, err := s3.New(conf, s3Repository)
+	if err != nil {
+		return nil, err
+	}
 	dictionaryService := dictionary.New(dictionaryRepository, metricsCounters, s3Service)
 	profileRepository := profile.NewRepository(gdb, rds, metricsCounters)
 	profileService := profile.New(configsConfigs, profileRepository, s3Service, dictionaryService)
@@ -63,7 +69,10 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	activitiesService := activities.New(activitiesRepository, dictionaryService)
 	commentsRepository := comments.NewRepository(gdb, rds, metricsCounters, cacheService)
 	confPrivate := s3PrivateConf(configsConfigs)
-	servicePrivate := s3.NewPrivate(confPrivate, s3Repository, cacheService)
+	servicePrivate, err := s3.NewPrivate(confPrivate, s3Repository, cacheService)
+	if err != nil {
+		return nil, err
+	}
 	commentsService := comments.New(commentsRepository, dictionaryService, servicePrivate, activitiesService)
 	taskService := task.New(taskRepository, dictionaryService, activitiesService, profileService, commentsService, servicePrivate)
 	remindersRepository := reminders.NewRepository(gdb)
@@ -81,6 +90,7 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	if err != nil {
 		return nil, err
 	}
+	templates := emails.NewTemplates(configsConfigs, emailRepository)
 	gatesRepository := gates.NewRepository(gdb, rds)
 	gatesService := gates.New(gatesRepository, dictionaryService)
 	companyRepository := company.NewRepository(gdb, rds, cacheService)
@@ -88,10 +98,20 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	smsRepository := sms.NewRepository(gdb)
 	smsService := sms.New(smsRepository)
 	agentsRepository := agents.NewRepository(gdb)
-	agentsService := agents.New(agentsRepository)
+	agentsService := agents.New(agentsRepository, activitiesService)
 	permissionsRepository := permissions.NewRepository(gdb, rds)
 	permissionsService := permissions.New(permissionsRepository)
-	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, federationService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService)
+	searchRepository := search.NewRepository(gdb, metricsCounters)
+	postgresBackend := search.NewPostgresBackend(gdb)
+	searchService := search.New(searchRepository, postgresBackend, dictionaryService)
+	inboundRepository := inbound.NewRepository(gdb)
+	inboundService, err := inbound.New(inboundRepository, configsConfigs)
+	if err != nil {
+		return nil, err
+	}
+	dealsRepository := deals.NewRepository(gdb)
+	dealsService := deals.New(dealsRepository, activitiesService)
+	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, templates, federationService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService, searchService, inboundService, dealsService)
 	return app, nil
 }
 
@@ -106,6 +126,10 @@ func s3Conf(conf *configs.Configs) s3.Conf {
 		Location:        conf.CDN_PUBLIC_REGION,
 		UseSSL:          conf.CDN_PUBLIC_SSL,
 		PublicURL:       conf.CDN_PUBLIC_URL,
+		Backend:         conf.CDN_BACKEND,
+		Root:            conf.CDN_PUBLIC_FS_ROOT,
+		Secret:          conf.CDN_PUBLIC_FS_SECRET,
+		BackendURL:      conf.URL_BACKEND,
 	}
 }
 
@@ -119,6 +143,12 @@ func s3PrivateConf(conf *configs.Configs) s3.ConfPrivate {
 		UseSSL:          conf.CDN_PRIVATE_SSL,
 		PublicURL:       conf.CDN_PRIVATE_URL,
 		BackendURL:      conf.URL_BACKEND,
+		Backend:         conf.CDN_BACKEND,
+		Root:            conf.CDN_PRIVATE_FS_ROOT,
+		Secret:          conf.CDN_PRIVATE_FS_SECRET,
+		MaxUploadSize:   int64(conf.CDN_MAX_UPLOAD_SIZE) << 20,
+		Scanner:         conf.AV_SCANNER,
+		ClamdAddr:       conf.CLAMD_ADDR,
 	}
 }
 
@@ -132,6 +162,7 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	logService logs.ILogService,
 	profileService *profile.Service,
 	emailService emails.IEmailsService,
+	emailTemplates *emails.Templates,
 	federationService *federation.Service,
 	taskService *task.Service,
 	commentService *comments.Service,
@@ -148,6 +179,9 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	smsService *sms.Service,
 	agentsService *agents.Service,
 	permissionsService *permissions.Service,
+	searchService *search.Service,
+	inboundService *inbound.Service,
+	dealsService *deals.Service,
 ) *App {
 	w := &App{
 		Env:  conf.ENV,
@@ -163,6 +197,7 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.NotificationsService = notificationsService
 	w.LogService = logService
 	w.EmailService = emailService
+	w.EmailTemplates = emailTemplates
 
 	jwtService := jwt.New(conf.SOLT)
 	jwtService.SetRefreshTokenValidator(func(token string) (bool, error) {
@@ -182,6 +217,7 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.CacheService = cacheService
 	w.FederationService = federationService
 	w.TaskService = taskService
+	w.TaskService.SetLinkHosts(conf.MARKDOWN_LINK_HOSTS)
 	w.CommentService = commentService
 	w.RemindersService = remindersService
 	w.CatalogService = catalogService
@@ -190,6 +226,9 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.SMSService = smsService
 	w.AgentsService = agentsService
 	w.PermissionsService = permissionsService
+	w.SearchService = searchService
+	w.InboundService = inboundService
+	w.DealsService = dealsService
 
 	return w
 }
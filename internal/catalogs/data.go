@@ -0,0 +1,221 @@
+package catalogs
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+)
+
+func (s *Service) GetDataRow(catalogUUID, uid uuid.UUID) (dm domain.CatalogData, err error) {
+	orm, err := s.repo.GetDataRow(uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if orm.CatalogUUID != catalogUUID {
+		return dm, dto.NotFoundErr("запись справочника не найдена")
+	}
+
+	return domain.CatalogData{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		CatalogUUID:    orm.CatalogUUID,
+		Fields:         orm.Fields,
+
+		CreatedBy:     orm.CreatedBy,
+		CreatedByUUID: orm.CreatedByUUID,
+
+		CreatedAt: orm.CreatedAt,
+		UpdatedAt: orm.UpdatedAt,
+	}, nil
+}
+
+// PutData полностью заменяет поля строки, поля не переданные в raw очищаются
+func (s *Service) PutData(catalogUUID, uid uuid.UUID, raw map[string]interface{}, crtr domain.Creator) (dd CatalogData, err error) {
+	dm, err := s.GetDataRow(catalogUUID, uid)
+	if err != nil {
+		return dd, err
+	}
+
+	dm.RawFields = raw
+
+	return s.updateData(dm, crtr)
+}
+
+// PatchData меняет только переданные поля, null удаляет значение поля
+func (s *Service) PatchData(catalogUUID, uid uuid.UUID, raw map[string]interface{}, crtr domain.Creator) (dd CatalogData, err error) {
+	dm, err := s.GetDataRow(catalogUUID, uid)
+	if err != nil {
+		return dd, err
+	}
+
+	fields, err := s.GetCatalogFields(catalogUUID)
+	if err != nil {
+		return dd, err
+	}
+
+	dm.RawFields = MergeFields(dm.Fields, raw, fields)
+
+	return s.updateData(dm, crtr)
+}
+
+func (s *Service) updateData(dm domain.CatalogData, crtr domain.Creator) (dd CatalogData, err error) {
+	err = s.FilterCatalogFields(&dm)
+	if err != nil {
+		return dd, err
+	}
+
+	dd, changed, err := s.repo.UpdateData(dm, crtr)
+	if err == nil && changed {
+		s.dataChanged(dd.UUID)
+	}
+
+	return dd, err
+}
+
+func (s *Service) DeleteData(catalogUUID, uid uuid.UUID, crtr domain.Creator) error {
+	_, err := s.GetDataRow(catalogUUID, uid)
+	if err != nil {
+		return err
+	}
+
+	deleted, updated, tasks, err := s.repo.DeleteData(uid, crtr)
+	if err != nil {
+		return err
+	}
+
+	for _, changed := range append(deleted, updated...) {
+		s.dataChanged(changed)
+	}
+
+	for _, t := range tasks {
+		s.taskChanged(t)
+	}
+
+	return nil
+}
+
+func (s *Service) GetDataHistory(catalogUUID, uid uuid.UUID) ([]domain.CatalogDataHistory, error) {
+	history, err := s.repo.GetDataHistory(uid)
+	if err != nil {
+		return history, err
+	}
+
+	if len(history) > 0 && history[0].CatalogUUID != catalogUUID {
+		return history, dto.NotFoundErr("запись справочника не найдена")
+	}
+
+	return history, nil
+}
+
+// MergeFields накладывает patch на текущие значения строки. Значения полей,
+// которых уже нет в справочнике, и вычисляемых полей отбрасываются, null в patch удаляет поле
+func MergeFields(current, patch map[string]interface{}, fields []domain.CatalogFiled) map[string]interface{} {
+	merged := make(map[string]interface{}, len(fields))
+
+	for _, f := range fields {
+		if f.DataType == domain.Formula {
+			continue
+		}
+
+		if v, ok := current[f.Hash]; ok && v != nil {
+			merged[f.Hash] = v
+		}
+	}
+
+	for k, v := range patch {
+		if v == nil {
+			delete(merged, k)
+			continue
+		}
+
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// FillFields дополняет значения строки current значениями дубля other в тех полях,
+// которые у current пустые. Вычисляемые поля не переносятся, они пересчитываются при записи
+func FillFields(current, other map[string]interface{}, fields []domain.CatalogFiled) map[string]interface{} {
+	filled := make(map[string]interface{}, len(fields))
+	for k, v := range current {
+		filled[k] = v
+	}
+
+	for _, f := range fields {
+		if f.DataType == domain.Formula || !isEmptyValue(filled[f.Hash]) || isEmptyValue(other[f.Hash]) {
+			continue
+		}
+
+		filled[f.Hash] = other[f.Hash]
+	}
+
+	return filled
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+// DiffFields возвращает изменившиеся поля в виде hash -> {old, new}.
+// Значения сравниваются после приведения к json, чтобы 1 и 1.0 или uuid и строка совпадали
+func DiffFields(prev, next map[string]interface{}) JSONB {
+	changes := JSONB{}
+
+	for k, nv := range next {
+		ov, ok := prev[k]
+		if ok && jsonEqual(ov, nv) {
+			continue
+		}
+
+		changes[k] = map[string]interface{}{"old": ov, "new": nv}
+	}
+
+	for k, ov := range prev {
+		if _, ok := next[k]; !ok {
+			changes[k] = map[string]interface{}{"old": ov, "new": nil}
+		}
+	}
+
+	return changes
+}
+
+func jsonEqual(a, b interface{}) bool {
+	an, err := toJSONValue(a)
+	if err != nil {
+		return false
+	}
+
+	bn, err := toJSONValue(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(an, bn)
+}
+
+func toJSONValue(v interface{}) (res interface{}, err error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return res, err
+	}
+
+	err = json.Unmarshal(b, &res)
+
+	return res, err
+}
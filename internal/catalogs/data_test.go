@@ -0,0 +1,69 @@
+package catalogs
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestMergeFields(t *testing.T) {
+	fields := []domain.CatalogFiled{{Hash: "a"}, {Hash: "b"}, {Hash: "c"}}
+
+	current := map[string]interface{}{"a": "x", "b": float64(1), "gone": "y"}
+	patch := map[string]interface{}{"b": nil, "c": true}
+
+	merged := MergeFields(current, patch, fields)
+
+	if len(merged) != 2 || merged["a"] != "x" || merged["c"] != true {
+		t.Errorf("unexpected merge: %v", merged)
+	}
+}
+
+func TestFillFields(t *testing.T) {
+	fields := []domain.CatalogFiled{{Hash: "a"}, {Hash: "b"}, {Hash: "c"}, {Hash: "d"}, {Hash: "f", DataType: domain.Formula}}
+
+	current := map[string]interface{}{"a": "x", "b": " ", "d": []interface{}{}}
+	other := map[string]interface{}{"a": "y", "b": "z", "c": float64(2), "d": []interface{}{"q"}, "f": float64(5)}
+
+	filled := FillFields(current, other, fields)
+
+	if filled["a"] != "x" || filled["b"] != "z" || filled["c"] != float64(2) || len(filled["d"].([]interface{})) != 1 {
+		t.Errorf("unexpected fill: %v", filled)
+	}
+
+	if _, ok := filled["f"]; ok {
+		t.Errorf("formula should not be filled: %v", filled)
+	}
+
+	if current["b"] != " " {
+		t.Errorf("current should not change: %v", current)
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	uid := uuid.New()
+
+	prev := map[string]interface{}{"a": float64(1), "b": uid.String(), "c": "old", "d": []interface{}{"x"}}
+	next := map[string]interface{}{"a": 1, "b": uid, "c": "new", "d": []string{"x"}, "e": false}
+
+	changes := DiffFields(prev, next)
+
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %v", changes)
+	}
+
+	c, ok := changes["c"].(map[string]interface{})
+	if !ok || c["old"] != "old" || c["new"] != "new" {
+		t.Errorf("unexpected change for c: %v", changes["c"])
+	}
+
+	if _, ok := changes["e"]; !ok {
+		t.Errorf("added field e not in changes")
+	}
+
+	removed := DiffFields(next, map[string]interface{}{})
+	if len(removed) != len(next) {
+		t.Errorf("expected all fields removed, got %v", removed)
+	}
+}
@@ -0,0 +1,253 @@
+package catalogs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/samber/lo"
+)
+
+// Названия полей с именем записи после DuplicateNameKey: name, название, наименование, фио, имя
+var nameFieldKeys = []string{"name", "nazvanie", "naimenovanie", "fio", "imia"}
+
+// DuplicateFields - поля справочника, по которым ищутся дубли
+type DuplicateFields struct {
+	Name   *domain.CatalogFiled
+	Phones []domain.CatalogFiled
+	Emails []domain.CatalogFiled
+	INNs   []domain.CatalogFiled
+}
+
+// NewDuplicateFields выбирает поля для поиска дублей: телефоны и email по типу поля, ИНН - строковые
+// и числовые поля со словом "ИНН" в названии. Имя - поле с названием "Название", "Наименование",
+// "ФИО" и т.п., а если такого нет - первое строковое поле
+func NewDuplicateFields(fields []domain.CatalogFiled) (df DuplicateFields) {
+	names := []domain.CatalogFiled{}
+
+	for _, f := range fields {
+		key := domain.DuplicateNameKey(f.Name)
+
+		switch {
+		case f.DataType == domain.Phone:
+			df.Phones = append(df.Phones, f)
+		case f.DataType == domain.Email:
+			df.Emails = append(df.Emails, f)
+		case (f.DataType == domain.String || f.DataType == domain.Integer) && lo.Contains(strings.Fields(key), "inn"):
+			df.INNs = append(df.INNs, f)
+		case f.DataType == domain.String:
+			names = append(names, f)
+		}
+	}
+
+	if name, ok := lo.Find(names, func(f domain.CatalogFiled) bool {
+		return lo.Contains(nameFieldKeys, domain.DuplicateNameKey(f.Name))
+	}); ok {
+		df.Name = &name
+	} else if len(names) > 0 {
+		df.Name = &names[0]
+	}
+
+	return df
+}
+
+// Keys - признаки строки для поиска дублей
+func (df DuplicateFields) Keys(values map[string]interface{}) domain.DuplicateKeys {
+	keys := domain.DuplicateKeys{
+		Name: domain.DuplicateNameKey(df.Title(values)),
+	}
+
+	for _, f := range df.Phones {
+		if phone, ok := sms.NormalizePhone(stringValue(values[f.Hash])); ok {
+			keys.Phones = append(keys.Phones, phone)
+		}
+	}
+
+	for _, f := range df.Emails {
+		if email := strings.ToLower(strings.TrimSpace(stringValue(values[f.Hash]))); email != "" {
+			keys.Emails = append(keys.Emails, email)
+		}
+	}
+
+	for _, f := range df.INNs {
+		inn := innDigits(values[f.Hash])
+		if len(inn) == 10 || len(inn) == 12 {
+			keys.INNs = append(keys.INNs, inn)
+		}
+	}
+
+	keys.Phones = lo.Uniq(keys.Phones)
+	keys.Emails = lo.Uniq(keys.Emails)
+	keys.INNs = lo.Uniq(keys.INNs)
+
+	return keys
+}
+
+// Title - значение поля с именем записи
+func (df DuplicateFields) Title(values map[string]interface{}) string {
+	if df.Name == nil {
+		return ""
+	}
+
+	return stringValue(values[df.Name.Hash])
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func innDigits(v interface{}) string {
+	var s string
+
+	switch v := v.(type) {
+	case string:
+		s = v
+	case float64:
+		s = fmt.Sprintf("%.0f", v)
+	case int, int64:
+		s = fmt.Sprint(v)
+	}
+
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// DataDuplicates ищет дубли строки среди строк ее справочника
+func (s *Service) DataDuplicates(catalogUUID, uid uuid.UUID, minScore int) ([]domain.CatalogDataDuplicate, error) {
+	dm, err := s.GetDataRow(catalogUUID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	df, rows, err := s.duplicateRows(catalogUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := lo.MapValues(rows, func(row domain.CatalogData, _ uuid.UUID) domain.DuplicateKeys { return df.Keys(row.Fields) })
+	pairs := domain.FindDuplicates(uid, df.Keys(dm.Fields), keys, minScore)
+
+	rows[uid] = dm
+
+	return toDataDuplicates(pairs, rows, df), nil
+}
+
+// AllDataDuplicates находит пары похожих строк справочника
+func (s *Service) AllDataDuplicates(catalogUUID uuid.UUID, minScore int) ([]domain.CatalogDataDuplicate, error) {
+	df, rows, err := s.duplicateRows(catalogUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := lo.MapValues(rows, func(row domain.CatalogData, _ uuid.UUID) domain.DuplicateKeys { return df.Keys(row.Fields) })
+
+	return toDataDuplicates(domain.DuplicatePairs(keys, minScore), rows, df), nil
+}
+
+func (s *Service) duplicateRows(catalogUUID uuid.UUID) (df DuplicateFields, rows map[uuid.UUID]domain.CatalogData, err error) {
+	fields, err := s.GetCatalogFields(catalogUUID)
+	if err != nil {
+		return df, rows, err
+	}
+
+	orms, err := s.repo.GetCatalogRows(catalogUUID)
+	if err != nil {
+		return df, rows, err
+	}
+
+	rows = make(map[uuid.UUID]domain.CatalogData, len(orms))
+	for _, orm := range orms {
+		rows[orm.UUID] = domain.CatalogData{
+			UUID:           orm.UUID,
+			FederationUUID: orm.FederationUUID,
+			CompanyUUID:    orm.CompanyUUID,
+			CatalogUUID:    orm.CatalogUUID,
+			Fields:         orm.Fields,
+			CreatedBy:      orm.CreatedBy,
+			CreatedByUUID:  orm.CreatedByUUID,
+			CreatedAt:      orm.CreatedAt,
+			UpdatedAt:      orm.UpdatedAt,
+		}
+	}
+
+	return NewDuplicateFields(fields), rows, nil
+}
+
+func toDataDuplicates(pairs []domain.DuplicatePair, rows map[uuid.UUID]domain.CatalogData, df DuplicateFields) []domain.CatalogDataDuplicate {
+	return lo.Map(pairs, func(p domain.DuplicatePair, _ int) domain.CatalogDataDuplicate {
+		return domain.CatalogDataDuplicate{
+			Data:           rows[p.UUID],
+			Duplicate:      rows[p.DuplicateUUID],
+			Title:          df.Title(rows[p.UUID].Fields),
+			DuplicateTitle: df.Title(rows[p.DuplicateUUID].Fields),
+			Score:          p.Score,
+			Matches:        p.Matches,
+		}
+	})
+}
+
+// MergeData сливает строку duplicate в survivor: пустые поля survivor заполняются значениями дубля,
+// ссылки других строк и задач на дубль переводятся на survivor, дубль удаляется.
+// Поля собираются из строк, заблокированных в транзакции, чтобы не потерять их параллельные изменения.
+// В историю обеих строк пишется действие merge
+func (s *Service) MergeData(catalogUUID, survivorUUID, duplicateUUID uuid.UUID, crtr domain.Creator) (dd CatalogData, err error) {
+	if survivorUUID == duplicateUUID {
+		return dd, errors.New("нельзя объединить запись с самой собой")
+	}
+
+	_, err = s.GetDataRow(catalogUUID, survivorUUID)
+	if err != nil {
+		return dd, err
+	}
+
+	_, err = s.GetDataRow(catalogUUID, duplicateUUID)
+	if err != nil {
+		return dd, err
+	}
+
+	fields, err := s.GetCatalogFields(catalogUUID)
+	if err != nil {
+		return dd, err
+	}
+
+	merge := func(survivor, duplicate CatalogData) (domain.CatalogData, error) {
+		raw := JSONB(FillFields(survivor.Fields, duplicate.Fields, fields))
+		for _, f := range fields {
+			if f.DataCatalogUUID != nil && *f.DataCatalogUUID == catalogUUID {
+				raw = RemoveRef(raw, f.Hash, duplicateUUID)
+			}
+		}
+
+		dm := domain.CatalogData{
+			CatalogUUID: survivor.CatalogUUID,
+			Fields:      survivor.Fields,
+			RawFields:   raw,
+		}
+
+		return dm, s.FilterCatalogFields(&dm)
+	}
+
+	dd, updated, tasks, err := s.repo.MergeData(survivorUUID, duplicateUUID, crtr, merge)
+	if err != nil {
+		return dd, err
+	}
+
+	for _, changed := range append([]uuid.UUID{survivorUUID, duplicateUUID}, updated...) {
+		s.dataChanged(changed)
+	}
+
+	for _, t := range tasks {
+		s.taskChanged(t)
+	}
+
+	return dd, nil
+}
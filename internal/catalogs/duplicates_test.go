@@ -0,0 +1,42 @@
+package catalogs
+
+import (
+	"testing"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestDuplicateFieldsKeys(t *testing.T) {
+	fields := []domain.CatalogFiled{
+		{Hash: "code", Name: "Код", DataType: domain.String},
+		{Hash: "title", Name: "Наименование", DataType: domain.String},
+		{Hash: "inn", Name: "ИНН клиента", DataType: domain.String},
+		{Hash: "phone", Name: "Телефон", DataType: domain.Phone},
+		{Hash: "mail", Name: "Почта", DataType: domain.Email},
+	}
+
+	df := NewDuplicateFields(fields)
+	if df.Name == nil || df.Name.Hash != "title" || len(df.INNs) != 1 || len(df.Phones) != 1 || len(df.Emails) != 1 {
+		t.Fatalf("unexpected fields: %+v", df)
+	}
+
+	keys := df.Keys(map[string]interface{}{
+		"code":  "A-1",
+		"title": "ООО «Ромашка»",
+		"inn":   "7707 083 893",
+		"phone": "8 (900) 123-45-67",
+		"mail":  " Info@Romashka.ru ",
+	})
+
+	if keys.Name != "romashka" || keys.INNs[0] != "7707083893" || keys.Phones[0] != "79001234567" || keys.Emails[0] != "info@romashka.ru" {
+		t.Errorf("unexpected keys: %+v", keys)
+	}
+
+	if keys := df.Keys(map[string]interface{}{"inn": "123", "phone": nil}); len(keys.INNs) != 0 || len(keys.Phones) != 0 {
+		t.Errorf("invalid values should be skipped: %+v", keys)
+	}
+
+	if df := NewDuplicateFields(fields[:1]); df.Name == nil || df.Name.Hash != "code" {
+		t.Errorf("first string field should be the name: %+v", df)
+	}
+}
@@ -0,0 +1,37 @@
+package catalogs
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *Service) OnDataChanged(fn func(uuid.UUID) error) {
+	s.onDataChanged = fn
+}
+
+func (s *Service) dataChanged(uid uuid.UUID) {
+	if s.onDataChanged == nil {
+		return
+	}
+
+	err := s.onDataChanged(uid)
+	if err != nil {
+		logrus.Error("onDataChanged error: ", err)
+	}
+}
+
+// OnTaskChanged вызывается для задач, ссылки которых на строки справочника изменились
+func (s *Service) OnTaskChanged(fn func(uuid.UUID) error) {
+	s.onTaskChanged = fn
+}
+
+func (s *Service) taskChanged(uid uuid.UUID) {
+	if s.onTaskChanged == nil {
+		return
+	}
+
+	err := s.onTaskChanged(uid)
+	if err != nil {
+		logrus.Error("onTaskChanged error: ", err)
+	}
+}
@@ -0,0 +1,171 @@
+package catalogs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/formula"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// formulas - скомпилированные формулы справочника и кэш строк, на которые они ссылаются
+type formulas struct {
+	repo    *Repository
+	program *formula.Program
+	fields  map[string]domain.CatalogFiled
+	rows    map[uuid.UUID]CatalogData
+}
+
+// formulaFields собирает поля справочника для формул. Через поле data доступны
+// обычные поля связанного справочника, вычисляемые поля другого справочника не раскрываются
+func (s *Service) formulaFields(fields []domain.CatalogFiled) (res map[string]formula.Field, err error) {
+	res = map[string]formula.Field{}
+
+	for _, f := range fields {
+		t, ok := formula.FieldType(f.DataType)
+		if !ok {
+			continue
+		}
+
+		if t == formula.TypeRef {
+			if f.DataCatalogUUID == nil {
+				continue
+			}
+
+			refFields, err := s.GetCatalogFields(*f.DataCatalogUUID)
+			if err != nil {
+				return res, err
+			}
+
+			ref := map[string]formula.Type{}
+			for _, rf := range refFields {
+				if rt, ok := formula.FieldType(rf.DataType); ok && rt != formula.TypeRef {
+					ref[rf.Hash] = rt
+				}
+			}
+
+			res[f.Hash] = formula.Field{Type: t, Ref: ref}
+			continue
+		}
+
+		res[f.Hash] = formula.Field{Type: t}
+	}
+
+	return res, nil
+}
+
+func formulaDefinitions(fields []domain.CatalogFiled) []formula.Definition {
+	return lo.FilterMap(fields, func(f domain.CatalogFiled, _ int) (formula.Definition, bool) {
+		return formula.Definition{Hash: f.Hash, Formula: f.Formula}, f.DataType == domain.Formula
+	})
+}
+
+// checkFormula проверяет формулу поля pf вместе с остальными полями справочника
+func (s *Service) checkFormula(pf *domain.CatalogFiled) error {
+	fields, err := s.GetCatalogFields(pf.CatalogUUID)
+	if err != nil {
+		return err
+	}
+
+	fields = lo.Filter(fields, func(f domain.CatalogFiled, _ int) bool {
+		return f.UUID != pf.UUID && f.Hash != pf.Hash
+	})
+
+	ff, err := s.formulaFields(fields)
+	if err != nil {
+		return err
+	}
+
+	p := formula.Compile(ff, append(formulaDefinitions(fields), formula.Definition{Hash: pf.Hash, Formula: pf.Formula}))
+	if err := p.Errors[pf.Hash]; err != nil {
+		return fmt.Errorf("ошибка в формуле: %w", err)
+	}
+
+	return nil
+}
+
+// catalogFormulas компилирует формулы справочника, nil - вычисляемых полей нет.
+// Формулы с ошибками (например, удалено поле, на которое они ссылались) пропускаются
+func (s *Service) catalogFormulas(fields []domain.CatalogFiled) (*formulas, error) {
+	defs := formulaDefinitions(fields)
+	if len(defs) == 0 {
+		return nil, nil
+	}
+
+	ff, err := s.formulaFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	p := formula.Compile(ff, defs)
+	for hash, err := range p.Errors {
+		logrus.WithField("hash", hash).Warn("formula: ", err)
+	}
+
+	return &formulas{
+		repo:    s.repo,
+		program: p,
+		fields:  lo.KeyBy(fields, func(f domain.CatalogFiled) string { return f.Hash }),
+		rows:    map[uuid.UUID]CatalogData{},
+	}, nil
+}
+
+// compute пересчитывает вычисляемые поля строки, присланные значения этих полей отбрасываются
+func (f *formulas) compute(dm *domain.CatalogData) error {
+	if f == nil {
+		return nil
+	}
+
+	if dm.Fields == nil {
+		dm.Fields = map[string]interface{}{}
+	}
+
+	for _, field := range f.fields {
+		if field.DataType == domain.Formula {
+			delete(dm.Fields, field.Hash)
+		}
+	}
+
+	refs := map[string]map[string]interface{}{}
+
+	for _, hash := range f.program.Refs() {
+		field := f.fields[hash]
+
+		uid, ok := dataUUID(dm.Fields[hash])
+		if !ok || field.DataCatalogUUID == nil {
+			continue
+		}
+
+		row, ok := f.rows[uid]
+		if !ok {
+			var err error
+
+			row, err = f.repo.GetDataRow(uid)
+			if err != nil && !errors.As(err, &dto.NotFoundError{}) {
+				return err
+			}
+
+			f.rows[uid] = row
+		}
+
+		if row.CatalogUUID == *field.DataCatalogUUID {
+			refs[hash] = row.Fields
+		}
+	}
+
+	f.program.Run(formula.Env{Values: dm.Fields, Refs: refs})
+
+	return nil
+}
+
+func dataUUID(value interface{}) (uuid.UUID, bool) {
+	if uid, ok := value.(uuid.UUID); ok {
+		return uid, true
+	}
+
+	return parseEntityUUID(value)
+}
@@ -0,0 +1,604 @@
+package catalogs
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+	FormatJSON = "json"
+
+	ImportBatchSize = 500
+	ImportMaxRows   = 50000
+
+	importMaxErrors = 1000
+)
+
+var ErrImportFormat = errors.New("неизвестный формат файла, ожидается csv, xlsx или json")
+
+// ImportTable - прочитанный файл импорта: колонки в порядке файла и строки вида колонка -> значение.
+// Для csv и xlsx значения - строки, для json - как в файле. RowNumbers - номера строк в файле
+// с учетом заголовка и пропущенных пустых строк, для json не заполняется
+type ImportTable struct {
+	Columns    []string
+	Rows       []map[string]interface{}
+	RowNumbers []int
+}
+
+// RowNumber - номер i-й строки в файле, для json - номер элемента массива начиная с 1
+func (t ImportTable) RowNumber(i int) int {
+	if i < len(t.RowNumbers) {
+		return t.RowNumbers[i]
+	}
+
+	return i + 1
+}
+
+// ImportOptions - Mapping: колонка файла -> hash поля, если пусто - колонки сопоставляются по hash
+// или названию поля. Lookup: hash поля Data/DataArray -> hash поля в связанном справочнике, по
+// которому ищется строка, без lookup значение должно быть uuid строки
+type ImportOptions struct {
+	Mapping map[string]string
+	Lookup  map[string]string
+	DryRun  bool
+}
+
+// ImportError - ошибка в строке файла, Row - номер строки в файле, как его покажет редактор таблиц
+type ImportError struct {
+	Row    int
+	Column string
+	Error  string
+}
+
+type ImportReport struct {
+	DryRun  bool
+	Total   int
+	Valid   int
+	Created int
+
+	Columns map[string]string
+	Ignored []string
+	Errors  []ImportError
+}
+
+func (r *ImportReport) addError(row int, column string, err error) {
+	if len(r.Errors) < importMaxErrors {
+		r.Errors = append(r.Errors, ImportError{Row: row, Column: column, Error: err.Error()})
+	}
+}
+
+// ImportFormat определяет формат по расширению файла
+func ImportFormat(name string) (string, error) {
+	ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(name)), ".")
+
+	switch ext {
+	case FormatCSV, FormatXLSX, FormatJSON:
+		return ext, nil
+	}
+
+	return "", ErrImportFormat
+}
+
+func ParseImport(format string, r io.Reader) (table ImportTable, err error) {
+	switch format {
+	case FormatCSV:
+		table, err = parseImportCSV(r)
+	case FormatXLSX:
+		table, err = parseImportXLSX(r)
+	case FormatJSON:
+		table, err = parseImportJSON(r)
+	default:
+		return table, ErrImportFormat
+	}
+
+	if err != nil {
+		return table, err
+	}
+
+	if len(table.Rows) == 0 {
+		return table, errors.New("в файле нет строк для импорта")
+	}
+
+	if len(table.Rows) > ImportMaxRows {
+		return table, fmt.Errorf("в файле больше %d строк", ImportMaxRows)
+	}
+
+	return table, nil
+}
+
+func parseImportCSV(r io.Reader) (table ImportTable, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return table, err
+	}
+
+	text := strings.TrimPrefix(string(data), "\ufeff")
+
+	firstLine, _, _ := strings.Cut(text, "\n")
+
+	cr := csv.NewReader(strings.NewReader(text))
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		cr.Comma = ';'
+	}
+
+	// csv пропускает пустые строки, поэтому номера строк берутся из ридера
+	rows, lines := [][]string{}, []int{}
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return table, fmt.Errorf("csv: %w", err)
+		}
+
+		line, _ := cr.FieldPos(0)
+		rows = append(rows, row)
+		lines = append(lines, line)
+	}
+
+	return tableFromRows(rows, lines), nil
+}
+
+func parseImportXLSX(r io.Reader) (table ImportTable, err error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return table, fmt.Errorf("xlsx: %w", err)
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return table, errors.New("xlsx: в файле нет листов")
+	}
+
+	rows, err := f.GetRows(sheets[0])
+	if err != nil {
+		return table, fmt.Errorf("xlsx: %w", err)
+	}
+
+	return tableFromRows(rows, nil), nil
+}
+
+// tableFromRows - первая строка заголовок, пустые строки пропускаются. lines - номера строк в файле,
+// если nil - строки идут в файле подряд
+func tableFromRows(rows [][]string, lines []int) (table ImportTable) {
+	if len(rows) == 0 {
+		return table
+	}
+
+	table.Columns = lo.Map(rows[0], func(h string, _ int) string {
+		return strings.TrimSpace(h)
+	})
+
+	for n, row := range rows {
+		if n == 0 {
+			continue
+		}
+
+		item := make(map[string]interface{}, len(table.Columns))
+		empty := true
+
+		for i, v := range row {
+			if i >= len(table.Columns) || table.Columns[i] == "" {
+				continue
+			}
+
+			v = strings.TrimSpace(v)
+			if v != "" {
+				empty = false
+			}
+
+			item[table.Columns[i]] = v
+		}
+
+		if !empty {
+			line := n + 1
+			if lines != nil {
+				line = lines[n]
+			}
+
+			table.Rows = append(table.Rows, item)
+			table.RowNumbers = append(table.RowNumbers, line)
+		}
+	}
+
+	return table
+}
+
+func parseImportJSON(r io.Reader) (table ImportTable, err error) {
+	err = json.NewDecoder(r).Decode(&table.Rows)
+	if err != nil {
+		return table, fmt.Errorf("json: ожидается массив объектов: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for _, row := range table.Rows {
+		for k := range row {
+			if !seen[k] {
+				seen[k] = true
+				table.Columns = append(table.Columns, k)
+			}
+		}
+	}
+
+	return table, nil
+}
+
+// ImportMapping сопоставляет колонки файла с полями справочника, возвращает колонка -> поле
+// и колонки, которые не попали в импорт
+func ImportMapping(columns []string, mapping map[string]string, fields []domain.CatalogFiled) (res map[string]domain.CatalogFiled, ignored []string, err error) {
+	res = map[string]domain.CatalogFiled{}
+
+	byHash := lo.KeyBy(fields, func(f domain.CatalogFiled) string { return f.Hash })
+
+	if len(mapping) > 0 {
+		for column, hash := range mapping {
+			if !lo.Contains(columns, column) {
+				return res, ignored, fmt.Errorf("колонка %q не найдена в файле", column)
+			}
+
+			field, ok := byHash[hash]
+			if !ok {
+				return res, ignored, fmt.Errorf("поле %q не найдено в справочнике", hash)
+			}
+
+			if field.DataType == domain.Formula {
+				return res, ignored, fmt.Errorf("поле %q вычисляется по формуле", hash)
+			}
+
+			res[column] = field
+		}
+	} else {
+		for _, column := range columns {
+			field, ok := lo.Find(fields, func(f domain.CatalogFiled) bool {
+				return f.DataType != domain.Formula && (strings.EqualFold(f.Hash, column) || strings.EqualFold(f.Name, column))
+			})
+			if ok {
+				res[column] = field
+			}
+		}
+	}
+
+	if len(res) == 0 {
+		return res, ignored, errors.New("ни одна колонка файла не сопоставлена с полями справочника")
+	}
+
+	ignored = lo.Filter(columns, func(column string, _ int) bool {
+		_, ok := res[column]
+		return !ok
+	})
+
+	return res, ignored, nil
+}
+
+// importRefs - найденные строки связанных справочников: hash поля -> значение -> uuid строк
+type importRefs map[string]map[string][]uuid.UUID
+
+func (refs importRefs) resolve(hash, value string) (string, error) {
+	uids := refs[hash][value]
+
+	switch len(uids) {
+	case 0:
+		return "", fmt.Errorf("значение %q не найдено в связанном справочнике", value)
+	case 1:
+		return uids[0].String(), nil
+	}
+
+	return "", fmt.Errorf("значение %q найдено в связанном справочнике несколько раз", value)
+}
+
+// refValues - значения ссылки из ячейки, для DataArray строка разбивается по запятой
+func refValues(field domain.CatalogFiled, value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		if field.DataType == domain.DataArray {
+			return splitList(v)
+		}
+
+		if v = strings.TrimSpace(v); v != "" {
+			return []string{v}
+		}
+	case []interface{}:
+		return lo.FilterMap(v, func(item interface{}, _ int) (string, bool) {
+			s, ok := item.(string)
+			s = strings.TrimSpace(s)
+			return s, ok && s != ""
+		})
+	}
+
+	return nil
+}
+
+// convertImportValue приводит значение из файла к виду, который принимает filterFields.
+// nil - значение пустое и поле не заполняется
+func convertImportValue(field domain.CatalogFiled, value interface{}, refs importRefs) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.DataType {
+	case domain.Data:
+		values := refValues(field, value)
+		if len(values) == 0 {
+			return nil, nil
+		}
+
+		return refs.resolve(field.Hash, values[0])
+	case domain.DataArray:
+		res := []interface{}{}
+		for _, v := range refValues(field, value) {
+			uid, err := refs.resolve(field.Hash, v)
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, uid)
+		}
+
+		return res, nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	switch field.DataType {
+	case domain.Integer:
+		v, err := strconv.Atoi(strings.ReplaceAll(s, " ", ""))
+		if err != nil {
+			return nil, fmt.Errorf("%q не целое число", s)
+		}
+
+		return v, nil
+	case domain.Float:
+		v, err := strconv.ParseFloat(strings.ReplaceAll(strings.ReplaceAll(s, " ", ""), ",", "."), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q не число", s)
+		}
+
+		return v, nil
+	case domain.Bool:
+		switch strings.ToLower(s) {
+		case "1", "true", "yes", "y", "да":
+			return true, nil
+		case "0", "false", "no", "n", "нет":
+			return false, nil
+		}
+
+		return nil, fmt.Errorf("%q не да/нет", s)
+	case domain.Switch:
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q не 0, 1 или 2", s)
+		}
+
+		return float64(v), nil
+	case domain.Array, domain.MultiSelect:
+		return lo.Map(splitList(s), func(item string, _ int) interface{} { return item }), nil
+	case domain.Money:
+		return domain.ParseMoney(s)
+	}
+
+	return s, nil
+}
+
+func splitList(s string) []string {
+	return lo.FilterMap(strings.Split(s, ","), func(item string, _ int) (string, bool) {
+		item = strings.TrimSpace(item)
+		return item, item != ""
+	})
+}
+
+// ImportData проверяет все строки файла и, если ошибок нет и это не dry run, сохраняет их пачками
+// по ImportBatchSize. Если пачка не сохранилась, импорт останавливается, в Created - сколько строк
+// уже сохранено
+func (s *Service) ImportData(catalog domain.Catalog, table ImportTable, opts ImportOptions, crtr domain.Creator) (report ImportReport, err error) {
+	report.DryRun = opts.DryRun
+	report.Total = len(table.Rows)
+
+	columns, ignored, err := ImportMapping(table.Columns, opts.Mapping, catalog.Fields)
+	if err != nil {
+		return report, err
+	}
+
+	report.Ignored = ignored
+	report.Columns = lo.MapValues(columns, func(f domain.CatalogFiled, _ string) string { return f.Hash })
+
+	refs, err := s.importRefs(catalog, table, columns, opts.Lookup)
+	if err != nil {
+		return report, err
+	}
+
+	calc, err := s.catalogFormulas(catalog.Fields)
+	if err != nil {
+		return report, err
+	}
+
+	valid := []domain.CatalogData{}
+
+	for i, row := range table.Rows {
+		raw := map[string]interface{}{}
+		rowOK := true
+
+		for column, field := range columns {
+			v, err := convertImportValue(field, row[column], refs)
+			if err != nil {
+				report.addError(table.RowNumber(i), column, err)
+				rowOK = false
+				continue
+			}
+
+			if v != nil {
+				raw[field.Hash] = v
+			}
+		}
+
+		if !rowOK {
+			continue
+		}
+
+		dm := domain.CatalogData{
+			UUID:           uuid.New(),
+			FederationUUID: catalog.FederationUUID,
+			CompanyUUID:    catalog.CompanyUUID,
+			CatalogUUID:    catalog.UUID,
+			RawFields:      raw,
+			Fields:         map[string]interface{}{},
+			Entities:       map[string]interface{}{},
+
+			CreatedBy:     crtr.Email,
+			CreatedByUUID: crtr.UUID,
+		}
+
+		err = filterFields(catalog.Fields, &dm)
+		if err != nil {
+			report.addError(table.RowNumber(i), "", err)
+			continue
+		}
+
+		err = calc.compute(&dm)
+		if err != nil {
+			return report, err
+		}
+
+		valid = append(valid, dm)
+	}
+
+	report.Valid = len(valid)
+
+	if opts.DryRun || report.Valid != report.Total {
+		return report, nil
+	}
+
+	for i, batch := range lo.Chunk(valid, ImportBatchSize) {
+		err = s.repo.AddDataBatch(batch)
+		if err != nil {
+			report.addError(table.RowNumber(i*ImportBatchSize), "", fmt.Errorf("импорт остановлен: %w", err))
+			return report, nil
+		}
+
+		report.Created += len(batch)
+
+		for _, dm := range batch {
+			s.dataChanged(dm.UUID)
+		}
+	}
+
+	return report, nil
+}
+
+// importRefs ищет строки связанных справочников для всех ссылок из файла одним запросом на поле
+func (s *Service) importRefs(catalog domain.Catalog, table ImportTable, columns map[string]domain.CatalogFiled, lookup map[string]string) (refs importRefs, err error) {
+	refs = importRefs{}
+
+	for hash := range lookup {
+		_, ok := lo.Find(lo.Values(columns), func(f domain.CatalogFiled) bool {
+			return f.Hash == hash && (f.DataType == domain.Data || f.DataType == domain.DataArray)
+		})
+		if !ok {
+			return refs, fmt.Errorf("lookup: поле %q не импортируется или не является ссылкой", hash)
+		}
+	}
+
+	for column, field := range columns {
+		if field.DataType != domain.Data && field.DataType != domain.DataArray {
+			continue
+		}
+
+		values := []string{}
+		for _, row := range table.Rows {
+			values = append(values, refValues(field, row[column])...)
+		}
+		values = lo.Uniq(values)
+
+		refCatalogUUID := lo.FromPtr(field.DataCatalogUUID)
+
+		lookupHash, ok := lookup[field.Hash]
+		if !ok {
+			uids := lo.FilterMap(values, func(v string, _ int) (uuid.UUID, bool) {
+				uid, ok := parseEntityUUID(v)
+				return uid, ok
+			})
+
+			refs[field.Hash], err = s.repo.FindDataByUUIDs(catalog.CompanyUUID, refCatalogUUID, uids)
+			if err != nil {
+				return refs, err
+			}
+
+			continue
+		}
+
+		refFields, err := s.GetCatalogFields(refCatalogUUID)
+		if err != nil {
+			return refs, err
+		}
+
+		if !lo.ContainsBy(refFields, func(f domain.CatalogFiled) bool { return f.Hash == lookupHash }) {
+			return refs, fmt.Errorf("lookup: в связанном справочнике нет поля %q", lookupHash)
+		}
+
+		refs[field.Hash], err = s.repo.LookupData(catalog.CompanyUUID, refCatalogUUID, lookupHash, values)
+		if err != nil {
+			return refs, err
+		}
+	}
+
+	return refs, nil
+}
+
+// ExportData возвращает все строки справочника по фильтру, не больше ImportMaxRows
+func (s *Service) ExportData(search dto.CatalogSearchDTO) (dmns []domain.CatalogData, err error) {
+	allowOrder := s.GetSortFields(search.CatalogUUID)
+
+	limit := ImportBatchSize
+	search.Limit = &limit
+
+	// GetData переписывает Order в выражение sql, поэтому на каждую страницу нужна копия
+	order := search.Order
+
+	for offset := 0; offset < ImportMaxRows; offset += limit {
+		page := offset
+		search.Offset = &page
+
+		if order != nil {
+			o := *order
+			search.Order = &o
+		}
+
+		items, _, err := s.repo.GetData(search, allowOrder)
+		if err != nil {
+			return dmns, err
+		}
+
+		dmns = append(dmns, items...)
+
+		if len(items) < limit {
+			break
+		}
+	}
+
+	return dmns, nil
+}
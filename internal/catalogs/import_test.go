@@ -0,0 +1,103 @@
+package catalogs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestParseImportCSV(t *testing.T) {
+	table, err := ParseImport(FormatCSV, strings.NewReader("\ufeffname;price\nчай; 10,5\n;\nкофе;20\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(table.Columns) != 2 || table.Columns[1] != "price" {
+		t.Errorf("unexpected columns: %v", table.Columns)
+	}
+
+	if len(table.Rows) != 2 || table.Rows[0]["price"] != "10,5" {
+		t.Errorf("unexpected rows: %v", table.Rows)
+	}
+
+	if table.RowNumber(0) != 2 || table.RowNumber(1) != 4 {
+		t.Errorf("unexpected row numbers: %v", table.RowNumbers)
+	}
+
+	table, err = ParseImport(FormatCSV, strings.NewReader("name\n\nчай\n"))
+	if err != nil || table.RowNumber(0) != 3 {
+		t.Errorf("blank line before row: %v %v", table.RowNumbers, err)
+	}
+
+	_, err = ParseImport(FormatJSON, strings.NewReader(`{"name": 1}`))
+	if err == nil {
+		t.Error("json object instead of array should fail")
+	}
+}
+
+func TestImportMapping(t *testing.T) {
+	fields := []domain.CatalogFiled{{Hash: "a", Name: "Название"}, {Hash: "b", Name: "Цена"}}
+
+	res, ignored, err := ImportMapping([]string{"название", "b", "uuid"}, nil, fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res["название"].Hash != "a" || res["b"].Hash != "b" || len(ignored) != 1 || ignored[0] != "uuid" {
+		t.Errorf("unexpected mapping: %v %v", res, ignored)
+	}
+
+	_, _, err = ImportMapping([]string{"x"}, map[string]string{"x": "zzz"}, fields)
+	if err == nil {
+		t.Error("unknown hash should fail")
+	}
+
+	_, _, err = ImportMapping([]string{"x"}, nil, fields)
+	if err == nil {
+		t.Error("no mapped columns should fail")
+	}
+}
+
+func TestConvertImportValue(t *testing.T) {
+	uid := uuid.New()
+	refs := importRefs{
+		"r": {"чай": {uid}, "двойник": {uuid.New(), uuid.New()}},
+	}
+
+	cases := []struct {
+		field domain.CatalogFiled
+		value interface{}
+		want  interface{}
+		ok    bool
+	}{
+		{domain.CatalogFiled{DataType: domain.Integer}, "1 000", 1000, true},
+		{domain.CatalogFiled{DataType: domain.Integer}, "abc", nil, false},
+		{domain.CatalogFiled{DataType: domain.Float}, "10,5", 10.5, true},
+		{domain.CatalogFiled{DataType: domain.Bool}, "да", true, true},
+		{domain.CatalogFiled{DataType: domain.Switch}, "2", float64(2), true},
+		{domain.CatalogFiled{DataType: domain.String}, "  ", nil, true},
+		{domain.CatalogFiled{DataType: domain.Float}, float64(3), float64(3), true},
+		{domain.CatalogFiled{Hash: "r", DataType: domain.Data}, "чай", uid.String(), true},
+		{domain.CatalogFiled{Hash: "r", DataType: domain.Data}, "кофе", nil, false},
+		{domain.CatalogFiled{Hash: "r", DataType: domain.Data}, "двойник", nil, false},
+	}
+
+	for _, c := range cases {
+		got, err := convertImportValue(c.field, c.value, refs)
+		if (err == nil) != c.ok {
+			t.Errorf("%v: unexpected error %v", c.value, err)
+			continue
+		}
+
+		if c.ok && got != c.want {
+			t.Errorf("%v: got %v (%T), want %v", c.value, got, got, c.want)
+		}
+	}
+
+	got, err := convertImportValue(domain.CatalogFiled{Hash: "r", DataType: domain.DataArray}, "чай, чай", refs)
+	if err != nil || len(got.([]interface{})) != 2 {
+		t.Errorf("data array: %v %v", got, err)
+	}
+}
@@ -11,11 +11,15 @@ import (
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
 )
 
 type Service struct {
 	repo *Repository
 	dict *dictionary.Service
+
+	onDataChanged func(uuid.UUID) error
+	onTaskChanged func(uuid.UUID) error
 }
 
 func New(repo *Repository, dict *dictionary.Service) *Service {
@@ -32,6 +36,11 @@ func (s *Service) CreateCatalog(c *domain.Catalog) (err error) {
 }
 
 func (s *Service) DeleteCatalog(uid uuid.UUID) (err error) {
+	err = s.checkCatalogReferences(uid)
+	if err != nil {
+		return err
+	}
+
 	return s.repo.DeleteCatalog(uid)
 }
 
@@ -91,6 +100,13 @@ func (s *Service) GetCatalogsByCompany(companyUUID uuid.UUID) (dmns []domain.Cat
 }
 
 func (s *Service) CreateCatalogField(pf *domain.CatalogFiled) (df domain.CatalogFiled, err error) {
+	if pf.DataType == domain.Formula {
+		err = s.checkFormula(pf)
+		if err != nil {
+			return df, err
+		}
+	}
+
 	orm, err := s.repo.CreateCatalogField(pf)
 	if err != nil {
 		return df, err
@@ -103,11 +119,73 @@ func (s *Service) CreateCatalogField(pf *domain.CatalogFiled) (df domain.Catalog
 		DataType:        domain.FieldDataType(orm.DataType),
 		DataCatalogUUID: orm.DataCatalogUUID,
 		Hash:            orm.Hash,
+		Formula:         orm.Formula,
+		Options:         orm.Options,
 	}, err
 }
 
-func (s *Service) PutCatalogField(pf *domain.CatalogFiled) error {
-	return s.repo.PutCatalogField(pf)
+func (s *Service) PutCatalogField(pf *domain.CatalogFiled, crtr domain.Creator) error {
+	if pf.OnDelete == "" && pf.Formula == "" && pf.Options == nil {
+		_, err := s.repo.PutCatalogField(pf, nil, crtr)
+		return err
+	}
+
+	fields, err := s.GetCatalogFields(pf.CatalogUUID)
+	if err != nil {
+		return err
+	}
+
+	field, ok := lo.Find(fields, func(f domain.CatalogFiled) bool { return f.UUID == pf.UUID })
+	if !ok {
+		return dto.NotFoundErr("поле не найдено")
+	}
+
+	pf.DataType = field.DataType
+	pf.Hash = field.Hash
+
+	if pf.OnDelete != "" {
+		err = pf.SetOnDelete(pf.OnDelete)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pf.Formula != "" {
+		err = pf.SetFormula(pf.Formula)
+		if err != nil {
+			return err
+		}
+
+		err = s.checkFormula(pf)
+		if err != nil {
+			return err
+		}
+	}
+
+	renames := map[string]string{}
+
+	if pf.Options != nil {
+		err = pf.SetOptions(pf.Options)
+		if err != nil {
+			return err
+		}
+
+		renames, err = field.Options.Renames(pf.Options)
+		if err != nil {
+			return err
+		}
+	}
+
+	updated, err := s.repo.PutCatalogField(pf, renames, crtr)
+	if err != nil {
+		return err
+	}
+
+	for _, uid := range updated {
+		s.dataChanged(uid)
+	}
+
+	return nil
 }
 
 func (s *Service) GetCatalogFields(uid uuid.UUID) (items []domain.CatalogFiled, err error) {
@@ -142,6 +220,9 @@ func (s *Service) AddData(dm domain.CatalogData) (dd CatalogData, err error) {
 	}
 
 	dd, err = s.repo.AddData(dm)
+	if err == nil {
+		s.dataChanged(dd.UUID)
+	}
 
 	return dd, err
 }
@@ -168,9 +249,33 @@ func (s *Service) GetData(search dto.CatalogSearchDTO) (dmns []domain.CatalogDat
 }
 
 func (s *Service) FilterCatalogFields(catalogData *domain.CatalogData) (err error) {
-	if len(catalogData.RawFields) > 0 {
-		projectFields, _ := s.dict.FindCatalogFields(catalogData.CatalogUUID)
+	projectFields, err := s.GetCatalogFields(catalogData.CatalogUUID)
+	if err != nil {
+		return err
+	}
+
+	err = filterFields(projectFields, catalogData)
+	if err != nil {
+		return err
+	}
 
+	calc, err := s.catalogFormulas(projectFields)
+	if err != nil {
+		return err
+	}
+
+	return calc.compute(catalogData)
+}
+
+// filterFields проверяет RawFields по списку полей справочника и заменяет ими Fields и Entities.
+// Текущие Fields нужны только для проверки вариантов select из архива
+func filterFields(projectFields []domain.CatalogFiled, catalogData *domain.CatalogData) (err error) {
+	current := catalogData.Fields
+
+	catalogData.Fields = map[string]interface{}{}
+	catalogData.Entities = map[string]interface{}{}
+
+	if len(catalogData.RawFields) > 0 {
 		filteredFields := make(map[string]interface{}, 0)
 
 		addedFieldsHash := []string{}
@@ -179,7 +284,7 @@ func (s *Service) FilterCatalogFields(catalogData *domain.CatalogData) (err erro
 			if value, ok := catalogData.RawFields[pfield.Hash]; ok {
 				addedFieldsHash = append(addedFieldsHash, pfield.Hash)
 
-				switch domain.FieldDataType(pfield.DataType) {
+				switch pfield.DataType {
 				case domain.Integer:
 					if v, ok := value.(int); ok {
 						filteredFields[pfield.Hash] = v
@@ -247,16 +352,53 @@ func (s *Service) FilterCatalogFields(catalogData *domain.CatalogData) (err erro
 						return errors.New(msg)
 					}
 				case domain.Data:
-					if v, ok := value.(string); ok {
-						uid := uuid.MustParse(v)
+					uid, ok := parseEntityUUID(value)
+					if !ok {
+						msg := fmt.Sprintf("field %s (%s) should be uuid", pfield.Name, pfield.Hash)
+						return errors.New(msg)
+					}
 
-						entities[uid.String()] = uid
+					entities[uid.String()] = lo.FromPtr(pfield.DataCatalogUUID)
 
-						filteredFields[pfield.Hash] = uid
-					} else {
-						msg := fmt.Sprintf("field %s (%s) should be string", pfield.Name, pfield.Hash)
+					filteredFields[pfield.Hash] = uid
+				case domain.DataArray:
+					values, ok := value.([]interface{})
+					if !ok {
+						msg := fmt.Sprintf("field %s (%s) should be array of uuid", pfield.Name, pfield.Hash)
 						return errors.New(msg)
 					}
+
+					uids := []uuid.UUID{}
+					for _, item := range values {
+						uid, ok := parseEntityUUID(item)
+						if !ok {
+							msg := fmt.Sprintf("field %s (%s) should be array of uuid", pfield.Name, pfield.Hash)
+							return errors.New(msg)
+						}
+
+						entities[uid.String()] = lo.FromPtr(pfield.DataCatalogUUID)
+
+						uids = append(uids, uid)
+					}
+
+					filteredFields[pfield.Hash] = lo.Uniq(uids)
+				case domain.Select, domain.MultiSelect:
+					v, err := pfield.Options.SelectValue(pfield.DataType, value, current[pfield.Hash])
+					if err != nil {
+						return fmt.Errorf("field %s (%s): %w", pfield.Name, pfield.Hash, err)
+					}
+
+					filteredFields[pfield.Hash] = v
+				case domain.Money:
+					m, err := domain.NewMoney(value)
+					if err != nil {
+						return fmt.Errorf("field %s (%s): %w", pfield.Name, pfield.Hash, err)
+					}
+
+					filteredFields[pfield.Hash] = m
+				case domain.Formula:
+					msg := fmt.Sprintf("field %s (%s) is calculated by formula and can't be set", pfield.Name, pfield.Hash)
+					return errors.New(msg)
 				}
 
 			}
@@ -283,3 +425,17 @@ func (s *Service) FilterCatalogFields(catalogData *domain.CatalogData) (err erro
 
 	return nil
 }
+
+func parseEntityUUID(value interface{}) (uid uuid.UUID, ok bool) {
+	v, ok := value.(string)
+	if !ok {
+		return uid, false
+	}
+
+	uid, err := uuid.Parse(v)
+	if err != nil {
+		return uid, false
+	}
+
+	return uid, true
+}
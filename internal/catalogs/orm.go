@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"gorm.io/datatypes"
 )
 
@@ -62,12 +63,22 @@ type CatalogFields struct {
 	DataType        int        `gorm:"type:int;not null;default:0"`
 	DataCatalogUUID *uuid.UUID `gorm:"type:uuid"`
 	CatalogUUID     uuid.UUID  `gorm:"type:uuid;not null"`
+	OnDelete        string     `gorm:"type:varchar(10);not null;default:'block'"`
+	Formula         string     `gorm:"type:text;not null;default:''"`
+
+	Options domain.FieldOptions `gorm:"type:jsonb;default:'[]';not null;"`
 
 	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
 }
 
+// CatalogReferenceField - поле data/data_array другого справочника вместе с именем справочника
+type CatalogReferenceField struct {
+	CatalogFields
+	CatalogName string
+}
+
 type CatalogData struct {
 	UUID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
 	FederationUUID uuid.UUID `gorm:"type:uuid;not null"`
@@ -89,3 +100,39 @@ type CatalogData struct {
 
 	Total int64 `gorm:"->"`
 }
+
+type CatalogDataHistory struct {
+	UUID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	DataUUID    uuid.UUID `gorm:"type:uuid;not null"`
+	CatalogUUID uuid.UUID `gorm:"type:uuid;not null"`
+
+	Action  string `gorm:"type:varchar(10);not null"`
+	Fields  JSONB  `gorm:"default:'{}';not null;"`
+	Changes JSONB  `gorm:"default:'{}';not null;"`
+
+	MergedUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (h *CatalogDataHistory) TableName() string {
+	return "catalog_data_history"
+}
+
+// TaskRefs - поля задачи и ее ссылки на строки справочников, task_entities хранится как uuid -> [hash поля]
+type TaskRefs struct {
+	UUID         uuid.UUID
+	Fields       JSONB
+	TaskEntities JSONB
+}
+
+// TaskFieldPolicy - политика удаления поля компании, через которое задача ссылается на строку справочника
+type TaskFieldPolicy struct {
+	TaskUUID uuid.UUID
+	Hash     string
+	Name     string
+	OnDelete string
+}
@@ -0,0 +1,181 @@
+package catalogs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+var ErrDataInUse = errors.New("запись используется, удаление запрещено")
+
+// DataUsage - строка справочника, которая ссылается на запись через поле Field
+type DataUsage struct {
+	DataUUID    uuid.UUID
+	CatalogUUID uuid.UUID
+	CatalogName string
+	Field       domain.CatalogFiled
+}
+
+// GetDataUsages возвращает строки других справочников, которые ссылаются на запись
+func (s *Service) GetDataUsages(catalogUUID, uid uuid.UUID) (usages []DataUsage, err error) {
+	_, err = s.GetDataRow(catalogUUID, uid)
+	if err != nil {
+		return usages, err
+	}
+
+	fields, err := s.repo.GetReferencingFields(catalogUUID)
+	if err != nil || len(fields) == 0 {
+		return usages, err
+	}
+
+	referrers, err := s.repo.GetDataReferrers(uid)
+	if err != nil {
+		return usages, err
+	}
+
+	for _, ref := range referrers {
+		_, used := ReferencePolicy(ref.CatalogUUID, ref.Fields, fields, uid)
+
+		for _, f := range used {
+			usages = append(usages, DataUsage{
+				DataUUID:    ref.UUID,
+				CatalogUUID: ref.CatalogUUID,
+				CatalogName: f.CatalogName,
+				Field: domain.CatalogFiled{
+					UUID:            f.UUID,
+					Hash:            f.Hash,
+					Name:            f.Name,
+					DataType:        domain.FieldDataType(f.DataType),
+					DataCatalogUUID: f.DataCatalogUUID,
+					CatalogUUID:     f.CatalogUUID,
+					OnDelete:        f.OnDelete,
+				},
+			})
+		}
+	}
+
+	return usages, nil
+}
+
+// checkCatalogReferences не дает удалить справочник, на который ссылаются поля других справочников
+func (s *Service) checkCatalogReferences(uid uuid.UUID) error {
+	fields, err := s.repo.GetReferencingFields(uid)
+	if err != nil {
+		return err
+	}
+
+	fields = lo.Filter(fields, func(f CatalogReferenceField, _ int) bool {
+		return f.CatalogUUID != uid
+	})
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	names := lo.Map(fields, func(f CatalogReferenceField, _ int) string {
+		return fmt.Sprintf("%s.%s", f.CatalogName, f.Name)
+	})
+
+	return fmt.Errorf("справочник используется в полях: %s", strings.Join(names, ", "))
+}
+
+// ReferencePolicy находит поля строки справочника refCatalogUUID, в которых есть ссылка на uid,
+// и самую строгую политику среди них: block, затем cascade, затем set_null. "" - ссылок нет
+func ReferencePolicy(refCatalogUUID uuid.UUID, values map[string]interface{}, fields []CatalogReferenceField, uid uuid.UUID) (policy string, used []CatalogReferenceField) {
+	used = lo.Filter(fields, func(f CatalogReferenceField, _ int) bool {
+		return f.CatalogUUID == refCatalogUUID && RefersTo(values[f.Hash], uid)
+	})
+
+	for _, p := range []string{domain.OnDeleteBlock, domain.OnDeleteCascade, domain.OnDeleteSetNull} {
+		strict := lo.Filter(used, func(f CatalogReferenceField, _ int) bool { return onDelete(f.OnDelete) == p })
+		if len(strict) > 0 {
+			rest := lo.Filter(used, func(f CatalogReferenceField, _ int) bool { return onDelete(f.OnDelete) != p })
+			return p, append(strict, rest...)
+		}
+	}
+
+	return "", used
+}
+
+// RefersTo - значение поля data (uuid строкой) или data_array (список uuid) содержит uid
+func RefersTo(value interface{}, uid uuid.UUID) bool {
+	switch v := value.(type) {
+	case string:
+		return v == uid.String()
+	case []interface{}:
+		return lo.Contains(v, interface{}(uid.String()))
+	}
+
+	return false
+}
+
+// RemoveRef убирает uid из поля hash: поле data удаляется, из data_array убирается элемент
+func RemoveRef(values JSONB, hash string, uid uuid.UUID) JSONB {
+	next := make(JSONB, len(values))
+	for k, v := range values {
+		next[k] = v
+	}
+
+	switch v := next[hash].(type) {
+	case string:
+		if v == uid.String() {
+			delete(next, hash)
+		}
+	case []interface{}:
+		next[hash] = lo.Without(v, interface{}(uid.String()))
+	}
+
+	return next
+}
+
+// ReplaceRef заменяет в поле hash ссылку from на to, в data_array повтор to не добавляется
+func ReplaceRef(values JSONB, hash string, from, to uuid.UUID) JSONB {
+	next := make(JSONB, len(values))
+	for k, v := range values {
+		next[k] = v
+	}
+
+	switch v := next[hash].(type) {
+	case string:
+		if v == from.String() {
+			next[hash] = to.String()
+		}
+	case []interface{}:
+		next[hash] = lo.Uniq(lo.Map(v, func(item interface{}, _ int) interface{} {
+			return lo.Ternary(item == interface{}(from.String()), interface{}(to.String()), item)
+		}))
+	}
+
+	return next
+}
+
+// TaskRefHashes - поля задачи, через которые она ссылается на строку uid
+func TaskRefHashes(te JSONB, uid uuid.UUID) []string {
+	return lo.Map(asSlice(te[uid.String()]), func(h interface{}, _ int) string { return fmt.Sprint(h) })
+}
+
+// UnlinkTask убирает ссылки задачи на строку uid из ее полей и task_entities
+func UnlinkTask(t TaskRefs, uid uuid.UUID) (fields, te JSONB) {
+	fields = t.Fields
+	for _, h := range TaskRefHashes(t.TaskEntities, uid) {
+		fields = RemoveRef(fields, h, uid)
+	}
+
+	te = make(JSONB, len(t.TaskEntities))
+	for k, v := range t.TaskEntities {
+		if k != uid.String() {
+			te[k] = v
+		}
+	}
+
+	return fields, te
+}
+
+// entityRef - условие для поиска по entities, которые хранятся как [{"uuid": ...}]
+func entityRef(uid uuid.UUID) string {
+	return fmt.Sprintf(`[{"uuid": %q}]`, uid.String())
+}
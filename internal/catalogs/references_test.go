@@ -0,0 +1,122 @@
+package catalogs
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestReferencePolicy(t *testing.T) {
+	uid := uuid.New()
+	other := uuid.New()
+	catalogUUID := uuid.New()
+
+	field := func(hash, policy string) CatalogReferenceField {
+		f := CatalogReferenceField{}
+		f.Hash = hash
+		f.OnDelete = policy
+		f.CatalogUUID = catalogUUID
+		return f
+	}
+
+	fields := []CatalogReferenceField{field("a", domain.OnDeleteSetNull), field("b", domain.OnDeleteCascade), field("c", "")}
+
+	values := map[string]interface{}{
+		"a": uid.String(),
+		"b": []interface{}{other.String(), uid.String()},
+		"c": other.String(),
+	}
+
+	policy, used := ReferencePolicy(catalogUUID, values, fields, uid)
+	if policy != domain.OnDeleteCascade || len(used) != 2 || used[0].Hash != "b" {
+		t.Errorf("unexpected policy %q %v", policy, used)
+	}
+
+	values["c"] = uid.String()
+	policy, _ = ReferencePolicy(catalogUUID, values, fields, uid)
+	if policy != domain.OnDeleteBlock {
+		t.Errorf("empty policy should block, got %q", policy)
+	}
+
+	policy, used = ReferencePolicy(uuid.New(), values, fields, uid)
+	if policy != "" || len(used) != 0 {
+		t.Errorf("fields of other catalog should not match: %q %v", policy, used)
+	}
+}
+
+func TestReplaceRef(t *testing.T) {
+	from, to := uuid.New(), uuid.New()
+
+	values := JSONB{
+		"a": from.String(),
+		"b": []interface{}{to.String(), from.String()},
+		"c": "text",
+	}
+
+	next := ReplaceRef(ReplaceRef(ReplaceRef(values, "a", from, to), "b", from, to), "c", from, to)
+
+	if next["a"] != to.String() || next["c"] != "text" {
+		t.Errorf("unexpected replace: %v", next)
+	}
+
+	if arr, ok := next["b"].([]interface{}); !ok || len(arr) != 1 || arr[0] != to.String() {
+		t.Errorf("data_array should hold one ref: %v", next["b"])
+	}
+
+	if values["a"] != from.String() {
+		t.Errorf("source values should not change: %v", values)
+	}
+}
+
+func TestRemoveRef(t *testing.T) {
+	uid := uuid.New()
+	other := uuid.New().String()
+
+	values := JSONB{
+		"a": uid.String(),
+		"b": []interface{}{other, uid.String()},
+		"c": "text",
+	}
+
+	next := RemoveRef(RemoveRef(values, "a", uid), "b", uid)
+
+	if _, ok := next["a"]; ok {
+		t.Error("data field should be removed")
+	}
+
+	if b := next["b"].([]interface{}); len(b) != 1 || b[0] != other {
+		t.Errorf("unexpected data array %v", b)
+	}
+
+	if values["a"] != uid.String() || next["c"] != "text" {
+		t.Error("source values should stay untouched")
+	}
+}
+
+func TestUnlinkTask(t *testing.T) {
+	uid := uuid.New()
+	other := uuid.New()
+
+	task := TaskRefs{
+		Fields: JSONB{"a": uid.String(), "b": []interface{}{other.String(), uid.String()}, "c": "x"},
+		TaskEntities: JSONB{
+			uid.String():   []interface{}{"a", "b"},
+			other.String(): []interface{}{"b"},
+		},
+	}
+
+	fields, te := UnlinkTask(task, uid)
+
+	if _, ok := fields["a"]; ok || len(asSlice(fields["b"])) != 1 || fields["c"] != "x" {
+		t.Errorf("unexpected fields %v", fields)
+	}
+
+	if _, ok := te[uid.String()]; ok || len(te) != 1 {
+		t.Errorf("unexpected task_entities %v", te)
+	}
+
+	if _, ok := task.TaskEntities[uid.String()]; !ok {
+		t.Error("task_entities of the task should not change")
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -118,7 +119,12 @@ func (r *Repository) CreateCatalogField(pf *domain.CatalogFiled) (orm CatalogFie
 
 		if orm.Hash != "" {
 			err = r.gorm.DB.Model(&orm).
-				UpdateColumn("deleted_at", nil).
+				UpdateColumns(map[string]interface{}{
+					"deleted_at": nil,
+					"on_delete":  onDelete(pf.OnDelete),
+					"formula":    pf.Formula,
+					"options":    pf.Options,
+				}).
 				Error
 
 			return orm, err
@@ -130,6 +136,9 @@ func (r *Repository) CreateCatalogField(pf *domain.CatalogFiled) (orm CatalogFie
 			DataCatalogUUID: pf.DataCatalogUUID,
 			Hash:            pf.Hash,
 			CatalogUUID:     pf.CatalogUUID,
+			OnDelete:        onDelete(pf.OnDelete),
+			Formula:         pf.Formula,
+			Options:         pf.Options,
 		}
 
 		err = r.gorm.DB.Create(&orm).Error
@@ -162,6 +171,9 @@ func (r *Repository) CreateCatalogField(pf *domain.CatalogFiled) (orm CatalogFie
 			DataCatalogUUID: pf.DataCatalogUUID,
 			Hash:            helpers.IntToLetters(catalog.FieldLastName + 1),
 			CatalogUUID:     pf.CatalogUUID,
+			OnDelete:        onDelete(pf.OnDelete),
+			Formula:         pf.Formula,
+			Options:         pf.Options,
 		}
 
 		err = tx.Create(&orm).Error
@@ -181,31 +193,53 @@ func (r *Repository) CreateCatalogField(pf *domain.CatalogFiled) (orm CatalogFie
 	return orm, err
 }
 
-func (r *Repository) PutCatalogField(pf *domain.CatalogFiled) error {
+// PutCatalogField сохраняет поле и в той же транзакции переименовывает варианты select/multi_select
+// в строках справочника, renames - старое название -> новое. Изменения строк пишутся в их историю
+// от имени crtr. Возвращает измененные строки
+func (r *Repository) PutCatalogField(pf *domain.CatalogFiled, renames map[string]string, crtr domain.Creator) (updated []uuid.UUID, err error) {
 	orm := CatalogFields{
 		Name: pf.Name,
 		UUID: pf.UUID,
 	}
 
-	err := r.gorm.DB.
-		Model(&orm).
-		Where("uuid = ?", pf.UUID).
-		Update("name", orm.Name).
-		Error
-	if err != nil {
-		return err
+	values := map[string]interface{}{"name": orm.Name}
+	if pf.OnDelete != "" {
+		values["on_delete"] = pf.OnDelete
 	}
 
-	err = r.gorm.DB.Exec("update catalogs set updated_at = NOW() where uuid = ?", pf.CatalogUUID).Error
-	if err != nil {
-		return err
+	if pf.Formula != "" {
+		values["formula"] = pf.Formula
+	}
+
+	if pf.Options != nil {
+		values["options"] = pf.Options
 	}
 
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Model(&orm).
+			Where("uuid = ?", pf.UUID).
+			Updates(values).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec("update catalogs set updated_at = NOW() where uuid = ?", pf.CatalogUUID).Error
+		if err != nil {
+			return err
+		}
+
+		updated, err = r.renameFieldOptions(tx, pf, renames, crtr)
+
+		return err
+	})
+
 	if err == nil {
 		r.PubUpdate()
 	}
 
-	return err
+	return updated, err
 }
 
 func (r *Repository) GetCatalogFields(catalogUUID uuid.UUID) (df []domain.CatalogFiled, err error) {
@@ -227,12 +261,96 @@ func (r *Repository) GetCatalogFields(catalogUUID uuid.UUID) (df []domain.Catalo
 			DataType:        domain.FieldDataType(item.DataType),
 			DataCatalogUUID: item.DataCatalogUUID,
 			Hash:            item.Hash,
+			CatalogUUID:     item.CatalogUUID,
+			OnDelete:        item.OnDelete,
+			Formula:         item.Formula,
+			Options:         item.Options,
 		}
 	})
 
 	return df, err
 }
 
+// renameFieldOptions переименовывает варианты поля pf в строках справочника и пишет изменения в их историю
+func (r *Repository) renameFieldOptions(tx *gorm.DB, pf *domain.CatalogFiled, renames map[string]string, crtr domain.Creator) (updated []uuid.UUID, err error) {
+	if len(renames) == 0 {
+		return updated, nil
+	}
+
+	prev := []CatalogData{}
+	err = tx.Raw("select * from catalog_data where catalog_uuid = ? and deleted_at is null and jsonb_exists(fields, ?) FOR UPDATE", pf.CatalogUUID, pf.Hash).
+		Scan(&prev).Error
+	if err != nil {
+		return updated, err
+	}
+
+	orms := []CatalogData{}
+
+	query := tx.
+		Model(&orms).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "uuid"}, {Name: "fields"}}}).
+		Where("catalog_uuid = ?", pf.CatalogUUID).
+		Where("deleted_at is null")
+
+	err = postgres.RenameFieldOptions(query, pf.Hash, pf.DataType == domain.MultiSelect, renames)
+	if err != nil || len(orms) == 0 {
+		return updated, err
+	}
+
+	byUUID := lo.KeyBy(prev, func(item CatalogData) uuid.UUID { return item.UUID })
+
+	history := lo.Map(orms, func(item CatalogData, _ int) CatalogDataHistory {
+		return CatalogDataHistory{
+			DataUUID:      item.UUID,
+			CatalogUUID:   pf.CatalogUUID,
+			Action:        domain.CatalogDataUpdated,
+			Fields:        item.Fields,
+			Changes:       DiffFields(byUUID[item.UUID].Fields, item.Fields),
+			CreatedBy:     crtr.Email,
+			CreatedByUUID: crtr.UUID,
+		}
+	})
+
+	err = tx.Create(&history).Error
+	if err != nil {
+		return updated, err
+	}
+
+	return lo.Map(orms, func(item CatalogData, _ int) uuid.UUID { return item.UUID }), nil
+}
+
+func onDelete(policy string) string {
+	if policy == "" {
+		return domain.OnDeleteBlock
+	}
+
+	return policy
+}
+
+// GetReferencingFields - живые поля data/data_array, которые ссылаются на справочник catalogUUID
+func (r *Repository) GetReferencingFields(catalogUUID uuid.UUID) ([]CatalogReferenceField, error) {
+	return r.referencingFields(r.gorm.DB, catalogUUID)
+}
+
+func (r *Repository) referencingFields(tx *gorm.DB, catalogUUID uuid.UUID) (orms []CatalogReferenceField, err error) {
+	err = tx.Raw(`select cf.*, c.name as catalog_name from catalog_fields cf
+			join catalogs c on c.uuid = cf.catalog_uuid
+			where cf.data_catalog_uuid = ? and cf.data_type IN ? and cf.deleted_at is null and c.deleted_at is null`,
+		catalogUUID, []int{int(domain.Data), int(domain.DataArray)}).
+		Scan(&orms).Error
+
+	return orms, err
+}
+
+// GetDataReferrers - живые строки, у которых uid есть в entities
+func (r *Repository) GetDataReferrers(uid uuid.UUID) (orms []CatalogData, err error) {
+	err = r.gorm.DB.
+		Raw("select * from catalog_data where deleted_at is null and entities @> ?::jsonb", entityRef(uid)).
+		Scan(&orms).Error
+
+	return orms, err
+}
+
 func (r *Repository) DeletecatalogField(uid uuid.UUID) (err error) {
 	orm := CatalogFields{}
 
@@ -312,51 +430,667 @@ func (r *Repository) ChangecatalogField(uid uuid.UUID, fieldName string, value i
 	return err
 }
 
-func (r *Repository) AddData(dm domain.CatalogData) (orm CatalogData, err error) {
+// checkEntities проверяет, что строки, на которые ссылаются поля Data/DataArray,
+// существуют, не удалены и лежат в справочнике, указанном в поле
+func (r *Repository) checkEntities(tx *gorm.DB, dm domain.CatalogData) (ent []any, err error) {
 	keys := helpers.GetMapKeys(dm.Entities)
+	if len(keys) == 0 {
+		return []any{}, nil
+	}
+
+	found := []CatalogData{}
+	err = tx.Model(&CatalogData{}).
+		Select("uuid", "catalog_uuid").
+		Where("uuid IN ?", keys).
+		Where("federation_uuid = ?", dm.FederationUUID).
+		Where("company_uuid = ?", dm.CompanyUUID).
+		Where("deleted_at is null").
+		Find(&found).Error
+	if err != nil {
+		return ent, err
+	}
+
+	inDB := make(map[string]uuid.UUID, len(found))
+	for _, item := range found {
+		inDB[item.UUID.String()] = item.CatalogUUID
+	}
+
+	missed := []string{}
+	for _, key := range keys {
+		catalogUUID, ok := inDB[key]
+		if !ok {
+			missed = append(missed, key)
+			continue
+		}
+
+		if expected, ok := dm.Entities[key].(uuid.UUID); ok && expected != catalogUUID {
+			missed = append(missed, key)
+			continue
+		}
+
+		ent = append(ent, domain.UUID{
+			UUID: uuid.MustParse(key),
+		})
+	}
+
+	if len(missed) > 0 {
+		return ent, fmt.Errorf("некоторые записи не найдены (%v)", strings.Join(missed, ", "))
+	}
+
+	return ent, nil
+}
+
+func (r *Repository) AddData(dm domain.CatalogData) (orm CatalogData, err error) {
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		ent, err := r.checkEntities(tx, dm)
+		if err != nil {
+			return err
+		}
 
-	entitesInDB := []string{}
+		orm = CatalogData{
+			UUID:           dm.UUID,
+			FederationUUID: dm.FederationUUID,
+			CompanyUUID:    dm.CompanyUUID,
+			CatalogUUID:    dm.CatalogUUID,
+			Fields:         dm.Fields,
+			Entities:       ent,
 
-	ent := []any{}
+			CreatedBy:     dm.CreatedBy,
+			CreatedByUUID: dm.CreatedByUUID,
+		}
+
+		err = tx.Create(&orm).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&CatalogDataHistory{
+			DataUUID:      orm.UUID,
+			CatalogUUID:   orm.CatalogUUID,
+			Action:        domain.CatalogDataCreated,
+			Fields:        orm.Fields,
+			Changes:       DiffFields(nil, orm.Fields),
+			CreatedBy:     dm.CreatedBy,
+			CreatedByUUID: dm.CreatedByUUID,
+		}).Error
+	})
+
+	return orm, err
+}
+
+// AddDataBatch сохраняет уже проверенные строки одной транзакцией, ссылки не перепроверяются
+func (r *Repository) AddDataBatch(dms []domain.CatalogData) error {
+	orms := make([]CatalogData, 0, len(dms))
+	history := make([]CatalogDataHistory, 0, len(dms))
+
+	for _, dm := range dms {
+		ent := lo.Map(helpers.GetMapKeys(dm.Entities), func(key string, _ int) any {
+			return domain.UUID{UUID: uuid.MustParse(key)}
+		})
+
+		orms = append(orms, CatalogData{
+			UUID:           dm.UUID,
+			FederationUUID: dm.FederationUUID,
+			CompanyUUID:    dm.CompanyUUID,
+			CatalogUUID:    dm.CatalogUUID,
+			Fields:         dm.Fields,
+			Entities:       ent,
+
+			CreatedBy:     dm.CreatedBy,
+			CreatedByUUID: dm.CreatedByUUID,
+		})
+
+		history = append(history, CatalogDataHistory{
+			DataUUID:      dm.UUID,
+			CatalogUUID:   dm.CatalogUUID,
+			Action:        domain.CatalogDataCreated,
+			Fields:        dm.Fields,
+			Changes:       DiffFields(nil, dm.Fields),
+			CreatedBy:     dm.CreatedBy,
+			CreatedByUUID: dm.CreatedByUUID,
+		})
+	}
+
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&orms).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&history).Error
+	})
+}
+
+// FindDataByUUIDs возвращает найденные строки справочника в виде uuid -> [uuid]
+func (r *Repository) FindDataByUUIDs(companyUUID, catalogUUID uuid.UUID, uids []uuid.UUID) (res map[string][]uuid.UUID, err error) {
+	res = map[string][]uuid.UUID{}
+
+	for _, chunk := range lo.Chunk(uids, 1000) {
+		found := []uuid.UUID{}
+
+		err = r.gorm.DB.Model(&CatalogData{}).
+			Where("uuid IN ?", chunk).
+			Where("catalog_uuid = ?", catalogUUID).
+			Where("company_uuid = ?", companyUUID).
+			Where("deleted_at is null").
+			Pluck("uuid", &found).Error
+		if err != nil {
+			return res, err
+		}
+
+		for _, uid := range found {
+			res[uid.String()] = []uuid.UUID{uid}
+		}
+	}
+
+	return res, nil
+}
+
+// LookupData ищет строки справочника по значению поля hash, возвращает значение -> [uuid]
+func (r *Repository) LookupData(companyUUID, catalogUUID uuid.UUID, hash string, values []string) (res map[string][]uuid.UUID, err error) {
+	res = map[string][]uuid.UUID{}
+
+	type row struct {
+		UUID  uuid.UUID
+		Value string
+	}
 
-	if len(keys) > 0 {
-		r.gorm.DB.Model(&CatalogData{}).
-			Select("uuid").
-			Where("uuid IN ?", keys).
-			Where("federation_uuid = ?", dm.FederationUUID).
-			Where("company_uuid = ?", dm.CompanyUUID).
-			Find(&entitesInDB)
+	for _, chunk := range lo.Chunk(values, 1000) {
+		rows := []row{}
 
-		if len(entitesInDB) != len(keys) {
-			diff := lo.Interleave(entitesInDB, keys)
+		err = r.gorm.DB.Raw(`select uuid, fields->>? as value from catalog_data
+			where catalog_uuid = ? and company_uuid = ? and deleted_at is null and fields->>? IN ?`,
+			hash, catalogUUID, companyUUID, hash, chunk).
+			Scan(&rows).Error
+		if err != nil {
+			return res, err
+		}
 
-			return orm, fmt.Errorf("некоторые записи не найдены (%v)", strings.Join(diff, ", "))
+		for _, item := range rows {
+			res[item.Value] = append(res[item.Value], item.UUID)
 		}
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetDataRow(uid uuid.UUID) (orm CatalogData, err error) {
+	err = r.gorm.DB.Model(&orm).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return orm, dto.NotFoundErr("запись справочника не найдена")
+	}
+
+	return orm, err
+}
 
-		for _, uid := range entitesInDB {
-			ent = append(ent, domain.UUID{
-				UUID: uuid.MustParse(uid),
+// UpdateData заменяет поля строки и пишет в историю изменившиеся значения,
+// changed = false, если значения полей не поменялись
+func (r *Repository) UpdateData(dm domain.CatalogData, crtr domain.Creator) (orm CatalogData, changed bool, err error) {
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw("select * from catalog_data where uuid = ? and deleted_at is null FOR UPDATE", dm.UUID).
+			Scan(&orm).Error
+		if err != nil {
+			return err
+		}
+
+		if orm.UUID == uuid.Nil {
+			return dto.NotFoundErr("запись справочника не найдена")
+		}
+
+		changes := DiffFields(orm.Fields, dm.Fields)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		dm.FederationUUID = orm.FederationUUID
+		dm.CompanyUUID = orm.CompanyUUID
+
+		ent, err := r.checkEntities(tx, dm)
+		if err != nil {
+			return err
+		}
+
+		orm.Fields = dm.Fields
+		orm.Entities = ent
+
+		err = tx.Model(&CatalogData{}).
+			Where("uuid = ?", orm.UUID).
+			Updates(map[string]interface{}{
+				"fields":     orm.Fields,
+				"entities":   orm.Entities,
+				"updated_at": gorm.Expr("now()"),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		changed = true
+
+		return tx.Create(&CatalogDataHistory{
+			DataUUID:      orm.UUID,
+			CatalogUUID:   orm.CatalogUUID,
+			Action:        domain.CatalogDataUpdated,
+			Fields:        orm.Fields,
+			Changes:       changes,
+			CreatedBy:     crtr.Email,
+			CreatedByUUID: crtr.UUID,
+		}).Error
+	})
+
+	return orm, changed, err
+}
+
+// DeleteData удаляет строку и применяет политики полей, которые на нее ссылаются:
+// block - отменяет удаление, cascade - удаляет ссылающуюся строку, set_null - убирает ссылку.
+// Для задач действуют политики полей компании, задачи не удаляются. Возвращает удаленные
+// и измененные строки и измененные задачи
+func (r *Repository) DeleteData(uid uuid.UUID, crtr domain.Creator) (deleted, updated, tasks []uuid.UUID, err error) {
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		return r.deleteData(tx, uid, crtr, &deleted, &updated, &tasks)
+	})
+
+	return deleted, updated, tasks, err
+}
+
+func (r *Repository) deleteData(tx *gorm.DB, uid uuid.UUID, crtr domain.Creator, deleted, updated, tasks *[]uuid.UUID) error {
+	orm := CatalogData{}
+	err := tx.Raw("update catalog_data set deleted_at = now() where uuid = ? and deleted_at is null returning *", uid).
+		Scan(&orm).Error
+	if err != nil {
+		return err
+	}
+
+	if orm.UUID == uuid.Nil {
+		return dto.NotFoundErr("запись справочника не найдена")
+	}
+
+	*deleted = append(*deleted, orm.UUID)
+
+	err = tx.Create(&CatalogDataHistory{
+		DataUUID:      orm.UUID,
+		CatalogUUID:   orm.CatalogUUID,
+		Action:        domain.CatalogDataDeleted,
+		Fields:        orm.Fields,
+		Changes:       JSONB{},
+		CreatedBy:     crtr.Email,
+		CreatedByUUID: crtr.UUID,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	err = r.unlinkTasks(tx, uid, tasks)
+	if err != nil {
+		return err
+	}
+
+	fields, err := r.referencingFields(tx, orm.CatalogUUID)
+	if err != nil || len(fields) == 0 {
+		return err
+	}
+
+	referrers := []CatalogData{}
+	err = tx.Raw("select * from catalog_data where deleted_at is null and entities @> ?::jsonb FOR UPDATE", entityRef(uid)).
+		Scan(&referrers).Error
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range referrers {
+		policy, used := ReferencePolicy(ref.CatalogUUID, ref.Fields, fields, uid)
+
+		switch policy {
+		case domain.OnDeleteBlock:
+			return fmt.Errorf("%w: справочник %q, поле %q, запись %s", ErrDataInUse, used[0].CatalogName, used[0].Name, ref.UUID)
+
+		case domain.OnDeleteCascade:
+			if lo.Contains(*deleted, ref.UUID) {
+				continue
+			}
+
+			err = r.deleteData(tx, ref.UUID, crtr, deleted, updated, tasks)
+			if err != nil {
+				return err
+			}
+
+		case domain.OnDeleteSetNull:
+			next := JSONB(ref.Fields)
+			for _, f := range used {
+				next = RemoveRef(next, f.Hash, uid)
+			}
+
+			ent := lo.Filter(ref.Entities, func(item any, _ int) bool {
+				e, ok := item.(map[string]interface{})
+				return !ok || e["uuid"] != uid.String()
 			})
+
+			err = tx.Model(&CatalogData{}).
+				Where("uuid = ?", ref.UUID).
+				Updates(map[string]interface{}{
+					"fields":     next,
+					"entities":   JSONArray(ent),
+					"updated_at": gorm.Expr("now()"),
+				}).Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.Create(&CatalogDataHistory{
+				DataUUID:      ref.UUID,
+				CatalogUUID:   ref.CatalogUUID,
+				Action:        domain.CatalogDataUpdated,
+				Fields:        next,
+				Changes:       DiffFields(ref.Fields, next),
+				CreatedBy:     crtr.Email,
+				CreatedByUUID: crtr.UUID,
+			}).Error
+			if err != nil {
+				return err
+			}
+
+			*updated = append(*updated, ref.UUID)
 		}
 	}
 
-	orm = CatalogData{
-		UUID:           dm.UUID,
-		FederationUUID: dm.FederationUUID,
-		CompanyUUID:    dm.CompanyUUID,
-		CatalogUUID:    dm.CatalogUUID,
-		Fields:         dm.Fields,
-		Entities:       ent,
+	return nil
+}
+
+// unlinkTasks применяет к задачам, которые ссылаются на строку uid, политики их полей: block отменяет
+// удаление, set_null убирает ссылку. Ссылки через удаленные поля просто убираются
+func (r *Repository) unlinkTasks(tx *gorm.DB, uid uuid.UUID, tasks *[]uuid.UUID) error {
+	refs := []TaskRefs{}
+	err := tx.Raw("select uuid, fields, task_entities from tasks where deleted_at is null and jsonb_exists(task_entities, ?) FOR UPDATE", uid.String()).
+		Scan(&refs).Error
+	if err != nil || len(refs) == 0 {
+		return err
+	}
+
+	hashes := lo.Uniq(lo.FlatMap(refs, func(t TaskRefs, _ int) []string { return TaskRefHashes(t.TaskEntities, uid) }))
 
-		CreatedBy:     dm.CreatedBy,
-		CreatedByUUID: dm.CreatedByUUID,
+	policies := []TaskFieldPolicy{}
+	err = tx.Raw(`select t.uuid as task_uuid, cf.hash, cf.name, cf.on_delete from tasks t
+			join projects p on p.uuid = t.project_uuid
+			join company_fields cf on cf.company_uuid = p.company_uuid
+			where t.uuid in ? and cf.hash in ? and cf.deleted_at is null`,
+		lo.Map(refs, func(t TaskRefs, _ int) uuid.UUID { return t.UUID }), hashes).
+		Scan(&policies).Error
+	if err != nil {
+		return err
 	}
 
+	for _, t := range refs {
+		hashes := TaskRefHashes(t.TaskEntities, uid)
+
+		block, ok := lo.Find(policies, func(p TaskFieldPolicy) bool {
+			return p.TaskUUID == t.UUID && lo.Contains(hashes, p.Hash) && onDelete(p.OnDelete) == domain.OnDeleteBlock
+		})
+		if ok {
+			return fmt.Errorf("%w: задача %s, поле %q", ErrDataInUse, t.UUID, block.Name)
+		}
+
+		fields, te := UnlinkTask(t, uid)
+
+		err = tx.Table("tasks").
+			Where("uuid = ?", t.UUID).
+			Updates(map[string]interface{}{
+				"fields":        fields,
+				"task_entities": te,
+				"updated_at":    gorm.Expr("now()"),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		if !lo.Contains(*tasks, t.UUID) {
+			*tasks = append(*tasks, t.UUID)
+		}
+	}
+
+	return nil
+}
+
+// GetCatalogRows - все живые строки справочника
+func (r *Repository) GetCatalogRows(catalogUUID uuid.UUID) (orms []CatalogData, err error) {
 	err = r.gorm.DB.
-		Create(&orm).
-		Error
+		Where("catalog_uuid = ?", catalogUUID).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&orms).Error
 
-	return orm, err
+	return orms, err
+}
+
+// MergeData удаляет строку duplicateUUID, записывает строке survivorUUID поля, которые merge собирает
+// из заблокированных в транзакции строк, и переводит на нее ссылки строк других справочников и задач.
+// Возвращает измененные ссылающиеся строки
+func (r *Repository) MergeData(survivorUUID, duplicateUUID uuid.UUID, crtr domain.Creator, merge func(survivor, duplicate CatalogData) (domain.CatalogData, error)) (orm CatalogData, updated, tasks []uuid.UUID, err error) {
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		dup := CatalogData{}
+		err := tx.Raw("update catalog_data set deleted_at = now() where uuid = ? and deleted_at is null returning *", duplicateUUID).
+			Scan(&dup).Error
+		if err != nil {
+			return err
+		}
+
+		if dup.UUID == uuid.Nil {
+			return dto.NotFoundErr("запись справочника не найдена")
+		}
+
+		err = tx.Raw("select * from catalog_data where uuid = ? and deleted_at is null FOR UPDATE", survivorUUID).
+			Scan(&orm).Error
+		if err != nil {
+			return err
+		}
+
+		if orm.UUID == uuid.Nil {
+			return dto.NotFoundErr("запись справочника не найдена")
+		}
+
+		dm, err := merge(orm, dup)
+		if err != nil {
+			return err
+		}
+
+		dm.UUID = orm.UUID
+		dm.FederationUUID = orm.FederationUUID
+		dm.CompanyUUID = orm.CompanyUUID
+
+		ent, err := r.checkEntities(tx, dm)
+		if err != nil {
+			return err
+		}
+
+		changes := DiffFields(orm.Fields, dm.Fields)
+		orm.Fields = dm.Fields
+		orm.Entities = ent
+
+		err = tx.Model(&CatalogData{}).
+			Where("uuid = ?", orm.UUID).
+			Updates(map[string]interface{}{
+				"fields":     orm.Fields,
+				"entities":   orm.Entities,
+				"updated_at": gorm.Expr("now()"),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create([]CatalogDataHistory{
+			{
+				DataUUID:      orm.UUID,
+				CatalogUUID:   orm.CatalogUUID,
+				Action:        domain.CatalogDataMerged,
+				Fields:        orm.Fields,
+				Changes:       changes,
+				MergedUUID:    &dup.UUID,
+				CreatedBy:     crtr.Email,
+				CreatedByUUID: crtr.UUID,
+			},
+			{
+				DataUUID:      dup.UUID,
+				CatalogUUID:   dup.CatalogUUID,
+				Action:        domain.CatalogDataMerged,
+				Fields:        dup.Fields,
+				Changes:       JSONB{},
+				MergedUUID:    &orm.UUID,
+				CreatedBy:     crtr.Email,
+				CreatedByUUID: crtr.UUID,
+			},
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		updated, err = r.moveDataRefs(tx, orm, dup.UUID, crtr)
+		if err != nil {
+			return err
+		}
+
+		tasks, err = r.moveTaskRefs(tx, orm.UUID, dup.UUID)
+		return err
+	})
+
+	return orm, updated, tasks, err
+}
+
+// moveDataRefs переводит ссылки живых строк с from на строку to и пишет изменения в их историю
+func (r *Repository) moveDataRefs(tx *gorm.DB, to CatalogData, from uuid.UUID, crtr domain.Creator) (updated []uuid.UUID, err error) {
+	fields, err := r.referencingFields(tx, to.CatalogUUID)
+	if err != nil || len(fields) == 0 {
+		return updated, err
+	}
+
+	referrers := []CatalogData{}
+	err = tx.Raw("select * from catalog_data where deleted_at is null and entities @> ?::jsonb FOR UPDATE", entityRef(from)).
+		Scan(&referrers).Error
+	if err != nil {
+		return updated, err
+	}
+
+	for _, ref := range referrers {
+		_, used := ReferencePolicy(ref.CatalogUUID, ref.Fields, fields, from)
+		if len(used) == 0 {
+			continue
+		}
+
+		next := JSONB(ref.Fields)
+		for _, f := range used {
+			next = ReplaceRef(next, f.Hash, from, to.UUID)
+		}
+
+		ent := lo.Filter(ref.Entities, func(item any, _ int) bool {
+			e, ok := item.(map[string]interface{})
+			return !ok || e["uuid"] != from.String() && e["uuid"] != to.UUID.String()
+		})
+		ent = append(ent, domain.UUID{UUID: to.UUID})
+
+		err = tx.Model(&CatalogData{}).
+			Where("uuid = ?", ref.UUID).
+			Updates(map[string]interface{}{
+				"fields":     next,
+				"entities":   JSONArray(ent),
+				"updated_at": gorm.Expr("now()"),
+			}).Error
+		if err != nil {
+			return updated, err
+		}
+
+		err = tx.Create(&CatalogDataHistory{
+			DataUUID:      ref.UUID,
+			CatalogUUID:   ref.CatalogUUID,
+			Action:        domain.CatalogDataUpdated,
+			Fields:        next,
+			Changes:       DiffFields(ref.Fields, next),
+			CreatedBy:     crtr.Email,
+			CreatedByUUID: crtr.UUID,
+		}).Error
+		if err != nil {
+			return updated, err
+		}
+
+		updated = append(updated, ref.UUID)
+	}
+
+	return updated, nil
+}
+
+// moveTaskRefs переводит поля задач, которые ссылаются на строку from, на строку to, и возвращает измененные задачи
+func (r *Repository) moveTaskRefs(tx *gorm.DB, to, from uuid.UUID) (moved []uuid.UUID, err error) {
+	tasks := []TaskRefs{}
+	err = tx.Raw("select uuid, fields, task_entities from tasks where deleted_at is null and jsonb_exists(task_entities, ?) FOR UPDATE", from.String()).
+		Scan(&tasks).Error
+	if err != nil {
+		return moved, err
+	}
+
+	for _, t := range tasks {
+		hashes := TaskRefHashes(t.TaskEntities, from)
+
+		next := t.Fields
+		for _, h := range hashes {
+			next = ReplaceRef(next, h, from, to)
+		}
+
+		te := JSONB{}
+		for k, v := range t.TaskEntities {
+			te[k] = v
+		}
+		delete(te, from.String())
+		te[to.String()] = lo.Uniq(append(lo.Map(asSlice(te[to.String()]), func(h interface{}, _ int) string { return fmt.Sprint(h) }), hashes...))
+
+		err = tx.Table("tasks").
+			Where("uuid = ?", t.UUID).
+			Updates(map[string]interface{}{
+				"fields":        next,
+				"task_entities": te,
+				"updated_at":    gorm.Expr("now()"),
+			}).Error
+		if err != nil {
+			return moved, err
+		}
+
+		moved = append(moved, t.UUID)
+	}
+
+	return moved, nil
+}
+
+func asSlice(v interface{}) []interface{} {
+	s, _ := v.([]interface{})
+	return s
+}
+
+func (r *Repository) GetDataHistory(uid uuid.UUID) (dms []domain.CatalogDataHistory, err error) {
+	orms := []CatalogDataHistory{}
+
+	err = r.gorm.DB.Model(&CatalogDataHistory{}).
+		Where("data_uuid = ?", uid).
+		Order("created_at desc").
+		Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = lo.Map(orms, func(item CatalogDataHistory, index int) domain.CatalogDataHistory {
+		return domain.CatalogDataHistory{
+			UUID:          item.UUID,
+			DataUUID:      item.DataUUID,
+			CatalogUUID:   item.CatalogUUID,
+			Action:        item.Action,
+			Fields:        item.Fields,
+			Changes:       item.Changes,
+			MergedUUID:    item.MergedUUID,
+			CreatedBy:     item.CreatedBy,
+			CreatedByUUID: item.CreatedByUUID,
+			CreatedAt:     item.CreatedAt,
+		}
+	})
+
+	return dms, err
 }
 
 func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (dms []domain.CatalogData, total int64, err error) {
@@ -379,7 +1113,9 @@ func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (d
 		queryWhere := r.gorm.DB
 		for _, item := range filter.Fields {
 			// @todo: add regular to check array
-			if strings.HasPrefix(fmt.Sprintf("%v", item.Value), "@> [") && strings.HasSuffix(fmt.Sprintf("%v", item.Value), "]") {
+			if rng, ok := item.Value.(dto.RangeDTO); ok && item.Operator == dto.FilterRange {
+				queryWhere = postgres.WhereFieldRange(queryWhere, item.Name, rng.From, rng.To, rng.Currency)
+			} else if strings.HasPrefix(fmt.Sprintf("%v", item.Value), "@> [") && strings.HasSuffix(fmt.Sprintf("%v", item.Value), "]") {
 				v := strings.TrimPrefix(item.Value.(string), "@> ")
 				queryWhere = queryWhere.Where(" fields->? @> ?", item.Name, v)
 			} else {
@@ -436,6 +1172,7 @@ func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (d
 				where  
 				 
 				o.catalog_uuid = ? 
+				and o.deleted_at is null
 				`+sqlWhere+" "+orderStr+" "+` 
 					limit ? offset ?
 
@@ -58,6 +58,10 @@ func (s *Service) GetTaskComments(uid uuid.UUID, withFiles, withLikes bool) (dms
 	// Files
 	if withFiles {
 		for i, dm := range dms {
+			if dm.IsDeleted() || dm.IsHidden() {
+				continue
+			}
+
 			files, err := s.storage.GetCommentFiles(dm.UUID, true)
 			if err != nil {
 				return dms, err
@@ -146,11 +150,71 @@ func (s *Service) UpdateComment(ctx context.Context, comment domain.Comment) (er
 		return dto.NotFoundErr("один из пользователей не найден")
 	}
 
+	prev, err := s.repo.GetComment(comment.UUID)
+	if err != nil {
+		return err
+	}
+
+	if prev.HiddenAt != nil {
+		return dto.ForbiddenErr("скрытый комментарий нельзя изменить, сначала восстановите его")
+	}
+
 	err = s.repo.UpdateComment(ctx, comment)
 	if err != nil {
 		return err
 	}
 
+	editor, found := s.dict.FindUser(comment.CreatedBy)
+	if !found {
+		return dto.NotFoundErr("пользователь не найден")
+	}
+
+	comment.TaskUUID = prev.TaskUUID
+	comment.CreatedBy = prev.CreatedBy
+
+	_, err = s.act.TaskCommentWasChanged(domain.Creator{UUID: editor.UUID, Email: editor.Email}, comment, prev.Comment, comment.Comment)
+
+	return err
+}
+
+func (s *Service) GetCommentHistory(_ context.Context, commentUUID uuid.UUID) (dms []domain.CommentRevision, err error) {
+	_, err = s.repo.GetComment(commentUUID)
+	if err != nil {
+		return dms, err
+	}
+
+	return s.repo.GetCommentRevisions(commentUUID)
+}
+
+func (s *Service) HideComment(ctx context.Context, crtr domain.Creator, commentUUID uuid.UUID) (err error) {
+	comment, err := s.GetComment(ctx, commentUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.HideComment(ctx, comment.TaskUUID, comment.UUID, crtr.Email)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.act.TaskCommentWasHidden(crtr, comment)
+
+	return err
+}
+
+func (s *Service) RestoreComment(ctx context.Context, crtr domain.Creator, commentUUID uuid.UUID) (err error) {
+	comment, err := s.GetComment(ctx, commentUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.RestoreComment(ctx, comment.TaskUUID, comment.UUID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.act.TaskCommentWasRestored(crtr, comment)
+
 	return err
 }
 
@@ -198,9 +262,19 @@ func (s *Service) PinComment(_ context.Context, commentUUID uuid.UUID) (err erro
 	return err
 }
 
-func (s *Service) DeleteComment(ctx context.Context, taskUUID, commentUID uuid.UUID, _ *uuid.UUID) (err error) {
-	err = s.repo.DeleteComment(ctx, taskUUID, commentUID)
+func (s *Service) DeleteComment(ctx context.Context, taskUUID, commentUID uuid.UUID, crtr domain.Creator) (err error) {
+	comment, err := s.GetComment(ctx, commentUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteComment(ctx, taskUUID, commentUID, crtr.Email)
+
+	if err != nil {
+		return err
+	}
 
+	_, err = s.act.TaskCommentWasDeleted(crtr, comment)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,8 @@ type Comment struct {
 	Comment   string    `gorm:"type:varchar(500);default:'';not null"`
 	CreatedBy string    `gorm:"type:varchar(100);default:'';not null;"`
 
+	CommentHTML string `gorm:"type:text;default:'';not null"`
+
 	TaskUUID uuid.UUID `gorm:"type:uuid;not null"`
 
 	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null;"`
@@ -26,6 +28,24 @@ type Comment struct {
 	People Persons `gorm:"type:text[];default:'{}';not null;"`
 
 	Pin bool `gorm:"type:boolean;default:false;not null;"`
+
+	DeletedAt *time.Time `gorm:"type:timestamptz;"`
+	DeletedBy *string    `gorm:"type:varchar(200);"`
+
+	HiddenAt *time.Time `gorm:"type:timestamptz;"`
+	HiddenBy *string    `gorm:"type:varchar(200);"`
+}
+
+type CommentRevision struct {
+	UUID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	CommentUUID uuid.UUID `gorm:"type:uuid;not null"`
+	TaskUUID    uuid.UUID `gorm:"type:uuid;not null"`
+
+	Comment string  `gorm:"type:text;default:'';not null"`
+	People  Persons `gorm:"type:jsonb;default:'{}';not null;"`
+
+	EditedBy  string    `gorm:"type:varchar(200);not null;"`
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null;"`
 }
 
 // JSONB Interface for JSONB Field of yourTableName Table.
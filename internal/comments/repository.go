@@ -11,6 +11,7 @@ import (
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -49,6 +50,8 @@ func (r *Repository) CreateComment(ctx context.Context, cmnt domain.Comment) (er
 			TaskUUID:  cmnt.TaskUUID,
 			Comment:   cmnt.Comment,
 
+			CommentHTML: cmnt.CommentHTML,
+
 			CreatedBy: cmnt.CreatedBy,
 
 			CreatedAt: cmnt.CreatedAt,
@@ -79,15 +82,43 @@ func (r *Repository) UpdateComment(ctx context.Context, cmnt domain.Comment) (er
 	defer r.storeTime("UpdateComment", tm())
 
 	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		prev := Comment{}
+
+		res := tx.
+			Where("uuid = ?", cmnt.UUID).
+			Where("deleted_at IS NULL").
+			First(&prev)
+
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("комментарий не найден")
+		}
+
+		rev := (&domain.Comment{UUID: prev.UUID, TaskUUID: prev.TaskUUID, Comment: prev.Comment, People: prev.People}).Revision(cmnt.CreatedBy)
+
+		revision := CommentRevision{
+			UUID:        rev.UUID,
+			CommentUUID: rev.CommentUUID,
+			TaskUUID:    rev.TaskUUID,
+			Comment:     rev.Comment,
+			People:      rev.People,
+			EditedBy:    rev.EditedBy,
+		}
+
+		err := tx.Create(&revision).Error
+		if err != nil {
+			return err
+		}
+
 		orm := Comment{
-			UUID:      cmnt.UUID,
-			ReplyUUID: cmnt.ReplyUUID,
-			Comment:   cmnt.Comment,
-			People:    cmnt.People,
-			Likes:     cmnt.Likes,
+			UUID:        cmnt.UUID,
+			ReplyUUID:   cmnt.ReplyUUID,
+			Comment:     cmnt.Comment,
+			CommentHTML: cmnt.CommentHTML,
+			People:      cmnt.People,
+			Likes:       cmnt.Likes,
 		}
 
-		err := tx.
+		err = tx.
 			Updates(orm).
 			Where("uuid = ?", cmnt.UUID).
 			Error
@@ -107,7 +138,7 @@ func (r *Repository) UpdateComment(ctx context.Context, cmnt domain.Comment) (er
 	return err
 }
 
-func (r *Repository) DeleteComment(ctx context.Context, taskUUID, uid uuid.UUID) (err error) {
+func (r *Repository) DeleteComment(ctx context.Context, taskUUID, uid uuid.UUID, deletedBy string) (err error) {
 	defer r.storeTime("DeleteComment", tm())
 
 	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
@@ -125,7 +156,10 @@ func (r *Repository) DeleteComment(ctx context.Context, taskUUID, uid uuid.UUID)
 		res := r.gorm.DB.
 			Model(orm).
 			Where("uuid = ?", uid.String()).
-			Update("deleted_at", "now()")
+			Updates(map[string]interface{}{
+				"deleted_at": "now()",
+				"deleted_by": deletedBy,
+			})
 
 		if res.Error != nil {
 			return res.Error
@@ -153,9 +187,8 @@ func (r *Repository) GetTaskComments(uid uuid.UUID, commentUID *uuid.UUID) (dms
 	orm := []Comment{}
 	q := r.gorm.DB.
 		Model(orm).
-		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.pin, c.comment as reply_comment").
+		Select("comments.uuid, comments.comment, comments.comment_html, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.pin, comments.deleted_at, comments.deleted_by, comments.hidden_at, comments.hidden_by, CASE WHEN c.deleted_at IS NULL AND c.hidden_at IS NULL THEN c.comment END as reply_comment").
 		Where("comments.task_uuid = ?", uid).
-		Where("comments.deleted_at IS NULL").
 		Joins("LEFT JOIN comments c ON c.uuid = comments.reply_uuid").
 		Order("comments.pin DESC, comments.created_at DESC").
 		Limit(300)
@@ -167,9 +200,10 @@ func (r *Repository) GetTaskComments(uid uuid.UUID, commentUID *uuid.UUID) (dms
 	err = q.Find(&orm).Error
 
 	for _, o := range orm {
-		dms = append(dms, domain.Comment{
+		dm := domain.Comment{
 			UUID:         o.UUID,
 			Comment:      o.Comment,
+			CommentHTML:  o.CommentHTML,
 			CreatedBy:    o.CreatedBy,
 			ReplyUUID:    o.ReplyUUID,
 			ReplyComment: o.ReplyComment,
@@ -179,7 +213,16 @@ func (r *Repository) GetTaskComments(uid uuid.UUID, commentUID *uuid.UUID) (dms
 			UpdatedAt:    o.UpdatedAt,
 			Likes:        o.Likes,
 			Pin:          o.Pin,
-		})
+			DeletedAt:    o.DeletedAt,
+			DeletedBy:    lo.FromPtr(o.DeletedBy),
+			HiddenAt:     o.HiddenAt,
+			HiddenBy:     lo.FromPtr(o.HiddenBy),
+		}
+
+		// deleted and hidden comments are returned as placeholders without text
+		dm.Placeholder()
+
+		dms = append(dms, dm)
 	}
 
 	return dms, err
@@ -191,7 +234,7 @@ func (r *Repository) GetTaskComment(commentUID uuid.UUID) (dms domain.Comment, e
 	orm := Comment{}
 	err = r.gorm.DB.
 		Model(orm).
-		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.pin, c.comment as reply_comment").
+		Select("comments.uuid, comments.comment, comments.comment_html, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.pin, comments.hidden_at, comments.hidden_by, c.comment as reply_comment").
 		Where("comments.deleted_at IS NULL").
 		Joins("LEFT JOIN comments c ON c.uuid = comments.reply_uuid").
 		Order("comments.pin DESC, comments.created_at DESC").
@@ -203,6 +246,7 @@ func (r *Repository) GetTaskComment(commentUID uuid.UUID) (dms domain.Comment, e
 	return domain.Comment{
 		UUID:         orm.UUID,
 		Comment:      orm.Comment,
+		CommentHTML:  orm.CommentHTML,
 		CreatedBy:    orm.CreatedBy,
 		ReplyUUID:    orm.ReplyUUID,
 		ReplyComment: orm.ReplyComment,
@@ -212,6 +256,8 @@ func (r *Repository) GetTaskComment(commentUID uuid.UUID) (dms domain.Comment, e
 		UpdatedAt:    orm.UpdatedAt,
 		Likes:        orm.Likes,
 		Pin:          orm.Pin,
+		HiddenAt:     orm.HiddenAt,
+		HiddenBy:     lo.FromPtr(orm.HiddenBy),
 	}, err
 }
 
@@ -286,3 +332,82 @@ func (r *Repository) PatchCommentPin(uid uuid.UUID) (err error) {
 
 	return res.Error
 }
+
+func (r *Repository) GetCommentRevisions(commentUID uuid.UUID) (dms []domain.CommentRevision, err error) {
+	defer r.storeTime("GetCommentRevisions", tm())
+
+	orm := []CommentRevision{}
+	err = r.gorm.DB.
+		Model(orm).
+		Where("comment_uuid = ?", commentUID).
+		Order("created_at DESC").
+		Limit(100).
+		Find(&orm).
+		Error
+
+	for _, o := range orm {
+		dms = append(dms, domain.CommentRevision{
+			UUID:        o.UUID,
+			CommentUUID: o.CommentUUID,
+			TaskUUID:    o.TaskUUID,
+			Comment:     o.Comment,
+			People:      o.People,
+			EditedBy:    o.EditedBy,
+			CreatedAt:   o.CreatedAt,
+		})
+	}
+
+	return dms, err
+}
+
+func (r *Repository) HideComment(ctx context.Context, taskUUID, uid uuid.UUID, hiddenBy string) (err error) {
+	defer r.storeTime("HideComment", tm())
+
+	res := r.gorm.DB.
+		Model(&Comment{}).
+		Where("comments.uuid = ?", uid).
+		Where("comments.deleted_at IS NULL").
+		Where("comments.hidden_at IS NULL").
+		Updates(map[string]interface{}{
+			"hidden_at": "now()",
+			"hidden_by": hiddenBy,
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("комментарий не найден или уже скрыт")
+	}
+
+	go r.cache.ClearTask(ctx, taskUUID)
+
+	return res.Error
+}
+
+func (r *Repository) RestoreComment(ctx context.Context, taskUUID, uid uuid.UUID) (err error) {
+	defer r.storeTime("RestoreComment", tm())
+
+	res := r.gorm.DB.
+		Model(&Comment{}).
+		Where("comments.uuid = ?", uid).
+		Where("comments.deleted_at IS NULL").
+		Where("comments.hidden_at IS NOT NULL").
+		Updates(map[string]interface{}{
+			"hidden_at": nil,
+			"hidden_by": nil,
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("скрытый комментарий не найден")
+	}
+
+	go r.cache.ClearTask(ctx, taskUUID)
+
+	return res.Error
+}
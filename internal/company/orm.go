@@ -49,8 +49,14 @@ type Company struct {
 }
 
 type SmsOptions struct {
-	API  string `json:"api"`
-	From string `json:"from"`
+	// Provider - smsru (по умолчанию), smpp, http или fake
+	Provider string            `json:"provider"`
+	API      string            `json:"api"`
+	From     string            `json:"from"`
+	Params   map[string]string `json:"params"`
+
+	// RateLimit - sms в минуту для рассылок, 0 - по умолчанию
+	RateLimit int `json:"rate_limit"`
 }
 
 func (j *SmsOptions) Scan(value interface{}) error {
@@ -34,6 +34,33 @@ type Configs struct {
 	SMTP_ENABLE bool   `env:"SMTP_ENABLE" envDefault:"true"`
 	SMTP_CREDS  string `env:"SMTP_CREDS" secured:"true"`
 
+	// EMAIL_TRANSPORT - smtp, maildir (письма складываются в EMAIL_MAILDIR) или memory
+	EMAIL_TRANSPORT       string `env:"EMAIL_TRANSPORT" envDefault:"smtp"`
+	EMAIL_FROM            string `env:"EMAIL_FROM" envDefault:""`
+	EMAIL_MAILDIR         string `env:"EMAIL_MAILDIR" envDefault:"./var/maildir"`
+	EMAIL_MAX_ATTEMPTS    int    `env:"EMAIL_MAX_ATTEMPTS" envDefault:"8"`
+	EMAIL_OUTBOX_INTERVAL int    `env:"EMAIL_OUTBOX_INTERVAL" envDefault:"5"`
+	// EMAIL_LOCALE - язык писем (ru или en), если у федерации он не задан
+	EMAIL_LOCALE          string `env:"EMAIL_LOCALE" envDefault:"ru"`
+
+	// INBOUND_EMAIL_DOMAIN - домен адресов входящей почты, пустой - прием выключен.
+	// INBOUND_EMAIL_SECRET - ключ подписи адресов ответов, обязателен при включенном приеме.
+	// INBOUND_EMAIL_MODE - smtp (слушает INBOUND_SMTP_ADDR) или maildir (читает INBOUND_MAILDIR).
+	// INBOUND_AUTHSERV_ID - authserv-id доверенного MTA, его Authentication-Results (DKIM/SPF/DMARC) учитываются
+	// только в режиме maildir и для писем с адресов INBOUND_TRUSTED_RELAYS.
+	INBOUND_EMAIL_DOMAIN    string   `env:"INBOUND_EMAIL_DOMAIN" envDefault:""`
+	INBOUND_EMAIL_SECRET    string   `env:"INBOUND_EMAIL_SECRET" envDefault:"" secured:"true"`
+	INBOUND_EMAIL_MODE      string   `env:"INBOUND_EMAIL_MODE" envDefault:"smtp"`
+	INBOUND_SMTP_ADDR       string   `env:"INBOUND_SMTP_ADDR" envDefault:"127.0.0.1:2525"`
+	INBOUND_MAILDIR         string   `env:"INBOUND_MAILDIR" envDefault:"./var/inbound"`
+	INBOUND_POLL_INTERVAL   int      `env:"INBOUND_POLL_INTERVAL" envDefault:"10"`
+	INBOUND_AUTHSERV_ID     string   `env:"INBOUND_AUTHSERV_ID" envDefault:""`
+	INBOUND_TRUSTED_RELAYS  []string `env:"INBOUND_TRUSTED_RELAYS" envDefault:"127.0.0.1,::1"`
+
+	// SMS
+	SMS_STATUS_POLL_INTERVAL int    `env:"SMS_STATUS_POLL_INTERVAL" envDefault:"300"`
+	SMS_CALLBACK_SECRET      string `env:"SMS_CALLBACK_SECRET" envDefault:"" secured:"true"`
+
 	// APP
 	GZIP                     int    `env:"GZIP" envDefault:"5"`
 	LOG_LEVEL                string `env:"LOG_LEVEL" envDefault:"debug"`
@@ -46,6 +73,21 @@ type Configs struct {
 	URL_BACKEND              string `env:"URL_BACKEND" envDefault:"http://localhost:8080"`
 
 	// CDN
+	// CDN_BACKEND - minio (S3) или fs: файлы хранятся в CDN_*_FS_ROOT и отдаются приложением по ссылкам,
+	// подписанным CDN_*_FS_SECRET. Без секрета fs-хранилище не запускается
+	CDN_BACKEND         string `env:"CDN_BACKEND" envDefault:"minio"`
+	CDN_PUBLIC_FS_ROOT    string `env:"CDN_PUBLIC_FS_ROOT" envDefault:"./var/blobs/public"`
+	CDN_PUBLIC_FS_SECRET  string `env:"CDN_PUBLIC_FS_SECRET" envDefault:"" secured:"true"`
+	CDN_PRIVATE_FS_ROOT   string `env:"CDN_PRIVATE_FS_ROOT" envDefault:"./var/blobs/private"`
+	CDN_PRIVATE_FS_SECRET string `env:"CDN_PRIVATE_FS_SECRET" envDefault:"" secured:"true"`
+	// CDN_MAX_UPLOAD_SIZE - предельный размер файла составной загрузки в МБ
+	CDN_MAX_UPLOAD_SIZE int    `env:"CDN_MAX_UPLOAD_SIZE" envDefault:"5120"`
+
+	// AV_SCANNER - антивирус для загруженных файлов: none, clamd (CLAMD_ADDR: tcp://host:3310 или unix:///path) или stub
+	AV_SCANNER         string `env:"AV_SCANNER" envDefault:"none"`
+	CLAMD_ADDR         string `env:"CLAMD_ADDR" envDefault:"tcp://localhost:3310"`
+	AV_RESCAN_INTERVAL int    `env:"AV_RESCAN_INTERVAL" envDefault:"300"`
+
 	CDN_PUBLIC_REGION            string `env:"CDN_PUBLIC_REGION" envDefault:"us-east-1"`
 	CDN_PUBLIC_ENDPOINT          string `env:"CDN_PUBLIC_ENDPOINT" envDefault:"storage.yandexcloud.net"`
 	CDN_PUBLIC_ACCESS_KEY_ID     string `env:"CDN_PUBLIC_ACCESS_KEY_ID" envDefault:""`
@@ -89,6 +131,9 @@ type Configs struct {
 	CORS_ALLOW_CREDENTIALS bool   `env:"CORS_ALLOW_CREDENTIALS" envDefault:"false"`
 	CORS_ALLOWED_ORIGINS   string `env:"CORS_ALLOWED_ORIGINS" envDefault:"*"`
 
+	// Markdown
+	MARKDOWN_LINK_HOSTS []string `env:"MARKDOWN_LINK_HOSTS" envDefault:""`
+
 	// SMS
 	SMS_API_ID string `env:"SMS_API_ID" secured:"true"`
 	SMS_FROM   string `env:"SMS_FROM" envDefault:"sector"`
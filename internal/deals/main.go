@@ -0,0 +1,243 @@
+package deals
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/activities"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultPriority - приоритет сделки по умолчанию, как в таблице deals
+const DefaultPriority = 10
+
+type Service struct {
+	repo *Repository
+	as   *activities.Service
+}
+
+func New(repo *Repository, as *activities.Service) *Service {
+	return &Service{
+		repo: repo,
+		as:   as,
+	}
+}
+
+func (s *Service) CreatePipeline(_ context.Context, dm *domain.DealPipeline) error {
+	if dm.UUID == uuid.Nil {
+		dm.UUID = uuid.New()
+	}
+
+	if err := dm.Validate(); err != nil {
+		return err
+	}
+
+	return s.repo.CreatePipeline(*dm)
+}
+
+func (s *Service) GetPipelines(_ context.Context, companyUUID uuid.UUID) ([]domain.DealPipeline, error) {
+	return s.repo.GetPipelines(companyUUID)
+}
+
+// GetPipeline возвращает воронку, если она принадлежит компании
+func (s *Service) GetPipeline(_ context.Context, companyUUID, uid uuid.UUID) (dm domain.DealPipeline, err error) {
+	dm, err = s.repo.GetPipeline(uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.CompanyUUID != companyUUID {
+		return dm, dto.NotFoundErr("воронка не найдена")
+	}
+
+	return dm, nil
+}
+
+// UpdatePipeline меняет название и этапы воронки. Этапы без uuid создаются, отсутствующие в запросе
+// удаляются, если на них нет сделок. Сделки на этапе получают его новый статус
+func (s *Service) UpdatePipeline(ctx context.Context, dm *domain.DealPipeline) error {
+	prev, err := s.GetPipeline(ctx, dm.CompanyUUID, dm.UUID)
+	if err != nil {
+		return err
+	}
+
+	for _, stage := range dm.Stages {
+		if _, ok := prev.Stage(stage.UUID); stage.UUID != uuid.Nil && !ok {
+			return dto.NotFoundErrf("этап %s не найден в воронке", stage.UUID)
+		}
+	}
+
+	if err := dm.Validate(); err != nil {
+		return err
+	}
+
+	removed := lo.Filter(prev.Stages, func(stage domain.DealStage, _ int) bool {
+		_, ok := dm.Stage(stage.UUID)
+		return !ok
+	})
+
+	return s.repo.UpdatePipeline(*dm, removed)
+}
+
+func (s *Service) DeletePipeline(_ context.Context, companyUUID, uid uuid.UUID) error {
+	return s.repo.DeletePipeline(companyUUID, uid)
+}
+
+// CreateDeal создает сделку на этапе StageUUID или на первом открытом этапе воронки
+func (s *Service) CreateDeal(ctx context.Context, crtr domain.Creator, dm *domain.Deal) error {
+	if dm.UUID == uuid.Nil {
+		dm.UUID = uuid.New()
+	}
+
+	if dm.Priority == 0 {
+		dm.Priority = DefaultPriority
+	}
+
+	if err := dm.Validate(); err != nil {
+		return err
+	}
+
+	pipeline, err := s.GetPipeline(ctx, dm.CompanyUUID, dm.PipelineUUID)
+	if err != nil {
+		return err
+	}
+
+	stage, ok := pipeline.FirstStage()
+	if dm.StageUUID != uuid.Nil {
+		stage, ok = pipeline.Stage(dm.StageUUID)
+	}
+	if !ok {
+		return dto.NotFoundErr("этап не найден в воронке")
+	}
+
+	if err := s.checkLinks(*dm); err != nil {
+		return err
+	}
+
+	dm.SetStage(stage, time.Now())
+
+	if err := s.repo.CreateDeal(*dm); err != nil {
+		return err
+	}
+
+	if _, err := s.as.DealStageChanged(crtr, dm.UUID, nil, stage); err != nil {
+		logrus.Error("deal activity error: ", err)
+	}
+
+	return nil
+}
+
+// GetDeal возвращает сделку, если она принадлежит компании
+func (s *Service) GetDeal(_ context.Context, companyUUID, uid uuid.UUID) (dm domain.Deal, err error) {
+	dm, err = s.repo.GetDeal(uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.CompanyUUID != companyUUID {
+		return dm, dto.NotFoundErr("сделка не найдена")
+	}
+
+	return dm, nil
+}
+
+func (s *Service) GetDeals(_ context.Context, filter domain.DealFilter) ([]domain.Deal, int64, error) {
+	return s.repo.GetDeals(filter)
+}
+
+// UpdateDeal сохраняет все поля сделки, кроме воронки и этапа - их меняет MoveDeal
+func (s *Service) UpdateDeal(_ context.Context, dm domain.Deal) error {
+	if err := dm.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.checkLinks(dm); err != nil {
+		return err
+	}
+
+	return s.repo.UpdateDeal(dm)
+}
+
+// MoveDeal переводит сделку на этап воронки pipelineUUID (по умолчанию текущей) и пишет активность
+func (s *Service) MoveDeal(ctx context.Context, crtr domain.Creator, dm domain.Deal, pipelineUUID *uuid.UUID, stageUUID uuid.UUID) (domain.Deal, error) {
+	current, err := s.GetPipeline(ctx, dm.CompanyUUID, dm.PipelineUUID)
+	if err != nil {
+		return dm, err
+	}
+
+	pipeline := current
+	if pipelineUUID != nil && *pipelineUUID != dm.PipelineUUID {
+		pipeline, err = s.GetPipeline(ctx, dm.CompanyUUID, *pipelineUUID)
+		if err != nil {
+			return dm, err
+		}
+	}
+
+	stage, ok := pipeline.Stage(stageUUID)
+	if !ok {
+		return dm, dto.NotFoundErr("этап не найден в воронке")
+	}
+
+	if stage.UUID == dm.StageUUID {
+		return dm, nil
+	}
+
+	from, hasFrom := current.Stage(dm.StageUUID)
+
+	dm.PipelineUUID = pipeline.UUID
+	dm.SetStage(stage, time.Now())
+
+	if err := s.repo.UpdateDealStage(dm); err != nil {
+		return dm, err
+	}
+
+	if _, err := s.as.DealStageChanged(crtr, dm.UUID, lo.Ternary(hasFrom, &from, nil), stage); err != nil {
+		logrus.Error("deal activity error: ", err)
+	}
+
+	return dm, nil
+}
+
+func (s *Service) DeleteDeal(_ context.Context, companyUUID, uid uuid.UUID) error {
+	return s.repo.DeleteDeal(companyUUID, uid)
+}
+
+func (s *Service) GetActivities(_ context.Context, uid uuid.UUID, limit, offset int) ([]domain.Activity, int64, error) {
+	return s.as.GetDealActivities(uid, limit, offset)
+}
+
+// Forecast - взвешенная сумма открытых сделок по этапам и месяцам ожидаемого закрытия в [from, to)
+func (s *Service) Forecast(_ context.Context, companyUUID uuid.UUID, pipelineUUID *uuid.UUID, from, to *time.Time) ([]domain.DealForecast, error) {
+	return s.repo.Forecast(companyUUID, pipelineUUID, from, to)
+}
+
+// checkLinks проверяет, что контрагент и задачи сделки принадлежат ее компании
+func (s *Service) checkLinks(dm domain.Deal) error {
+	if dm.AgentUUID != nil {
+		ok, err := s.repo.AgentExists(dm.CompanyUUID, *dm.AgentUUID)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return dto.NotFoundErr("контрагент не найден")
+		}
+	}
+
+	found, err := s.repo.CompanyTasks(dm.CompanyUUID, dm.TaskUUIDs)
+	if err != nil {
+		return err
+	}
+
+	missing, _ := lo.Difference(dm.TaskUUIDs, found)
+	if len(missing) > 0 {
+		return dto.NotFoundErrf("задачи не найдены: %s", strings.Join(lo.Map(missing, func(uid uuid.UUID, _ int) string { return uid.String() }), ", "))
+	}
+
+	return nil
+}
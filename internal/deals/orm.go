@@ -0,0 +1,115 @@
+package deals
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+)
+
+type Pipeline struct {
+	UUID uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;"`
+	CompanyUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Name string `gorm:"type:varchar(100);default:'';not null;"`
+	Sort int    `gorm:"type:int;default:0;not null;"`
+
+	Stages []Stage `gorm:"foreignKey:PipelineUUID;references:UUID"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+func (Pipeline) TableName() string {
+	return "deal_pipelines"
+}
+
+type Stage struct {
+	UUID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	PipelineUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Name        string `gorm:"type:varchar(100);default:'';not null;"`
+	Color       string `gorm:"type:varchar(7);default:'';not null;"`
+	Probability int    `gorm:"type:int;default:0;not null;"`
+	Status      int    `gorm:"type:int;default:0;not null;"`
+	Sort        int    `gorm:"type:int;default:0;not null;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (Stage) TableName() string {
+	return "deal_stages"
+}
+
+type Deal struct {
+	UUID uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;"`
+
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;"`
+	CompanyUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Name        string         `gorm:"type:varchar(50);default:'';not null;"`
+	Description string         `gorm:"type:text;default:'';not null;"`
+	Tags        pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
+	Priority    int            `gorm:"type:int8;default:10;not null;"`
+	Status      int            `gorm:"type:int8;default:0;not null;"`
+
+	PipelineUUID uuid.UUID `gorm:"type:uuid;not null;"`
+	StageUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Amount      int64  `gorm:"type:int8;default:0;not null;"`
+	Currency    string `gorm:"type:varchar(3);default:'RUB';not null;"`
+	Probability *int   `gorm:"type:int;default:NULL;"`
+
+	AgentUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+
+	CloseAt        *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	FinishedAt     *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	StageChangedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	ActivityAt     time.Time  `gorm:"type:timestamptz;default:now();not null"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	Fields datatypes.JSON `gorm:"default:'{}';not null;"`
+	Meta   datatypes.JSON `gorm:"default:'{}';not null;"`
+
+	Total int64 `gorm:"->"`
+}
+
+func (Deal) TableName() string {
+	return "deals"
+}
+
+// DealTask - связь сделки с задачей
+type DealTask struct {
+	DealUUID    uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	TaskUUID    uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	CompanyUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (DealTask) TableName() string {
+	return "deal_tasks"
+}
+
+type Forecast struct {
+	StageUUID uuid.UUID
+	Month     string
+	Currency  string
+	Count     int
+	Amount    int64
+	Weighted  int64
+}
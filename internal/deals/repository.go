@@ -0,0 +1,597 @@
+package deals
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) CreatePipeline(dm domain.DealPipeline) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit("Stages").Create(&Pipeline{
+			UUID:           dm.UUID,
+			FederationUUID: dm.FederationUUID,
+			CompanyUUID:    dm.CompanyUUID,
+			CreatedBy:      dm.CreatedBy,
+			CreatedByUUID:  dm.CreatedByUUID,
+			Name:           dm.Name,
+			Sort:           dm.Sort,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(lo.Map(dm.Stages, toStageOrm)).Error
+	})
+}
+
+func (r *Repository) GetPipelines(companyUUID uuid.UUID) (dms []domain.DealPipeline, err error) {
+	orms := []Pipeline{}
+
+	err = r.gorm.DB.
+		Preload("Stages", func(db *gorm.DB) *gorm.DB { return db.Order("sort") }).
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Order("sort, created_at").
+		Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	return lo.Map(orms, func(orm Pipeline, _ int) domain.DealPipeline { return toPipelineDomain(orm) }), nil
+}
+
+func (r *Repository) GetPipeline(uid uuid.UUID) (dm domain.DealPipeline, err error) {
+	orm := Pipeline{}
+
+	err = r.gorm.DB.
+		Preload("Stages", func(db *gorm.DB) *gorm.DB { return db.Order("sort") }).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("воронка не найдена")
+	}
+	if err != nil {
+		return dm, err
+	}
+
+	return toPipelineDomain(orm), nil
+}
+
+// UpdatePipeline сохраняет название и этапы воронки. Этап можно удалить, только если на нем нет сделок
+func (r *Repository) UpdatePipeline(dm domain.DealPipeline, removed []domain.DealStage) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		// блокируем удаляемые этапы: перенос сделки ждет блокировку в lockStage и после коммита этапа не найдет
+		if len(removed) > 0 {
+			var locked []uuid.UUID
+
+			err := tx.Model(&Stage{}).
+				Where("uuid in ?", lo.Map(removed, func(s domain.DealStage, _ int) uuid.UUID { return s.UUID })).
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Pluck("uuid", &locked).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, stage := range removed {
+			var count int64
+
+			err := tx.Model(&Deal{}).
+				Where("company_uuid = ?", dm.CompanyUUID).
+				Where("stage_uuid = ?", stage.UUID).
+				Where("deleted_at is null").
+				Count(&count).Error
+			if err != nil {
+				return err
+			}
+
+			if count > 0 {
+				return fmt.Errorf("на этапе %q есть сделки (%d), сначала перенесите их", stage.Name, count)
+			}
+		}
+
+		if len(removed) > 0 {
+			err := tx.Where("uuid in ?", lo.Map(removed, func(s domain.DealStage, _ int) uuid.UUID { return s.UUID })).
+				Delete(&Stage{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, stage := range dm.Stages {
+			orm := toStageOrm(stage, 0)
+
+			res := tx.Model(&Stage{}).
+				Where("uuid = ?", stage.UUID).
+				Where("pipeline_uuid = ?", dm.UUID).
+				Updates(map[string]interface{}{
+					"name":        orm.Name,
+					"color":       orm.Color,
+					"probability": orm.Probability,
+					"status":      orm.Status,
+					"sort":        orm.Sort,
+					"updated_at":  time.Now(),
+				})
+			if res.Error != nil {
+				return res.Error
+			}
+
+			if res.RowsAffected == 0 {
+				if err := tx.Create(&orm).Error; err != nil {
+					return err
+				}
+			}
+
+			// статус сделок следует за статусом этапа
+			err := tx.Model(&Deal{}).
+				Where("company_uuid = ?", dm.CompanyUUID).
+				Where("stage_uuid = ?", stage.UUID).
+				Where("status != ?", stage.Status).
+				Where("deleted_at is null").
+				Updates(map[string]interface{}{
+					"status":      stage.Status,
+					"finished_at": gorm.Expr("CASE WHEN ? = 0 THEN NULL ELSE coalesce(finished_at, now()) END", stage.Status),
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&Pipeline{}).
+			Where("uuid = ?", dm.UUID).
+			Updates(map[string]interface{}{
+				"name":       dm.Name,
+				"sort":       dm.Sort,
+				"updated_at": time.Now(),
+			}).Error
+	})
+}
+
+// DeletePipeline удаляет воронку без сделок
+func (r *Repository) DeletePipeline(companyUUID, uid uuid.UUID) error {
+	var count int64
+
+	err := r.gorm.DB.Model(&Deal{}).
+		Where("company_uuid = ?", companyUUID).
+		Where("pipeline_uuid = ?", uid).
+		Where("deleted_at is null").
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return fmt.Errorf("в воронке есть сделки (%d), сначала перенесите или удалите их", count)
+	}
+
+	res := r.gorm.DB.Model(&Pipeline{}).
+		Where("uuid = ?", uid).
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("воронка не найдена")
+	}
+
+	return nil
+}
+
+// lockStage держит этап до конца транзакции, чтобы UpdatePipeline не удалил его, пока на него ставят сделку
+func lockStage(tx *gorm.DB, dm domain.Deal) error {
+	var stages []uuid.UUID
+
+	err := tx.Model(&Stage{}).
+		Where("uuid = ?", dm.StageUUID).
+		Where("pipeline_uuid = ?", dm.PipelineUUID).
+		Clauses(clause.Locking{Strength: "SHARE"}).
+		Pluck("uuid", &stages).Error
+	if err != nil {
+		return err
+	}
+
+	if len(stages) == 0 {
+		return dto.NotFoundErr("этап не найден в воронке")
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateDeal(dm domain.Deal) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		if err := lockStage(tx, dm); err != nil {
+			return err
+		}
+
+		orm := toDealOrm(dm)
+
+		if err := tx.Create(&orm).Error; err != nil {
+			return err
+		}
+
+		return setDealTasks(tx, dm)
+	})
+}
+
+func (r *Repository) GetDeal(uid uuid.UUID) (dm domain.Deal, err error) {
+	orm := Deal{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("сделка не найдена")
+	}
+	if err != nil {
+		return dm, err
+	}
+
+	tasks, err := r.dealTasks([]uuid.UUID{orm.UUID})
+	if err != nil {
+		return dm, err
+	}
+
+	return toDealDomain(orm, tasks[orm.UUID]), nil
+}
+
+func (r *Repository) GetDeals(filter domain.DealFilter) (dms []domain.Deal, total int64, err error) {
+	orms := []Deal{}
+
+	query := r.gorm.DB.
+		Select("*, count(*) OVER() AS total").
+		Where("company_uuid = ?", filter.CompanyUUID).
+		Where("deleted_at is null")
+
+	if filter.PipelineUUID != nil {
+		query = query.Where("pipeline_uuid = ?", *filter.PipelineUUID)
+	}
+
+	if filter.StageUUID != nil {
+		query = query.Where("stage_uuid = ?", *filter.StageUUID)
+	}
+
+	if filter.AgentUUID != nil {
+		query = query.Where("agent_uuid = ?", *filter.AgentUUID)
+	}
+
+	if filter.TaskUUID != nil {
+		query = query.Where("uuid in (select deal_uuid from deal_tasks where task_uuid = ?)", *filter.TaskUUID)
+	}
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if filter.Name != nil {
+		query = query.Where("name ilike ?", "%"+*filter.Name+"%")
+	}
+
+	err = query.
+		Order("activity_at desc").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&orms).Error
+	if err != nil {
+		return dms, -1, err
+	}
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	tasks, err := r.dealTasks(lo.Map(orms, func(orm Deal, _ int) uuid.UUID { return orm.UUID }))
+	if err != nil {
+		return dms, -1, err
+	}
+
+	return lo.Map(orms, func(orm Deal, _ int) domain.Deal { return toDealDomain(orm, tasks[orm.UUID]) }), total, nil
+}
+
+func (r *Repository) UpdateDeal(dm domain.Deal) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		orm := toDealOrm(dm)
+
+		err := tx.Model(&Deal{}).
+			Where("company_uuid = ?", dm.CompanyUUID).
+			Where("uuid = ?", dm.UUID).
+			Where("deleted_at is null").
+			Updates(map[string]interface{}{
+				"name":        orm.Name,
+				"description": orm.Description,
+				"tags":        orm.Tags,
+				"priority":    orm.Priority,
+				"amount":      orm.Amount,
+				"currency":    orm.Currency,
+				"probability": orm.Probability,
+				"agent_uuid":  orm.AgentUUID,
+				"close_at":    orm.CloseAt,
+				"activity_at": time.Now(),
+				"updated_at":  time.Now(),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return setDealTasks(tx, dm)
+	})
+}
+
+// UpdateDealStage сохраняет этап, статус и дату закрытия сделки
+func (r *Repository) UpdateDealStage(dm domain.Deal) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		if err := lockStage(tx, dm); err != nil {
+			return err
+		}
+
+		return tx.Model(&Deal{}).
+			Where("company_uuid = ?", dm.CompanyUUID).
+			Where("uuid = ?", dm.UUID).
+			Where("deleted_at is null").
+			Updates(map[string]interface{}{
+				"pipeline_uuid":    dm.PipelineUUID,
+				"stage_uuid":       dm.StageUUID,
+				"status":           dm.Status,
+				"finished_at":      dm.FinishedAt,
+				"stage_changed_at": dm.StageChangedAt,
+				"activity_at":      time.Now(),
+				"updated_at":       time.Now(),
+			}).Error
+	})
+}
+
+func (r *Repository) DeleteDeal(companyUUID, uid uuid.UUID) error {
+	res := r.gorm.DB.Model(&Deal{}).
+		Where("company_uuid = ?", companyUUID).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("сделка не найдена")
+	}
+
+	return nil
+}
+
+// AgentExists - есть ли у компании контрагент uid
+func (r *Repository) AgentExists(companyUUID, uid uuid.UUID) (bool, error) {
+	var count int64
+
+	err := r.gorm.DB.Table("agents").
+		Where("uuid = ?", uid).
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+// CompanyTasks - какие из задач uids есть у компании
+func (r *Repository) CompanyTasks(companyUUID uuid.UUID, uids []uuid.UUID) (found []uuid.UUID, err error) {
+	if len(uids) == 0 {
+		return found, nil
+	}
+
+	err = r.gorm.DB.Table("tasks").
+		Where("uuid in ?", uids).
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Pluck("uuid", &found).Error
+
+	return found, err
+}
+
+// Forecast - открытые сделки по этапу, месяцу ожидаемого закрытия и валюте со взвешенной суммой:
+// сумма сделки умножается на ее вероятность или вероятность этапа
+func (r *Repository) Forecast(companyUUID uuid.UUID, pipelineUUID *uuid.UUID, from, to *time.Time) (dms []domain.DealForecast, err error) {
+	orms := []Forecast{}
+
+	query := r.gorm.DB.Table("deals d").
+		Select("d.stage_uuid, coalesce(to_char(d.close_at, 'YYYY-MM'), '') as month, d.currency, count(*) as count, "+
+			"sum(d.amount)::bigint as amount, round(sum(d.amount * coalesce(d.probability, s.probability) / 100.0))::bigint as weighted").
+		Joins("join deal_stages s on s.uuid = d.stage_uuid").
+		Where("d.company_uuid = ?", companyUUID).
+		Where("d.status = ?", domain.DealOpen).
+		Where("d.deleted_at is null")
+
+	if pipelineUUID != nil {
+		query = query.Where("d.pipeline_uuid = ?", *pipelineUUID)
+	}
+
+	if from != nil {
+		query = query.Where("d.close_at >= ?", *from)
+	}
+
+	if to != nil {
+		query = query.Where("d.close_at < ?", *to)
+	}
+
+	err = query.
+		Group("d.stage_uuid, s.sort, month, d.currency").
+		Order("month, s.sort, d.currency").
+		Scan(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	return lo.Map(orms, func(orm Forecast, _ int) domain.DealForecast {
+		return domain.DealForecast{
+			StageUUID: orm.StageUUID,
+			Month:     orm.Month,
+			Currency:  orm.Currency,
+			Count:     orm.Count,
+			Amount:    orm.Amount,
+			Weighted:  orm.Weighted,
+		}
+	}), nil
+}
+
+func (r *Repository) dealTasks(dealUUIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	res := map[uuid.UUID][]uuid.UUID{}
+
+	if len(dealUUIDs) == 0 {
+		return res, nil
+	}
+
+	orms := []DealTask{}
+
+	err := r.gorm.DB.
+		Where("deal_uuid in ?", dealUUIDs).
+		Order("created_at").
+		Find(&orms).Error
+	if err != nil {
+		return res, err
+	}
+
+	for _, orm := range orms {
+		res[orm.DealUUID] = append(res[orm.DealUUID], orm.TaskUUID)
+	}
+
+	return res, nil
+}
+
+func setDealTasks(tx *gorm.DB, dm domain.Deal) error {
+	err := tx.Where("deal_uuid = ?", dm.UUID).Delete(&DealTask{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(dm.TaskUUIDs) == 0 {
+		return nil
+	}
+
+	return tx.Create(lo.Map(dm.TaskUUIDs, func(uid uuid.UUID, _ int) DealTask {
+		return DealTask{
+			DealUUID:    dm.UUID,
+			TaskUUID:    uid,
+			CompanyUUID: dm.CompanyUUID,
+		}
+	})).Error
+}
+
+func toStageOrm(dm domain.DealStage, _ int) Stage {
+	return Stage{
+		UUID:         dm.UUID,
+		PipelineUUID: dm.PipelineUUID,
+		Name:         dm.Name,
+		Color:        dm.Color,
+		Probability:  dm.Probability,
+		Status:       dm.Status,
+		Sort:         dm.Sort,
+	}
+}
+
+func toPipelineDomain(orm Pipeline) domain.DealPipeline {
+	return domain.DealPipeline{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		CreatedBy:      orm.CreatedBy,
+		CreatedByUUID:  orm.CreatedByUUID,
+
+		Name: orm.Name,
+		Sort: orm.Sort,
+		Stages: lo.Map(orm.Stages, func(s Stage, _ int) domain.DealStage {
+			return domain.DealStage{
+				UUID:         s.UUID,
+				PipelineUUID: s.PipelineUUID,
+				Name:         s.Name,
+				Color:        s.Color,
+				Probability:  s.Probability,
+				Status:       s.Status,
+				Sort:         s.Sort,
+			}
+		}),
+
+		CreatedAt: orm.CreatedAt,
+		UpdatedAt: orm.UpdatedAt,
+		DeletedAt: orm.DeletedAt,
+	}
+}
+
+func toDealOrm(dm domain.Deal) Deal {
+	return Deal{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		CreatedBy:      dm.CreatedBy,
+		CreatedByUUID:  dm.CreatedByUUID,
+
+		Name:        dm.Name,
+		Description: dm.Description,
+		Tags:        dm.Tags,
+		Priority:    dm.Priority,
+		Status:      dm.Status,
+
+		PipelineUUID: dm.PipelineUUID,
+		StageUUID:    dm.StageUUID,
+
+		Amount:      dm.Amount.Amount,
+		Currency:    dm.Amount.Currency,
+		Probability: dm.Probability,
+		AgentUUID:   dm.AgentUUID,
+
+		CloseAt:        dm.CloseAt,
+		FinishedAt:     dm.FinishedAt,
+		StageChangedAt: dm.StageChangedAt,
+	}
+}
+
+func toDealDomain(orm Deal, tasks []uuid.UUID) domain.Deal {
+	return domain.Deal{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		CreatedBy:      orm.CreatedBy,
+		CreatedByUUID:  orm.CreatedByUUID,
+
+		Name:        orm.Name,
+		Description: orm.Description,
+		Tags:        orm.Tags,
+		Priority:    orm.Priority,
+		Status:      orm.Status,
+
+		PipelineUUID: orm.PipelineUUID,
+		StageUUID:    orm.StageUUID,
+
+		Amount:      domain.MoneyValue{Amount: orm.Amount, Currency: orm.Currency},
+		Probability: orm.Probability,
+		AgentUUID:   orm.AgentUUID,
+		TaskUUIDs:   tasks,
+
+		CloseAt:        orm.CloseAt,
+		FinishedAt:     orm.FinishedAt,
+		StageChangedAt: orm.StageChangedAt,
+
+		CreatedAt: orm.CreatedAt,
+		UpdatedAt: orm.UpdatedAt,
+		DeletedAt: orm.DeletedAt,
+	}
+}
@@ -400,7 +400,10 @@ func (s *Service) SyncProjectFields() error {
 				Hash:               i.Hash,
 				Name:               i.Name,
 				DataType:           i.DataType,
+				Formula:            i.Formula,
 				RequiredOnStatuses: i.RequiredOnStatuses,
+				Rules:              i.Rules,
+				Options:            i.Options,
 				ProjectUUID:        i.ProjectUUID,
 			})
 
@@ -439,11 +442,15 @@ func (s *Service) SyncCatalogFields() error {
 				mp[i.CatalogUUID] = []dto.CatalogFieldDTO{}
 			}
 
-			mp[i.CatalogUUID] = lo.Uniq(append(mp[i.CatalogUUID], dto.CatalogFieldDTO{
+			// измененное поле заменяет прежнюю запись с тем же hash
+			mp[i.CatalogUUID] = append(lo.Filter(mp[i.CatalogUUID], func(f dto.CatalogFieldDTO, _ int) bool {
+				return f.Hash != i.Hash
+			}), dto.CatalogFieldDTO{
 				Hash:     i.Hash,
 				Name:     i.Name,
 				DataType: i.DataType,
-			}))
+				Formula:  i.Formula,
+			})
 		}
 
 		if i.UpdatedAt.After(lastUpdatedAt) {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type User struct {
@@ -111,13 +112,16 @@ type ProjectFields struct {
 	Hash        string    `gorm:"type:varchar(15);not null;"`
 	Name        string    `gorm:"type:varchar(100);not null;"`
 	DataType    int       `gorm:"type:int;not null;default:0"`
+	Formula     string    `gorm:"type:text;not null;default:''"`
 	CompanyUUID uuid.UUID `gorm:"type:uuid;not null"`
 	ProjectUUID uuid.UUID `gorm:"type:uuid;not null"`
 
 	UpdatedAt time.Time  `gorm:"type:timestamptz;"`
 	DeletedAt *time.Time `gorm:"type:timestamptz;"`
 
-	RequiredOnStatuses IntArray `gorm:"type:jsonb;default:'[]';not null;"`
+	RequiredOnStatuses IntArray            `gorm:"type:jsonb;default:'[]';not null;"`
+	Rules              domain.FieldRules   `gorm:"type:jsonb;default:'{}';not null;"`
+	Options            domain.FieldOptions `gorm:"type:jsonb;default:'[]';not null;"`
 }
 
 type UUIDArray []uuid.UUID
@@ -144,6 +148,7 @@ type CatalogFields struct {
 	Hash        string    `gorm:"type:varchar(15);not null;"`
 	Name        string    `gorm:"type:varchar(100);not null;"`
 	DataType    int       `gorm:"type:int;not null;default:0"`
+	Formula     string    `gorm:"type:text;not null;default:''"`
 	CatalogUUID uuid.UUID `gorm:"type:uuid;not null"`
 
 	UpdatedAt time.Time  `gorm:"type:timestamptz;"`
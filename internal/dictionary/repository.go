@@ -93,7 +93,7 @@ func (r *Repository) FetchCompanyFields(updatedAt time.Time) (items []CompanyFie
 
 func (r *Repository) FetchProjectFields(updatedAt time.Time) (items []ProjectFields, err error) {
 	err = r.gorm.DB.Table("project_fields").
-		Select("project_fields.project_uuid project_uuid, project_fields.uuid uuid, project_fields.company_uuid company_uuid, cf.name name, cf.hash hash, project_fields.style, project_fields.required_on_statuses required_on_statuses").
+		Select("project_fields.project_uuid project_uuid, project_fields.uuid uuid, project_fields.company_uuid company_uuid, cf.name name, cf.hash hash, cf.data_type data_type, cf.formula formula, cf.options options, project_fields.style, project_fields.required_on_statuses required_on_statuses, project_fields.rules rules").
 		Joins("LEFT JOIN company_fields cf ON project_fields.company_field_uuid = cf.uuid").
 		Where("project_fields.updated_at >= ? or cf.updated_at >= ?", updatedAt, updatedAt).
 		Find(&items).Error
@@ -1,20 +1,28 @@
 package emails
 
-import (
-	"bytes"
-	"text/template"
-
-	_ "embed"
-)
-
 type IMessage interface {
 	GetSubject() string
 	GetBody() string
+	GetReplyTo() string
 }
 
 type Message struct {
 	subject string
 	body    string
+	replyTo string
+}
+
+func NewMessage(subject, body string) Message {
+	return Message{
+		subject: subject,
+		body:    body,
+	}
+}
+
+// WithReplyTo - ответ на письмо уйдет на replyTo, например на адрес задачи для входящей почты
+func (m Message) WithReplyTo(replyTo string) Message {
+	m.replyTo = replyTo
+	return m
 }
 
 func (m Message) GetSubject() string {
@@ -25,62 +33,25 @@ func (m Message) GetBody() string {
 	return m.body
 }
 
-// Confirmation email template
-//
-//go:embed confirmation.html
-var confirmationTmpl string
-
-//go:embed reset.html
-var resetTmpl string
+func (m Message) GetReplyTo() string {
+	return m.replyTo
+}
 
+// NewConfirmationMessage - письмо с кодом подтверждения по встроенному шаблону,
+// письма федераций собираются через Templates.Render
 func NewConfirmationMessage(code string) (IMessage, error) {
-	templateData := struct {
-		Code string
-	}{
-		Code: code,
-	}
-
-	body, err := parseTemplate("confirmation", confirmationTmpl, templateData)
-
-	if err == nil {
-		return Message{
-			subject: "Подтверждение профиля",
-			body:    body,
-		}, nil
-	}
-
-	return Message{}, nil
+	return newDefaultMessage(TemplateConfirmation, CodeData{Code: code})
 }
 
 func NewResetMessage(code string) (IMessage, error) {
-	templateData := struct {
-		Code string
-	}{
-		Code: code,
-	}
-
-	body, err := parseTemplate("reset", resetTmpl, templateData)
-
-	if err == nil {
-		return Message{
-			subject: "Сброс пароля",
-			body:    body,
-		}, nil
-	}
-
-	return Message{}, nil
+	return newDefaultMessage(TemplateReset, CodeData{Code: code})
 }
 
-func parseTemplate(name, templateString string, data interface{}) (string, error) {
-	t, err := template.New(name).Parse(templateString)
-	if err != nil {
-		return "", err
-	}
-	buf := new(bytes.Buffer)
-	err = t.Execute(buf, data)
+func newDefaultMessage(name string, data interface{}) (IMessage, error) {
+	tpl, err := DefaultTemplate(name, LocaleRU)
 	if err != nil {
-		return "", err
+		return Message{}, err
 	}
 
-	return buf.String(), nil
+	return RenderTemplate(tpl, DefaultBranding, data)
 }
@@ -3,17 +3,73 @@ package emails
 import (
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/datatypes"
 )
 
+// Mail - журнал доставки: запись на каждую попытку отправки письма из очереди
 type Mail struct {
 	UUID    string `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
 	From    string `gorm:"type:varchar(100);default:'';not null"`
-	To      string `gorm:"type:varchar(100);default:'';not null;"`
+	To      string `gorm:"type:text;default:'';not null;"`
 	Subject string `gorm:"type:varchar(200);default:'';not null;"`
 	Text    string `gorm:"type:text;default:'';not null;"`
 
+	OutboxUUID *string `gorm:"type:uuid;"`
+	Transport  string  `gorm:"type:varchar(20);default:'';not null;"`
+	Attempt    int     `gorm:"type:int;default:0;not null;"`
+	Status     string  `gorm:"type:varchar(20);default:'';not null;"`
+	Error      string  `gorm:"type:text;default:'';not null;"`
+
 	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
 
 	Meta datatypes.JSON `gorm:"default:'{}';not null;"`
 }
+
+type outboxMail struct {
+	UUID    string         `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	From    string         `gorm:"type:varchar(100);default:'';not null"`
+	To      pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
+	Subject string         `gorm:"type:varchar(200);default:'';not null;"`
+	Body    string         `gorm:"type:text;default:'';not null;"`
+	ReplyTo string         `gorm:"type:varchar(250);default:'';not null;"`
+
+	Status        string     `gorm:"type:varchar(20);default:'pending';not null;"`
+	Attempts      int        `gorm:"type:int;default:0;not null;"`
+	NextAttemptAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	LastError     string     `gorm:"type:text;default:'';not null;"`
+	SentAt        *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (outboxMail) TableName() string {
+	return "mail_outbox"
+}
+
+type emailTemplate struct {
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	Name           string    `gorm:"type:varchar(50);not null;primary_key:true"`
+	Locale         string    `gorm:"type:varchar(10);not null;primary_key:true"`
+
+	Subject string `gorm:"type:varchar(200);default:'';not null;"`
+	Body    string `gorm:"type:text;default:'';not null;"`
+
+	UpdatedBy string    `gorm:"type:varchar(100);default:'';not null;"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+type emailBranding struct {
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+
+	LogoURL         string `gorm:"type:varchar(500);default:'';not null;"`
+	PrimaryColor    string `gorm:"type:varchar(20);default:'';not null;"`
+	BackgroundColor string `gorm:"type:varchar(20);default:'';not null;"`
+	Footer          string `gorm:"type:text;default:'';not null;"`
+	Locale          string `gorm:"type:varchar(10);default:'';not null;"`
+
+	UpdatedBy string    `gorm:"type:varchar(100);default:'';not null;"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
@@ -0,0 +1,180 @@
+package emails
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	OutboxPending = "pending"
+	OutboxSending = "sending"
+	OutboxSent    = "sent"
+	OutboxFailed  = "failed"
+
+	// OutboxRetry - статус записи журнала о неудачной попытке, после которой будет повтор
+	OutboxRetry = "retry"
+	// OutboxDisabled - статус записи журнала, если отправка почты выключена
+	OutboxDisabled = "disabled"
+)
+
+const (
+	// OutboxBatch - сколько писем отправляется за один проход
+	OutboxBatch = 50
+	// OutboxStuck - через сколько письмо в sending считается брошенным упавшим воркером
+	OutboxStuck = 10 * time.Minute
+	// SendTimeout - ограничение на отправку одного письма
+	SendTimeout = time.Minute
+	// DefaultMaxAttempts - после стольких неудачных попыток письмо помечается failed
+	DefaultMaxAttempts = 8
+
+	backoffBase = 30 * time.Second
+	backoffMax  = 6 * time.Hour
+)
+
+type OutboxMail struct {
+	UUID    string
+	From    string
+	To      []string
+	Subject string
+	Body    string
+	ReplyTo string
+
+	// Status - pending, sending, sent или failed
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	SentAt        *time.Time
+
+	CreatedAt time.Time
+}
+
+// Backoff - пауза перед следующей попыткой после attempt неудачных: 30s, 1m, 2m, ... но не больше 6h
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	d := backoffBase
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= backoffMax {
+			return backoffMax
+		}
+	}
+
+	return d
+}
+
+// BuildMessage собирает письмо с заголовками. Date и Message-ID берутся из записи очереди,
+// поэтому при повторных попытках письмо не меняется.
+func BuildMessage(m OutboxMail) []byte {
+	domain := "localhost"
+	if i := strings.LastIndex(m.From, "@"); i != -1 {
+		domain = strings.Trim(m.From[i+1:], "> ")
+	}
+
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "From: %s\r\n", m.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(m.To, ", "))
+	if m.ReplyTo != "" {
+		fmt.Fprintf(&b, "Reply-To: %s\r\n", m.ReplyTo)
+	}
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", m.CreatedAt.Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Message-ID: <%s@%s>\r\n", m.UUID, domain)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/html; charset=\"UTF-8\"\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n")
+	b.WriteString("\r\n")
+
+	body := base64.StdEncoding.EncodeToString([]byte(m.Body))
+	for len(body) > 76 {
+		b.WriteString(body[:76] + "\r\n")
+		body = body[76:]
+	}
+	b.WriteString(body + "\r\n")
+
+	return b.Bytes()
+}
+
+// Enqueue ставит письмо в очередь, отправит его ProcessOutbox
+func (e *Emails) Enqueue(to []string, message IMessage) (OutboxMail, error) {
+	m := OutboxMail{
+		From:    e.from,
+		To:      to,
+		Subject: message.GetSubject(),
+		Body:    message.GetBody(),
+		ReplyTo: message.GetReplyTo(),
+	}
+
+	err := e.repo.Enqueue(&m)
+
+	return m, err
+}
+
+// ProcessOutbox отправляет очередную порцию писем. Неудачная попытка откладывает письмо на Backoff,
+// после maxAttempts попыток письмо помечается failed. Каждая попытка пишется в журнал.
+func (e *Emails) ProcessOutbox(ctx context.Context) (sent int, err error) {
+	now := time.Now()
+
+	mails, err := e.repo.ClaimOutbox(now, now.Add(-OutboxStuck), OutboxBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, m := range mails {
+		m.Attempts++
+
+		sendCtx, cancel := context.WithTimeout(ctx, SendTimeout)
+		sendErr := e.transport.Send(sendCtx, m.From, m.To, BuildMessage(m))
+		cancel()
+
+		status := OutboxSent
+		m.LastError = ""
+
+		switch {
+		case sendErr == nil:
+			at := time.Now()
+			m.Status = OutboxSent
+			m.SentAt = &at
+			sent++
+		case m.Attempts >= e.maxAttempts:
+			m.Status = OutboxFailed
+			m.LastError = sendErr.Error()
+			status = OutboxFailed
+		default:
+			m.Status = OutboxPending
+			m.LastError = sendErr.Error()
+			m.NextAttemptAt = time.Now().Add(Backoff(m.Attempts))
+			status = OutboxRetry
+		}
+
+		if sendErr != nil {
+			logrus.WithFields(logrus.Fields{
+				"module":  "emails",
+				"to":      m.To,
+				"attempt": m.Attempts,
+			}).Warn("email was not sent: ", sendErr)
+		}
+
+		err = e.repo.SaveAttempt(m)
+		if err != nil {
+			return sent, err
+		}
+
+		if err := e.repo.LogDelivery(m, e.transport.Name(), status, m.LastError); err != nil {
+			logrus.Error("email delivery log error: ", err)
+		}
+	}
+
+	return sent, nil
+}
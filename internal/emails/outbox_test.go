@@ -0,0 +1,88 @@
+package emails
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		0:  30 * time.Second,
+		1:  30 * time.Second,
+		2:  time.Minute,
+		4:  4 * time.Minute,
+		20: 6 * time.Hour,
+	}
+
+	for attempt, want := range cases {
+		if got := Backoff(attempt); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+}
+
+func TestBuildMessage(t *testing.T) {
+	m := OutboxMail{
+		UUID:      "5e2b3c1a-0000-0000-0000-000000000001",
+		From:      "crm@example.com",
+		To:        []string{"a@example.com", "b@example.com"},
+		Subject:   "Сброс пароля",
+		Body:      "<b>" + strings.Repeat("код ", 40) + "</b>",
+		ReplyTo:   "r-task@in.example.com",
+		CreatedAt: time.Date(2024, 6, 21, 10, 0, 0, 0, time.UTC),
+	}
+
+	msg := string(BuildMessage(m))
+
+	for _, want := range []string{
+		"To: a@example.com, b@example.com\r\n",
+		"Subject: =?utf-8?q?",
+		"Date: Fri, 21 Jun 2024 10:00:00 +0000\r\n",
+		"Message-ID: <5e2b3c1a-0000-0000-0000-000000000001@example.com>\r\n",
+		"Reply-To: r-task@in.example.com\r\n",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("message has no %q:\n%s", want, msg)
+		}
+	}
+
+	_, body, _ := strings.Cut(msg, "\r\n\r\n")
+	for _, line := range strings.Split(strings.TrimSpace(body), "\r\n") {
+		if len(line) > 76 {
+			t.Fatalf("body line is too long: %d", len(line))
+		}
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\r\n", ""))
+	if err != nil || string(decoded) != m.Body {
+		t.Errorf("body = %q, %v", decoded, err)
+	}
+}
+
+func TestMaildirTransport(t *testing.T) {
+	dir := t.TempDir()
+
+	err := NewMaildir(dir).Send(context.Background(), "crm@example.com", []string{"a@example.com"}, []byte("Subject: test\r\n\r\nhello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "new", "*.eml"))
+	if len(files) != 1 {
+		t.Fatalf("new/ has %d files, want 1", len(files))
+	}
+
+	b, _ := os.ReadFile(files[0])
+	if !strings.HasSuffix(string(b), "hello") {
+		t.Errorf("unexpected content %q", b)
+	}
+
+	if tmp, _ := filepath.Glob(filepath.Join(dir, "tmp", "*")); len(tmp) != 0 {
+		t.Errorf("tmp/ is not empty: %v", tmp)
+	}
+}
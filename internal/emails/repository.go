@@ -1,7 +1,14 @@
 package emails
 
 import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm/clause"
 )
 
 type EmailRepository struct {
@@ -14,10 +21,14 @@ func NewRepository(db *postgres.GDB) *EmailRepository {
 	}
 }
 
-func (r *EmailRepository) StoreEmail(email IMessage) (string, error) {
+// StoreEmail записывает письмо в журнал без отправки, например при выключенной почте
+func (r *EmailRepository) StoreEmail(from string, to []string, email IMessage, status string) (string, error) {
 	mail := &Mail{
+		From:    from,
+		To:      strings.Join(to, ", "),
 		Subject: email.GetSubject(),
 		Text:    email.GetBody(),
+		Status:  status,
 	}
 
 	err := r.gorm.DB.Create(&mail).Error
@@ -27,3 +38,211 @@ func (r *EmailRepository) StoreEmail(email IMessage) (string, error) {
 
 	return mail.UUID, nil
 }
+
+// LogDelivery записывает в журнал результат попытки отправки письма из очереди
+func (r *EmailRepository) LogDelivery(m OutboxMail, transport, status, errText string) error {
+	return r.gorm.DB.Create(&Mail{
+		From:       m.From,
+		To:         strings.Join(m.To, ", "),
+		Subject:    m.Subject,
+		Text:       m.Body,
+		OutboxUUID: &m.UUID,
+		Transport:  transport,
+		Attempt:    m.Attempts,
+		Status:     status,
+		Error:      errText,
+	}).Error
+}
+
+func (r *EmailRepository) Enqueue(m *OutboxMail) error {
+	orm := outboxMail{
+		From:          m.From,
+		To:            m.To,
+		Subject:       m.Subject,
+		Body:          m.Body,
+		ReplyTo:       m.ReplyTo,
+		Status:        OutboxPending,
+		NextAttemptAt: time.Now(),
+	}
+
+	err := r.gorm.DB.Create(&orm).Error
+	if err != nil {
+		return err
+	}
+
+	*m = outboxToDomain(orm)
+
+	return nil
+}
+
+// ClaimOutbox помечает до limit писем, время отправки которых наступило, как отправляемые и возвращает их.
+// Письма, зависшие в sending дольше stuckBefore (упал воркер), берутся повторно.
+func (r *EmailRepository) ClaimOutbox(now, stuckBefore time.Time, limit int) ([]OutboxMail, error) {
+	orms := []outboxMail{}
+
+	err := r.gorm.DB.Raw(`
+		update mail_outbox set status = ?, updated_at = ?
+		where uuid in (
+			select uuid from mail_outbox
+			where (status = ? and next_attempt_at <= ?) or (status = ? and updated_at < ?)
+			order by next_attempt_at
+			limit ?
+			for update skip locked
+		)
+		returning *
+	`, OutboxSending, now, OutboxPending, now, OutboxSending, stuckBefore, limit).Scan(&orms).Error
+
+	return helpers.Map(orms, func(item outboxMail, i int) OutboxMail {
+		return outboxToDomain(item)
+	}), err
+}
+
+// SaveAttempt сохраняет результат попытки: статус, число попыток, ошибку и время следующей попытки
+func (r *EmailRepository) SaveAttempt(m OutboxMail) error {
+	return r.gorm.DB.Model(&outboxMail{}).
+		Where("uuid = ?", m.UUID).
+		Updates(map[string]interface{}{
+			"status":          m.Status,
+			"attempts":        m.Attempts,
+			"next_attempt_at": m.NextAttemptAt,
+			"last_error":      m.LastError,
+			"sent_at":         m.SentAt,
+			"updated_at":      time.Now(),
+		}).Error
+}
+
+func outboxToDomain(item outboxMail) OutboxMail {
+	return OutboxMail{
+		UUID:    item.UUID,
+		From:    item.From,
+		To:      item.To,
+		Subject: item.Subject,
+		Body:    item.Body,
+		ReplyTo: item.ReplyTo,
+
+		Status:        item.Status,
+		Attempts:      item.Attempts,
+		NextAttemptAt: item.NextAttemptAt,
+		LastError:     item.LastError,
+		SentAt:        item.SentAt,
+
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+func (r *EmailRepository) GetEmailTemplate(federationUUID uuid.UUID, name, locale string) (domain.EmailTemplate, bool, error) {
+	orms := []emailTemplate{}
+
+	err := r.gorm.DB.
+		Where("federation_uuid = ? and name = ? and locale = ?", federationUUID, name, locale).
+		Limit(1).
+		Find(&orms).Error
+	if err != nil || len(orms) == 0 {
+		return domain.EmailTemplate{}, false, err
+	}
+
+	return templateToDomain(orms[0]), true, nil
+}
+
+func (r *EmailRepository) GetEmailTemplates(federationUUID uuid.UUID) ([]domain.EmailTemplate, error) {
+	orms := []emailTemplate{}
+
+	err := r.gorm.DB.
+		Where("federation_uuid = ?", federationUUID).
+		Order("name, locale").
+		Find(&orms).Error
+
+	return helpers.Map(orms, func(item emailTemplate, i int) domain.EmailTemplate {
+		return templateToDomain(item)
+	}), err
+}
+
+func (r *EmailRepository) SaveEmailTemplate(tpl domain.EmailTemplate) error {
+	return r.gorm.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "federation_uuid"}, {Name: "name"}, {Name: "locale"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"subject":    tpl.Subject,
+			"body":       tpl.Body,
+			"updated_by": tpl.UpdatedBy,
+			"updated_at": "now()",
+		}),
+	}).Create(&emailTemplate{
+		FederationUUID: tpl.FederationUUID,
+		Name:           tpl.Name,
+		Locale:         tpl.Locale,
+		Subject:        tpl.Subject,
+		Body:           tpl.Body,
+		UpdatedBy:      tpl.UpdatedBy,
+	}).Error
+}
+
+func (r *EmailRepository) DeleteEmailTemplate(federationUUID uuid.UUID, name, locale string) error {
+	return r.gorm.DB.
+		Where("federation_uuid = ? and name = ? and locale = ?", federationUUID, name, locale).
+		Delete(&emailTemplate{}).Error
+}
+
+func (r *EmailRepository) GetEmailBranding(federationUUID uuid.UUID) (domain.EmailBranding, bool, error) {
+	orms := []emailBranding{}
+
+	err := r.gorm.DB.
+		Where("federation_uuid = ?", federationUUID).
+		Limit(1).
+		Find(&orms).Error
+	if err != nil || len(orms) == 0 {
+		return domain.EmailBranding{}, false, err
+	}
+
+	item := orms[0]
+
+	return domain.EmailBranding{
+		FederationUUID: item.FederationUUID,
+
+		LogoURL:         item.LogoURL,
+		PrimaryColor:    item.PrimaryColor,
+		BackgroundColor: item.BackgroundColor,
+		Footer:          item.Footer,
+		Locale:          item.Locale,
+
+		UpdatedBy: item.UpdatedBy,
+		UpdatedAt: &item.UpdatedAt,
+	}, true, nil
+}
+
+func (r *EmailRepository) SaveEmailBranding(b domain.EmailBranding) error {
+	return r.gorm.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "federation_uuid"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"logo_url":         b.LogoURL,
+			"primary_color":    b.PrimaryColor,
+			"background_color": b.BackgroundColor,
+			"footer":           b.Footer,
+			"locale":           b.Locale,
+			"updated_by":       b.UpdatedBy,
+			"updated_at":       "now()",
+		}),
+	}).Create(&emailBranding{
+		FederationUUID:  b.FederationUUID,
+		LogoURL:         b.LogoURL,
+		PrimaryColor:    b.PrimaryColor,
+		BackgroundColor: b.BackgroundColor,
+		Footer:          b.Footer,
+		Locale:          b.Locale,
+		UpdatedBy:       b.UpdatedBy,
+	}).Error
+}
+
+func templateToDomain(item emailTemplate) domain.EmailTemplate {
+	return domain.EmailTemplate{
+		FederationUUID: item.FederationUUID,
+
+		Name:    item.Name,
+		Locale:  item.Locale,
+		Subject: item.Subject,
+		Body:    item.Body,
+
+		Custom:    true,
+		UpdatedBy: item.UpdatedBy,
+		UpdatedAt: &item.UpdatedAt,
+	}
+}
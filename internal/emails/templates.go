@@ -0,0 +1,366 @@
+package emails
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	LocaleRU = "ru"
+	LocaleEN = "en"
+)
+
+const (
+	TemplateConfirmation = "confirmation"
+	TemplateReset        = "reset"
+	TemplateTaskAck      = "task_ack"
+)
+
+var (
+	Locales       = []string{LocaleRU, LocaleEN}
+	TemplateNames = []string{TemplateConfirmation, TemplateReset, TemplateTaskAck}
+)
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+var defaultSubjects = map[string]map[string]string{
+	TemplateConfirmation: {LocaleRU: "Подтверждение профиля", LocaleEN: "Profile confirmation"},
+	TemplateReset:        {LocaleRU: "Сброс пароля", LocaleEN: "Password reset"},
+	TemplateTaskAck:      {LocaleRU: "Re: {{ .Name }} [#{{ .ID }}]", LocaleEN: "Re: {{ .Name }} [#{{ .ID }}]"},
+}
+
+// SampleData - данные для предпросмотра и проверки шаблонов
+var SampleData = map[string]map[string]interface{}{
+	TemplateConfirmation: {"Code": "123456"},
+	TemplateReset:        {"Code": "123456"},
+	TemplateTaskAck:      {"ID": 1024, "Name": "Не работает касса"},
+}
+
+// DefaultBranding - оформление писем, если федерация не задала свое
+var DefaultBranding = domain.EmailBranding{
+	PrimaryColor:    "#3b82f6",
+	BackgroundColor: "#f5f5f5",
+}
+
+type CodeData struct {
+	Code string
+}
+
+type TaskAckData struct {
+	ID   int
+	Name string
+}
+
+var reColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+var layout = htmltemplate.Must(htmltemplate.ParseFS(templatesFS, "templates/layout.html"))
+
+func IsLocale(locale string) bool {
+	return lo.Contains(Locales, locale)
+}
+
+func IsTemplate(name string) bool {
+	return lo.Contains(TemplateNames, name)
+}
+
+// DefaultTemplate - встроенный шаблон, для неизвестного языка берется русский
+func DefaultTemplate(name, locale string) (domain.EmailTemplate, error) {
+	if !IsTemplate(name) {
+		return domain.EmailTemplate{}, fmt.Errorf("неизвестный шаблон письма: %s", name)
+	}
+
+	if !IsLocale(locale) {
+		locale = LocaleRU
+	}
+
+	body, err := templatesFS.ReadFile("templates/" + name + "." + locale + ".html")
+	if err != nil {
+		return domain.EmailTemplate{}, err
+	}
+
+	return domain.EmailTemplate{
+		Name:    name,
+		Locale:  locale,
+		Subject: defaultSubjects[name][locale],
+		Body:    strings.ReplaceAll(string(body), "\r\n", "\n"),
+	}, nil
+}
+
+// RenderTemplate собирает письмо: тема - text/template в одну строку,
+// тело - html/template (данные экранируются), вставленный в макет с оформлением
+func RenderTemplate(tpl domain.EmailTemplate, branding domain.EmailBranding, data interface{}) (Message, error) {
+	st, err := template.New("subject").Option("missingkey=zero").Parse(tpl.Subject)
+	if err != nil {
+		return Message{}, fmt.Errorf("тема письма: %w", err)
+	}
+
+	var subject bytes.Buffer
+	if err := st.Execute(&subject, data); err != nil {
+		return Message{}, fmt.Errorf("тема письма: %w", err)
+	}
+
+	bt, err := htmltemplate.New("body").Option("missingkey=zero").Parse(tpl.Body)
+	if err != nil {
+		return Message{}, fmt.Errorf("текст письма: %w", err)
+	}
+
+	var content bytes.Buffer
+	if err := bt.Execute(&content, data); err != nil {
+		return Message{}, fmt.Errorf("текст письма: %w", err)
+	}
+
+	locale := tpl.Locale
+	if locale == "" {
+		locale = LocaleRU
+	}
+
+	var body bytes.Buffer
+	err = layout.Execute(&body, struct {
+		Locale   string
+		Branding domain.EmailBranding
+		Content  htmltemplate.HTML
+	}{
+		Locale:   locale,
+		Branding: branding,
+		Content:  htmltemplate.HTML(content.String()), //nolint:gosec // содержимое уже собрано html/template
+	})
+	if err != nil {
+		return Message{}, err
+	}
+
+	return NewMessage(strings.Join(strings.Fields(subject.String()), " "), body.String()), nil
+}
+
+// ValidateTemplate проверяет шаблон на примере данных, чтобы сломанный шаблон нельзя было сохранить
+func ValidateTemplate(tpl domain.EmailTemplate) error {
+	if !IsTemplate(tpl.Name) {
+		return fmt.Errorf("неизвестный шаблон письма: %s", tpl.Name)
+	}
+
+	if !IsLocale(tpl.Locale) {
+		return fmt.Errorf("неизвестный язык: %s", tpl.Locale)
+	}
+
+	if strings.TrimSpace(tpl.Subject) == "" || strings.TrimSpace(tpl.Body) == "" {
+		return errors.New("тема и текст письма обязательны")
+	}
+
+	_, err := RenderTemplate(tpl, DefaultBranding, SampleData[tpl.Name])
+
+	return err
+}
+
+func ValidateBranding(b domain.EmailBranding) error {
+	for _, color := range []string{b.PrimaryColor, b.BackgroundColor} {
+		if color != "" && !reColor.MatchString(color) {
+			return fmt.Errorf("цвет должен быть в формате #rrggbb: %s", color)
+		}
+	}
+
+	if b.LogoURL != "" {
+		u, err := url.Parse(b.LogoURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return errors.New("логотип должен быть http(s) ссылкой")
+		}
+	}
+
+	if b.Locale != "" && !IsLocale(b.Locale) {
+		return fmt.Errorf("неизвестный язык: %s", b.Locale)
+	}
+
+	return nil
+}
+
+// Templates - реестр шаблонов писем: встроенные шаблоны на ru/en и переопределения федераций.
+// Все исходящие письма собираются через Render.
+type Templates struct {
+	repo   *EmailRepository
+	locale string
+}
+
+func NewTemplates(conf *configs.Configs, repo *EmailRepository) *Templates {
+	locale := conf.EMAIL_LOCALE
+	if !IsLocale(locale) {
+		locale = LocaleRU
+	}
+
+	return &Templates{
+		repo:   repo,
+		locale: locale,
+	}
+}
+
+// Render собирает письмо name для федерации. Язык: locale, иначе язык федерации, иначе EMAIL_LOCALE.
+// Если шаблон федерации не собирается, письмо уходит по встроенному шаблону.
+// Для писем вне федерации (регистрация, сброс пароля) federationUUID - uuid.Nil.
+func (t *Templates) Render(federationUUID uuid.UUID, locale, name string, data interface{}) (Message, error) {
+	branding, err := t.Branding(federationUUID)
+	if err != nil {
+		return Message{}, err
+	}
+
+	if !IsLocale(locale) {
+		locale = branding.Locale
+	}
+	if !IsLocale(locale) {
+		locale = t.locale
+	}
+
+	tpl, err := t.Template(federationUUID, name, locale)
+	if err != nil {
+		return Message{}, err
+	}
+
+	msg, err := RenderTemplate(tpl, branding, data)
+	if err != nil && tpl.Custom {
+		logrus.WithFields(logrus.Fields{
+			"federation": federationUUID,
+			"template":   name,
+			"locale":     locale,
+		}).Warn("email template: ", err)
+
+		tpl, err = DefaultTemplate(name, locale)
+		if err != nil {
+			return Message{}, err
+		}
+
+		return RenderTemplate(tpl, branding, data)
+	}
+
+	return msg, err
+}
+
+// Preview собирает письмо по шаблону tpl, пустые тема и текст берутся из действующего шаблона
+func (t *Templates) Preview(federationUUID uuid.UUID, tpl domain.EmailTemplate, data map[string]interface{}) (Message, error) {
+	current, err := t.Template(federationUUID, tpl.Name, tpl.Locale)
+	if err != nil {
+		return Message{}, err
+	}
+
+	if tpl.Subject == "" {
+		tpl.Subject = current.Subject
+	}
+	if tpl.Body == "" {
+		tpl.Body = current.Body
+	}
+	tpl.Locale = current.Locale
+
+	if data == nil {
+		data = SampleData[tpl.Name]
+	}
+
+	branding, err := t.Branding(federationUUID)
+	if err != nil {
+		return Message{}, err
+	}
+
+	return RenderTemplate(tpl, branding, data)
+}
+
+// Template - шаблон федерации или встроенный, если федерация его не переопределяла
+func (t *Templates) Template(federationUUID uuid.UUID, name, locale string) (domain.EmailTemplate, error) {
+	def, err := DefaultTemplate(name, locale)
+	if err != nil {
+		return def, err
+	}
+
+	def.FederationUUID = federationUUID
+
+	if federationUUID == uuid.Nil {
+		return def, nil
+	}
+
+	tpl, found, err := t.repo.GetEmailTemplate(federationUUID, def.Name, def.Locale)
+	if err != nil || !found {
+		return def, err
+	}
+
+	return tpl, nil
+}
+
+// List - все шаблоны федерации на всех языках
+func (t *Templates) List(federationUUID uuid.UUID) ([]domain.EmailTemplate, error) {
+	custom, err := t.repo.GetEmailTemplates(federationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []domain.EmailTemplate
+
+	for _, name := range TemplateNames {
+		for _, locale := range Locales {
+			tpl, found := lo.Find(custom, func(item domain.EmailTemplate) bool {
+				return item.Name == name && item.Locale == locale
+			})
+
+			if !found {
+				tpl, err = DefaultTemplate(name, locale)
+				if err != nil {
+					return nil, err
+				}
+				tpl.FederationUUID = federationUUID
+			}
+
+			res = append(res, tpl)
+		}
+	}
+
+	return res, nil
+}
+
+func (t *Templates) Save(tpl domain.EmailTemplate) error {
+	if err := ValidateTemplate(tpl); err != nil {
+		return err
+	}
+
+	return t.repo.SaveEmailTemplate(tpl)
+}
+
+// Reset удаляет шаблон федерации, дальше используется встроенный
+func (t *Templates) Reset(federationUUID uuid.UUID, name, locale string) error {
+	return t.repo.DeleteEmailTemplate(federationUUID, name, locale)
+}
+
+// Branding - оформление федерации, незаданные значения берутся из DefaultBranding
+func (t *Templates) Branding(federationUUID uuid.UUID) (domain.EmailBranding, error) {
+	b := DefaultBranding
+	b.FederationUUID = federationUUID
+	b.Locale = t.locale
+
+	if federationUUID == uuid.Nil {
+		return b, nil
+	}
+
+	stored, found, err := t.repo.GetEmailBranding(federationUUID)
+	if err != nil || !found {
+		return b, err
+	}
+
+	stored.PrimaryColor = lo.Ternary(stored.PrimaryColor != "", stored.PrimaryColor, b.PrimaryColor)
+	stored.BackgroundColor = lo.Ternary(stored.BackgroundColor != "", stored.BackgroundColor, b.BackgroundColor)
+	stored.Locale = lo.Ternary(stored.Locale != "", stored.Locale, t.locale)
+
+	return stored, nil
+}
+
+func (t *Templates) SaveBranding(b domain.EmailBranding) error {
+	if err := ValidateBranding(b); err != nil {
+		return err
+	}
+
+	return t.repo.SaveEmailBranding(b)
+}
@@ -0,0 +1,81 @@
+package emails
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestDefaultTemplates(t *testing.T) {
+	for _, name := range TemplateNames {
+		for _, locale := range Locales {
+			tpl, err := DefaultTemplate(name, locale)
+			if err != nil {
+				t.Fatalf("%s.%s: %v", name, locale, err)
+			}
+
+			if err := ValidateTemplate(tpl); err != nil {
+				t.Errorf("%s.%s: %v", name, locale, err)
+			}
+		}
+	}
+
+	if tpl, err := DefaultTemplate(TemplateReset, "de"); err != nil || tpl.Locale != LocaleRU {
+		t.Errorf("unknown locale = %q, %v", tpl.Locale, err)
+	}
+
+	if _, err := DefaultTemplate("welcome", LocaleRU); err == nil {
+		t.Error("unknown template must fail")
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	tpl, _ := DefaultTemplate(TemplateTaskAck, LocaleEN)
+	branding := domain.EmailBranding{
+		LogoURL:         "https://example.com/logo.png",
+		PrimaryColor:    "#ff0000",
+		BackgroundColor: "#eeeeee",
+		Footer:          "ООО Ромашка",
+	}
+
+	msg, err := RenderTemplate(tpl, branding, TaskAckData{ID: 7, Name: "<script>x</script>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if msg.GetSubject() != "Re: <script>x</script> [#7]" {
+		t.Errorf("subject = %q", msg.GetSubject())
+	}
+
+	body := msg.GetBody()
+	for _, want := range []string{`lang="en"`, "#ff0000", "#eeeeee", "https://example.com/logo.png", "ООО Ромашка", "&lt;script&gt;", "#7"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("body has no %q:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, "<script>") {
+		t.Error("task name must be escaped")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	broken := domain.EmailTemplate{Name: TemplateReset, Locale: LocaleRU, Subject: "Код", Body: "{{ .Code "}
+	if err := ValidateTemplate(broken); err == nil {
+		t.Error("broken template must fail")
+	}
+
+	for _, b := range []domain.EmailBranding{
+		{PrimaryColor: "red"},
+		{LogoURL: "javascript:alert(1)"},
+		{Locale: "de"},
+	} {
+		if err := ValidateBranding(b); err == nil {
+			t.Errorf("ValidateBranding(%+v) must fail", b)
+		}
+	}
+
+	if err := ValidateBranding(domain.EmailBranding{PrimaryColor: "#abc", LogoURL: "https://example.com/a.png", Locale: LocaleEN}); err != nil {
+		t.Error(err)
+	}
+}
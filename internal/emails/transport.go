@@ -0,0 +1,233 @@
+package emails
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TransportSMTP    = "smtp"
+	TransportMaildir = "maildir"
+	TransportMemory  = "memory"
+)
+
+// Transport доставляет готовое письмо (заголовки и тело) получателям
+type Transport interface {
+	Name() string
+	Send(ctx context.Context, from string, to []string, msg []byte) error
+}
+
+// SMTPTransport - отправка через smtp. На 465 порту соединение сразу по TLS,
+// на остальных - STARTTLS, если сервер его поддерживает. Без пароля авторизация не выполняется,
+// так что подходит и локальный smtp-перехватчик (mailhog, mailpit).
+type SMTPTransport struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+
+	Timeout time.Duration
+}
+
+func NewSMTP(host, port, user, password string) *SMTPTransport {
+	return &SMTPTransport{
+		Host:     host,
+		Port:     port,
+		User:     user,
+		Password: password,
+
+		Timeout: 30 * time.Second,
+	}
+}
+
+func (t *SMTPTransport) Name() string {
+	return TransportSMTP
+}
+
+func (t *SMTPTransport) Send(ctx context.Context, from string, to []string, msg []byte) error {
+	addr := net.JoinHostPort(t.Host, t.Port)
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: t.Host,
+	}
+
+	dialer := &net.Dialer{Timeout: t.Timeout}
+
+	var conn net.Conn
+	var err error
+
+	if t.Port == "465" {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else if t.Timeout > 0 {
+		_ = conn.SetDeadline(time.Now().Add(t.Timeout))
+	}
+
+	client, err := smtp.NewClient(conn, t.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if t.Port != "465" {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			err = client.StartTLS(tlsConfig)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if t.Password != "" {
+		err = client.Auth(smtp.PlainAuth("", t.User, t.Password, t.Host))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(from)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range to {
+		err = client.Rcpt(k)
+		if err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// MaildirTransport складывает письма в maildir (tmp/ -> new/) для разработки:
+// их можно открыть почтовым клиентом или просто как .eml
+type MaildirTransport struct {
+	Dir string
+}
+
+func NewMaildir(dir string) *MaildirTransport {
+	return &MaildirTransport{Dir: dir}
+}
+
+func (t *MaildirTransport) Name() string {
+	return TransportMaildir
+}
+
+func (t *MaildirTransport) Send(_ context.Context, _ string, _ []string, msg []byte) error {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(t.Dir, sub), 0o755); err != nil {
+			return err
+		}
+	}
+
+	name := fmt.Sprintf("%d.%s.crm.eml", time.Now().UnixNano(), uuid.NewString())
+
+	tmp := filepath.Join(t.Dir, "tmp", name)
+	if err := os.WriteFile(tmp, msg, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filepath.Join(t.Dir, "new", name))
+}
+
+// SentMail - письмо, принятое MemoryTransport
+type SentMail struct {
+	From string
+	To   []string
+	Msg  []byte
+}
+
+// MemoryTransport хранит письма в памяти, для тестов. Если задан Err, Send возвращает его.
+type MemoryTransport struct {
+	mu   sync.Mutex
+	sent []SentMail
+
+	Err error
+}
+
+func NewMemory() *MemoryTransport {
+	return &MemoryTransport{}
+}
+
+func (t *MemoryTransport) Name() string {
+	return TransportMemory
+}
+
+func (t *MemoryTransport) Send(_ context.Context, from string, to []string, msg []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Err != nil {
+		return t.Err
+	}
+
+	t.sent = append(t.sent, SentMail{From: from, To: append([]string{}, to...), Msg: append([]byte{}, msg...)})
+
+	return nil
+}
+
+func (t *MemoryTransport) Sent() []SentMail {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]SentMail{}, t.sent...)
+}
+
+func (t *MemoryTransport) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.sent = nil
+}
+
+// NewTransport создает транспорт по имени из настроек
+func NewTransport(name, host, port, user, password, dir string) (Transport, error) {
+	switch name {
+	case "", TransportSMTP:
+		if host == "" {
+			return nil, errors.New("smtp: не задан сервер")
+		}
+		return NewSMTP(host, port, user, password), nil
+	case TransportMaildir:
+		return NewMaildir(dir), nil
+	case TransportMemory:
+		return NewMemory(), nil
+	}
+
+	return nil, fmt.Errorf("неизвестный транспорт почты: %s", name)
+}
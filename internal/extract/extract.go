@@ -0,0 +1,254 @@
+package extract
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	MimePDF  = "application/pdf"
+	MimeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	MimeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	// MaxText - ограничение на размер извлекаемого текста
+	MaxText = 1 << 20
+)
+
+var ErrUnsupported = errors.New("извлечение текста не поддерживается")
+
+type Result struct {
+	Text  string
+	Pages int
+}
+
+// Supported - можно ли извлечь текст из файла с таким mime
+func Supported(mime string) bool {
+	mime = baseMime(mime)
+	return mime == MimePDF || mime == MimeDOCX || mime == MimeXLSX || strings.HasPrefix(mime, "text/")
+}
+
+// File извлекает текст и количество страниц из файла по его mime
+func File(path, mime string) (res Result, err error) {
+	mime = baseMime(mime)
+
+	switch {
+	case strings.HasPrefix(mime, "text/"):
+		res, err = txt(path)
+	case mime == MimePDF:
+		res, err = pdf(path)
+	case mime == MimeDOCX:
+		res, err = docx(path)
+	case mime == MimeXLSX:
+		res, err = xlsx(path)
+	default:
+		return res, ErrUnsupported
+	}
+
+	res.Text = clean(res.Text)
+
+	return res, err
+}
+
+func baseMime(mime string) string {
+	mime, _, _ = strings.Cut(mime, ";")
+	return strings.ToLower(strings.TrimSpace(mime))
+}
+
+// clean приводит текст к валидному utf-8 без NUL и обрезает до MaxText
+func clean(s string) string {
+	s = strings.ToValidUTF8(s, "")
+	s = strings.ReplaceAll(s, "\x00", "")
+
+	if len(s) > MaxText {
+		s = s[:MaxText]
+		for !utf8.ValidString(s) {
+			s = s[:len(s)-1]
+		}
+	}
+
+	return strings.TrimSpace(s)
+}
+
+func txt(path string) (res Result, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return res, err
+	}
+	defer f.Close()
+
+	b, err := io.ReadAll(io.LimitReader(f, MaxText))
+	if err != nil {
+		return res, err
+	}
+
+	res.Text = string(b)
+
+	return res, nil
+}
+
+func docx(path string) (res Result, err error) {
+	z, err := zip.OpenReader(path)
+	if err != nil {
+		return res, err
+	}
+	defer z.Close()
+
+	for _, f := range z.File {
+		switch f.Name {
+		case "word/document.xml":
+			res.Text, err = docxText(f)
+			if err != nil {
+				return res, err
+			}
+		case "docProps/app.xml":
+			res.Pages = docxPages(f)
+		}
+	}
+
+	return res, nil
+}
+
+func docxText(f *zip.File) (string, error) {
+	r, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	var b strings.Builder
+
+	inText := false
+	d := xml.NewDecoder(io.LimitReader(r, 16*MaxText))
+	for {
+		t, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return b.String(), err
+		}
+
+		switch v := t.(type) {
+		case xml.StartElement:
+			switch v.Name.Local {
+			case "t":
+				inText = true
+			case "tab":
+				b.WriteByte('\t')
+			case "br", "cr":
+				b.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch v.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				b.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				b.Write(v)
+			}
+		}
+
+		if b.Len() > MaxText {
+			break
+		}
+	}
+
+	return b.String(), nil
+}
+
+func docxPages(f *zip.File) int {
+	r, err := f.Open()
+	if err != nil {
+		return 0
+	}
+	defer r.Close()
+
+	var props struct {
+		Pages int `xml:"Pages"`
+	}
+
+	if err := xml.NewDecoder(r).Decode(&props); err != nil {
+		return 0
+	}
+
+	return props.Pages
+}
+
+func xlsx(path string) (res Result, err error) {
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		return res, err
+	}
+	defer f.Close()
+
+	var b strings.Builder
+
+	sheets := f.GetSheetList()
+	for _, sheet := range sheets {
+		rows, err := f.GetRows(sheet)
+		if err != nil {
+			return res, err
+		}
+
+		b.WriteString(sheet + "\n")
+		for _, row := range rows {
+			b.WriteString(strings.Join(row, "\t") + "\n")
+		}
+
+		if b.Len() > MaxText {
+			break
+		}
+	}
+
+	res.Text = b.String()
+	res.Pages = len(sheets)
+
+	return res, nil
+}
+
+func pdf(path string) (res Result, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return res, err
+	}
+
+	if !bytes.HasPrefix(data, []byte("%PDF")) {
+		return res, errors.New("файл не является pdf")
+	}
+
+	res.Pages = pdfPages(data)
+	res.Text = pdfText(data)
+
+	return res, nil
+}
+
+// pdfPages считает объекты /Type /Page (без /Pages)
+func pdfPages(data []byte) int {
+	n := 0
+
+	for i := 0; ; {
+		j := bytes.Index(data[i:], []byte("/Type"))
+		if j < 0 {
+			break
+		}
+		i += j + len("/Type")
+
+		rest := bytes.TrimLeft(data[i:], " \r\n\t")
+		if bytes.HasPrefix(rest, []byte("/Page")) && !bytes.HasPrefix(rest, []byte("/Pages")) {
+			n++
+		}
+	}
+
+	return n
+}
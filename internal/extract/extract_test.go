@@ -0,0 +1,95 @@
+package extract
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPDF(t *testing.T) {
+	var flate bytes.Buffer
+	w := zlib.NewWriter(&flate)
+	w.Write([]byte("BT /F1 12 Tf (second) Tj ET"))
+	w.Close()
+
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	b.WriteString("1 0 obj << /Type /Pages /Count 2 >> endobj\n")
+	b.WriteString("2 0 obj << /Type /Page >> endobj\n")
+	b.WriteString("3 0 obj << /Type/Page >> endobj\n")
+	b.WriteString("4 0 obj << /Length 60 >>\nstream\nBT /F1 12 Tf 10 10 Td (Hello \\(pdf\\)) Tj T* [(wor) -20 (ld)] TJ ET\nendstream endobj\n")
+	b.WriteString("5 0 obj << /Length 1 /Filter /FlateDecode >>\nstream\n")
+	b.Write(flate.Bytes())
+	b.WriteString("\nendstream endobj\n%%EOF")
+
+	path := filepath.Join(t.TempDir(), "a.pdf")
+	if err := os.WriteFile(path, b.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := File(path, MimePDF)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "Hello (pdf)\nworld\nsecond"; res.Text != want {
+		t.Errorf("Text = %q, want %q", res.Text, want)
+	}
+
+	if res.Pages != 2 {
+		t.Errorf("Pages = %d, want 2", res.Pages)
+	}
+}
+
+func TestDOCX(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.docx")
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	z := zip.NewWriter(f)
+	doc, _ := z.Create("word/document.xml")
+	doc.Write([]byte(`<w:document xmlns:w="w"><w:body><w:p><w:r><w:t>Привет</w:t></w:r><w:r><w:tab/><w:t>мир</w:t></w:r></w:p><w:p><w:r><w:t>2</w:t></w:r></w:p></w:body></w:document>`))
+	app, _ := z.Create("docProps/app.xml")
+	app.Write([]byte(`<Properties><Pages>3</Pages></Properties>`))
+	z.Close()
+	f.Close()
+
+	res, err := File(path, MimeDOCX)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "Привет\tмир\n2"; res.Text != want {
+		t.Errorf("Text = %q, want %q", res.Text, want)
+	}
+
+	if res.Pages != 3 {
+		t.Errorf("Pages = %d, want 3", res.Pages)
+	}
+}
+
+func TestTXT(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(path, []byte(" текст\x00 \xff"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := File(path, "text/plain; charset=utf-8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Text != "текст" {
+		t.Errorf("Text = %q", res.Text)
+	}
+
+	if _, err := File(path, "image/png"); err != ErrUnsupported {
+		t.Errorf("err = %v, want ErrUnsupported", err)
+	}
+}
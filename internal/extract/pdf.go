@@ -0,0 +1,284 @@
+package extract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+// pdfText достаёт текст из потоков содержимого pdf.
+// Поддерживаются несжатые потоки и FlateDecode, строки в Tj, TJ, ' и ".
+// Шрифты со своей кодировкой (CID) не разбираются, такие строки пропускаются.
+func pdfText(data []byte) string {
+	var b strings.Builder
+
+	for i := 0; i < len(data) && b.Len() < MaxText; {
+		j := bytes.Index(data[i:], []byte("stream"))
+		if j < 0 {
+			break
+		}
+		start := i + j + len("stream")
+
+		// endstream содержит stream, пропускаем
+		if j >= 3 && bytes.Equal(data[i+j-3:i+j], []byte("end")) {
+			i = start
+			continue
+		}
+
+		if start < len(data) && data[start] == '\r' {
+			start++
+		}
+		if start < len(data) && data[start] == '\n' {
+			start++
+		}
+
+		k := bytes.Index(data[start:], []byte("endstream"))
+		if k < 0 {
+			break
+		}
+		end := start + k
+
+		dict := data[i : i+j]
+		if o := bytes.LastIndex(dict, []byte("obj")); o >= 0 {
+			dict = dict[o:]
+		}
+
+		if content, ok := pdfStream(dict, data[start:end]); ok {
+			pdfContent(content, &b)
+		}
+
+		i = end + len("endstream")
+	}
+
+	return b.String()
+}
+
+func pdfStream(dict, raw []byte) ([]byte, bool) {
+	if bytes.Contains(dict, []byte("/Image")) {
+		return nil, false
+	}
+
+	if !bytes.Contains(dict, []byte("/Filter")) {
+		return raw, true
+	}
+
+	if !bytes.Contains(dict, []byte("/FlateDecode")) {
+		return nil, false
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false
+	}
+	defer r.Close()
+
+	// поврежденный хвост потока не мешает забрать то, что успели распаковать
+	out, _ := io.ReadAll(io.LimitReader(r, 16*MaxText))
+
+	return out, len(out) > 0
+}
+
+// pdfContent разбирает операторы потока содержимого и пишет текст в b
+func pdfContent(content []byte, b *strings.Builder) {
+	if !bytes.Contains(content, []byte("BT")) {
+		return
+	}
+
+	pending := []string{}
+	line := false
+
+	flush := func(newline bool) {
+		if newline && line {
+			b.WriteByte('\n')
+			line = false
+		}
+		for _, s := range pending {
+			b.WriteString(s)
+			line = true
+		}
+	}
+
+	for i := 0; i < len(content); {
+		c := content[i]
+
+		switch {
+		case c == '(':
+			s, n := pdfLiteral(content[i:])
+			pending = append(pending, s)
+			i += n
+
+		case c == '<' && i+1 < len(content) && content[i+1] != '<':
+			end := bytes.IndexByte(content[i:], '>')
+			if end < 0 {
+				return
+			}
+			if s, ok := pdfHex(content[i+1 : i+end]); ok {
+				pending = append(pending, s)
+			}
+			i += end + 1
+
+		case c == '%':
+			for i < len(content) && content[i] != '\n' && content[i] != '\r' {
+				i++
+			}
+
+		case isPDFRegular(c):
+			n := 1
+			for i+n < len(content) && isPDFRegular(content[i+n]) {
+				n++
+			}
+
+			switch string(content[i : i+n]) {
+			case "Tj", "TJ":
+				flush(false)
+			case "'", "\"":
+				flush(true)
+			case "T*", "Td", "TD":
+				if line {
+					b.WriteByte('\n')
+					line = false
+				}
+			case "ET":
+				if line {
+					b.WriteByte('\n')
+					line = false
+				}
+			}
+
+			// числа и имена оставляем строкам, все остальное - оператор
+			if !isPDFOperand(content[i : i+n]) {
+				pending = pending[:0]
+			}
+			i += n
+
+		default:
+			i++
+		}
+	}
+}
+
+func isPDFRegular(c byte) bool {
+	return c > ' ' && strings.IndexByte("()<>[]{}/%", c) < 0 || c == '/'
+}
+
+func isPDFOperand(tok []byte) bool {
+	if tok[0] == '/' {
+		return true
+	}
+
+	for _, c := range tok {
+		if (c < '0' || c > '9') && c != '.' && c != '-' && c != '+' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pdfLiteral читает строку (...) с экранированием, n - количество прочитанных байт
+func pdfLiteral(src []byte) (string, int) {
+	var out []byte
+
+	depth := 0
+	i := 0
+	for ; i < len(src); i++ {
+		c := src[i]
+
+		switch c {
+		case '(':
+			depth++
+			if depth == 1 {
+				continue
+			}
+		case ')':
+			depth--
+			if depth == 0 {
+				return pdfDecode(out), i + 1
+			}
+		case '\\':
+			i++
+			if i >= len(src) {
+				break
+			}
+
+			switch e := src[i]; e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b', 'f':
+			case '\r', '\n':
+			default:
+				if e >= '0' && e <= '7' {
+					v := 0
+					k := 0
+					for ; k < 3 && i+k < len(src) && src[i+k] >= '0' && src[i+k] <= '7'; k++ {
+						v = v*8 + int(src[i+k]-'0')
+					}
+					out = append(out, byte(v))
+					i += k - 1
+				} else {
+					out = append(out, e)
+				}
+			}
+			continue
+		}
+
+		out = append(out, c)
+	}
+
+	return pdfDecode(out), i
+}
+
+func pdfHex(src []byte) (string, bool) {
+	src = bytes.Map(func(r rune) rune {
+		if r == ' ' || r == '\n' || r == '\r' || r == '\t' {
+			return -1
+		}
+		return r
+	}, src)
+
+	if len(src)%2 == 1 {
+		src = append(src, '0')
+	}
+
+	out := make([]byte, hex.DecodedLen(len(src)))
+	if _, err := hex.Decode(out, src); err != nil {
+		return "", false
+	}
+
+	if bytes.HasPrefix(out, []byte{0xFE, 0xFF}) {
+		return pdfDecode(out), true
+	}
+
+	for _, c := range out {
+		if c < ' ' && c != '\n' && c != '\r' && c != '\t' {
+			return "", false
+		}
+	}
+
+	return pdfDecode(out), true
+}
+
+// pdfDecode - UTF-16BE с BOM или однобайтовая кодировка, приближенно latin-1
+func pdfDecode(b []byte) string {
+	if len(b) >= 2 && b[0] == 0xFE && b[1] == 0xFF {
+		u := make([]uint16, 0, len(b)/2)
+		for i := 2; i+1 < len(b); i += 2 {
+			u = append(u, uint16(b[i])<<8|uint16(b[i+1]))
+		}
+		return string(utf16.Decode(u))
+	}
+
+	r := make([]rune, len(b))
+	for i, c := range b {
+		r[i] = rune(c)
+	}
+
+	return string(r)
+}
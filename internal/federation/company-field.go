@@ -1,13 +1,35 @@
 package federation
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/formula"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrInvalidFormula = errors.New("ошибка в формуле")
+	ErrInvalidRules   = errors.New("неверные правила поля")
 )
 
 func (s *Service) CreateCompanyField(cf *domain.CompanyField) (items dto.CompanyFieldDTO, err error) {
+	if cf.DataType == domain.Formula {
+		fields, err := s.repo.GetCompanyFields(cf.CompanyUUID)
+		if err != nil {
+			return items, err
+		}
+
+		err = checkFormula(cf, fields)
+		if err != nil {
+			return items, err
+		}
+	}
+
 	orm, err := s.repo.CreateCompanyField(cf)
 	if err != nil {
 		return items, err
@@ -20,11 +42,104 @@ func (s *Service) CreateCompanyField(cf *domain.CompanyField) (items dto.Company
 		DataType:    orm.DataType,
 		Hash:        orm.Hash,
 		Icon:        orm.Icon,
+		Formula:     orm.Formula,
+		Options:     orm.Options,
+		OnDelete:    orm.OnDelete,
 	}, err
 }
 
 func (s *Service) PutCompanyField(pf *domain.CompanyField) error {
-	return s.repo.PutCompanyField(pf)
+	if pf.Formula == "" && pf.Options == nil && pf.OnDelete == "" {
+		_, err := s.repo.PutCompanyField(pf, nil)
+		return err
+	}
+
+	fields, err := s.repo.GetCompanyFields(pf.CompanyUUID)
+	if err != nil {
+		return err
+	}
+
+	field, ok := lo.Find(fields, func(f domain.CompanyField) bool { return f.UUID == pf.UUID })
+	if !ok {
+		return dto.NotFoundErr("поле не найдено")
+	}
+
+	pf.DataType = field.DataType
+	pf.Hash = field.Hash
+
+	if pf.OnDelete != "" {
+		err = pf.SetOnDelete(pf.OnDelete)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pf.Formula != "" {
+		err = pf.SetFormula(pf.Formula)
+		if err != nil {
+			return err
+		}
+
+		err = checkFormula(pf, fields)
+		if err != nil {
+			return err
+		}
+	}
+
+	renames := map[string]string{}
+
+	if pf.Options != nil {
+		err = pf.SetOptions(pf.Options)
+		if err != nil {
+			return err
+		}
+
+		renames, err = field.Options.Renames(pf.Options)
+		if err != nil {
+			return err
+		}
+	}
+
+	tasks, err := s.repo.PutCompanyField(pf, renames)
+	if err != nil {
+		return err
+	}
+
+	for _, uid := range tasks {
+		s.taskChanged(uid)
+	}
+
+	return nil
+}
+
+// checkFormula проверяет формулу поля cf по остальным полям компании. Поля проекта
+// подключаются позже, поэтому формула проверяется по всем полям компании. У полей-ссылок,
+// в отличие от полей справочника, нет связанного справочника, формулы с обращением к ним отклоняются
+func checkFormula(cf *domain.CompanyField, fields []domain.CompanyField) error {
+	ff := map[string]formula.Field{}
+	defs := []formula.Definition{}
+
+	for _, f := range fields {
+		if f.UUID == cf.UUID || f.Hash == cf.Hash {
+			continue
+		}
+
+		if f.DataType == domain.Formula {
+			defs = append(defs, formula.Definition{Hash: f.Hash, Formula: f.Formula})
+			continue
+		}
+
+		if t, ok := formula.FieldType(f.DataType); ok {
+			ff[f.Hash] = formula.Field{Type: t}
+		}
+	}
+
+	p := formula.Compile(ff, append(defs, formula.Definition{Hash: cf.Hash, Formula: cf.Formula}))
+	if err := p.Errors[cf.Hash]; err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFormula, err)
+	}
+
+	return nil
 }
 
 func (s *Service) GetProjectFields(uid uuid.UUID) (items []domain.CompanyField, err error) {
@@ -45,6 +160,9 @@ func (s *Service) GetProjectFields(uid uuid.UUID) (items []domain.CompanyField,
 			CompanyUUID:        item.CompanyUUID,
 			RequiredOnStatuses: item.RequiredOnStatuses,
 			Style:              item.Style,
+			Formula:            item.Formula,
+			Options:            item.Options,
+			Rules:              item.Rules,
 		}
 	})
 
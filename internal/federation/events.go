@@ -0,0 +1,22 @@
+package federation
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// OnTaskChanged вызывается для задач, значения полей которых изменились вместе с полем компании
+func (s *Service) OnTaskChanged(fn func(uuid.UUID) error) {
+	s.onTaskChanged = fn
+}
+
+func (s *Service) taskChanged(uid uuid.UUID) {
+	if s.onTaskChanged == nil {
+		return
+	}
+
+	err := s.onTaskChanged(uid)
+	if err != nil {
+		logrus.Error("onTaskChanged error: ", err)
+	}
+}
@@ -14,6 +14,8 @@ type Service struct {
 	repo     *Repository
 	dict     *dictionary.Service
 	catalogs *catalogs.Service
+
+	onTaskChanged func(uuid.UUID) error
 }
 
 func NewUserService(repo *Repository, dict *dictionary.Service, cs *catalogs.Service) *Service {
@@ -94,6 +94,11 @@ type CompanyFields struct {
 	Icon        string    `gorm:"type:varchar(50);not null;"`
 	DataType    int       `gorm:"type:int;not null;default:0"`
 	CompanyUUID uuid.UUID `gorm:"type:uuid;not null"`
+	Formula     string    `gorm:"type:text;not null;default:''"`
+
+	Options domain.FieldOptions `gorm:"type:jsonb;default:'[]';not null;"`
+
+	OnDelete string `gorm:"type:varchar(10);not null;default:'block'"`
 
 	ProjectUUID JSONArray `gorm:"->;type:jsonb;default:'[]';not null;column:project_uuids"`
 
@@ -106,8 +111,9 @@ type CompanyFields struct {
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
 
-	RequiredOnStatuses IntArray `gorm:"->;type:jsonb;default:'[]';not null;"`
-	Style              string   `gorm:"->;type:varchar(20);default:'';not null;"`
+	RequiredOnStatuses IntArray          `gorm:"->;type:jsonb;default:'[]';not null;"`
+	Style              string            `gorm:"->;type:varchar(20);default:'';not null;"`
+	Rules              domain.FieldRules `gorm:"->;type:jsonb;default:'{}';not null;"`
 }
 
 type JSONArray []any
@@ -278,8 +284,9 @@ type ProjectField struct {
 	ProjectUUID      uuid.UUID `gorm:"type:uuid;not null;"`
 	CompanyFieldUUID uuid.UUID `gorm:"type:uuid;not null;"`
 
-	RequiredOnStatuses IntArray `gorm:"type:jsonb;default:'[]';not null;"`
-	Style              string   `gorm:"type:varchar(50);default:'';not null;"`
+	RequiredOnStatuses IntArray          `gorm:"type:jsonb;default:'[]';not null;"`
+	Style              string            `gorm:"type:varchar(50);default:'';not null;"`
+	Rules              domain.FieldRules `gorm:"type:jsonb;default:'{}';not null;"`
 
 	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
@@ -330,4 +337,22 @@ type FieldStatistics struct {
 	Count  int     `json:"count"`
 	Total  int     `json:"total"`
 	Filled float64 `json:"filled"`
+
+	Money []MoneyStatistics `json:"money" gorm:"-"`
+}
+
+// MoneyStatistics - агрегаты поля money по одной валюте, суммы в минорных единицах
+type MoneyStatistics struct {
+	Hash     string  `json:"hash"`
+	Currency string  `json:"currency"`
+	Count    int     `json:"count"`
+	Sum      int64   `json:"sum"`
+	Min      int64   `json:"min"`
+	Max      int64   `json:"max"`
+	Avg      float64 `json:"avg"`
+}
+
+// TaskRef - задача, которую изменил запрос к таблице tasks
+type TaskRef struct {
+	UUID uuid.UUID
 }
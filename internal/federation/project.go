@@ -134,6 +134,16 @@ func (s *Service) GetProjectStatistic(companyUID, uid uuid.UUID) (item ProjectSt
 			Filled: item.Filled,
 			Count:  item.Count,
 			Total:  item.Total,
+			Money: lo.Map(item.Money, func(m MoneyStatistics, _ int) dto.MoneyStatistics {
+				return dto.MoneyStatistics{
+					Currency: m.Currency,
+					Count:    m.Count,
+					Sum:      m.Sum,
+					Min:      m.Min,
+					Max:      m.Max,
+					Avg:      m.Avg,
+				}
+			}),
 		}
 	}), err
 }
@@ -287,8 +297,24 @@ func (s *Service) GetCompanyProjectCatalogData(companyUUID uuid.UUID, catalogNam
 	return s.repo.GetCompanyProjectCatalogData(companyUUID, catalogName)
 }
 
-func (s *Service) AddProjectField(uid, companyUUID, companyFieldUUID uuid.UUID, requiredOnstatuses []int, style string) error {
-	err := s.repo.AddProjectField(uid, companyUUID, companyFieldUUID, requiredOnstatuses, style)
+func (s *Service) AddProjectField(uid, companyUUID, companyFieldUUID uuid.UUID, requiredOnstatuses []int, style string, rules domain.FieldRules) error {
+	fields, err := s.repo.GetCompanyFields(companyUUID)
+	if err != nil {
+		return err
+	}
+
+	field, ok := lo.Find(fields, func(f domain.CompanyField) bool {
+		return f.UUID == companyFieldUUID
+	})
+	if !ok {
+		return dto.NotFoundErr("поле не найдено")
+	}
+
+	if err := rules.Validate(field.DataType); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRules, err)
+	}
+
+	err = s.repo.AddProjectField(uid, companyUUID, companyFieldUUID, requiredOnstatuses, style, rules)
 
 	if err == nil {
 		s.repo.PubUpdate()
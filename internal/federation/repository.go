@@ -3,6 +3,7 @@ package federation
 import (
 	"context"
 	"errors"
+	"reflect"
 	"strings"
 
 	"github.com/google/uuid"
@@ -15,6 +16,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -283,6 +285,9 @@ func (r *Repository) CreateCompanyField(cf *domain.CompanyField) (orm CompanyFie
 			DataType:    int(cf.DataType),
 			Hash:        helpers.IntToLetters(company.FieldLastName + 1),
 			CompanyUUID: cf.CompanyUUID,
+			Formula:     cf.Formula,
+			Options:     cf.Options,
+			OnDelete:    cf.OnDelete,
 		}
 
 		err = tx.Create(&orm).Error
@@ -301,7 +306,9 @@ func (r *Repository) CreateCompanyField(cf *domain.CompanyField) (orm CompanyFie
 	return orm, err
 }
 
-func (r *Repository) PutCompanyField(pf *domain.CompanyField) error {
+// PutCompanyField сохраняет поле и в той же транзакции переименовывает варианты select/multi_select
+// в задачах компании, renames - старое название -> новое. Возвращает измененные задачи
+func (r *Repository) PutCompanyField(pf *domain.CompanyField, renames map[string]string) (tasks []uuid.UUID, err error) {
 	if pf.RequiredOnStatuses == nil {
 		pf.RequiredOnStatuses = []int{}
 	}
@@ -313,25 +320,58 @@ func (r *Repository) PutCompanyField(pf *domain.CompanyField) error {
 		Icon:        pf.Icon,
 	}
 
-	err := r.gorm.DB.
-		Model(&orm).
-		Where("uuid = ?", pf.UUID).
-		Update("name", orm.Name).
-		Update("description", orm.Description).
-		Error
+	values := map[string]interface{}{"name": orm.Name, "description": orm.Description}
+	if pf.Formula != "" {
+		values["formula"] = pf.Formula
+	}
+
+	if pf.Options != nil {
+		values["options"] = pf.Options
+	}
+
+	if pf.OnDelete != "" {
+		values["on_delete"] = pf.OnDelete
+	}
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Model(&orm).
+			Where("uuid = ?", pf.UUID).
+			Updates(values).
+			Error
+		if err != nil || len(renames) == 0 {
+			return err
+		}
+
+		rows := []TaskRef{}
+
+		query := tx.Table("tasks").
+			Model(&rows).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "uuid"}}}).
+			Where("company_uuid = ?", pf.CompanyUUID)
+
+		err = postgres.RenameFieldOptions(query, pf.Hash, pf.DataType == domain.MultiSelect, renames)
+		if err != nil {
+			return err
+		}
+
+		tasks = lo.Map(rows, func(item TaskRef, _ int) uuid.UUID { return item.UUID })
+
+		return nil
+	})
 
 	if err == nil {
 		r.PubUpdate()
 	}
 
-	return err
+	return tasks, err
 }
 
 func (r *Repository) GetProjectFields(projectUUID uuid.UUID) (orm []CompanyFields, err error) {
 	orm = []CompanyFields{}
 
 	r.gorm.DB.Model(&orm).
-		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, pf.style, pf.required_on_statuses").
+		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, company_fields.formula, company_fields.options, pf.style, pf.required_on_statuses, pf.rules").
 		Joins("left join project_fields pf on pf.company_field_uuid = company_fields.uuid").
 		Where("pf.project_uuid = ?", projectUUID).
 		Where("company_fields.deleted_at is null").
@@ -350,7 +390,7 @@ func (r *Repository) GetCompanyFields(companyUUID uuid.UUID) (dmns []domain.Comp
 
 	// Company Fields
 	res := r.gorm.DB.Model(&orm).
-		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, COALESCE(json_agg(distinct pf.project_uuid) FILTER (WHERE pf.project_uuid IS NOT NULL), '[]' ) as project_uuids,"+
+		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, company_fields.formula, company_fields.options, company_fields.on_delete, COALESCE(json_agg(distinct pf.project_uuid) FILTER (WHERE pf.project_uuid IS NOT NULL), '[]' ) as project_uuids,"+
 			"count(*) as tasks_total,"+
 			"count(*) FILTER (WHERE t.fields->>company_fields.hash is not null) as tasks_filled,"+
 			"count(*) FILTER (WHERE t.fields->>company_fields.hash is not null and t.finished_at is null) as tasks_active_filled",
@@ -375,6 +415,9 @@ func (r *Repository) GetCompanyFields(companyUUID uuid.UUID) (dmns []domain.Comp
 			Icon:        item.Icon,
 			DataType:    domain.FieldDataType(item.DataType),
 			CompanyUUID: item.CompanyUUID,
+			Formula:     item.Formula,
+			Options:     item.Options,
+			OnDelete:    item.OnDelete,
 			ProjectUUID: lo.Map(item.ProjectUUID, func(uid any, index int) uuid.UUID {
 				return uuid.MustParse(uid.(string))
 			}),
@@ -476,6 +519,18 @@ func (r *Repository) ProjectStatistic(companyUID, uid uuid.UUID) (orm ProjectSta
 		return orm, fieldStatistics, res.Error
 	}
 
+	// Суммы по полям money, отдельно по каждой валюте, удаленные задачи не считаются
+	moneyStatistics := []MoneyStatistics{}
+	res = r.gorm.DB.Raw("select key as hash, tasks.fields->key->>'currency' as currency, count(*), sum((tasks.fields->key->>'amount')::bigint)::bigint as sum, min((tasks.fields->key->>'amount')::bigint) as min, max((tasks.fields->key->>'amount')::bigint) as max, avg((tasks.fields->key->>'amount')::bigint)::float8 as avg from tasks, jsonb_object_keys(fields) AS key join company_fields cf on cf.hash = key and cf.company_uuid = ? and cf.data_type = ? where tasks.project_uuid = ? AND tasks.deleted_at is null AND jsonb_typeof(tasks.fields->key) = 'object' group by key, tasks.fields->key->>'currency' order by key, currency", companyUID, domain.Money, uid).Scan(&moneyStatistics)
+	if res.Error != nil {
+		return orm, fieldStatistics, res.Error
+	}
+
+	money := lo.GroupBy(moneyStatistics, func(item MoneyStatistics) string { return item.Hash })
+	for i := range fieldStatistics {
+		fieldStatistics[i].Money = money[fieldStatistics[i].Hash]
+	}
+
 	return orm, fieldStatistics, err
 }
 
@@ -1401,7 +1456,7 @@ func (r *Repository) RemoveUserFromGroups(groupUUID, userUUID uuid.UUID) (err er
 	return err
 }
 
-func (r *Repository) AddProjectField(projectUUID, companyUUID, companyFieldUUID uuid.UUID, requiredOnStatuses []int, style string) (err error) {
+func (r *Repository) AddProjectField(projectUUID, companyUUID, companyFieldUUID uuid.UUID, requiredOnStatuses []int, style string, rules domain.FieldRules) (err error) {
 	existingRecord := &ProjectField{}
 
 	if style != "" && style != "hide_when_empty" && style != "show_when_empty" {
@@ -1420,7 +1475,8 @@ func (r *Repository) AddProjectField(projectUUID, companyUUID, companyFieldUUID
 
 	if res.RowsAffected > 0 &&
 		style == existingRecord.Style &&
-		helpers.EquelSlices(existingRecord.RequiredOnStatuses, requiredOnStatuses) {
+		helpers.EquelSlices(existingRecord.RequiredOnStatuses, requiredOnStatuses) &&
+		reflect.DeepEqual(existingRecord.Rules, rules) {
 		return errors.New("поле уже добавлено")
 	}
 
@@ -1432,6 +1488,7 @@ func (r *Repository) AddProjectField(projectUUID, companyUUID, companyFieldUUID
 			Where("deleted_at is null").
 			Update("required_on_statuses", IntArray(requiredOnStatuses)).
 			Update("style", style).
+			Update("rules", rules).
 			Error
 	} else {
 		existingRecord = &ProjectField{
@@ -1441,6 +1498,7 @@ func (r *Repository) AddProjectField(projectUUID, companyUUID, companyFieldUUID
 			CompanyFieldUUID:   companyFieldUUID,
 			RequiredOnStatuses: requiredOnStatuses,
 			Style:              style,
+			Rules:              rules,
 		}
 
 		err = r.gorm.DB.Create(&existingRecord).Error
@@ -0,0 +1,268 @@
+package formula
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// Env - значения полей строки и поля строк справочников, на которые ссылаются поля data
+type Env struct {
+	Values map[string]interface{}
+	Refs   map[string]map[string]interface{}
+}
+
+// Run вычисляет формулы по порядку и записывает результаты в env.Values.
+// Если значения не хватает или оно неверного типа (пустое поле, деление на ноль), поле удаляется
+func (p *Program) Run(env Env) {
+	for _, s := range p.steps {
+		v := p.eval(s.expr, env)
+		if v == nil {
+			delete(env.Values, s.hash)
+			continue
+		}
+
+		if t, ok := v.(time.Time); ok {
+			v = t.Format(time.RFC3339)
+		}
+
+		env.Values[s.hash] = v
+	}
+}
+
+func (p *Program) eval(n node, env Env) interface{} {
+	switch v := n.(type) {
+	case numLit:
+		return v.v
+	case strLit:
+		return v.v
+	case boolLit:
+		return v.v
+
+	case ident:
+		return convert(env.Values[v.name], p.fields[v.name].Type)
+
+	case member:
+		row, ok := env.Refs[v.ref]
+		if !ok {
+			return nil
+		}
+		return convert(row[v.name], p.fields[v.ref].Ref[v.name])
+
+	case unary:
+		x := p.eval(v.x, env)
+		switch x := x.(type) {
+		case float64:
+			return -x
+		case bool:
+			return !x
+		}
+		return nil
+
+	case binary:
+		return p.binary(v, env)
+
+	case call:
+		return p.call(v, env)
+	}
+
+	return nil
+}
+
+func (p *Program) binary(v binary, env Env) interface{} {
+	l := p.eval(v.l, env)
+
+	// && и || вычисляют правую часть только при необходимости
+	if b, ok := l.(bool); ok && (v.op == "&&" && !b || v.op == "||" && b) {
+		return b
+	}
+
+	r := p.eval(v.r, env)
+	if l == nil || r == nil {
+		return nil
+	}
+
+	switch v.op {
+	case "&&", "||":
+		return r
+
+	case "=":
+		return compare(l, r) == 0
+	case "!=":
+		return compare(l, r) != 0
+	case "<":
+		return compare(l, r) < 0
+	case "<=":
+		return compare(l, r) <= 0
+	case ">":
+		return compare(l, r) > 0
+	case ">=":
+		return compare(l, r) >= 0
+	}
+
+	switch l := l.(type) {
+	case string:
+		return l + r.(string)
+
+	case time.Time:
+		switch r := r.(type) {
+		case time.Time:
+			return l.Sub(r).Hours() / 24
+		case float64:
+			d := time.Duration(r * float64(24*time.Hour))
+			return lo.Ternary(v.op == "+", l.Add(d), l.Add(-d))
+		}
+
+	case float64:
+		r := r.(float64)
+
+		switch v.op {
+		case "+":
+			return l + r
+		case "-":
+			return l - r
+		case "*":
+			return l * r
+		case "/":
+			if r == 0 {
+				return nil
+			}
+			return l / r
+		case "%":
+			if r == 0 {
+				return nil
+			}
+			return math.Mod(l, r)
+		}
+	}
+
+	return nil
+}
+
+func (p *Program) call(v call, env Env) interface{} {
+	if v.fn == "if" {
+		cond, ok := p.eval(v.args[0], env).(bool)
+		if !ok {
+			return nil
+		}
+		return p.eval(v.args[lo.Ternary(cond, 1, 2)], env)
+	}
+
+	args := make([]interface{}, 0, len(v.args))
+	for _, arg := range v.args {
+		args = append(args, p.eval(arg, env))
+	}
+
+	if v.fn == "concat" {
+		var b strings.Builder
+		for _, a := range args {
+			switch a := a.(type) {
+			case nil:
+			case time.Time:
+				b.WriteString(a.Format("2006-01-02"))
+			default:
+				fmt.Fprint(&b, a)
+			}
+		}
+		return b.String()
+	}
+
+	nums := make([]float64, 0, len(args))
+	for _, a := range args {
+		n, ok := a.(float64)
+		if !ok {
+			return nil
+		}
+		nums = append(nums, n)
+	}
+
+	switch v.fn {
+	case "round":
+		pow := 1.0
+		if len(nums) == 2 {
+			pow = math.Pow(10, math.Round(nums[1]))
+		}
+		return math.Round(nums[0]*pow) / pow
+	case "abs":
+		return math.Abs(nums[0])
+	case "min":
+		res := nums[0]
+		for _, n := range nums[1:] {
+			res = math.Min(res, n)
+		}
+		return res
+	case "max":
+		res := nums[0]
+		for _, n := range nums[1:] {
+			res = math.Max(res, n)
+		}
+		return res
+	}
+
+	return nil
+}
+
+func compare(l, r interface{}) int {
+	switch l := l.(type) {
+	case float64:
+		r := r.(float64)
+		return lo.Ternary(l < r, -1, lo.Ternary(l > r, 1, 0))
+	case string:
+		return strings.Compare(l, r.(string))
+	case time.Time:
+		return l.Compare(r.(time.Time))
+	case bool:
+		return lo.Ternary(l == r.(bool), 0, 1)
+	}
+
+	return 1
+}
+
+// convert приводит сохраненное значение поля к типу формулы, nil - значения нет
+func convert(value interface{}, t Type) interface{} {
+	switch t {
+	case TypeNumber:
+		switch v := value.(type) {
+		case float64:
+			return v
+		case float32:
+			return float64(v)
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		case json.Number:
+			if f, err := v.Float64(); err == nil {
+				return f
+			}
+		}
+
+	case TypeString:
+		if v, ok := value.(string); ok {
+			return v
+		}
+
+	case TypeBool:
+		if v, ok := value.(bool); ok {
+			return v
+		}
+
+	case TypeDate:
+		switch v := value.(type) {
+		case time.Time:
+			return v
+		case string:
+			for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+				if d, err := time.Parse(layout, v); err == nil {
+					return d
+				}
+			}
+		}
+	}
+
+	return nil
+}
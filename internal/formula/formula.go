@@ -0,0 +1,358 @@
+package formula
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+// Type - тип значения в формуле
+type Type int
+
+const (
+	TypeNumber Type = iota + 1
+	TypeString
+	TypeBool
+	TypeDate
+	TypeRef
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeNumber:
+		return "число"
+	case TypeString:
+		return "строка"
+	case TypeBool:
+		return "логическое"
+	case TypeDate:
+		return "дата"
+	case TypeRef:
+		return "ссылка"
+	}
+
+	return "unknown"
+}
+
+// Field - поле, которое можно использовать в формуле. Для ссылки (TypeRef) в Ref
+// перечислены поля строки справочника, доступные через точку: client.price.
+// Ссылка с пустым Ref - справочник поля неизвестен, обращаться к ней нельзя
+type Field struct {
+	Type Type
+	Ref  map[string]Type
+}
+
+// Definition - вычисляемое поле и текст его формулы
+type Definition struct {
+	Hash    string
+	Formula string
+}
+
+type step struct {
+	hash string
+	expr node
+	typ  Type
+}
+
+// Program - проверенные формулы в порядке вычисления
+type Program struct {
+	fields map[string]Field
+	steps  []step
+
+	// Errors - ошибки разбора и проверки типов по hash поля, такие формулы не вычисляются
+	Errors map[string]error
+}
+
+// Check разбирает формулу и проверяет типы, возвращает тип результата
+func Check(src string, fields map[string]Field) (Type, error) {
+	n, err := parse(src)
+	if err != nil {
+		return 0, err
+	}
+
+	return typeOf(n, fields)
+}
+
+// Compile разбирает и проверяет формулы с учетом зависимостей друг от друга.
+// Формула может ссылаться на другое вычисляемое поле, циклы запрещены
+func Compile(fields map[string]Field, defs []Definition) *Program {
+	p := &Program{
+		fields: make(map[string]Field, len(fields)+len(defs)),
+		Errors: map[string]error{},
+	}
+
+	for hash, f := range fields {
+		p.fields[hash] = f
+	}
+
+	byHash := lo.KeyBy(defs, func(d Definition) string { return d.Hash })
+	state := map[string]int{}
+
+	var visit func(hash string, path []string)
+	visit = func(hash string, path []string) {
+		switch state[hash] {
+		case 1:
+			p.Errors[hash] = fmt.Errorf("циклическая зависимость формул: %s", strings.Join(append(path, hash), " -> "))
+			return
+		case 2:
+			return
+		}
+
+		state[hash] = 1
+		defer func() { state[hash] = 2 }()
+
+		n, err := parse(byHash[hash].Formula)
+		if err != nil {
+			p.Errors[hash] = err
+			return
+		}
+
+		for _, dep := range lo.Uniq(idents(n)) {
+			if _, ok := byHash[dep]; ok {
+				visit(dep, append(path, hash))
+			}
+		}
+
+		if _, ok := p.Errors[hash]; ok {
+			return
+		}
+
+		for _, dep := range lo.Uniq(idents(n)) {
+			if _, ok := p.Errors[dep]; ok {
+				p.Errors[hash] = fmt.Errorf("формула поля %s содержит ошибку", dep)
+				return
+			}
+		}
+
+		typ, err := typeOf(n, p.fields)
+		if err != nil {
+			p.Errors[hash] = err
+			return
+		}
+
+		p.fields[hash] = Field{Type: typ}
+		p.steps = append(p.steps, step{hash: hash, expr: n, typ: typ})
+	}
+
+	for _, d := range defs {
+		visit(d.Hash, nil)
+	}
+
+	return p
+}
+
+// Type возвращает тип результата вычисляемого поля
+func (p *Program) Type(hash string) (Type, bool) {
+	s, ok := lo.Find(p.steps, func(s step) bool { return s.hash == hash })
+	return s.typ, ok
+}
+
+// Refs возвращает поля-ссылки, через которые формулы читают поля других строк
+func (p *Program) Refs() (refs []string) {
+	for _, s := range p.steps {
+		refs = append(refs, refsOf(s.expr)...)
+	}
+
+	return lo.Uniq(refs)
+}
+
+func refsOf(n node) (refs []string) {
+	switch v := n.(type) {
+	case member:
+		refs = append(refs, v.ref)
+	case unary:
+		refs = append(refs, refsOf(v.x)...)
+	case binary:
+		refs = append(refs, refsOf(v.l)...)
+		refs = append(refs, refsOf(v.r)...)
+	case call:
+		for _, arg := range v.args {
+			refs = append(refs, refsOf(arg)...)
+		}
+	}
+
+	return refs
+}
+
+func typeOf(n node, fields map[string]Field) (Type, error) {
+	switch v := n.(type) {
+	case numLit:
+		return TypeNumber, nil
+	case strLit:
+		return TypeString, nil
+	case boolLit:
+		return TypeBool, nil
+
+	case ident:
+		f, ok := fields[v.name]
+		if !ok {
+			return 0, fmt.Errorf("неизвестное поле %s", v.name)
+		}
+		if f.Type == TypeRef {
+			return 0, fmt.Errorf("поле %s - ссылка, укажите поле справочника через точку: %s.hash", v.name, v.name)
+		}
+		return f.Type, nil
+
+	case member:
+		f, ok := fields[v.ref]
+		if !ok {
+			return 0, fmt.Errorf("неизвестное поле %s", v.ref)
+		}
+		if f.Type != TypeRef {
+			return 0, fmt.Errorf("поле %s не ссылка на справочник", v.ref)
+		}
+		if f.Ref == nil {
+			return 0, fmt.Errorf("у поля %s не задан справочник, его поля в формуле недоступны", v.ref)
+		}
+		t, ok := f.Ref[v.name]
+		if !ok {
+			return 0, fmt.Errorf("в справочнике поля %s нет поля %s", v.ref, v.name)
+		}
+		return t, nil
+
+	case unary:
+		t, err := typeOf(v.x, fields)
+		if err != nil {
+			return 0, err
+		}
+		want := lo.Ternary(v.op == "!", TypeBool, TypeNumber)
+		if t != want {
+			return 0, fmt.Errorf("оператор %s не применим к типу %s", v.op, t)
+		}
+		return t, nil
+
+	case binary:
+		return binaryType(v, fields)
+
+	case call:
+		return callType(v, fields)
+	}
+
+	return 0, fmt.Errorf("неизвестное выражение")
+}
+
+func binaryType(v binary, fields map[string]Field) (Type, error) {
+	l, err := typeOf(v.l, fields)
+	if err != nil {
+		return 0, err
+	}
+
+	r, err := typeOf(v.r, fields)
+	if err != nil {
+		return 0, err
+	}
+
+	mismatch := fmt.Errorf("оператор %s не применим к типам %s и %s", v.op, l, r)
+
+	switch v.op {
+	case "&&", "||":
+		if l == TypeBool && r == TypeBool {
+			return TypeBool, nil
+		}
+
+	case "=", "!=":
+		if l == r {
+			return TypeBool, nil
+		}
+
+	case "<", "<=", ">", ">=":
+		if l == r && l != TypeBool {
+			return TypeBool, nil
+		}
+
+	case "+":
+		switch {
+		case l == TypeNumber && r == TypeNumber, l == TypeString && r == TypeString:
+			return l, nil
+		case l == TypeDate && r == TypeNumber:
+			return TypeDate, nil
+		}
+
+	case "-":
+		switch {
+		case l == TypeNumber && r == TypeNumber:
+			return TypeNumber, nil
+		case l == TypeDate && r == TypeDate:
+			// разница дат в днях
+			return TypeNumber, nil
+		case l == TypeDate && r == TypeNumber:
+			return TypeDate, nil
+		}
+
+	case "*", "/", "%":
+		if l == TypeNumber && r == TypeNumber {
+			return TypeNumber, nil
+		}
+	}
+
+	return 0, mismatch
+}
+
+func callType(v call, fields map[string]Field) (Type, error) {
+	args := make([]Type, 0, len(v.args))
+	for _, arg := range v.args {
+		t, err := typeOf(arg, fields)
+		if err != nil {
+			return 0, err
+		}
+		args = append(args, t)
+	}
+
+	numbers := func(from, to int) error {
+		if len(args) < from || to > 0 && len(args) > to {
+			return fmt.Errorf("неверное число аргументов функции %s", v.fn)
+		}
+		for i, t := range args {
+			if t != TypeNumber {
+				return fmt.Errorf("аргумент %d функции %s должен быть числом", i+1, v.fn)
+			}
+		}
+		return nil
+	}
+
+	switch v.fn {
+	case "round":
+		return TypeNumber, numbers(1, 2)
+	case "abs":
+		return TypeNumber, numbers(1, 1)
+	case "min", "max":
+		return TypeNumber, numbers(1, 0)
+
+	case "if":
+		if len(args) != 3 {
+			return 0, fmt.Errorf("функция if принимает 3 аргумента: if(условие, да, нет)")
+		}
+		if args[0] != TypeBool {
+			return 0, fmt.Errorf("условие функции if должно быть логическим")
+		}
+		if args[1] != args[2] {
+			return 0, fmt.Errorf("ветки функции if должны быть одного типа: %s и %s", args[1], args[2])
+		}
+		return args[1], nil
+
+	case "concat":
+		return TypeString, nil
+	}
+
+	return 0, fmt.Errorf("неизвестная функция %s", v.fn)
+}
+
+// FieldType - тип значения поля в формуле, ok = false для полей, которые в формулах не используются
+func FieldType(dt domain.FieldDataType) (t Type, ok bool) {
+	switch dt {
+	case domain.Integer, domain.Float, domain.Switch:
+		return TypeNumber, true
+	case domain.String, domain.Text, domain.Link, domain.Email, domain.Select:
+		return TypeString, true
+	case domain.Bool:
+		return TypeBool, true
+	case domain.DateTime:
+		return TypeDate, true
+	case domain.Data:
+		return TypeRef, true
+	}
+
+	return t, false
+}
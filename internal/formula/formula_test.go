@@ -0,0 +1,146 @@
+package formula
+
+import (
+	"strings"
+	"testing"
+)
+
+var testFields = map[string]Field{
+	"price": {Type: TypeNumber},
+	"qty":   {Type: TypeNumber},
+	"name":  {Type: TypeString},
+	"vip":   {Type: TypeBool},
+	"start": {Type: TypeDate},
+	"end":   {Type: TypeDate},
+	"client": {Type: TypeRef, Ref: map[string]Type{
+		"discount": TypeNumber,
+		"city":     TypeString,
+	}},
+	"owner": {Type: TypeRef},
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		src  string
+		want Type
+		err  string
+	}{
+		{src: "price * qty", want: TypeNumber},
+		{src: "round(price * qty * (1 - client.discount / 100), 2)", want: TypeNumber},
+		{src: "end - start", want: TypeNumber},
+		{src: "start + 7", want: TypeDate},
+		{src: `if(vip && qty >= 10, "опт", name)`, want: TypeString},
+		{src: `concat(name, " ", client.city)`, want: TypeString},
+		{src: "!vip || price > 0", want: TypeBool},
+		{src: "", err: "пустой"},
+		{src: "price *", err: "неожиданный конец"},
+		{src: "price + name", err: "не применим"},
+		{src: "total * 2", err: "неизвестное поле total"},
+		{src: "client + 1", err: "ссылка"},
+		{src: "client.phone", err: "нет поля phone"},
+		{src: "price.value", err: "не ссылка"},
+		{src: "owner.name", err: "не задан справочник"},
+		{src: "owner", err: "ссылка"},
+		{src: `if(vip, 1, "a")`, err: "одного типа"},
+		{src: "sqrt(price)", err: "неизвестная функция"},
+		{src: `"abc`, err: "незакрытая"},
+	}
+
+	for _, tt := range tests {
+		got, err := Check(tt.src, testFields)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%q: want error %q, got %v", tt.src, tt.err, err)
+			}
+			continue
+		}
+
+		if err != nil || got != tt.want {
+			t.Errorf("%q: want %s, got %s (%v)", tt.src, tt.want, got, err)
+		}
+	}
+}
+
+func TestCompile(t *testing.T) {
+	p := Compile(testFields, []Definition{
+		{Hash: "total", Formula: "sum * 2"},
+		{Hash: "sum", Formula: "price * qty"},
+		{Hash: "loop", Formula: "loop + 1"},
+		{Hash: "a", Formula: "b + 1"},
+		{Hash: "b", Formula: "a + 1"},
+		{Hash: "bad", Formula: "loop * 2"},
+	})
+
+	if typ, ok := p.Type("total"); !ok || typ != TypeNumber {
+		t.Errorf("total should be compiled, errors: %v", p.Errors)
+	}
+
+	for _, hash := range []string{"loop", "a", "b", "bad"} {
+		if p.Errors[hash] == nil {
+			t.Errorf("%s should fail", hash)
+		}
+	}
+
+	if !strings.Contains(p.Errors["loop"].Error(), "циклическая") {
+		t.Errorf("unexpected error: %v", p.Errors["loop"])
+	}
+}
+
+func TestRun(t *testing.T) {
+	p := Compile(testFields, []Definition{
+		{Hash: "sum", Formula: "round(price * qty * (1 - client.discount / 100), 2)"},
+		{Hash: "days", Formula: "end - start"},
+		{Hash: "due", Formula: "start + 2"},
+		{Hash: "avg", Formula: "round(sum / qty, 2)"},
+		{Hash: "label", Formula: `concat(name, ": ", sum)`},
+	})
+
+	if len(p.Errors) > 0 {
+		t.Fatal(p.Errors)
+	}
+
+	if refs := p.Refs(); len(refs) != 1 || refs[0] != "client" {
+		t.Errorf("unexpected refs %v", refs)
+	}
+
+	env := Env{
+		Values: map[string]interface{}{
+			"price": 10.5,
+			"qty":   3,
+			"name":  "Заказ",
+			"start": "2024-06-01T10:00:00Z",
+			"end":   "2024-06-11T10:00:00Z",
+		},
+		Refs: map[string]map[string]interface{}{
+			"client": {"discount": float64(10)},
+		},
+	}
+
+	p.Run(env)
+
+	want := map[string]interface{}{
+		"sum":   28.35,
+		"days":  float64(10),
+		"due":   "2024-06-03T10:00:00Z",
+		"avg":   9.45,
+		"label": "Заказ: 28.35",
+	}
+
+	for k, v := range want {
+		if env.Values[k] != v {
+			t.Errorf("%s: want %v, got %v", k, v, env.Values[k])
+		}
+	}
+
+	env.Values["qty"] = 0
+	delete(env.Refs, "client")
+	p.Run(env)
+
+	if _, ok := env.Values["sum"]; ok {
+		t.Errorf("sum without client should be empty, got %v", env.Values["sum"])
+	}
+
+	if _, ok := env.Values["avg"]; ok {
+		t.Errorf("division by zero should be empty, got %v", env.Values["avg"])
+	}
+}
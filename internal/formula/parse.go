@@ -0,0 +1,349 @@
+package formula
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MaxLength - максимальная длина текста формулы
+const MaxLength = 1000
+
+type node interface{}
+
+type (
+	numLit  struct{ v float64 }
+	strLit  struct{ v string }
+	boolLit struct{ v bool }
+	ident   struct{ name string }
+
+	// member - поле строки справочника, на которую ссылается поле data: client.price
+	member struct {
+		ref  string
+		name string
+	}
+
+	unary struct {
+		op string
+		x  node
+	}
+
+	binary struct {
+		op   string
+		l, r node
+	}
+
+	call struct {
+		fn   string
+		args []node
+	}
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNum
+	tokStr
+	tokIdent
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+var operators = []string{"&&", "||", "!=", "<=", ">=", "=", "<", ">", "+", "-", "*", "/", "%", "!", "(", ")", ",", "."}
+
+func tokenize(src string) (tokens []token, err error) {
+	rs := []rune(src)
+
+	for i := 0; i < len(rs); {
+		r := rs[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case unicode.IsDigit(r):
+			j := i
+			for j < len(rs) && (unicode.IsDigit(rs[j]) || rs[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, token{tokNum, string(rs[i:j]), i})
+			i = j
+
+		case r == '_' || r < unicode.MaxASCII && unicode.IsLetter(r):
+			j := i
+			for j < len(rs) && (rs[j] == '_' || rs[j] < unicode.MaxASCII && (unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j]))) {
+				j++
+			}
+			tokens = append(tokens, token{tokIdent, string(rs[i:j]), i})
+			i = j
+
+		case r == '"' || r == '\'':
+			j := i + 1
+			for j < len(rs) && rs[j] != r {
+				j++
+			}
+			if j == len(rs) {
+				return tokens, fmt.Errorf("позиция %d: незакрытая строка", i+1)
+			}
+			tokens = append(tokens, token{tokStr, string(rs[i+1 : j]), i})
+			i = j + 1
+
+		default:
+			op, ok := "", false
+			for _, o := range operators {
+				if strings.HasPrefix(string(rs[i:]), o) {
+					op, ok = o, true
+					break
+				}
+			}
+			if !ok {
+				return tokens, fmt.Errorf("позиция %d: неожиданный символ %q", i+1, r)
+			}
+			tokens = append(tokens, token{tokOp, op, i})
+			i += len([]rune(op))
+		}
+	}
+
+	return append(tokens, token{tokEOF, "", len(rs)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// parse разбирает текст формулы в дерево выражения
+func parse(src string) (n node, err error) {
+	if strings.TrimSpace(src) == "" {
+		return n, fmt.Errorf("формула не может быть пустой")
+	}
+
+	if len([]rune(src)) > MaxLength {
+		return n, fmt.Errorf("формула длиннее %d символов", MaxLength)
+	}
+
+	tokens, err := tokenize(src)
+	if err != nil {
+		return n, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	n, err = p.or()
+	if err != nil {
+		return n, err
+	}
+
+	if t := p.peek(); t.kind != tokEOF {
+		return n, p.unexpected(t)
+	}
+
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != tokOp {
+		return "", false
+	}
+
+	for _, op := range ops {
+		if t.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+
+	return "", false
+}
+
+func (p *parser) expect(op string) error {
+	if _, ok := p.accept(op); !ok {
+		return p.unexpected(p.peek())
+	}
+	return nil
+}
+
+func (p *parser) unexpected(t token) error {
+	if t.kind == tokEOF {
+		return fmt.Errorf("неожиданный конец формулы")
+	}
+	return fmt.Errorf("позиция %d: неожиданное %q", t.pos+1, t.text)
+}
+
+func (p *parser) binary(next func() (node, error), ops ...string) (node, error) {
+	l, err := next()
+	if err != nil {
+		return l, err
+	}
+
+	for {
+		op, ok := p.accept(ops...)
+		if !ok {
+			return l, nil
+		}
+
+		r, err := next()
+		if err != nil {
+			return r, err
+		}
+
+		l = binary{op: op, l: l, r: r}
+	}
+}
+
+func (p *parser) or() (node, error) {
+	return p.binary(p.and, "||")
+}
+
+func (p *parser) and() (node, error) {
+	return p.binary(p.compare, "&&")
+}
+
+func (p *parser) compare() (node, error) {
+	l, err := p.add()
+	if err != nil {
+		return l, err
+	}
+
+	op, ok := p.accept("=", "!=", "<", "<=", ">", ">=")
+	if !ok {
+		return l, nil
+	}
+
+	r, err := p.add()
+	if err != nil {
+		return r, err
+	}
+
+	return binary{op: op, l: l, r: r}, nil
+}
+
+func (p *parser) add() (node, error) {
+	return p.binary(p.mul, "+", "-")
+}
+
+func (p *parser) mul() (node, error) {
+	return p.binary(p.unary, "*", "/", "%")
+}
+
+func (p *parser) unary() (node, error) {
+	if op, ok := p.accept("-", "!"); ok {
+		x, err := p.unary()
+		if err != nil {
+			return x, err
+		}
+		return unary{op: op, x: x}, nil
+	}
+
+	return p.primary()
+}
+
+func (p *parser) primary() (n node, err error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokNum:
+		v, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return n, fmt.Errorf("позиция %d: неверное число %q", t.pos+1, t.text)
+		}
+		return numLit{v}, nil
+
+	case tokStr:
+		return strLit{t.text}, nil
+
+	case tokIdent:
+		switch t.text {
+		case "true", "false":
+			return boolLit{t.text == "true"}, nil
+		}
+
+		if _, ok := p.accept("("); ok {
+			return p.call(t.text)
+		}
+
+		if _, ok := p.accept("."); ok {
+			f := p.next()
+			if f.kind != tokIdent {
+				return n, p.unexpected(f)
+			}
+			return member{ref: t.text, name: f.text}, nil
+		}
+
+		return ident{t.text}, nil
+
+	case tokOp:
+		if t.text == "(" {
+			n, err = p.or()
+			if err != nil {
+				return n, err
+			}
+			return n, p.expect(")")
+		}
+	}
+
+	return n, p.unexpected(t)
+}
+
+func (p *parser) call(fn string) (n node, err error) {
+	c := call{fn: fn}
+
+	if _, ok := p.accept(")"); ok {
+		return c, nil
+	}
+
+	for {
+		arg, err := p.or()
+		if err != nil {
+			return n, err
+		}
+		c.args = append(c.args, arg)
+
+		if _, ok := p.accept(","); ok {
+			continue
+		}
+
+		return c, p.expect(")")
+	}
+}
+
+// idents возвращает имена полей, которые использует выражение
+func idents(n node) (names []string) {
+	switch v := n.(type) {
+	case ident:
+		names = append(names, v.name)
+	case member:
+		names = append(names, v.ref)
+	case unary:
+		names = append(names, idents(v.x)...)
+	case binary:
+		names = append(names, idents(v.l)...)
+		names = append(names, idents(v.r)...)
+	case call:
+		for _, arg := range v.args {
+			names = append(names, idents(arg)...)
+		}
+	}
+
+	return names
+}
@@ -60,7 +60,7 @@ func (a *Service) FederationPatch(federationUUID, userUUID uuid.UUID) error {
 		return fmt.Errorf("федерация не найдена")
 	}
 
-	if federation.CreatedByUUID == nil || *federation.CreatedByUUID == userUUID {
+	if federation.CreatedByUUID == nil || *federation.CreatedByUUID != userUUID {
 		return fmt.Errorf("федерцию может изменить только создатель")
 	}
 
@@ -0,0 +1,40 @@
+package gates
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
+)
+
+func TestFederationPatch(t *testing.T) {
+	owner, member, stranger := uuid.New(), uuid.New(), uuid.New()
+	federation, orphan := uuid.New(), uuid.New()
+
+	s := New(nil, dictStub{
+		userFederations: map[uuid.UUID][]uuid.UUID{
+			owner:  {federation, orphan},
+			member: {federation},
+		},
+		federations: map[uuid.UUID]dto.FederationDTO{
+			federation: {UUID: federation, CreatedByUUID: &owner},
+			orphan:     {UUID: orphan},
+		},
+	})
+
+	for name, tc := range map[string]struct {
+		user       uuid.UUID
+		federation uuid.UUID
+		ok         bool
+	}{
+		"owner":      {owner, federation, true},
+		"member":     {member, federation, false},
+		"stranger":   {stranger, federation, false},
+		"no creator": {owner, orphan, false},
+	} {
+		err := s.FederationPatch(tc.federation, tc.user)
+		if (err == nil) != tc.ok {
+			t.Errorf("%s: got %v", name, err)
+		}
+	}
+}
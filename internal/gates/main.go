@@ -11,6 +11,7 @@ type IDictionary interface {
 	GetUserCompanies(userUUID uuid.UUID) []uuid.UUID
 	FindFederation(federationUUID uuid.UUID) (*dto.FederationDTO, bool)
 	FindCompany(uuid uuid.UUID) (*dto.CompanyDTO, bool)
+	FindProject(uuid uuid.UUID) (*dto.ProjectDTO, bool)
 }
 
 type Service struct {
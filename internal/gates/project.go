@@ -53,3 +53,22 @@ func (a *Service) ProjectPatch(project domain.Project, userUUID uuid.UUID) error
 
 	return nil
 }
+
+// ProjectAdmin allows the project responsible user and the federation owner.
+func (a *Service) ProjectAdmin(projectUUID, userUUID uuid.UUID) error {
+	project, found := a.dict.FindProject(projectUUID)
+	if !found {
+		return fmt.Errorf("проект не найден")
+	}
+
+	if project.ResponsibleBy != nil && project.ResponsibleBy.UUID == userUUID {
+		return nil
+	}
+
+	federation, found := a.dict.FindFederation(project.FederationUUID)
+	if found && federation.CreatedByUUID != nil && *federation.CreatedByUUID == userUUID {
+		return nil
+	}
+
+	return fmt.Errorf("действие доступно только администратору проекта")
+}
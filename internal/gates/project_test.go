@@ -0,0 +1,66 @@
+package gates
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
+)
+
+type dictStub struct {
+	userFederations map[uuid.UUID][]uuid.UUID
+	federations     map[uuid.UUID]dto.FederationDTO
+	projects        map[uuid.UUID]dto.ProjectDTO
+}
+
+func (d dictStub) GetUserFederatons(userUUID uuid.UUID) []uuid.UUID {
+	return d.userFederations[userUUID]
+}
+
+func (d dictStub) GetUserCompanies(_ uuid.UUID) []uuid.UUID {
+	return nil
+}
+
+func (d dictStub) FindFederation(uid uuid.UUID) (*dto.FederationDTO, bool) {
+	f, ok := d.federations[uid]
+	return &f, ok
+}
+
+func (d dictStub) FindCompany(_ uuid.UUID) (*dto.CompanyDTO, bool) {
+	return &dto.CompanyDTO{}, false
+}
+
+func (d dictStub) FindProject(uid uuid.UUID) (*dto.ProjectDTO, bool) {
+	p, ok := d.projects[uid]
+	return &p, ok
+}
+
+func TestProjectAdmin(t *testing.T) {
+	owner, responsible, member := uuid.New(), uuid.New(), uuid.New()
+	federation, project := uuid.New(), uuid.New()
+
+	s := New(nil, dictStub{
+		federations: map[uuid.UUID]dto.FederationDTO{federation: {UUID: federation, CreatedByUUID: &owner}},
+		projects: map[uuid.UUID]dto.ProjectDTO{project: {
+			UUID:           project,
+			FederationUUID: federation,
+			ResponsibleBy:  &dto.UserDTO{UUID: responsible},
+		}},
+	})
+
+	for name, tc := range map[string]struct {
+		user    uuid.UUID
+		project uuid.UUID
+		ok      bool
+	}{
+		"responsible":      {responsible, project, true},
+		"federation owner": {owner, project, true},
+		"member":           {member, project, false},
+		"unknown project":  {owner, uuid.New(), false},
+	} {
+		err := s.ProjectAdmin(tc.project, tc.user)
+		if (err == nil) != tc.ok {
+			t.Errorf("%s: got %v", name, err)
+		}
+	}
+}
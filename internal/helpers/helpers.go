@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"mime"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -489,6 +490,13 @@ func FileMimeToPreview(mime string) bool {
 	return mimetype.EqualsAny(mime, "image/jpeg", "image/png", "image/tiff", "image/webp", "image/gif")
 }
 
+// FileInline - файл с таким именем можно показать в браузере (inline): растровая картинка по расширению.
+// Остальное, включая html и svg, отдается на скачивание, чтобы загруженный файл не выполнялся на нашем домене
+func FileInline(name string) bool {
+	return mimetype.EqualsAny(mime.TypeByExtension(strings.ToLower(filepath.Ext(name))),
+		"image/jpeg", "image/png", "image/webp", "image/gif", "image/bmp")
+}
+
 func FileSize(path string) (int64, error) {
 	f, err := os.Stat(path)
 	if err != nil {
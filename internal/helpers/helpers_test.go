@@ -119,3 +119,21 @@ func TestPatchPath(t *testing.T) {
 		})
 	}
 }
+
+func TestFileInline(t *testing.T) {
+	for name, want := range map[string]bool{
+		"photo.JPG":     true,
+		"a/b/scan.png":  true,
+		"anim.gif":      true,
+		"logo.svg":      false,
+		"page.html":     false,
+		"report.pdf":    false,
+		"noext":         false,
+		"evil.png.html": false,
+		"picture.webp":  true,
+	} {
+		if got := FileInline(name); got != want {
+			t.Errorf("FileInline(%s) = %v, want %v", name, got, want)
+		}
+	}
+}
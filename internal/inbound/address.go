@@ -0,0 +1,122 @@
+package inbound
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	KindProject = "project"
+	KindReply   = "reply"
+)
+
+const (
+	projectPrefix = "p-"
+	replyPrefix   = "r-"
+	signLen       = 12
+	senderSignLen = 10
+)
+
+// Address - куда адресовано письмо: в проект (новая задача) или ответом в задачу (комментарий).
+// SenderSign - подпись получателя письма, на которое отвечают, если адрес выдавался конкретному человеку
+type Address struct {
+	Kind       string
+	UUID       uuid.UUID
+	SenderSign string
+}
+
+// Addresses собирает и разбирает адреса входящей почты вида p-<uuid проекта>-<подпись>@domain
+// и r-<uuid задачи>-<подпись>[-<подпись получателя>]@domain. Подпись не дает создать задачу в чужом
+// проекте или написать комментарий в чужую задачу, зная uuid, а подпись получателя подтверждает,
+// что ответ пришел от того, кому ушло письмо. Поддерживается plus-адресация: support+p-<uuid>-<подпись>@domain.
+type Addresses struct {
+	Domain string
+	Secret string
+}
+
+func (a Addresses) ProjectAddress(projectUUID uuid.UUID) string {
+	return projectPrefix + projectUUID.String() + "-" + a.sign(projectUUID) + "@" + a.Domain
+}
+
+// ReplyAddress - адрес для ответа в задачу, recipient - кому уходит письмо с этим адресом
+func (a Addresses) ReplyAddress(taskUUID uuid.UUID, recipient string) string {
+	local := replyPrefix + taskUUID.String() + "-" + a.sign(taskUUID)
+	if recipient != "" {
+		local += "-" + a.senderSign(taskUUID, recipient)
+	}
+
+	return local + "@" + a.Domain
+}
+
+// Sender - письмо от from пришло на адрес ответа, выданный именно ему
+func (a Addresses) Sender(addr Address, from string) bool {
+	return addr.Kind == KindReply && addr.SenderSign != "" &&
+		hmac.Equal([]byte(addr.SenderSign), []byte(a.senderSign(addr.UUID, from)))
+}
+
+// Parse разбирает адрес получателя, ok = false для чужого домена, неизвестного вида или неверной подписи
+func (a Addresses) Parse(addr string) (Address, bool) {
+	if parsed, err := mail.ParseAddress(addr); err == nil {
+		addr = parsed.Address
+	}
+
+	local, domain, found := strings.Cut(strings.ToLower(strings.TrimSpace(addr)), "@")
+	if !found || a.Domain == "" || domain != strings.ToLower(a.Domain) {
+		return Address{}, false
+	}
+
+	if i := strings.LastIndex(local, "+"); i != -1 {
+		local = local[i+1:]
+	}
+
+	switch {
+	case strings.HasPrefix(local, projectPrefix):
+		rest := local[len(projectPrefix):]
+		if len(rest) != 36+1+signLen || rest[36] != '-' {
+			return Address{}, false
+		}
+
+		uid, err := uuid.Parse(rest[:36])
+		if err != nil || !hmac.Equal([]byte(rest[37:]), []byte(a.sign(uid))) {
+			return Address{}, false
+		}
+		return Address{Kind: KindProject, UUID: uid}, true
+	case strings.HasPrefix(local, replyPrefix):
+		rest := local[len(replyPrefix):]
+		if len(rest) < 36+1+signLen || rest[36] != '-' {
+			return Address{}, false
+		}
+
+		senderSign := rest[37+signLen:]
+		if senderSign != "" && (len(senderSign) != 1+senderSignLen || senderSign[0] != '-') {
+			return Address{}, false
+		}
+
+		uid, err := uuid.Parse(rest[:36])
+		if err != nil || !hmac.Equal([]byte(rest[37:37+signLen]), []byte(a.sign(uid))) {
+			return Address{}, false
+		}
+		return Address{Kind: KindReply, UUID: uid, SenderSign: strings.TrimPrefix(senderSign, "-")}, true
+	}
+
+	return Address{}, false
+}
+
+func (a Addresses) sign(uid uuid.UUID) string {
+	mac := hmac.New(sha256.New, []byte(a.Secret))
+	mac.Write([]byte("inbound:" + uid.String()))
+
+	return hex.EncodeToString(mac.Sum(nil))[:signLen]
+}
+
+func (a Addresses) senderSign(uid uuid.UUID, email string) string {
+	mac := hmac.New(sha256.New, []byte(a.Secret))
+	mac.Write([]byte("inbound-sender:" + uid.String() + ":" + strings.ToLower(strings.TrimSpace(email))))
+
+	return hex.EncodeToString(mac.Sum(nil))[:senderSignLen]
+}
@@ -0,0 +1,51 @@
+package inbound
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestAddresses(t *testing.T) {
+	a := Addresses{Domain: "in.example.com", Secret: "secret"}
+	uid := uuid.MustParse("0b7c1a52-6f1e-4a39-9a8e-2f5d3c4b1a00")
+
+	project := a.ProjectAddress(uid)
+	if got, ok := a.Parse(project); !ok || got.Kind != KindProject || got.UUID != uid {
+		t.Errorf("Parse(%s) = %+v, %v", project, got, ok)
+	}
+
+	reply := a.ReplyAddress(uid, "")
+	if got, ok := a.Parse("Поддержка <" + strings.ToUpper(reply) + ">"); !ok || got.Kind != KindReply || got.UUID != uid || a.Sender(got, "ivan@example.com") {
+		t.Errorf("Parse(%s) = %+v, %v", reply, got, ok)
+	}
+
+	personal := a.ReplyAddress(uid, "Ivan@Example.com")
+	if len(strings.Split(personal, "@")[0]) > 64 {
+		t.Errorf("local part too long: %s", personal)
+	}
+	if got, ok := a.Parse(personal); !ok || got.UUID != uid || !a.Sender(got, "ivan@example.com") || a.Sender(got, "petr@example.com") {
+		t.Errorf("Parse(%s) = %+v, %v", personal, got, ok)
+	}
+
+	if got, ok := a.Parse("support+" + project); !ok || got.UUID != uid {
+		t.Errorf("plus address = %+v, %v", got, ok)
+	}
+
+	other := Addresses{Domain: a.Domain, Secret: "other"}
+	for _, addr := range []string{
+		other.ReplyAddress(uid, ""),
+		reply[:strings.Index(reply, "@")] + "-0123@in.example.com",
+		strings.Replace(project, "in.example.com", "example.com", 1),
+		"r-" + uid.String() + "@in.example.com",
+		"p-123@in.example.com",
+		"p-" + uid.String() + "@in.example.com",
+		other.ProjectAddress(uid),
+		"info@in.example.com",
+	} {
+		if got, ok := a.Parse(addr); ok {
+			t.Errorf("Parse(%s) = %+v, want false", addr, got)
+		}
+	}
+}
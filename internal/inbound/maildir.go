@@ -0,0 +1,74 @@
+package inbound
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+// PollMaildir обрабатывает письма из new/ каталога maildir, куда их складывает локальный MTA
+// (postfix, dovecot-lda, fetchmail). Обработанное письмо переносится в cur/ с флагом S,
+// при временной ошибке письмо остается в new/ до следующего прохода.
+func PollMaildir(ctx context.Context, dir string, deliver DeliverFunc) (processed int, err error) {
+	newDir := filepath.Join(dir, "new")
+	curDir := filepath.Join(dir, "cur")
+
+	if err := os.MkdirAll(curDir, 0o750); err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(newDir, 0o750); err != nil {
+		return 0, err
+	}
+
+	entries, err := os.ReadDir(newDir)
+	if err != nil {
+		return 0, err
+	}
+
+	// имена в maildir начинаются со времени доставки, так письма обрабатываются по порядку
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	for _, e := range entries {
+		if ctx.Err() != nil {
+			return processed, ctx.Err()
+		}
+
+		if e.IsDir() {
+			continue
+		}
+
+		path := filepath.Join(newDir, e.Name())
+
+		info, err := e.Info()
+		if err != nil || info.Size() > MaxMessageSize {
+			logrus.WithField("file", path).Warn("inbound maildir: письмо пропущено, слишком большое")
+			_ = os.Rename(path, filepath.Join(curDir, e.Name()+":2,T"))
+			continue
+		}
+
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return processed, err
+		}
+
+		err = deliver(ctx, nil, nil, raw)
+		if err != nil {
+			logrus.WithField("file", path).Warn("inbound maildir: ", err)
+			continue
+		}
+
+		err = os.Rename(path, filepath.Join(curDir, e.Name()+":2,S"))
+		if err != nil {
+			return processed, err
+		}
+
+		processed++
+	}
+
+	return processed, nil
+}
@@ -0,0 +1,242 @@
+package inbound
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	ModeSMTP    = "smtp"
+	ModeMaildir = "maildir"
+)
+
+const (
+	StatusDone    = "done"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+)
+
+// ErrUnknownRecipient - среди получателей нет адреса проекта или задачи
+var ErrUnknownRecipient = errors.New("неизвестный адрес получателя")
+
+// Result - что получилось из письма: задача или комментарий и кем отправитель оказался в системе
+type Result struct {
+	TaskUUID    *uuid.UUID
+	CommentUUID *uuid.UUID
+	UserEmail   string
+	AgentUUID   *uuid.UUID
+}
+
+// Handler обрабатывает письмо для проекта или задачи addr.UUID
+type Handler func(ctx context.Context, m Message, addr Address) (Result, error)
+
+type Service struct {
+	repo *Repository
+
+	Addresses Addresses
+
+	// authservID - Authentication-Results с этим authserv-id ставит доверенный MTA
+	authservID string
+	// trustedRelays - адреса MTA, которым в режиме smtp доверяют Authentication-Results
+	trustedRelays []net.IP
+
+	mode     string
+	smtpAddr string
+	maildir  string
+	interval time.Duration
+
+	onNewTask Handler
+	onReply   Handler
+}
+
+func New(repo *Repository, conf *configs.Configs) (*Service, error) {
+	if conf.INBOUND_EMAIL_DOMAIN != "" && conf.INBOUND_EMAIL_SECRET == "" {
+		return nil, errors.New("inbound: не задан INBOUND_EMAIL_SECRET")
+	}
+
+	relays := make([]net.IP, 0, len(conf.INBOUND_TRUSTED_RELAYS))
+	for _, relay := range conf.INBOUND_TRUSTED_RELAYS {
+		ip := net.ParseIP(strings.TrimSpace(relay))
+		if ip == nil {
+			return nil, fmt.Errorf("inbound: неверный адрес в INBOUND_TRUSTED_RELAYS: %s", relay)
+		}
+		relays = append(relays, ip)
+	}
+
+	return &Service{
+		repo: repo,
+
+		Addresses: Addresses{
+			Domain: conf.INBOUND_EMAIL_DOMAIN,
+			Secret: conf.INBOUND_EMAIL_SECRET,
+		},
+
+		authservID:    conf.INBOUND_AUTHSERV_ID,
+		trustedRelays: relays,
+
+		mode:     conf.INBOUND_EMAIL_MODE,
+		smtpAddr: conf.INBOUND_SMTP_ADDR,
+		maildir:  conf.INBOUND_MAILDIR,
+		interval: time.Second * time.Duration(conf.INBOUND_POLL_INTERVAL),
+	}, nil
+}
+
+// OnNewTask задает создание задачи из письма на адрес проекта
+func (s *Service) OnNewTask(fn Handler) {
+	s.onNewTask = fn
+}
+
+// OnReply задает создание комментария из ответа на уведомление по задаче
+func (s *Service) OnReply(fn Handler) {
+	s.onReply = fn
+}
+
+// Enabled - прием почты включен, задан домен адресов
+func (s *Service) Enabled() bool {
+	return s.Addresses.Domain != ""
+}
+
+// Accept - адрес принадлежит проекту или задаче, письма на остальные адреса smtp-сервер не принимает
+func (s *Service) Accept(rcpt string) bool {
+	_, ok := s.Addresses.Parse(rcpt)
+	return ok
+}
+
+// Receive разбирает письмо и передает его обработчику по первому подходящему адресу.
+// Адреса берутся из конверта (rcpts), для maildir - из заголовков To, Cc, Delivered-To.
+// Authentication-Results учитываются только для maildir (remote = nil) и писем от доверенных MTA.
+// Ошибка возвращается только временная (например, недоступна база), чтобы письмо доставили повторно;
+// письма с ошибкой обработки записываются в журнал со статусом failed.
+func (s *Service) Receive(ctx context.Context, remote net.Addr, rcpts []string, raw []byte) error {
+	m, err := ParseMessage(raw)
+	if err != nil {
+		logrus.Warn("inbound email: письмо не разобрано: ", err)
+		return nil
+	}
+
+	if remote != nil && !s.trustedRelay(remote) {
+		m.AuthResults = StripAuthResults(m.AuthResults, s.authservID)
+	}
+
+	if m.MessageID == "" {
+		sum := sha256.Sum256(raw)
+		m.MessageID = hex.EncodeToString(sum[:16])
+	}
+
+	if len(rcpts) == 0 {
+		rcpts = m.To
+	}
+
+	var to string
+	var addr Address
+
+	for _, rcpt := range rcpts {
+		if a, ok := s.Addresses.Parse(rcpt); ok {
+			to, addr = rcpt, a
+			break
+		}
+	}
+
+	if to == "" {
+		return s.repo.Log(m, strings.Join(rcpts, ", "), addr, Result{}, StatusSkipped, ErrUnknownRecipient.Error())
+	}
+
+	m.SenderVerified = s.Addresses.Sender(addr, m.From) || AuthenticatedSender(m.AuthResults, s.authservID, m.From)
+
+	done, err := s.repo.Processed(m.MessageID)
+	if err != nil {
+		return err
+	}
+
+	if done {
+		logrus.WithField("message_id", m.MessageID).Debug("inbound email: письмо уже обработано")
+		return nil
+	}
+
+	handler := s.onNewTask
+	if addr.Kind == KindReply {
+		handler = s.onReply
+	}
+
+	if handler == nil {
+		return errors.New("inbound: не задан обработчик писем")
+	}
+
+	res, err := handler(ctx, m, addr)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"from": m.From,
+			"to":   to,
+		}).Warn("inbound email: ", err)
+
+		return s.repo.Log(m, to, addr, res, StatusFailed, err.Error())
+	}
+
+	return s.repo.Log(m, to, addr, res, StatusDone, "")
+}
+
+// trustedRelay - smtp-клиент входит в INBOUND_TRUSTED_RELAYS
+func (s *Service) trustedRelay(remote net.Addr) bool {
+	addr, ok := remote.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+
+	return lo.ContainsBy(s.trustedRelays, func(ip net.IP) bool {
+		return ip.Equal(addr.IP)
+	})
+}
+
+// Serve принимает почту в выбранном режиме до отмены ctx
+func (s *Service) Serve(ctx context.Context) error {
+	switch s.mode {
+	case ModeMaildir:
+		if s.interval <= 0 {
+			return errors.New("inbound: не задан интервал опроса maildir")
+		}
+
+		for {
+			n, err := PollMaildir(ctx, s.maildir, s.Receive)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				logrus.Error("inbound maildir error: ", err)
+			}
+
+			if n > 0 {
+				logrus.Infof("inbound maildir: %d processed", n)
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(s.interval):
+			}
+		}
+	case ModeSMTP, "":
+		hostname, _ := os.Hostname()
+
+		srv := &SMTPServer{
+			Addr:     s.smtpAddr,
+			Hostname: hostname,
+			Accept:   s.Accept,
+			Deliver:  s.Receive,
+		}
+
+		logrus.Info("inbound smtp listening on ", s.smtpAddr)
+
+		return srv.ListenAndServe(ctx)
+	}
+
+	return fmt.Errorf("inbound: неизвестный режим %s", s.mode)
+}
@@ -0,0 +1,341 @@
+package inbound
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"golang.org/x/net/html/charset"
+)
+
+// MaxParts - ограничение на число частей письма, защита от писем-бомб
+const MaxParts = 100
+
+type Attachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// Message - разобранное входящее письмо: текст (text/plain или html без разметки) и вложения
+type Message struct {
+	MessageID string
+	InReplyTo string
+	Date      time.Time
+
+	From     string
+	FromName string
+	To       []string
+
+	Subject     string
+	Text        string
+	Attachments []Attachment
+
+	// Auto - автоответ или рассылка, на такие письма не отвечаем, чтобы не зациклиться
+	Auto bool
+
+	// AuthResults - заголовки Authentication-Results с проверками DKIM/SPF/DMARC от MTA
+	AuthResults []string
+	// SenderVerified - From подтвержден подписанным адресом ответа или проверкой доверенного MTA,
+	// без этого From ничего не доказывает и отправитель считается внешним
+	SenderVerified bool
+}
+
+var wordDecoder = &mime.WordDecoder{
+	CharsetReader: charset.NewReaderLabel,
+}
+
+// ParseMessage разбирает письмо в формате RFC 5322 с вложенными multipart, base64/quoted-printable
+// и кодировками, отличными от utf-8 (windows-1251, koi8-r и т.п.)
+func ParseMessage(raw []byte) (Message, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return Message{}, err
+	}
+
+	h := msg.Header
+	ap := &mail.AddressParser{WordDecoder: wordDecoder}
+
+	from, err := ap.Parse(h.Get("From"))
+	if err != nil {
+		return Message{}, fmt.Errorf("from: %w", err)
+	}
+
+	m := Message{
+		MessageID: strings.Trim(h.Get("Message-Id"), "<> "),
+		InReplyTo: strings.Trim(h.Get("In-Reply-To"), "<> "),
+		From:      strings.ToLower(from.Address),
+		FromName:  from.Name,
+		Subject:   decodeHeader(h.Get("Subject")),
+	}
+
+	m.AuthResults = h["Authentication-Results"]
+
+	auto := strings.ToLower(strings.TrimSpace(h.Get("Auto-Submitted")))
+	precedence := strings.ToLower(strings.TrimSpace(h.Get("Precedence")))
+	m.Auto = (auto != "" && auto != "no") || precedence == "bulk" || precedence == "junk" || precedence == "list"
+
+	if date, err := h.Date(); err == nil {
+		m.Date = date
+	}
+
+	for _, key := range []string{"To", "Cc", "Delivered-To", "X-Original-To"} {
+		if list, err := ap.ParseList(h.Get(key)); err == nil {
+			for _, a := range list {
+				m.To = append(m.To, strings.ToLower(a.Address))
+			}
+		}
+	}
+
+	p := &parser{}
+	err = p.walk(h, msg.Body)
+	if err != nil {
+		return m, err
+	}
+
+	m.Text = p.text
+	if m.Text == "" && p.html != "" {
+		m.Text = HTMLToText(p.html)
+	}
+	m.Text = strings.TrimSpace(strings.ReplaceAll(m.Text, "\r\n", "\n"))
+	m.Attachments = p.attachments
+
+	return m, nil
+}
+
+var authCommentRe = regexp.MustCompile(`\([^)]*\)`)
+
+// AuthenticatedSender - среди results есть заголовок доверенного MTA authservID, где пройдена проверка
+// dkim, spf или dmarc для домена адреса from. Пустой authservID не доверяет никому.
+func AuthenticatedSender(results []string, authservID, from string) bool {
+	_, domain, found := strings.Cut(strings.ToLower(from), "@")
+	if authservID == "" || !found || domain == "" {
+		return false
+	}
+
+	for _, res := range results {
+		parts := strings.Split(authCommentRe.ReplaceAllString(strings.ToLower(res), ""), ";")
+
+		if authserv(parts[0]) != strings.ToLower(authservID) {
+			continue
+		}
+
+		for _, part := range parts[1:] {
+			fields := strings.Fields(part)
+			if len(fields) == 0 {
+				continue
+			}
+
+			var key string
+			switch fields[0] {
+			case "dkim=pass":
+				key = "header.d"
+			case "spf=pass":
+				key = "smtp.mailfrom"
+			case "dmarc=pass":
+				key = "header.from"
+			default:
+				continue
+			}
+
+			for _, prop := range fields[1:] {
+				k, v, _ := strings.Cut(prop, "=")
+				if k != key {
+					continue
+				}
+
+				if i := strings.LastIndex(v, "@"); i != -1 {
+					v = v[i+1:]
+				}
+
+				if v != "" && (domain == v || strings.HasSuffix(domain, "."+v)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// StripAuthResults убирает из results заголовки с authserv-id authservID: письмо пришло не от доверенного
+// MTA, и такие заголовки подставил отправитель
+func StripAuthResults(results []string, authservID string) []string {
+	if authservID == "" {
+		return results
+	}
+
+	return lo.Reject(results, func(res string, _ int) bool {
+		id, _, _ := strings.Cut(authCommentRe.ReplaceAllString(strings.ToLower(res), ""), ";")
+		return authserv(id) == strings.ToLower(authservID)
+	})
+}
+
+func authserv(id string) string {
+	fields := strings.Fields(id)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return fields[0]
+}
+
+type header interface {
+	Get(key string) string
+}
+
+type parser struct {
+	text        string
+	html        string
+	attachments []Attachment
+	parts       int
+}
+
+func (p *parser) walk(h header, body io.Reader) error {
+	p.parts++
+	if p.parts > MaxParts {
+		return fmt.Errorf("в письме больше %d частей", MaxParts)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			err = p.walk(part.Header, part)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := io.ReadAll(decodeTransfer(h.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return err
+	}
+
+	disposition, dparams, _ := mime.ParseMediaType(h.Get("Content-Disposition"))
+
+	name := dparams["filename"]
+	if name == "" {
+		name = params["name"]
+	}
+	name = decodeHeader(name)
+
+	if disposition == "attachment" || name != "" || mediaType == "message/rfc822" {
+		if name == "" {
+			name = "message.eml"
+		}
+
+		p.attachments = append(p.attachments, Attachment{Name: name, ContentType: mediaType, Data: data})
+		return nil
+	}
+
+	switch mediaType {
+	case "text/plain":
+		if p.text == "" {
+			p.text = decodeCharset(params["charset"], data)
+		}
+	case "text/html":
+		if p.html == "" {
+			p.html = decodeCharset(params["charset"], data)
+		}
+	}
+
+	return nil
+}
+
+func decodeTransfer(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, r)
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	}
+
+	return r
+}
+
+func decodeCharset(label string, data []byte) string {
+	if label == "" || strings.EqualFold(label, "utf-8") || strings.EqualFold(label, "us-ascii") {
+		return string(data)
+	}
+
+	r, err := charset.NewReaderLabel(label, bytes.NewReader(data))
+	if err != nil {
+		return string(data)
+	}
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return string(data)
+	}
+
+	return string(b)
+}
+
+func decodeHeader(s string) string {
+	d, err := wordDecoder.DecodeHeader(s)
+	if err != nil {
+		return s
+	}
+
+	return d
+}
+
+var (
+	reBlock  = regexp.MustCompile(`(?i)<(br\s*/?|/p|/div|/tr|/li|/h[1-6])\s*>`)
+	reHidden = regexp.MustCompile(`(?is)<(style|script|head)[^>]*>.*?</(style|script|head)>`)
+	reTag    = regexp.MustCompile(`(?s)<[^>]*>`)
+	reBlank  = regexp.MustCompile(`\n[ \t]*(\n[ \t]*)+`)
+)
+
+// HTMLToText - текст письма без разметки, если в письме нет text/plain
+func HTMLToText(s string) string {
+	s = reHidden.ReplaceAllString(s, "")
+	s = reBlock.ReplaceAllString(s, "\n")
+	s = reTag.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = strings.ReplaceAll(s, "\u00a0", " ")
+	s = reBlank.ReplaceAllString(s, "\n\n")
+
+	return strings.TrimSpace(s)
+}
+
+var reQuoteHeader = regexp.MustCompile(`(?i)^(on .+ wrote:|.+ (пишет|написал|написала|написал\(а\)):|-{2,}\s*(original message|исходное сообщение|forwarded message|пересылаемое сообщение)\s*-{2,}|.*<[^<>@\s]+@[^<>\s]+>:)\s*$`)
+
+// StripQuoted отрезает от ответа цитату исходного письма и подпись после "-- "
+func StripQuoted(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if line == "-- " || line == "--" || strings.HasPrefix(trimmed, ">") || reQuoteHeader.MatchString(trimmed) {
+			lines = lines[:i]
+			break
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
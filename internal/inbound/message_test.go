@@ -0,0 +1,122 @@
+package inbound
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseMessage(t *testing.T) {
+	raw := strings.Join([]string{
+		"From: =?windows-1251?B?yOLg7SDP5fLw7uI=?= <Ivan@Example.com>",
+		"To: p-0b7c1a52-6f1e-4a39-9a8e-2f5d3c4b1a00@in.example.com",
+		"Subject: =?utf-8?B?0J3QtSDRgNCw0LHQvtGC0LDQtdGCINC60LDRgdGB0LA=?=",
+		"Message-ID: <abc@example.com>",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="b1"`,
+		"",
+		"--b1",
+		`Content-Type: multipart/alternative; boundary="b2"`,
+		"",
+		"--b2",
+		"Content-Type: text/plain; charset=windows-1251",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"=CF=F0=E8=E2=E5=F2",
+		"--b2",
+		"Content-Type: text/html; charset=utf-8",
+		"",
+		"<p>html</p>",
+		"--b2--",
+		"--b1",
+		`Content-Type: application/pdf; name="check.pdf"`,
+		"Content-Disposition: attachment",
+		"Content-Transfer-Encoding: base64",
+		"",
+		"JVBERi0=",
+		"--b1--",
+		"",
+	}, "\r\n")
+
+	m, err := ParseMessage([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.From != "ivan@example.com" || m.FromName != "Иван Петров" {
+		t.Errorf("from = %q %q", m.From, m.FromName)
+	}
+	if m.Subject != "Не работает касса" {
+		t.Errorf("subject = %q", m.Subject)
+	}
+	if m.MessageID != "abc@example.com" {
+		t.Errorf("message id = %q", m.MessageID)
+	}
+	if m.Text != "Привет" {
+		t.Errorf("text = %q", m.Text)
+	}
+	if len(m.Attachments) != 1 || m.Attachments[0].Name != "check.pdf" || string(m.Attachments[0].Data) != "%PDF-" {
+		t.Errorf("attachments = %+v", m.Attachments)
+	}
+	if m.Auto {
+		t.Error("message is not auto")
+	}
+}
+
+func TestHTMLToText(t *testing.T) {
+	got := HTMLToText("<style>p{}</style><p>Добрый&nbsp;день</p><p>Касса &laquo;1&raquo;</p>")
+	if got != "Добрый день\nКасса «1»" {
+		t.Errorf("HTMLToText = %q", got)
+	}
+}
+
+func TestStripQuoted(t *testing.T) {
+	cases := map[string]string{
+		"Спасибо, работает\n\n> исходное письмо":                     "Спасибо, работает",
+		"Ок\n\n21.06.2024, 10:00, Поддержка пишет:\n> текст":         "Ок",
+		"Готово\n\nOn Fri, Jun 21, 2024 at 10:00 CRM wrote:\n> text": "Готово",
+		"Принято\n-- \nИван":                                         "Принято",
+		"Без цитаты":                                                 "Без цитаты",
+	}
+
+	for in, want := range cases {
+		if got := StripQuoted(in); got != want {
+			t.Errorf("StripQuoted(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestAuthenticatedSender(t *testing.T) {
+	results := []string{
+		"evil.example; dkim=pass header.d=corp.ru",
+		"mx.in.example.com 1; dkim=fail header.d=corp.ru; spf=pass (sender ok) smtp.mailfrom=bounce@mail.corp.ru",
+	}
+
+	if !AuthenticatedSender(results, "mx.in.example.com", "ivan@mail.corp.ru") {
+		t.Error("spf pass for from domain: want true")
+	}
+
+	if AuthenticatedSender(results, "mx.in.example.com", "ivan@corp.ru") {
+		t.Error("spf for other domain, dkim failed: want false")
+	}
+
+	if AuthenticatedSender(results, "", "ivan@mail.corp.ru") {
+		t.Error("no trusted authserv-id: want false")
+	}
+}
+
+func TestStripAuthResults(t *testing.T) {
+	results := []string{
+		"MX.in.example.com; dkim=pass header.d=corp.ru",
+		"evil.example; dkim=pass header.d=corp.ru",
+		"mx.in.example.com (forged) 1; spf=pass smtp.mailfrom=corp.ru",
+	}
+
+	got := StripAuthResults(results, "mx.in.example.com")
+	if len(got) != 1 || got[0] != results[1] {
+		t.Errorf("StripAuthResults = %q", got)
+	}
+
+	if AuthenticatedSender(got, "mx.in.example.com", "ivan@corp.ru") {
+		t.Error("stripped results: want false")
+	}
+}
@@ -0,0 +1,35 @@
+//nolint
+package inbound
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// inboundEmail - журнал входящих писем, по message_id повторная доставка того же письма пропускается
+type inboundEmail struct {
+	UUID uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;unique:true"`
+
+	MessageID string `gorm:"type:varchar(250);default:'';not null;"`
+	From      string `gorm:"type:varchar(100);default:'';not null;"`
+	To        string `gorm:"type:varchar(250);default:'';not null;"`
+	Subject   string `gorm:"type:varchar(250);default:'';not null;"`
+
+	Kind        string     `gorm:"type:varchar(20);default:'';not null;"`
+	ProjectUUID *uuid.UUID `gorm:"type:uuid;"`
+	TaskUUID    *uuid.UUID `gorm:"type:uuid;"`
+	CommentUUID *uuid.UUID `gorm:"type:uuid;"`
+	UserEmail   string     `gorm:"type:varchar(100);default:'';not null;"`
+	AgentUUID   *uuid.UUID `gorm:"type:uuid;"`
+	Attachments int        `gorm:"type:int;default:0;not null;"`
+
+	Status string `gorm:"type:varchar(20);default:'';not null;"`
+	Error  string `gorm:"type:text;default:'';not null;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (inboundEmail) TableName() string {
+	return "inbound_emails"
+}
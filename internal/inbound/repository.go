@@ -0,0 +1,52 @@
+package inbound
+
+import (
+	"github.com/krisch/crm-backend/pkg/postgres"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+// Processed - письмо с таким message_id уже создало задачу или комментарий
+func (r *Repository) Processed(messageID string) (bool, error) {
+	var count int64
+
+	err := r.gorm.DB.Model(&inboundEmail{}).
+		Where("message_id = ?", messageID).
+		Where("status = ?", StatusDone).
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *Repository) Log(m Message, to string, addr Address, res Result, status, errText string) error {
+	orm := inboundEmail{
+		MessageID: m.MessageID,
+		From:      m.From,
+		To:        to,
+		Subject:   m.Subject,
+
+		Kind:        addr.Kind,
+		TaskUUID:    res.TaskUUID,
+		CommentUUID: res.CommentUUID,
+		UserEmail:   res.UserEmail,
+		AgentUUID:   res.AgentUUID,
+		Attachments: len(m.Attachments),
+
+		Status: status,
+		Error:  errText,
+	}
+
+	if addr.Kind == KindProject {
+		orm.ProjectUUID = &addr.UUID
+	}
+
+	return r.gorm.DB.Create(&orm).Error
+}
@@ -0,0 +1,274 @@
+package inbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// MaxMessageSize - письма больше отклоняются сервером
+	MaxMessageSize = 25 << 20
+	// MaxRecipients - ограничение RCPT TO на одно письмо
+	MaxRecipients = 50
+
+	smtpTimeout = 5 * time.Minute
+)
+
+// DeliverFunc принимает письмо целиком вместе с адресами конверта (RCPT TO).
+// remote - адрес smtp-клиента, для писем из maildir nil.
+// Письмо из smtp приходит с переводами строк \n вместо \r\n, как после textproto.DotReader
+type DeliverFunc func(ctx context.Context, remote net.Addr, rcpts []string, raw []byte) error
+
+// AcceptFunc решает, принимать ли письмо для адреса, чтобы не собирать спам на чужие ящики
+type AcceptFunc func(rcpt string) bool
+
+// SMTPServer - минимальный smtp-сервер для приема почты от локального MTA (postfix, exim)
+// или напрямую. Поддерживает HELO/EHLO, MAIL, RCPT, DATA, RSET, NOOP и QUIT, без TLS и авторизации.
+type SMTPServer struct {
+	Addr     string
+	Hostname string
+
+	Accept  AcceptFunc
+	Deliver DeliverFunc
+}
+
+func (s *SMTPServer) ListenAndServe(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				continue
+			}
+
+			return err
+		}
+
+		go s.serve(ctx, conn)
+	}
+}
+
+func (s *SMTPServer) serve(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	defer func() {
+		if r := recover(); r != nil {
+			logrus.Error("inbound smtp panic: ", r)
+		}
+	}()
+
+	tp := textproto.NewConn(conn)
+
+	hostname := s.Hostname
+	if hostname == "" {
+		hostname = "localhost"
+	}
+
+	reply := func(code int, msg string) bool {
+		_ = conn.SetWriteDeadline(time.Now().Add(smtpTimeout))
+		return tp.PrintfLine("%d %s", code, msg) == nil
+	}
+
+	if !reply(220, hostname+" ESMTP crm") {
+		return
+	}
+
+	var from string
+	var rcpts []string
+
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(smtpTimeout))
+
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		cmd, arg, _ := strings.Cut(line, " ")
+		cmd = strings.ToUpper(cmd)
+
+		switch cmd {
+		case "HELO":
+			reply(250, hostname)
+		case "EHLO":
+			_ = tp.PrintfLine("250-%s", hostname)
+			_ = tp.PrintfLine("250-SIZE %d", MaxMessageSize)
+			_ = tp.PrintfLine("250-8BITMIME")
+			reply(250, "SMTPUTF8")
+		case "MAIL":
+			addr, ok := pathArg(arg, "FROM:")
+			if !ok {
+				reply(501, "syntax: MAIL FROM:<address>")
+				continue
+			}
+			from, rcpts = addr, nil
+			reply(250, "ok")
+		case "RCPT":
+			addr, ok := pathArg(arg, "TO:")
+			switch {
+			case !ok || addr == "":
+				reply(501, "syntax: RCPT TO:<address>")
+			case len(rcpts) >= MaxRecipients:
+				reply(452, "too many recipients")
+			case s.Accept != nil && !s.Accept(addr):
+				reply(550, "no such user")
+			default:
+				rcpts = append(rcpts, addr)
+				reply(250, "ok")
+			}
+		case "DATA":
+			if len(rcpts) == 0 {
+				reply(503, "need RCPT first")
+				continue
+			}
+
+			reply(354, "end data with <CR><LF>.<CR><LF>")
+
+			_ = conn.SetReadDeadline(time.Now().Add(smtpTimeout))
+			raw, err := io.ReadAll(io.LimitReader(tp.DotReader(), MaxMessageSize+1))
+			if err != nil {
+				return
+			}
+
+			if len(raw) > MaxMessageSize {
+				// остаток письма уже не прочитать по протоколу, соединение закрывается
+				reply(552, "message too large")
+				return
+			}
+
+			err = s.Deliver(ctx, conn.RemoteAddr(), rcpts, raw)
+			if err != nil {
+				logrus.WithField("from", from).Warn("inbound email: ", err)
+				reply(451, "temporary failure, try again later")
+			} else {
+				reply(250, "ok: queued")
+			}
+
+			from, rcpts = "", nil
+		case "RSET":
+			from, rcpts = "", nil
+			reply(250, "ok")
+		case "NOOP":
+			reply(250, "ok")
+		case "VRFY":
+			reply(252, "cannot verify")
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			reply(502, "command not implemented")
+		}
+	}
+}
+
+// pathArg достает адрес из "FROM:<a@b> SIZE=100"
+func pathArg(arg, prefix string) (string, bool) {
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", false
+	}
+
+	arg = strings.TrimSpace(arg[len(prefix):])
+	if path, _, found := strings.Cut(arg, " "); found {
+		arg = path
+	}
+
+	arg = strings.Trim(arg, "<>")
+	if arg == "" {
+		// пустой обратный путь для уведомлений о недоставке
+		return "", true
+	}
+
+	if _, err := mail.ParseAddress(arg); err != nil {
+		return "", false
+	}
+
+	return arg, true
+}
+
+// Send доставляет письмо на адрес сервера, нужен для проверки и локальной разработки
+func Send(addr, from string, to []string, raw []byte) error {
+	conn, err := net.DialTimeout("tcp", addr, 10*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+
+	expect := func(code int) error {
+		_, _, err := tp.ReadResponse(code)
+		return err
+	}
+
+	if err := expect(220); err != nil {
+		return err
+	}
+
+	steps := []struct {
+		cmd  string
+		code int
+	}{
+		{"HELO localhost", 250},
+		{fmt.Sprintf("MAIL FROM:<%s>", from), 250},
+	}
+	for _, rcpt := range to {
+		steps = append(steps, struct {
+			cmd  string
+			code int
+		}{fmt.Sprintf("RCPT TO:<%s>", rcpt), 250})
+	}
+
+	for _, st := range steps {
+		if err := tp.PrintfLine("%s", st.cmd); err != nil {
+			return err
+		}
+		if err := expect(st.code); err != nil {
+			return err
+		}
+	}
+
+	if err := tp.PrintfLine("DATA"); err != nil {
+		return err
+	}
+	if err := expect(354); err != nil {
+		return err
+	}
+
+	w := tp.DotWriter()
+	if _, err := w.Write(raw); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := expect(250); err != nil {
+		return err
+	}
+
+	_ = tp.PrintfLine("QUIT")
+
+	return nil
+}
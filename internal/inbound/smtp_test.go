@@ -0,0 +1,59 @@
+package inbound
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSMTPServer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	got := make(chan []string, 1)
+
+	srv := &SMTPServer{
+		Addr: addr,
+		Accept: func(rcpt string) bool {
+			return strings.HasSuffix(rcpt, "@in.example.com")
+		},
+		Deliver: func(_ context.Context, _ net.Addr, rcpts []string, raw []byte) error {
+			got <- append(rcpts, string(raw))
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go srv.ListenAndServe(ctx)
+
+	raw := []byte("Subject: test\r\n\r\n.hello\r\n")
+
+	var sendErr error
+	for i := 0; i < 50; i++ {
+		sendErr = Send(addr, "a@example.com", []string{"p-1@in.example.com"}, raw)
+		if sendErr == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if sendErr != nil {
+		t.Fatal(sendErr)
+	}
+
+	res := <-got
+	if res[0] != "p-1@in.example.com" || res[1] != "Subject: test\n\n.hello\n" {
+		t.Errorf("delivered = %q", res)
+	}
+
+	if err := Send(addr, "a@example.com", []string{"info@example.com"}, raw); err == nil {
+		t.Error("foreign recipient must be rejected")
+	}
+}
@@ -0,0 +1,367 @@
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Resolver разрешает ссылки на задачи (#123) и упоминания пользователей (@email)
+type Resolver interface {
+	TaskRef(id int) (uid string, ok bool)
+	Mention(email string) (uid, name string, ok bool)
+}
+
+type Options struct {
+	// Hosts - разрешённые хосты для внешних ссылок, при пустом списке остаются только относительные ссылки
+	Hosts    []string
+	Resolver Resolver
+}
+
+var schemes = []string{"http", "https", "mailto"}
+
+// Render превращает ограниченный markdown в безопасный html.
+// Поддерживаются абзацы, цитаты, списки, блоки кода, **жирный**, *курсив*,
+// ~~зачёркнутый~~, `код`, ссылки, #задачи и @упоминания. Любой другой html экранируется.
+func Render(src string, opts Options) string {
+	r := renderer{opts: opts}
+
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+
+	var b strings.Builder
+	for i := 0; i < len(lines); {
+		line := strings.TrimSpace(lines[i])
+
+		switch {
+		case line == "":
+			i++
+
+		case isFence(line):
+			j := i + 1
+			for j < len(lines) && !isFence(strings.TrimSpace(lines[j])) {
+				j++
+			}
+
+			b.WriteString("<pre><code>")
+			b.WriteString(html.EscapeString(strings.Join(lines[i+1:min(j, len(lines))], "\n")))
+			b.WriteString("</code></pre>")
+
+			i = j + 1
+
+		case isQuote(line):
+			quote := []string{}
+			for ; i < len(lines) && isQuote(strings.TrimSpace(lines[i])); i++ {
+				text := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quote = append(quote, r.inline(strings.TrimSpace(text)))
+			}
+
+			b.WriteString("<blockquote>" + strings.Join(quote, "<br>") + "</blockquote>")
+
+		case isListItem(line):
+			ordered, _, _ := listItem(line)
+
+			tag := "ul"
+			if ordered {
+				tag = "ol"
+			}
+
+			b.WriteString("<" + tag + ">")
+			for ; i < len(lines); i++ {
+				o, text, ok := listItem(strings.TrimSpace(lines[i]))
+				if !ok || o != ordered {
+					break
+				}
+				b.WriteString("<li>" + r.inline(text) + "</li>")
+			}
+			b.WriteString("</" + tag + ">")
+
+		default:
+			paragraph := []string{}
+			for ; i < len(lines); i++ {
+				l := strings.TrimSpace(lines[i])
+				if l == "" || isFence(l) || isQuote(l) || isListItem(l) {
+					break
+				}
+				paragraph = append(paragraph, r.inline(l))
+			}
+
+			b.WriteString("<p>" + strings.Join(paragraph, "<br>") + "</p>")
+		}
+	}
+
+	return b.String()
+}
+
+func isFence(line string) bool {
+	return strings.HasPrefix(line, "```")
+}
+
+func isQuote(line string) bool {
+	return strings.HasPrefix(line, ">")
+}
+
+func isListItem(line string) bool {
+	_, _, ok := listItem(line)
+	return ok
+}
+
+func listItem(line string) (ordered bool, text string, ok bool) {
+	for _, p := range []string{"- ", "* ", "+ "} {
+		if strings.HasPrefix(line, p) {
+			return false, strings.TrimSpace(line[len(p):]), true
+		}
+	}
+
+	n := 0
+	for n < len(line) && line[n] >= '0' && line[n] <= '9' {
+		n++
+	}
+
+	if n > 0 && strings.HasPrefix(line[n:], ". ") {
+		return true, strings.TrimSpace(line[n+2:]), true
+	}
+
+	return false, "", false
+}
+
+type renderer struct {
+	opts Options
+}
+
+func (r *renderer) inline(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); {
+		if out, n := r.token(s, i); n > 0 {
+			b.WriteString(out)
+			i += n
+			continue
+		}
+
+		b.WriteString(html.EscapeString(s[i : i+1]))
+		i++
+	}
+
+	return b.String()
+}
+
+// token пытается разобрать элемент разметки с позиции i, n - количество прочитанных байт
+func (r *renderer) token(s string, i int) (out string, n int) {
+	rest := s[i:]
+
+	switch rest[0] {
+	case '`':
+		if j := strings.IndexByte(rest[1:], '`'); j > 0 {
+			return "<code>" + html.EscapeString(rest[1:j+1]) + "</code>", j + 2
+		}
+
+	case '*':
+		if strings.HasPrefix(rest, "**") {
+			return r.wrap(rest, "**", "strong")
+		}
+		return r.wrap(rest, "*", "em")
+
+	case '_':
+		if boundary(s, i) {
+			return r.wrap(rest, "_", "em")
+		}
+
+	case '~':
+		if strings.HasPrefix(rest, "~~") {
+			return r.wrap(rest, "~~", "del")
+		}
+
+	case '[':
+		return r.link(rest)
+
+	case 'h':
+		if boundary(s, i) && (strings.HasPrefix(rest, "http://") || strings.HasPrefix(rest, "https://")) {
+			return r.autolink(rest)
+		}
+
+	case '#':
+		if boundary(s, i) {
+			return r.taskRef(rest)
+		}
+
+	case '@':
+		if boundary(s, i) {
+			return r.mention(rest)
+		}
+	}
+
+	return "", 0
+}
+
+func (r *renderer) wrap(rest, delim, tag string) (string, int) {
+	j := strings.Index(rest[len(delim):], delim)
+	if j <= 0 {
+		return "", 0
+	}
+
+	inner := rest[len(delim) : len(delim)+j]
+	if strings.TrimSpace(inner) != inner {
+		return "", 0
+	}
+
+	return "<" + tag + ">" + r.inline(inner) + "</" + tag + ">", j + 2*len(delim)
+}
+
+func (r *renderer) link(rest string) (string, int) {
+	k := strings.Index(rest, "](")
+	if k < 0 {
+		return "", 0
+	}
+
+	m := closingParen(rest[k+2:])
+	if m < 0 {
+		return "", 0
+	}
+
+	text, href := rest[1:k], strings.TrimSpace(rest[k+2:k+2+m])
+	n := k + 3 + m
+
+	if !r.allowed(href) {
+		return r.inline(text), n
+	}
+
+	return anchor(href, r.inline(text)), n
+}
+
+// closingParen - индекс скобки, закрывающей ссылку, вложенные пары скобок в адресе пропускаются
+func closingParen(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+
+	return -1
+}
+
+func (r *renderer) autolink(rest string) (string, int) {
+	n := strings.IndexAny(rest, " \t<>\"")
+	if n < 0 {
+		n = len(rest)
+	}
+
+	n = len(strings.TrimRight(rest[:n], ".,;:!?)"))
+	href := rest[:n]
+
+	if !r.allowed(href) {
+		return html.EscapeString(href), n
+	}
+
+	return anchor(href, html.EscapeString(href)), n
+}
+
+func (r *renderer) taskRef(rest string) (string, int) {
+	n := 1
+	for n < len(rest) && rest[n] >= '0' && rest[n] <= '9' {
+		n++
+	}
+
+	if n == 1 || (n < len(rest) && isWord(rest[n])) {
+		return "", 0
+	}
+
+	id, err := strconv.Atoi(rest[1:n])
+	if err != nil || r.opts.Resolver == nil {
+		return "", 0
+	}
+
+	uid, ok := r.opts.Resolver.TaskRef(id)
+	if !ok {
+		return "", 0
+	}
+
+	return `<a class="task-ref" data-uuid="` + html.EscapeString(uid) + `" href="/task/` + html.EscapeString(uid) + `">` + rest[:n] + `</a>`, n
+}
+
+func (r *renderer) mention(rest string) (string, int) {
+	n := 1
+	for n < len(rest) && isEmailByte(rest[n]) {
+		n++
+	}
+
+	email := strings.TrimRight(rest[1:n], ".-")
+	if !strings.Contains(email, "@") || r.opts.Resolver == nil {
+		return "", 0
+	}
+
+	uid, name, ok := r.opts.Resolver.Mention(email)
+	if !ok {
+		return "", 0
+	}
+
+	return `<span class="mention" data-uuid="` + html.EscapeString(uid) + `">@` + html.EscapeString(name) + `</span>`, len(email) + 1
+}
+
+func (r *renderer) allowed(href string) bool {
+	u, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+
+	// относительная ссылка внутри приложения
+	if scheme == "" && u.Host == "" && u.Opaque == "" && !strings.HasPrefix(href, "//") {
+		return true
+	}
+
+	found := false
+	for _, s := range schemes {
+		if s == scheme {
+			found = true
+		}
+	}
+
+	if !found {
+		return false
+	}
+
+	if scheme == "mailto" {
+		return u.Opaque != ""
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return false
+	}
+
+	for _, h := range r.opts.Hosts {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if h != "" && (host == h || strings.HasSuffix(host, "."+h)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func anchor(href, text string) string {
+	return `<a href="` + html.EscapeString(href) + `" rel="nofollow noopener noreferrer" target="_blank">` + text + `</a>`
+}
+
+// boundary - позиция i не продолжает слово
+func boundary(s string, i int) bool {
+	return i == 0 || !isWord(s[i-1])
+}
+
+func isWord(c byte) bool {
+	return c == '_' || c >= 0x80 ||
+		(c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isEmailByte(c byte) bool {
+	return isWord(c) && c < 0x80 || strings.IndexByte(".%+-@", c) >= 0
+}
@@ -0,0 +1,103 @@
+package markdown
+
+import (
+	"testing"
+)
+
+type fakeResolver struct{}
+
+func (fakeResolver) TaskRef(id int) (string, bool) {
+	if id == 12 {
+		return "t-12", true
+	}
+	return "", false
+}
+
+func (fakeResolver) Mention(email string) (string, string, bool) {
+	if email == "ivan@mail.ru" {
+		return "u-1", "Иван", true
+	}
+	return "", "", false
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		opts Options
+		want string
+	}{
+		{
+			name: "escape html",
+			src:  "<script>alert(1)</script>",
+			want: "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>",
+		},
+		{
+			name: "emphasis",
+			src:  "**bold** *it* ~~del~~ `a<b`",
+			want: "<p><strong>bold</strong> <em>it</em> <del>del</del> <code>a&lt;b</code></p>",
+		},
+		{
+			name: "paragraphs and line breaks",
+			src:  "a\r\nb\n\nc",
+			want: "<p>a<br>b</p><p>c</p>",
+		},
+		{
+			name: "lists",
+			src:  "- a\n- b\n1. c",
+			want: "<ul><li>a</li><li>b</li></ul><ol><li>c</li></ol>",
+		},
+		{
+			name: "quote and code block",
+			src:  "> q\n```\n<b>\n```",
+			want: "<blockquote>q</blockquote><pre><code>&lt;b&gt;</code></pre>",
+		},
+		{
+			name: "allowed link",
+			src:  "[site](https://example.com/?a=1&b=2)",
+			opts: Options{Hosts: []string{"example.com"}},
+			want: `<p><a href="https://example.com/?a=1&amp;b=2" rel="nofollow noopener noreferrer" target="_blank">site</a></p>`,
+		},
+		{
+			name: "javascript link",
+			src:  "[x](javascript:alert(1))",
+			want: "<p>x</p>",
+		},
+		{
+			name: "link with parentheses",
+			src:  "[wiki](https://example.com/a_(b)) c",
+			opts: Options{Hosts: []string{"example.com"}},
+			want: `<p><a href="https://example.com/a_(b)" rel="nofollow noopener noreferrer" target="_blank">wiki</a> c</p>`,
+		},
+		{
+			name: "no hosts allows only relative links",
+			src:  "[a](/task/1) [b](https://example.com) [c](//example.com)",
+			want: `<p><a href="/task/1" rel="nofollow noopener noreferrer" target="_blank">a</a> b c</p>`,
+		},
+		{
+			name: "host not in allow list",
+			src:  "https://evil.com",
+			opts: Options{Hosts: []string{"example.com"}},
+			want: "<p>https://evil.com</p>",
+		},
+		{
+			name: "subdomain in allow list",
+			src:  "see https://docs.example.com.",
+			opts: Options{Hosts: []string{"example.com"}},
+			want: `<p>see <a href="https://docs.example.com" rel="nofollow noopener noreferrer" target="_blank">https://docs.example.com</a>.</p>`,
+		},
+		{
+			name: "task ref and mention",
+			src:  "#12 #13 a#12 @ivan@mail.ru, @petr@mail.ru",
+			opts: Options{Resolver: fakeResolver{}},
+			want: `<p><a class="task-ref" data-uuid="t-12" href="/task/t-12">#12</a> #13 a#12 <span class="mention" data-uuid="u-1">@Иван</span>, @petr@mail.ru</p>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Render(tt.src, tt.opts); got != tt.want {
+				t.Errorf("Render() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
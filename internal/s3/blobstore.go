@@ -0,0 +1,84 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+const (
+	BackendMinio = "minio"
+	BackendFS    = "fs"
+
+	// PresignTTL - время жизни подписанных ссылок на файлы
+	PresignTTL = 24 * time.Hour
+)
+
+var ErrBlobSecret = errors.New("для хранилища fs нужен секрет подписи ссылок")
+
+// ObjectInfo - метаданные объекта в хранилище
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+}
+
+// Part - загруженная часть составной (multipart) загрузки
+type Part struct {
+	Number int
+	ETag   string
+	Size   int64
+}
+
+// BlobStore - хранилище файлов: MinIO (S3) или локальная папка для dev и тестов
+type BlobStore interface {
+	// Put загружает локальный файл в хранилище, бакет создается при необходимости
+	Put(ctx context.Context, bucket, object, filePath, contentType string) error
+	// Get скачивает объект в локальный файл
+	Get(ctx context.Context, bucket, object, filePath string) error
+	// Open открывает объект на чтение, используется чтобы не скачивать большой файл целиком
+	Open(ctx context.Context, bucket, object string) (io.ReadCloser, error)
+	Delete(ctx context.Context, bucket, object string) error
+	Stat(ctx context.Context, bucket, object string) (ObjectInfo, error)
+	List(ctx context.Context, bucket string) ([]ObjectInfo, error)
+
+	// Presign возвращает временную ссылку на скачивание, name - имя файла для браузера
+	Presign(ctx context.Context, bucket, object, name string, ttl time.Duration) (string, error)
+	// URL возвращает постоянную ссылку на публичный объект
+	URL(bucket, object string) string
+
+	// Составная загрузка: клиент грузит части напрямую в хранилище по подписанным ссылкам
+	CreateMultipart(ctx context.Context, bucket, object, contentType string) (uploadID string, err error)
+	PresignPart(ctx context.Context, bucket, object, uploadID string, number int, ttl time.Duration) (string, error)
+	ListParts(ctx context.Context, bucket, object, uploadID string) ([]Part, error)
+	CompleteMultipart(ctx context.Context, bucket, object, uploadID string, parts []Part) error
+	AbortMultipart(ctx context.Context, bucket, object, uploadID string) error
+}
+
+// StoreConf - настройки хранилища, для MinIO используются ключи доступа, для fs - папка и секрет подписи ссылок
+type StoreConf struct {
+	Backend string
+
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	Location        string
+	UseSSL          bool
+
+	Root       string
+	Secret     string
+	BackendURL string
+}
+
+func NewStore(conf StoreConf) (BlobStore, error) {
+	if conf.Backend == BackendFS {
+		if conf.Secret == "" {
+			return nil, ErrBlobSecret
+		}
+		return NewFSStore(conf.Root, conf.BackendURL, conf.Secret), nil
+	}
+
+	return NewMinioStore(conf.Endpoint, conf.AccessKeyID, conf.SecretAccessKey, conf.Location, conf.UseSSL), nil
+}
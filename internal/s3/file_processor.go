@@ -0,0 +1,265 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/extract"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	PreviewWidth    = 400
+	ParallelProcess = 2
+)
+
+// OnFileProcessed вызывается после обработки чистого файла: текст, превью и количество страниц сохранены
+func (s3 *ServicePrivate) OnFileProcessed(fn func(fileUUID uuid.UUID) error) {
+	s3.onFileProcessed = fn
+}
+
+// ToProcessFile ставит файл в очередь на извлечение текста и создание превью
+func (s3 *ServicePrivate) ToProcessFile(fileUUID uuid.UUID) {
+	select {
+	case s3.toProcess <- fileUUID:
+	default:
+		logrus.WithField("file", fileUUID).Warn("S3: file process queue is full")
+	}
+}
+
+func (s3 *ServicePrivate) ToProcess() {
+	for uid := range s3.toProcess {
+		err := s3.processFile(uid)
+		if errors.Is(err, ErrFileInfected) {
+			logrus.WithField("file", uid).Warn(err)
+			continue
+		}
+		if err != nil {
+			logrus.WithField("file", uid).Error(err)
+			continue
+		}
+
+		if s3.onFileProcessed != nil {
+			if err := s3.onFileProcessed(uid); err != nil {
+				logrus.WithField("file", uid).Error(err)
+			}
+		}
+	}
+}
+
+func (s3 *ServicePrivate) processFile(uid uuid.UUID) error {
+	file, err := s3.repo.GetFile(uid)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	dir, err := os.MkdirTemp("", "file-process-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	err = s3.scanVersions(ctx, file, dir)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, "original"+file.Ext)
+
+	err = s3.store.Get(ctx, file.BucketName, file.ObjectName, path)
+	if err != nil {
+		return err
+	}
+
+	// зараженный файл не разбирается: ни текста, ни превью. При ошибке антивируса файл остается pending.
+	scan, err := s3.scanFile(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	if !scan.Clean {
+		err = s3.repo.SaveScan(file.ObjectName, ScanStatusInfected, scan.Virus)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("%w: %s", ErrFileInfected, scan.Virus)
+	}
+
+	err = s3.repo.SaveScan(file.ObjectName, ScanStatusClean, "")
+	if err != nil {
+		return err
+	}
+
+	processErrors := []error{}
+
+	res := extract.Result{}
+	if extract.Supported(file.MimeType) {
+		res, err = extract.File(path, file.MimeType)
+		if err != nil {
+			processErrors = append(processErrors, fmt.Errorf("text: %w", err))
+		}
+	}
+
+	previewObjectName := ""
+
+	previewPath, err := makePreview(path, file.MimeType)
+	if err != nil {
+		processErrors = append(processErrors, fmt.Errorf("preview: %w", err))
+	}
+
+	if previewPath != "" {
+		contentType, err := helpers.FileMimetype(previewPath)
+		if err != nil {
+			return err
+		}
+
+		objectName := helpers.ParsePathFileName(file.ObjectName) + ".preview" + helpers.FileExt(previewPath)
+
+		err = s3.store.Put(ctx, file.BucketName, objectName, previewPath, contentType)
+		if err != nil {
+			return err
+		}
+
+		previewObjectName = objectName
+	}
+
+	processError := ""
+	if err := errors.Join(processErrors...); err != nil {
+		processError = err.Error()
+		logrus.WithField("file", uid).Warn(processError)
+	}
+
+	return s3.repo.SaveProcessing(uid, res.Text, res.Pages, previewObjectName, processError)
+}
+
+// scanVersions проверяет прошлые версии файла, которые сменила новая версия до их проверки
+func (s3 *ServicePrivate) scanVersions(ctx context.Context, file File, dir string) error {
+	versions, err := s3.repo.GetPendingVersions(file.UUID)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range versions {
+		if v.ObjectName == file.ObjectName {
+			continue
+		}
+
+		path := filepath.Join(dir, "version"+strconv.Itoa(v.Version)+v.Ext)
+
+		err = s3.store.Get(ctx, file.BucketName, v.ObjectName, path)
+		if err != nil {
+			return err
+		}
+
+		scan, err := s3.scanFile(ctx, path)
+		os.Remove(path)
+		if err != nil {
+			return err
+		}
+
+		status := ScanStatusClean
+		if !scan.Clean {
+			status = ScanStatusInfected
+			logrus.WithField("file", file.UUID).Warnf("%s: version %d: %s", ErrFileInfected, v.Version, scan.Virus)
+		}
+
+		err = s3.repo.SaveScan(v.ObjectName, status, scan.Virus)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s3 *ServicePrivate) scanFile(ctx context.Context, path string) (ScanResult, error) {
+	if s3.scanner == nil {
+		return ScanResult{Clean: true}, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return ScanResult{}, err
+	}
+	defer f.Close()
+
+	return s3.scanner.Scan(ctx, f)
+}
+
+// RequeuePendingScans заново ставит в обработку файлы, которые сами или прошлые версии которых давно ждут проверки
+func (s3 *ServicePrivate) RequeuePendingScans(olderThan time.Duration) (int, error) {
+	uids, err := s3.repo.GetPendingScanFiles(time.Now().Add(-olderThan), 100)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, uid := range uids {
+		s3.ToProcessFile(uid)
+	}
+
+	return len(uids), nil
+}
+
+// makePreview возвращает путь к превью или пустую строку, если превью для такого файла не делается
+func makePreview(path, mime string) (string, error) {
+	switch {
+	case helpers.FileMimeIsImage(mime):
+		return helpers.ResizeImage(path, PreviewWidth)
+
+	case mime == extract.MimePDF:
+		// первую страницу pdf рендерит poppler, без него превью не будет
+		bin, err := exec.LookPath("pdftoppm")
+		if err != nil {
+			return "", nil
+		}
+
+		to := helpers.ParsePathFileName(path) + ".preview"
+
+		out, err := exec.Command(bin, "-f", "1", "-l", "1", "-png", "-singlefile", "-scale-to", strconv.Itoa(PreviewWidth), path, to).CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("pdftoppm: %w: %s", err, out)
+		}
+
+		return to + ".png", nil
+	}
+
+	return "", nil
+}
+
+func (s3 *ServicePrivate) PresignedPreviewURL(fileUUID uuid.UUID) (res string, err error) {
+	file, err := s3.repo.GetFile(fileUUID)
+	if err != nil {
+		return res, err
+	}
+
+	err = checkScan(file.ScanStatus)
+	if err != nil {
+		return res, err
+	}
+
+	if file.PreviewObjectName == "" {
+		return res, dto.NotFoundErr("превью не найдено")
+	}
+
+	return s3.PresignedURL(file.Name+helpers.FileExt(file.PreviewObjectName), file.PreviewObjectName)
+}
+
+func (s3 *ServicePrivate) previewURL(file File) string {
+	if file.PreviewObjectName == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/task/%s/upload/%s/preview", s3.backendURL, fileTaskUUID(file), file.UUID)
+}
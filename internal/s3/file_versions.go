@@ -0,0 +1,233 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// UploadTaskFileVersion загружает новую версию файла задачи, имя логического файла не меняется
+func (s3 *ServicePrivate) UploadTaskFileVersion(federatonUUID, taskUUID, fileUUID uuid.UUID, fileName, filePath string, userUUID uuid.UUID) (file File, err error) {
+	file, err = s3.taskFile(taskUUID, fileUUID)
+	if err != nil {
+		return file, err
+	}
+
+	ext := helpers.FileExt(filePath)
+	objectName := fmt.Sprintf("%s/task/%s/%s%s", federatonUUID, taskUUID, uuid.New().String(), ext)
+
+	fileDTO, err := NewFileDTO(fileName, filePath, objectName, userUUID)
+	if err != nil {
+		return file, err
+	}
+
+	v := FileVersion{
+		UUID:     uuid.New(),
+		FileUUID: file.UUID,
+
+		Name:       fileDTO.Name,
+		ObjectName: objectName,
+		Size:       fileDTO.Size,
+		Ext:        fileDTO.Ext,
+		MimeType:   fileDTO.ContentType,
+		ImgWidth:   fileDTO.Width,
+		ImgHeight:  fileDTO.Height,
+
+		CreatedBy: userUUID,
+	}
+
+	err = s3.putObject(file.BucketName, v.ObjectName, filePath, v.MimeType)
+	if err != nil {
+		return file, err
+	}
+
+	return s3.registerVersion(file, v)
+}
+
+// registerVersion делает последней версию v, объект которой уже лежит в хранилище.
+// Номер версии выдается при сохранении. Новая версия, как и новый файл, ждет проверки антивирусом.
+func (s3 *ServicePrivate) registerVersion(file File, v FileVersion) (File, error) {
+	v.ScanStatus = ScanStatusPending
+
+	err := s3.repo.CreateNextVersion(&v)
+	if err != nil {
+		return file, err
+	}
+
+	err = s3.setLatest(&file, v)
+	if err != nil {
+		return file, err
+	}
+
+	s3.ToProcessFile(file.UUID)
+
+	return file, nil
+}
+
+// GetFileVersions - версии файла задачи taskUUID
+func (s3 *ServicePrivate) GetFileVersions(taskUUID, fileUUID uuid.UUID) (dmns []domain.FileVersion, err error) {
+	file, err := s3.taskFile(taskUUID, fileUUID)
+	if err != nil {
+		return dmns, err
+	}
+
+	versions, err := s3.repo.GetVersions(fileUUID)
+	if err != nil {
+		return dmns, err
+	}
+
+	return s3.toFileVersions(file, versions), nil
+}
+
+func (s3 *ServicePrivate) toFileVersions(file File, versions []FileVersion) []domain.FileVersion {
+	return lo.Map(versions, func(item FileVersion, index int) domain.FileVersion {
+		return domain.FileVersion{
+			UUID:     item.UUID,
+			FileUUID: item.FileUUID,
+			Version:  item.Version,
+			Name:     item.Name,
+			Ext:      item.Ext,
+			Size:     item.Size,
+			Mime:     item.MimeType,
+			URL:      fmt.Sprintf("%s?version=%d", s3.fileURL(file), item.Version),
+			IsLatest: item.Version == file.Version,
+
+			ScanStatus: item.ScanStatus,
+
+			CreatedAt: item.CreatedAt,
+			CreatedBy: item.CreatedBy,
+		}
+	})
+}
+
+func (s3 *ServicePrivate) PresignedURLFromFileVersion(taskUUID, fileUUID uuid.UUID, version int) (res string, err error) {
+	file, err := s3.taskFile(taskUUID, fileUUID)
+	if err != nil {
+		return res, err
+	}
+
+	v, err := s3.repo.GetVersion(fileUUID, version)
+	if err != nil {
+		return res, err
+	}
+
+	err = checkScan(v.ScanStatus)
+	if err != nil {
+		return res, err
+	}
+
+	return s3.PresignedURL(file.Name, v.ObjectName)
+}
+
+// DeleteLatestVersion удаляет последнюю версию файла, файл откатывается на предыдущую.
+// Единственную версию так удалить нельзя, для этого есть Delete.
+func (s3 *ServicePrivate) DeleteLatestVersion(fileUUID uuid.UUID) error {
+	file, err := s3.repo.GetFile(fileUUID)
+	if err != nil {
+		return err
+	}
+
+	versions, err := s3.repo.GetVersions(fileUUID)
+	if err != nil {
+		return err
+	}
+
+	latest, prev, err := latestVersions(versions)
+	if err != nil {
+		return err
+	}
+
+	err = s3.repo.DeleteVersion(latest.UUID)
+	if err != nil {
+		return err
+	}
+
+	err = s3.setLatest(&file, prev)
+	if err != nil {
+		return err
+	}
+
+	err = s3.removeObject(file.BucketName, latest.ObjectName)
+	if err != nil {
+		return err
+	}
+
+	s3.ToProcessFile(file.UUID)
+
+	return nil
+}
+
+// latestVersions - последняя и предыдущая версии из списка по убыванию номера
+func latestVersions(versions []FileVersion) (latest, prev FileVersion, err error) {
+	if len(versions) < 2 {
+		return latest, prev, errors.New("у файла одна версия, удалите файл целиком")
+	}
+
+	return versions[0], versions[1], nil
+}
+
+// setLatest переключает файл на версию v и убирает превью прошлой версии
+func (s3 *ServicePrivate) setLatest(file *File, v FileVersion) error {
+	preview := file.PreviewObjectName
+
+	err := s3.repo.SetLatest(file.UUID, v)
+	if err != nil {
+		return err
+	}
+
+	s3.cache.ClearURL(context.Background(), file.UUID)
+
+	if preview != "" {
+		if err := s3.removeObject(file.BucketName, preview); err != nil {
+			logrus.Warn(err)
+		}
+	}
+
+	file.applyVersion(v)
+
+	return nil
+}
+
+// applyVersion переносит в файл поля версии v, превью строится заново после обработки
+func (file *File) applyVersion(v FileVersion) {
+	file.Version = v.Version
+	file.ObjectName = v.ObjectName
+	file.Size = v.Size
+	file.Ext = v.Ext
+	file.MimeType = v.MimeType
+	file.ImgWidth = v.ImgWidth
+	file.ImgHeight = v.ImgHeight
+	file.PreviewObjectName = ""
+	file.ScanStatus = v.ScanStatus
+	file.ScanResult = v.ScanResult
+}
+
+func (s3 *ServicePrivate) removeObject(bucketName, objectName string) error {
+	return s3.store.Delete(context.Background(), bucketName, objectName)
+}
+
+// ownedByTask - файл приложен к самой задаче taskUUID, а не к ее комментарию или другой задаче
+func (file *File) ownedByTask(taskUUID uuid.UUID) bool {
+	return file.Type == "task" && file.TypeUUID == taskUUID
+}
+
+// taskFile - файл задачи taskUUID, файлы других задач и комментариев не отдаются
+func (s3 *ServicePrivate) taskFile(taskUUID, fileUUID uuid.UUID) (file File, err error) {
+	file, err = s3.repo.GetFile(fileUUID)
+	if err != nil {
+		return file, err
+	}
+
+	if !file.ownedByTask(taskUUID) {
+		return file, dto.NotFoundErr("файл не найден")
+	}
+
+	return file, nil
+}
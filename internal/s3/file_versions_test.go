@@ -0,0 +1,75 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestFileURL(t *testing.T) {
+	s := &ServicePrivate{backendURL: "https://api.test"}
+	task, comment, fileUUID := uuid.New(), uuid.New(), uuid.New()
+
+	taskFile := File{UUID: fileUUID, Type: "task", TypeUUID: task, PreviewObjectName: "p.png"}
+	if got := s.fileURL(taskFile); got != "https://api.test/task/"+task.String()+"/upload/"+fileUUID.String() {
+		t.Errorf("task file: %s", got)
+	}
+
+	commentFile := File{UUID: fileUUID, Type: "comment", TypeUUID: comment, TaskUUID: task, PreviewObjectName: "p.png"}
+	if got := s.fileURL(commentFile); got != s.fileURL(taskFile) {
+		t.Errorf("comment file: %s", got)
+	}
+	if got := s.previewURL(commentFile); got != s.fileURL(taskFile)+"/preview" {
+		t.Errorf("comment preview: %s", got)
+	}
+}
+
+func TestFileOwnedByTask(t *testing.T) {
+	task := uuid.New()
+
+	if f := (File{Type: "task", TypeUUID: task}); !f.ownedByTask(task) {
+		t.Error("task file")
+	}
+	if f := (File{Type: "task", TypeUUID: uuid.New()}); f.ownedByTask(task) {
+		t.Error("file of another task")
+	}
+	if f := (File{Type: "comment", TypeUUID: task, TaskUUID: task}); f.ownedByTask(task) {
+		t.Error("comment file")
+	}
+}
+
+func TestApplyVersion(t *testing.T) {
+	file := File{Version: 1, ObjectName: "v1.pdf", PreviewObjectName: "v1.png", ScanStatus: ScanStatusClean}
+	file.applyVersion(FileVersion{Version: 2, ObjectName: "v2.docx", Ext: ".docx", Size: 7, ScanStatus: ScanStatusPending})
+
+	if file.Version != 2 || file.ObjectName != "v2.docx" || file.Ext != ".docx" || file.Size != 7 {
+		t.Errorf("version not applied: %+v", file)
+	}
+	if file.PreviewObjectName != "" || file.ScanStatus != ScanStatusPending {
+		t.Errorf("new version must wait for preview and scan: %+v", file)
+	}
+}
+
+func TestFileVersionsList(t *testing.T) {
+	s := &ServicePrivate{backendURL: "https://api.test"}
+	file := File{UUID: uuid.New(), Type: "task", TypeUUID: uuid.New(), Version: 2}
+
+	list := s.toFileVersions(file, []FileVersion{{Version: 2}, {Version: 1}})
+	if len(list) != 2 || !list[0].IsLatest || list[1].IsLatest {
+		t.Fatalf("got %+v", list)
+	}
+	if list[1].URL != s.fileURL(file)+"?version=1" {
+		t.Errorf("version url: %s", list[1].URL)
+	}
+}
+
+func TestLatestVersions(t *testing.T) {
+	if _, _, err := latestVersions([]FileVersion{{Version: 1}}); err == nil {
+		t.Error("single version must not be deleted")
+	}
+
+	latest, prev, err := latestVersions([]FileVersion{{Version: 3}, {Version: 2}, {Version: 1}})
+	if err != nil || latest.Version != 3 || prev.Version != 2 {
+		t.Errorf("got %d %d %v", latest.Version, prev.Version, err)
+	}
+}
@@ -8,22 +8,16 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/internal/helpers"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 )
 
 type Service struct {
-	endpoint        string
-	accessKeyID     string
-	secretAccessKey string
-	bucketName      string
-	location        string // "ru-central1"
-	useSSL          bool
-	publicURL       string
+	bucketName string
+	publicURL  string
 
-	repo *Repository
+	store BlobStore
+	repo  *Repository
 
 	cropWidth []int
 
@@ -52,19 +46,36 @@ type Conf struct {
 	Location        string
 	UseSSL          bool
 	PublicURL       string
+
+	// Backend - minio или fs (файлы в папке Root, ссылки отдает BackendURL)
+	Backend    string
+	Root       string
+	Secret     string
+	BackendURL string
 }
 
-func New(conf Conf, repo *Repository) *Service {
+func New(conf Conf, repo *Repository) (*Service, error) {
+	store, err := NewStore(StoreConf{
+		Backend:         conf.Backend,
+		Endpoint:        conf.Endpoint,
+		AccessKeyID:     conf.AccessKeyID,
+		SecretAccessKey: conf.SecretAccessKey,
+		Location:        conf.Location,
+		UseSSL:          conf.UseSSL,
+		Root:            conf.Root,
+		Secret:          conf.Secret,
+		BackendURL:      conf.BackendURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	s3 := &Service{
-		endpoint:        conf.Endpoint,
-		accessKeyID:     conf.AccessKeyID,
-		secretAccessKey: conf.SecretAccessKey,
-		bucketName:      conf.BucketName,
-		location:        conf.Location,
-		useSSL:          conf.UseSSL,
-		publicURL:       conf.PublicURL,
-		repo:            repo,
-		cropWidth:       []int{OriginalPhotoSize, SmallPhotoSize, LargePhotoSize, MediumPhotoSize},
+		bucketName: conf.BucketName,
+		publicURL:  conf.PublicURL,
+		repo:       repo,
+		cropWidth:  []int{OriginalPhotoSize, SmallPhotoSize, LargePhotoSize, MediumPhotoSize},
+		store:      store,
 
 		toResize:       make(chan ToUpload, 1000),
 		toUpload:       make(chan ToUpload, 1000),
@@ -89,44 +100,20 @@ func New(conf Conf, repo *Repository) *Service {
 		go s3.ToResize()
 	}
 
-	return s3
+	return s3, nil
 }
 
-func (s3 *Service) Upload(filePath, contentType, objectName string) error {
-	ctx := context.Background()
-
-	// Initialize minio client object.
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
-	if err != nil {
-		return err
-	}
-
-	err = minioClient.MakeBucket(ctx, s3.bucketName, minio.MakeBucketOptions{Region: s3.location})
-	if err != nil {
-		exists, errBucketExists := minioClient.BucketExists(ctx, s3.bucketName)
-		if errBucketExists != nil || !exists {
-			return err
-		}
-	} else {
-		logrus.Infof("S3: successfully created %s\n", s3.bucketName)
-	}
-
-	// Upload the test file with FPutObject
-	info, err := minioClient.FPutObject(ctx, s3.bucketName, objectName, filePath, minio.PutObjectOptions{ContentType: contentType})
-	if err != nil {
-		return err
-	}
-
-	logrus.Debugf("successfully uploaded %s of size %d\n", objectName, info.Size)
+// Store возвращает хранилище публичных файлов
+func (s3 *Service) Store() BlobStore {
+	return s3.store
+}
 
-	return nil
+func (s3 *Service) Upload(filePath, contentType, objectName string) error {
+	return s3.store.Put(context.Background(), s3.bucketName, objectName, filePath, contentType)
 }
 
 func (s3 *Service) URL(objectName string) string {
-	return fmt.Sprintf("https://%s.%s/%s", s3.bucketName, s3.endpoint, objectName)
+	return s3.store.URL(s3.bucketName, objectName)
 }
 
 func (s3 *Service) UploadPhoto(ctx context.Context, filePath string, userUUID uuid.UUID) (err error) {
@@ -184,18 +171,7 @@ func (s3 *Service) DeletePhoto(uid uuid.UUID) (err error) {
 				objectName := s3.GetPhotoObjectName(uid, size)
 				logrus.Debug("deleting photo: ", objectName)
 
-				// Initialize minio client object.
-				minioClient, err := minio.New(s3.endpoint, &minio.Options{
-					Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-					Secure: s3.useSSL,
-				})
-				if err != nil {
-					return err
-				}
-
-				err = minioClient.RemoveObject(ctx, s3.bucketName, objectName, minio.RemoveObjectOptions{
-					ForceDelete: true,
-				})
+				err := s3.store.Delete(ctx, s3.bucketName, objectName)
 				if err != nil {
 					logrus.Error(err)
 					return err
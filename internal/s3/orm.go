@@ -12,6 +12,9 @@ type File struct {
 	Type     string    `gorm:"type:int;not null"`
 	TypeUUID uuid.UUID `gorm:"type:uuid;not null"`
 
+	// TaskUUID - задача комментария, к которому приложен файл, заполняется только GetCommentFiles
+	TaskUUID uuid.UUID `gorm:"->"`
+
 	Name       string `gorm:"type:varchar(50);default:'';not null"`
 	ObjectName string `gorm:"type:varchar(250);default:'';not null"`
 	Size       int64  `gorm:"type:bigint;default:0;not null"`
@@ -21,13 +24,77 @@ type File struct {
 	ImgHeight  int  `gorm:"type:int;default:0;not null"`
 
 	Ext        string `gorm:"type:varchar(10);default:'';not null"`
-	MimeType   string `gorm:"type:varchar(20);default:'';not null"`
+	MimeType   string `gorm:"type:varchar(250);default:'';not null"`
 	BucketName string `gorm:"type:varchar(200);default:'';not null"`
 	Endpoint   string `gorm:"type:varchar(30);default:'';not null"`
 
+	Version int `gorm:"type:int;default:1;not null"`
+
+	TextContent       string     `gorm:"type:text;default:'';not null"`
+	PageCount         int        `gorm:"type:int;default:0;not null"`
+	PreviewObjectName string     `gorm:"type:varchar(250);default:'';not null"`
+	ProcessError      string     `gorm:"type:text;default:'';not null"`
+	ProcessedAt       *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	// ScanStatus - проверка антивирусом: pending, clean или infected, ScanResult - имя вируса
+	ScanStatus string     `gorm:"type:varchar(20);default:'pending';not null"`
+	ScanResult string     `gorm:"type:varchar(250);default:'';not null"`
+	ScannedAt  *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
 	CreatedBy uuid.UUID `gorm:"type:uuid;not null;"`
 
 	CreatedAt   time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	DeletedAt   *time.Time `gorm:"type:timestamptz;default:NULL;"`
 	ToDeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
 }
+
+type FileVersion struct {
+	UUID     uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	FileUUID uuid.UUID `gorm:"type:uuid;not null"`
+	Version  int       `gorm:"type:int;not null"`
+
+	Name       string `gorm:"type:varchar(50);default:'';not null"`
+	ObjectName string `gorm:"type:varchar(250);default:'';not null"`
+	Size       int64  `gorm:"type:bigint;default:0;not null"`
+	Ext        string `gorm:"type:varchar(10);default:'';not null"`
+	MimeType   string `gorm:"type:varchar(250);default:'';not null"`
+	ImgWidth   int    `gorm:"type:int;default:0;not null"`
+	ImgHeight  int    `gorm:"type:int;default:0;not null"`
+
+	ScanStatus string `gorm:"type:varchar(20);default:'pending';not null"`
+	ScanResult string `gorm:"type:varchar(250);default:'';not null"`
+
+	CreatedBy uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+// UploadSession - составная загрузка файла напрямую в хранилище
+type UploadSession struct {
+	UUID uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+
+	FederationUUID uuid.UUID  `gorm:"type:uuid;not null"`
+	TaskUUID       uuid.UUID  `gorm:"type:uuid;not null"`
+	CommentUUID    *uuid.UUID `gorm:"type:uuid;default:NULL"`
+
+	Name       string `gorm:"type:varchar(50);not null"`
+	Size       int64  `gorm:"type:bigint;not null"`
+	MimeType   string `gorm:"type:varchar(250);default:'';not null"`
+	PartSize   int64  `gorm:"type:bigint;not null"`
+	PartsCount int    `gorm:"type:int;not null"`
+
+	BucketName string `gorm:"type:varchar(200);default:'';not null"`
+	ObjectName string `gorm:"type:varchar(250);not null"`
+	UploadID   string `gorm:"type:varchar(1024);not null"`
+
+	Status   string     `gorm:"type:varchar(20);default:'active';not null"`
+	Error    string     `gorm:"type:text;default:'';not null"`
+	FileUUID *uuid.UUID `gorm:"type:uuid;default:NULL"`
+
+	CreatedBy uuid.UUID `gorm:"type:uuid;not null"`
+
+	CreatedAt   time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	ExpiresAt   time.Time  `gorm:"type:timestamptz;not null"`
+	CompletedAt *time.Time `gorm:"type:timestamptz;default:NULL"`
+}
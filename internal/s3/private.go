@@ -2,33 +2,34 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net/url"
 	"strings"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/cache"
 	"github.com/krisch/crm-backend/internal/helpers"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
 type ServicePrivate struct {
-	endpoint        string
-	accessKeyID     string
-	secretAccessKey string
-	bucketName      string
-	location        string // "ru-central1"
-	useSSL          bool
-	publicURL       string
-	backendURL      string
-
-	repo  *Repository
-	cache *cache.Service
+	endpoint   string
+	bucketName string
+	publicURL  string
+	backendURL string
+
+	maxUploadSize int64
+
+	store   BlobStore
+	scanner Scanner
+	repo    *Repository
+	cache   *cache.Service
+
+	toProcess       chan uuid.UUID
+	onFileProcessed func(fileUUID uuid.UUID) error
 }
 
 type ConfPrivate struct {
@@ -40,27 +41,80 @@ type ConfPrivate struct {
 	Location        string
 	UseSSL          bool
 	PublicURL       string
+
+	// Backend - minio или fs (файлы в папке Root, ссылки подписываются Secret)
+	Backend string
+	Root    string
+	Secret  string
+
+	// MaxUploadSize - предельный размер файла составной загрузки в байтах
+	MaxUploadSize int64
+
+	// Scanner - антивирус для новых файлов: none, clamd (адрес ClamdAddr) или stub
+	Scanner   string
+	ClamdAddr string
 }
 
-func NewPrivate(conf ConfPrivate, repo *Repository, cs *cache.Service) *ServicePrivate {
+func NewPrivate(conf ConfPrivate, repo *Repository, cs *cache.Service) (*ServicePrivate, error) {
+	store, err := NewStore(StoreConf{
+		Backend:         conf.Backend,
+		Endpoint:        conf.Endpoint,
+		AccessKeyID:     conf.AccessKeyID,
+		SecretAccessKey: conf.SecretAccessKey,
+		Location:        conf.Location,
+		UseSSL:          conf.UseSSL,
+		Root:            conf.Root,
+		Secret:          conf.Secret,
+		BackendURL:      conf.BackendURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	s3 := &ServicePrivate{
 		repo:  repo,
 		cache: cs,
 
-		endpoint:        conf.Endpoint,
-		accessKeyID:     conf.AccessKeyID,
-		secretAccessKey: conf.SecretAccessKey,
-		bucketName:      conf.BucketName,
-		location:        conf.Location,
-		useSSL:          conf.UseSSL,
-		publicURL:       conf.PublicURL,
-		backendURL:      conf.BackendURL,
+		endpoint:   conf.Endpoint,
+		bucketName: conf.BucketName,
+		publicURL:  conf.PublicURL,
+		backendURL: conf.BackendURL,
+
+		maxUploadSize: conf.MaxUploadSize,
+
+		store: store,
+
+		toProcess: make(chan uuid.UUID, 1000),
+	}
+
+	if s3.maxUploadSize <= 0 {
+		s3.maxUploadSize = DefaultMaxUploadSize
+	}
+
+	scanner, err := NewScanner(conf.Scanner, conf.ClamdAddr)
+	if err != nil {
+		return nil, err
+	}
+	s3.scanner = scanner
+
+	for i := 0; i < ParallelProcess; i++ {
+		go s3.ToProcess()
 	}
 
-	return s3
+	return s3, nil
 }
 
+// UploadTaskFile загружает файл в задачу, файл с таким же именем становится новой версией существующего
 func (s3 *ServicePrivate) UploadTaskFile(federatonUUID, taskUUID uuid.UUID, fileName, filePath string, userUUID uuid.UUID) (file File, err error) {
+	exist, err := s3.repo.FindTaskFileByName(taskUUID, fileName)
+	if err == nil {
+		return s3.UploadTaskFileVersion(federatonUUID, taskUUID, exist.UUID, fileName, filePath, userUUID)
+	}
+
+	if !errors.As(err, &dto.NotFoundError{}) {
+		return file, err
+	}
+
 	ext := helpers.FileExt(filePath)
 	objectName := fmt.Sprintf("%s/task/%s/%s%s", federatonUUID, taskUUID, uuid.New().String(), ext)
 
@@ -106,6 +160,7 @@ func (s3 *ServicePrivate) UploadTaskCommentFile(federatonUUID, taskUUID, comment
 
 		Type:     "comment",
 		TypeUUID: commentUUID,
+		TaskUUID: taskUUID,
 
 		Name:       fileDTO.Name,
 		ObjectName: objectName,
@@ -124,63 +179,91 @@ func (s3 *ServicePrivate) UploadTaskCommentFile(federatonUUID, taskUUID, comment
 	return s3.uploadFile(file, filePath)
 }
 
-func (s3 *ServicePrivate) uploadFile(file File, filePath string) (File, error) {
-	err := s3.repo.Create(file)
+// UploadAgentFile загружает файл в карточку агента
+func (s3 *ServicePrivate) UploadAgentFile(federatonUUID, agentUUID uuid.UUID, fileName, filePath string, userUUID uuid.UUID) (file File, err error) {
+	ext := helpers.FileExt(filePath)
+	objectName := fmt.Sprintf("%s/agent/%s/%s%s", federatonUUID, agentUUID, uuid.New().String(), ext)
+
+	fileDTO, err := NewFileDTO(fileName, filePath, objectName, userUUID)
 	if err != nil {
 		return file, err
 	}
 
-	ctx := context.Background()
+	file = File{
+		UUID: uuid.New(),
 
-	// Initialize minio client object.
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
+		Type:     "agent",
+		TypeUUID: agentUUID,
+
+		Name:       fileDTO.Name,
+		ObjectName: objectName,
+		Size:       fileDTO.Size,
+		Ext:        fileDTO.Ext,
+
+		ImgWidth:  fileDTO.Width,
+		ImgHeight: fileDTO.Height,
+
+		MimeType:   fileDTO.ContentType,
+		BucketName: s3.bucketName,
+		Endpoint:   s3.endpoint,
+		CreatedBy:  userUUID,
+	}
+
+	return s3.uploadFile(file, filePath)
+}
+
+func (s3 *ServicePrivate) uploadFile(file File, filePath string) (File, error) {
+	err := s3.putObject(file.BucketName, file.ObjectName, filePath, file.MimeType)
 	if err != nil {
 		return file, err
 	}
 
-	err = minioClient.MakeBucket(ctx, file.BucketName, minio.MakeBucketOptions{Region: s3.location})
+	return s3.registerFile(file)
+}
+
+// registerFile сохраняет файл, который уже лежит в хранилище, и ставит его в обработку.
+// До проверки антивирусом файл в карантине (pending).
+func (s3 *ServicePrivate) registerFile(file File) (File, error) {
+	file.ScanStatus = ScanStatusPending
+
+	err := s3.repo.Create(file)
 	if err != nil {
-		exists, errBucketExists := minioClient.BucketExists(ctx, s3.bucketName)
-		if errBucketExists != nil || !exists {
-			return file, err
-		}
-	} else {
-		logrus.Infof("S3: successfully created %s\n", file.BucketName)
+		return file, err
 	}
 
-	info, err := minioClient.FPutObject(ctx, file.BucketName, file.ObjectName, filePath, minio.PutObjectOptions{ContentType: file.MimeType})
+	err = s3.repo.CreateVersion(FileVersion{
+		FileUUID:   file.UUID,
+		Version:    1,
+		Name:       file.Name,
+		ObjectName: file.ObjectName,
+		Size:       file.Size,
+		Ext:        file.Ext,
+		MimeType:   file.MimeType,
+		ImgWidth:   file.ImgWidth,
+		ImgHeight:  file.ImgHeight,
+		ScanStatus: file.ScanStatus,
+		CreatedBy:  file.CreatedBy,
+	})
 	if err != nil {
 		return file, err
 	}
 
-	logrus.Debugf("successfully uploaded %s of size %d\n", file.ObjectName, info.Size)
+	s3.ToProcessFile(file.UUID)
 
 	return file, err
 }
 
-func (s3 *ServicePrivate) DeleteFile(file File) error {
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
-	if err != nil {
-		return fmt.Errorf("S3: %w", err)
-	}
-
-	ctx := context.Background()
-
-	err = minioClient.RemoveObject(ctx, file.BucketName, file.ObjectName, minio.RemoveObjectOptions{
-		ForceDelete: true,
-	})
+// Store возвращает хранилище файлов задач
+func (s3 *ServicePrivate) Store() BlobStore {
+	return s3.store
+}
 
-	if err != nil {
-		return fmt.Errorf("S3: %w", err)
-	}
+func (s3 *ServicePrivate) putObject(bucketName, objectName, filePath, contentType string) error {
+	return s3.store.Put(context.Background(), bucketName, objectName, filePath, contentType)
+}
 
-	return err
+func (s3 *ServicePrivate) DeleteFile(file File) error {
+	return s3.store.Delete(context.Background(), file.BucketName, file.ObjectName)
 }
 
 func (s3 *ServicePrivate) Delete(fileUUID uuid.UUID) error {
@@ -189,27 +272,30 @@ func (s3 *ServicePrivate) Delete(fileUUID uuid.UUID) error {
 		return err
 	}
 
-	err = s3.repo.MarkForDelete(fileUUID)
+	versions, err := s3.repo.GetVersions(fileUUID)
 	if err != nil {
 		return err
 	}
 
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
+	err = s3.repo.MarkForDelete(fileUUID)
 	if err != nil {
-		return fmt.Errorf("S3: %w", err)
+		return err
 	}
 
-	ctx := context.Background()
-
-	err = minioClient.RemoveObject(ctx, file.BucketName, file.ObjectName, minio.RemoveObjectOptions{
-		ForceDelete: true,
+	// все версии и превью удаляются вместе с файлом
+	objectNames := lo.Map(versions, func(v FileVersion, _ int) string {
+		return v.ObjectName
 	})
+	objectNames = append(objectNames, file.ObjectName)
+	if file.PreviewObjectName != "" {
+		objectNames = append(objectNames, file.PreviewObjectName)
+	}
 
-	if err != nil {
-		return fmt.Errorf("S3: %w", err)
+	for _, objectName := range lo.Uniq(objectNames) {
+		err = s3.removeObject(file.BucketName, objectName)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = s3.repo.Delete(fileUUID)
@@ -232,38 +318,58 @@ func (s3 *ServicePrivate) Rename(fileUUID uuid.UUID, name string) error {
 }
 
 func (s3 *ServicePrivate) PresignedURL(name, objectName string) (res string, err error) {
-	// Initialize minio client object.
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
-	if err != nil {
-		return res, err
-	}
+	return s3.store.Presign(context.Background(), s3.bucketName, objectName, name, PresignTTL)
+}
 
-	ctx := context.Background()
+// checkScan запрещает скачивание файлов, которые не проверены или заражены
+func checkScan(status string) error {
+	switch status {
+	case ScanStatusInfected:
+		return ErrFileInfected
+	case ScanStatusPending:
+		return ErrFilePending
+	}
 
-	reqParams := make(url.Values)
+	return nil
+}
 
-	reqParams.Set("response-content-disposition", fmt.Sprintf("filename=\"%q\"", name))
+func (s3 *ServicePrivate) PresignedURLFromFile(fileUUID uuid.UUID) (res string, err error) {
+	file, err := s3.repo.GetFile(fileUUID)
+	if err != nil {
+		return res, err
+	}
 
-	presignedURL, err := minioClient.PresignedGetObject(ctx, s3.bucketName, objectName, time.Second*24*60*60, reqParams)
+	err = checkScan(file.ScanStatus)
 	if err != nil {
 		return res, err
 	}
 
-	return presignedURL.String(), err
+	return s3.PresignedURL(file.Name, file.ObjectName)
 }
 
-func (s3 *ServicePrivate) PresignedURLFromFile(fileUUID uuid.UUID) (res string, err error) {
-	file, err := s3.repo.GetFile(fileUUID)
-	if err != nil {
-		return res, err
+// DownloadURL - ссылка на файл для ответа клиенту: подписанная для проверенного файла,
+// иначе ссылка на бэкенд, которая начнет отдавать файл после проверки
+func (s3 *ServicePrivate) DownloadURL(file File) (string, error) {
+	if file.ScanStatus != ScanStatusClean {
+		return s3.fileURL(file), nil
 	}
 
 	return s3.PresignedURL(file.Name, file.ObjectName)
 }
 
+func (s3 *ServicePrivate) fileURL(file File) string {
+	return fmt.Sprintf("%s/task/%s/upload/%s", s3.backendURL, fileTaskUUID(file), file.UUID)
+}
+
+// fileTaskUUID - задача, к которой относится файл задачи или комментария: ссылки на файлы строятся от нее
+func fileTaskUUID(file File) uuid.UUID {
+	if file.Type == "comment" {
+		return file.TaskUUID
+	}
+
+	return file.TypeUUID
+}
+
 func (s3 *ServicePrivate) GetTaskFiles(taskUUID uuid.UUID, openImages bool) (dmns []domain.File, err error) {
 	files, err := s3.repo.GetTaskFiles(taskUUID)
 	if err != nil {
@@ -271,9 +377,9 @@ func (s3 *ServicePrivate) GetTaskFiles(taskUUID uuid.UUID, openImages bool) (dmn
 	}
 
 	return lo.Map(files, func(item File, index int) domain.File {
-		fileURL := fmt.Sprintf("%s/task/%s/upload/%s", s3.backendURL, item.TypeUUID, item.UUID)
+		fileURL := s3.fileURL(item)
 
-		if openImages && helpers.FileMimeToPreview(item.MimeType) {
+		if openImages && item.ScanStatus == ScanStatusClean && helpers.FileMimeToPreview(item.MimeType) {
 			urlFromRedis, err := s3.cache.GetURL(context.Background(), item.UUID)
 			if err == nil && urlFromRedis != "" {
 				fileURL = urlFromRedis
@@ -297,6 +403,45 @@ func (s3 *ServicePrivate) GetTaskFiles(taskUUID uuid.UUID, openImages bool) (dmn
 			URL:       fileURL,
 			CreatedAt: item.CreatedAt,
 			CreatedBy: item.CreatedBy,
+
+			Mime:       item.MimeType,
+			Width:      item.ImgWidth,
+			Height:     item.ImgHeight,
+			Pages:      item.PageCount,
+			PreviewURL: s3.previewURL(item),
+			Version:    item.Version,
+			ScanStatus: item.ScanStatus,
+		}
+	}), err
+}
+
+// AgentFileURL - ссылка на бэкенд, которая отдает файл агента после проверки антивирусом
+func (s3 *ServicePrivate) AgentFileURL(federationUUID, agentUUID, fileUUID uuid.UUID) string {
+	return fmt.Sprintf("%s/federation/%s/agent/%s/files/%s", s3.backendURL, federationUUID, agentUUID, fileUUID)
+}
+
+func (s3 *ServicePrivate) GetAgentFiles(federationUUID, agentUUID uuid.UUID) (dmns []domain.File, err error) {
+	files, err := s3.repo.GetAgentFiles(agentUUID)
+	if err != nil {
+		return dmns, err
+	}
+
+	return lo.Map(files, func(item File, index int) domain.File {
+		return domain.File{
+			UUID:      item.UUID,
+			Name:      item.Name,
+			Ext:       item.Ext,
+			Size:      item.Size,
+			URL:       s3.AgentFileURL(federationUUID, agentUUID, item.UUID),
+			CreatedAt: item.CreatedAt,
+			CreatedBy: item.CreatedBy,
+
+			Mime:       item.MimeType,
+			Width:      item.ImgWidth,
+			Height:     item.ImgHeight,
+			Pages:      item.PageCount,
+			Version:    item.Version,
+			ScanStatus: item.ScanStatus,
 		}
 	}), err
 }
@@ -308,9 +453,9 @@ func (s3 *ServicePrivate) GetCommentFiles(uid uuid.UUID, openImages bool) (dmns
 	}
 
 	return lo.Map(files, func(item File, index int) domain.File {
-		fileURL := fmt.Sprintf("%s/task/%s/upload/%s", s3.backendURL, item.TypeUUID, item.UUID)
+		fileURL := s3.fileURL(item)
 
-		if openImages && helpers.FileMimeToPreview(item.MimeType) {
+		if openImages && item.ScanStatus == ScanStatusClean && helpers.FileMimeToPreview(item.MimeType) {
 			presignedURL, err := s3.PresignedURL(item.Name, item.ObjectName)
 			if err != nil {
 				logrus.Warn(err)
@@ -325,26 +470,29 @@ func (s3 *ServicePrivate) GetCommentFiles(uid uuid.UUID, openImages bool) (dmns
 			Ext:  item.Ext,
 			Size: item.Size,
 			URL:  fileURL,
+
+			Mime:       item.MimeType,
+			Width:      item.ImgWidth,
+			Height:     item.ImgHeight,
+			Pages:      item.PageCount,
+			PreviewURL: s3.previewURL(item),
+			Version:    item.Version,
+			ScanStatus: item.ScanStatus,
 		}
 	}), err
 }
 
 // @todo: in poc.
 func (s3 *ServicePrivate) DangerousWipeS3FederationData(existFederations []domain.Federation) (uids []string, err error) {
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
+	ctx := context.Background()
+
+	objects, err := s3.store.List(ctx, s3.bucketName)
 	if err != nil {
-		return []string{}, fmt.Errorf("S3: %w", err)
+		return []string{}, err
 	}
 
-	ctx := context.Background()
-
 	deletedTotal := 0
-	for i := range minioClient.ListObjects(ctx, s3.bucketName, minio.ListObjectsOptions{
-		Recursive: true,
-	}) {
+	for _, i := range objects {
 		if deletedTotal > 1000 {
 			return []string{}, nil
 		}
@@ -361,12 +509,10 @@ func (s3 *ServicePrivate) DangerousWipeS3FederationData(existFederations []domai
 			deletedTotal++
 			logrus.WithField("key", i.Key).Info("deleting federation s3 data")
 
-			err = minioClient.RemoveObject(ctx, s3.bucketName, i.Key, minio.RemoveObjectOptions{
-				ForceDelete: true,
-			})
+			err = s3.store.Delete(ctx, s3.bucketName, i.Key)
 			if err != nil {
 				logrus.Error(err)
-				return []string{}, fmt.Errorf("S3: %w", err)
+				return []string{}, err
 			}
 		}
 
@@ -1,9 +1,12 @@
 package s3
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm"
 )
 
 type Repository struct {
@@ -50,12 +53,26 @@ func (r *Repository) GetTaskFiles(taskUUID uuid.UUID) (files []File, err error)
 	return files, res.Error
 }
 
-func (r *Repository) GetCommentFiles(commnetUUID uuid.UUID) (files []File, err error) {
+func (r *Repository) GetAgentFiles(agentUUID uuid.UUID) (files []File, err error) {
 	res := r.gorm.DB.
 		Model(&File{}).
-		Where("type = ?", "comment").
-		Where("type_uuid = ?", commnetUUID).
+		Where("type = ?", "agent").
+		Where("type_uuid = ?", agentUUID).
 		Where("deleted_at IS NULL").
+		Order("created_at desc").
+		Find(&files)
+
+	return files, res.Error
+}
+
+func (r *Repository) GetCommentFiles(commnetUUID uuid.UUID) (files []File, err error) {
+	res := r.gorm.DB.
+		Model(&File{}).
+		Select("files.*, c.task_uuid").
+		Joins("JOIN comments c ON c.uuid = files.type_uuid").
+		Where("files.type = ?", "comment").
+		Where("files.type_uuid = ?", commnetUUID).
+		Where("files.deleted_at IS NULL").
 		Find(&files)
 
 	return files, res.Error
@@ -112,3 +129,292 @@ func (r *Repository) Rename(fileUUID uuid.UUID, name string) error {
 
 	return res.Error
 }
+
+func (r *Repository) SaveProcessing(fileUUID uuid.UUID, textContent string, pageCount int, previewObjectName, processError string) error {
+	res := r.gorm.DB.
+		Model(&File{}).
+		Where("uuid = ?", fileUUID).
+		Updates(map[string]interface{}{
+			"text_content":        textContent,
+			"page_count":          pageCount,
+			"preview_object_name": previewObjectName,
+			"process_error":       processError,
+			"processed_at":        time.Now(),
+		})
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("файл не найден")
+	}
+
+	return res.Error
+}
+
+func (r *Repository) FindTaskFileByName(taskUUID uuid.UUID, name string) (file File, err error) {
+	res := r.gorm.DB.
+		Model(&File{}).
+		Where("type = ?", "task").
+		Where("type_uuid = ?", taskUUID).
+		Where("name = ?", name).
+		Where("deleted_at IS NULL").
+		Where("to_deleted_at IS NULL").
+		Order("created_at DESC").
+		Limit(1).
+		Find(&file)
+
+	if res.Error != nil {
+		return file, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return file, dto.NotFoundErr("файл не найден")
+	}
+
+	return file, res.Error
+}
+
+func (r *Repository) CreateVersion(orm FileVersion) error {
+	return r.gorm.DB.Create(&orm).Error
+}
+
+// CreateNextVersion сохраняет версию со следующим номером. Строка файла блокируется до конца транзакции,
+// поэтому параллельные загрузки версий одного файла не получат одинаковый номер
+func (r *Repository) CreateNextVersion(orm *FileVersion) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("select uuid from files where uuid = ? for update", orm.FileUUID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.
+			Model(&FileVersion{}).
+			Select("coalesce(max(version), 0) + 1").
+			Where("file_uuid = ?", orm.FileUUID).
+			Scan(&orm.Version).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(orm).Error
+	})
+}
+
+// GetVersions возвращает неудаленные версии файла, последняя первой
+func (r *Repository) GetVersions(fileUUID uuid.UUID) (versions []FileVersion, err error) {
+	res := r.gorm.DB.
+		Model(&FileVersion{}).
+		Where("file_uuid = ?", fileUUID).
+		Where("deleted_at IS NULL").
+		Order("version DESC").
+		Find(&versions)
+
+	return versions, res.Error
+}
+
+// GetPendingVersions - версии файла, которые еще не проверены антивирусом
+func (r *Repository) GetPendingVersions(fileUUID uuid.UUID) (versions []FileVersion, err error) {
+	res := r.gorm.DB.
+		Model(&FileVersion{}).
+		Where("file_uuid = ?", fileUUID).
+		Where("scan_status = ?", ScanStatusPending).
+		Order("version").
+		Find(&versions)
+
+	return versions, res.Error
+}
+
+func (r *Repository) GetVersion(fileUUID uuid.UUID, version int) (v FileVersion, err error) {
+	res := r.gorm.DB.
+		Model(&FileVersion{}).
+		Where("file_uuid = ?", fileUUID).
+		Where("version = ?", version).
+		Where("deleted_at IS NULL").
+		Find(&v)
+
+	if res.RowsAffected == 0 {
+		return v, dto.NotFoundErr("версия файла не найдена")
+	}
+
+	return v, res.Error
+}
+
+func (r *Repository) DeleteVersion(versionUUID uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&FileVersion{}).
+		Where("uuid = ?", versionUUID).
+		Where("deleted_at IS NULL").
+		UpdateColumn("deleted_at", "now()")
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("версия файла не найдена")
+	}
+
+	return res.Error
+}
+
+// SetLatest переключает файл на версию v, результаты обработки прошлой версии сбрасываются
+func (r *Repository) SetLatest(fileUUID uuid.UUID, v FileVersion) error {
+	res := r.gorm.DB.
+		Model(&File{}).
+		Where("uuid = ?", fileUUID).
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"version":             v.Version,
+			"object_name":         v.ObjectName,
+			"size":                v.Size,
+			"ext":                 v.Ext,
+			"mime_type":           v.MimeType,
+			"img_width":           v.ImgWidth,
+			"img_height":          v.ImgHeight,
+			"text_content":        "",
+			"page_count":          0,
+			"preview_object_name": "",
+			"process_error":       "",
+			"processed_at":        nil,
+			"scan_status":         v.ScanStatus,
+			"scan_result":         v.ScanResult,
+		})
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("файл не найден")
+	}
+
+	return res.Error
+}
+
+// SaveScan сохраняет результат проверки объекта для файла и его версии. Файл обновляется, только если
+// объект все еще его текущая версия, иначе результат относится к устаревшей версии.
+func (r *Repository) SaveScan(objectName, status, virus string) error {
+	err := r.gorm.DB.
+		Model(&FileVersion{}).
+		Where("object_name = ?", objectName).
+		Updates(map[string]interface{}{
+			"scan_status": status,
+			"scan_result": virus,
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	return r.gorm.DB.
+		Model(&File{}).
+		Where("object_name = ?", objectName).
+		Updates(map[string]interface{}{
+			"scan_status": status,
+			"scan_result": virus,
+			"scanned_at":  time.Now(),
+		}).Error
+}
+
+// GetPendingScanFiles - файлы, которые сами или прошлые версии которых ждут проверки дольше olderThan:
+// очередь была переполнена или антивирус недоступен
+func (r *Repository) GetPendingScanFiles(olderThan time.Time, limit int) (uids []uuid.UUID, err error) {
+	res := r.gorm.DB.
+		Model(&File{}).
+		Where("(scan_status = ? and created_at < ?) or uuid in (select file_uuid from file_versions where scan_status = ? and created_at < ?)",
+			ScanStatusPending, olderThan, ScanStatusPending, olderThan).
+		Where("deleted_at IS NULL").
+		Order("created_at").
+		Limit(limit).
+		Pluck("uuid", &uids)
+
+	return uids, res.Error
+}
+
+func (r *Repository) CreateUploadSession(orm UploadSession) error {
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) GetUploadSession(uid uuid.UUID) (session UploadSession, err error) {
+	res := r.gorm.DB.
+		Model(&UploadSession{}).
+		Where("uuid = ?", uid).
+		Limit(1).
+		Find(&session)
+
+	if res.Error != nil {
+		return session, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return session, dto.NotFoundErr("загрузка не найдена")
+	}
+
+	return session, nil
+}
+
+// SetUploadStatus переводит загрузку из статуса from в to, false - если статус уже сменил другой запрос
+func (r *Repository) SetUploadStatus(uid uuid.UUID, from, to, errText string, fileUUID *uuid.UUID) (bool, error) {
+	values := map[string]interface{}{
+		"status":    to,
+		"error":     errText,
+		"file_uuid": fileUUID,
+	}
+	switch to {
+	case UploadStatusActive:
+		values["expires_at"] = time.Now().Add(UploadSessionTTL)
+	case UploadStatusCompleting:
+		values["expires_at"] = time.Now().Add(UploadCompletingTTL)
+	default:
+		values["completed_at"] = time.Now()
+	}
+
+	res := r.gorm.DB.
+		Model(&UploadSession{}).
+		Where("uuid = ?", uid).
+		Where("status = ?", from).
+		Updates(values)
+
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *Repository) ExtendUploadSession(uid uuid.UUID, expiresAt time.Time) error {
+	return r.gorm.DB.
+		Model(&UploadSession{}).
+		Where("uuid = ?", uid).
+		Update("expires_at", expiresAt).Error
+}
+
+// GetExpiredUploadSessions - брошенные загрузки и загрузки, завершение которых прервалось
+func (r *Repository) GetExpiredUploadSessions(now time.Time, limit int) (sessions []UploadSession, err error) {
+	res := r.gorm.DB.
+		Model(&UploadSession{}).
+		Where("status in ?", []string{UploadStatusActive, UploadStatusCompleting}).
+		Where("expires_at < ?", now).
+		Order("expires_at").
+		Limit(limit).
+		Find(&sessions)
+
+	return sessions, res.Error
+}
+
+// FindObjectFile возвращает файл, текущая или прошлая версия которого хранится в объекте
+func (r *Repository) FindObjectFile(objectName string) (fileUUID uuid.UUID, err error) {
+	var uids []uuid.UUID
+
+	err = r.gorm.DB.
+		Model(&File{}).
+		Where("object_name = ?", objectName).
+		Limit(1).
+		Pluck("uuid", &uids).Error
+	if err != nil {
+		return fileUUID, err
+	}
+
+	if len(uids) == 0 {
+		err = r.gorm.DB.
+			Model(&FileVersion{}).
+			Where("object_name = ?", objectName).
+			Limit(1).
+			Pluck("file_uuid", &uids).Error
+		if err != nil {
+			return fileUUID, err
+		}
+	}
+
+	if len(uids) == 0 {
+		return fileUUID, dto.NotFoundErr("файл не найден")
+	}
+
+	return uids[0], nil
+}
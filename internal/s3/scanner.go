@@ -0,0 +1,177 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	ScanStatusPending  = "pending"
+	ScanStatusClean    = "clean"
+	ScanStatusInfected = "infected"
+
+	ScannerNone  = "none"
+	ScannerClamd = "clamd"
+	ScannerStub  = "stub"
+)
+
+var (
+	ErrFilePending  = errors.New("файл проверяется антивирусом, попробуйте позже")
+	ErrFileInfected = errors.New("в файле найден вирус, скачивание запрещено")
+)
+
+// EICAR - тестовая сигнатура, которую находят все антивирусы
+const EICAR = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// ScanResult - результат проверки, Virus - имя найденной сигнатуры
+type ScanResult struct {
+	Clean bool
+	Virus string
+}
+
+// Scanner проверяет содержимое файла антивирусом
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (ScanResult, error)
+}
+
+// NewScanner создает сканер по имени из настроек, addr используется только для clamd
+func NewScanner(kind, addr string) (Scanner, error) {
+	switch kind {
+	case "", ScannerNone:
+		return nil, nil
+	case ScannerStub:
+		return StubScanner{}, nil
+	case ScannerClamd:
+		s, err := NewClamdScanner(addr)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("неизвестный антивирус: %s", kind)
+}
+
+// StubScanner находит только тестовую сигнатуру EICAR, для dev и тестов
+type StubScanner struct{}
+
+func (StubScanner) Scan(_ context.Context, r io.Reader) (ScanResult, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return ScanResult{}, err
+	}
+
+	if bytes.Contains(b, []byte(EICAR)) {
+		return ScanResult{Virus: "Eicar-Test-Signature"}, nil
+	}
+
+	return ScanResult{Clean: true}, nil
+}
+
+// ClamdScanner отправляет файл в clamd командой INSTREAM
+type ClamdScanner struct {
+	network string
+	addr    string
+
+	Timeout   time.Duration
+	ChunkSize int
+}
+
+// NewClamdScanner принимает адрес вида tcp://host:3310 или unix:///var/run/clamav/clamd.ctl
+func NewClamdScanner(addr string) (*ClamdScanner, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, fmt.Errorf("clamd: %w", err)
+	}
+
+	s := &ClamdScanner{
+		Timeout:   5 * time.Minute,
+		ChunkSize: 64 << 10,
+	}
+
+	switch u.Scheme {
+	case "tcp":
+		s.network, s.addr = "tcp", u.Host
+	case "unix":
+		s.network, s.addr = "unix", u.Path
+	default:
+		return nil, fmt.Errorf("clamd: неизвестная схема адреса %q", addr)
+	}
+
+	return s, nil
+}
+
+func (s *ClamdScanner) Scan(ctx context.Context, r io.Reader) (res ScanResult, err error) {
+	dialer := net.Dialer{Timeout: 10 * time.Second}
+
+	conn, err := dialer.DialContext(ctx, s.network, s.addr)
+	if err != nil {
+		return res, fmt.Errorf("clamd: %w", err)
+	}
+	defer conn.Close()
+
+	if s.Timeout > 0 {
+		_ = conn.SetDeadline(time.Now().Add(s.Timeout))
+	}
+
+	_, err = conn.Write([]byte("zINSTREAM\x00"))
+	if err != nil {
+		return res, fmt.Errorf("clamd: %w", err)
+	}
+
+	// поток режется на части с длиной в 4 байта big-endian, конец - часть нулевой длины
+	buf := make([]byte, 4+s.ChunkSize)
+	for {
+		n, rerr := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, err := conn.Write(buf[:4+n]); err != nil {
+				// clamd закрывает соединение при превышении StreamMaxLength, ответ еще можно прочитать
+				break
+			}
+		}
+
+		if errors.Is(rerr, io.EOF) || errors.Is(rerr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if rerr != nil {
+			return res, rerr
+		}
+	}
+
+	_, _ = conn.Write([]byte{0, 0, 0, 0})
+
+	reply, err := io.ReadAll(conn)
+	if err != nil && len(reply) == 0 {
+		return res, fmt.Errorf("clamd: %w", err)
+	}
+
+	return ParseClamdReply(string(reply))
+}
+
+// ParseClamdReply разбирает ответ clamd: "stream: OK", "stream: <virus> FOUND" или "<текст> ERROR"
+func ParseClamdReply(reply string) (res ScanResult, err error) {
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+
+	switch {
+	case strings.HasSuffix(reply, " FOUND"):
+		virus := strings.TrimSuffix(reply, " FOUND")
+		if i := strings.Index(virus, ": "); i >= 0 {
+			virus = virus[i+2:]
+		}
+		return ScanResult{Virus: virus}, nil
+
+	case strings.HasSuffix(reply, ": OK"):
+		return ScanResult{Clean: true}, nil
+	}
+
+	return res, fmt.Errorf("clamd: %s", reply)
+}
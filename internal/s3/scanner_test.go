@@ -0,0 +1,121 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestParseClamdReply(t *testing.T) {
+	cases := []struct {
+		reply string
+		clean bool
+		virus string
+		err   bool
+	}{
+		{"stream: OK\x00", true, "", false},
+		{"stream: Eicar-Test-Signature FOUND\x00", false, "Eicar-Test-Signature", false},
+		{"INSTREAM size limit exceeded. ERROR\x00", false, "", true},
+		{"", false, "", true},
+	}
+
+	for _, c := range cases {
+		res, err := ParseClamdReply(c.reply)
+		if (err != nil) != c.err || res.Clean != c.clean || res.Virus != c.virus {
+			t.Errorf("%q = %+v, %v", c.reply, res, err)
+		}
+	}
+}
+
+// fakeClamd принимает INSTREAM и отвечает как clamd, заражен поток с EICAR
+func fakeClamd(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			go func(conn net.Conn) {
+				defer conn.Close()
+
+				cmd := make([]byte, len("zINSTREAM\x00"))
+				if _, err := io.ReadFull(conn, cmd); err != nil || string(cmd) != "zINSTREAM\x00" {
+					conn.Write([]byte("UNKNOWN COMMAND\x00"))
+					return
+				}
+
+				var data bytes.Buffer
+				for {
+					var size uint32
+					if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+						return
+					}
+					if size == 0 {
+						break
+					}
+					if _, err := io.CopyN(&data, conn, int64(size)); err != nil {
+						return
+					}
+				}
+
+				if bytes.Contains(data.Bytes(), []byte(EICAR)) {
+					conn.Write([]byte("stream: Eicar-Test-Signature FOUND\x00"))
+					return
+				}
+				conn.Write([]byte("stream: OK\x00"))
+			}(conn)
+		}
+	}()
+
+	return "tcp://" + ln.Addr().String()
+}
+
+func TestClamdScanner(t *testing.T) {
+	scanner, err := NewScanner(ScannerClamd, fakeClamd(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner.(*ClamdScanner).ChunkSize = 16
+
+	res, err := scanner.Scan(context.Background(), strings.NewReader("just a document, nothing to see here"))
+	if err != nil || !res.Clean {
+		t.Errorf("clean = %+v, %v", res, err)
+	}
+
+	// сигнатура разрезана на несколько частей потока
+	res, err = scanner.Scan(context.Background(), strings.NewReader("prefix "+EICAR+" suffix"))
+	if err != nil || res.Clean || res.Virus != "Eicar-Test-Signature" {
+		t.Errorf("infected = %+v, %v", res, err)
+	}
+
+	if _, err := NewScanner(ScannerClamd, "clamd:3310"); err == nil {
+		t.Error("address without scheme must fail")
+	}
+
+	if _, err := NewScanner("kaspersky", ""); err == nil {
+		t.Error("unknown scanner must fail")
+	}
+}
+
+func TestStubScanner(t *testing.T) {
+	res, err := StubScanner{}.Scan(context.Background(), strings.NewReader(EICAR))
+	if err != nil || res.Clean {
+		t.Errorf("eicar = %+v, %v", res, err)
+	}
+
+	res, err = StubScanner{}.Scan(context.Background(), strings.NewReader("hello"))
+	if err != nil || !res.Clean {
+		t.Errorf("clean = %+v, %v", res, err)
+	}
+}
@@ -0,0 +1,522 @@
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5" //nolint:gosec // etag частей, как у S3
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
+)
+
+var (
+	ErrBlobSignature = errors.New("неверная подпись ссылки")
+	ErrBlobExpired   = errors.New("срок действия ссылки истек")
+	ErrBlobPath      = errors.New("недопустимое имя объекта")
+)
+
+const (
+	// BlobRoute - маршрут веб-приложения, через который FSStore отдает файлы
+	BlobRoute = "/blob"
+	// BlobUploadRoute - маршрут для загрузки частей составной загрузки (PUT)
+	BlobUploadRoute = "/blob-upload"
+
+	// части незавершенных загрузок лежат в root/_multipart/<uploadID>, имя бакета S3 не может начинаться с "_"
+	multipartDir = "_multipart"
+)
+
+// FSStore хранит объекты в папке root/<bucket>/<object>, ссылки подписываются HMAC и отдаются самим приложением
+type FSStore struct {
+	root       string
+	backendURL string
+	secret     []byte
+}
+
+func NewFSStore(root, backendURL, secret string) *FSStore {
+	return &FSStore{
+		root:       root,
+		backendURL: strings.TrimRight(backendURL, "/"),
+		secret:     []byte(secret),
+	}
+}
+
+// Path возвращает путь к объекту на диске, имена с выходом за пределы бакета отклоняются
+func (s *FSStore) Path(bucket, object string) (string, error) {
+	if bucket == "" {
+		bucket = "default"
+	}
+
+	if strings.ContainsAny(bucket, `/\`) || bucket == "." || bucket == ".." {
+		return "", ErrBlobPath
+	}
+
+	clean := path.Clean("/" + object)
+	if object == "" || clean == "/" || clean != "/"+object {
+		return "", ErrBlobPath
+	}
+
+	return filepath.Join(s.root, bucket, filepath.FromSlash(clean[1:])), nil
+}
+
+func (s *FSStore) Put(_ context.Context, bucket, object, filePath, _ string) error {
+	to, err := s.Path(bucket, object)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(to), 0o755)
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	src, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+	defer src.Close()
+
+	// пишем во временный файл рядом, чтобы читатели не увидели объект наполовину
+	tmp, err := os.CreateTemp(filepath.Dir(to), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, src)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), to)
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FSStore) Get(_ context.Context, bucket, object, filePath string) error {
+	from, err := s.Path(bucket, object)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(from)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return dto.NotFoundErr("файл не найден")
+		}
+		return fmt.Errorf("blob: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := os.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	_, err = io.Copy(dst, src)
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FSStore) Open(_ context.Context, bucket, object string) (io.ReadCloser, error) {
+	p, err := s.Path(bucket, object)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, dto.NotFoundErr("файл не найден")
+		}
+		return nil, fmt.Errorf("blob: %w", err)
+	}
+
+	return f, nil
+}
+
+func (s *FSStore) Delete(_ context.Context, bucket, object string) error {
+	p, err := s.Path(bucket, object)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(p)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FSStore) Stat(_ context.Context, bucket, object string) (info ObjectInfo, err error) {
+	p, err := s.Path(bucket, object)
+	if err != nil {
+		return info, err
+	}
+
+	stat, err := os.Stat(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return info, dto.NotFoundErr("файл не найден")
+		}
+		return info, fmt.Errorf("blob: %w", err)
+	}
+
+	if stat.IsDir() {
+		return info, dto.NotFoundErr("файл не найден")
+	}
+
+	return ObjectInfo{
+		Key:         object,
+		Size:        stat.Size(),
+		ContentType: mime.TypeByExtension(path.Ext(object)),
+		ModTime:     stat.ModTime(),
+	}, nil
+}
+
+func (s *FSStore) List(_ context.Context, bucket string) (res []ObjectInfo, err error) {
+	if bucket == "" {
+		bucket = "default"
+	}
+
+	dir := filepath.Join(s.root, bucket)
+
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		stat, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+		res = append(res, ObjectInfo{
+			Key:         key,
+			Size:        stat.Size(),
+			ContentType: mime.TypeByExtension(path.Ext(key)),
+			ModTime:     stat.ModTime(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return res, fmt.Errorf("blob: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *FSStore) Presign(_ context.Context, bucket, object, name string, ttl time.Duration) (string, error) {
+	if _, err := s.Path(bucket, object); err != nil {
+		return "", err
+	}
+
+	return s.signedURL(bucket, object, name, time.Now().Add(ttl).Unix()), nil
+}
+
+// URL возвращает бессрочную подписанную ссылку, так отдаются публичные файлы (фото профиля)
+func (s *FSStore) URL(bucket, object string) string {
+	return s.signedURL(bucket, object, "", 0)
+}
+
+func (s *FSStore) signedURL(bucket, object, name string, exp int64) string {
+	if bucket == "" {
+		bucket = "default"
+	}
+
+	q := url.Values{}
+	if exp > 0 {
+		q.Set("exp", strconv.FormatInt(exp, 10))
+	}
+	if name != "" {
+		q.Set("name", name)
+	}
+	q.Set("sig", s.sign(bucket, object, name, exp))
+
+	segments := strings.Split(object, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+
+	return fmt.Sprintf("%s%s/%s/%s?%s", s.backendURL, BlobRoute, url.PathEscape(bucket), strings.Join(segments, "/"), q.Encode())
+}
+
+func (s *FSStore) sign(bucket, object, name string, exp int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(bucket + "\n" + object + "\n" + name + "\n" + strconv.FormatInt(exp, 10)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись и срок ссылки, выданной Presign или URL, и возвращает имя файла для скачивания
+func (s *FSStore) Verify(bucket, object string, query url.Values) (name string, err error) {
+	var exp int64
+	if v := query.Get("exp"); v != "" {
+		exp, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return "", ErrBlobSignature
+		}
+	}
+
+	name = query.Get("name")
+
+	expected := s.sign(bucket, object, name, exp)
+	if !hmac.Equal([]byte(expected), []byte(query.Get("sig"))) {
+		return "", ErrBlobSignature
+	}
+
+	if exp > 0 && time.Now().Unix() > exp {
+		return "", ErrBlobExpired
+	}
+
+	return name, nil
+}
+
+func (s *FSStore) uploadDir(uploadID string) (string, error) {
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return "", dto.NotFoundErr("загрузка не найдена")
+	}
+
+	return filepath.Join(s.root, multipartDir, uploadID), nil
+}
+
+func (s *FSStore) CreateMultipart(_ context.Context, bucket, object, _ string) (string, error) {
+	if _, err := s.Path(bucket, object); err != nil {
+		return "", err
+	}
+
+	uploadID := uuid.New().String()
+
+	dir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return "", fmt.Errorf("blob: %w", err)
+	}
+
+	return uploadID, nil
+}
+
+func (s *FSStore) PresignPart(_ context.Context, _, _, uploadID string, number int, ttl time.Duration) (string, error) {
+	exp := time.Now().Add(ttl).Unix()
+
+	q := url.Values{}
+	q.Set("exp", strconv.FormatInt(exp, 10))
+	q.Set("sig", s.signPart(uploadID, number, exp))
+
+	return fmt.Sprintf("%s%s/%s/%d?%s", s.backendURL, BlobUploadRoute, url.PathEscape(uploadID), number, q.Encode()), nil
+}
+
+func (s *FSStore) signPart(uploadID string, number int, exp int64) string {
+	return s.sign(multipartDir, uploadID, strconv.Itoa(number), exp)
+}
+
+// VerifyPart проверяет подпись ссылки на загрузку части, выданной PresignPart
+func (s *FSStore) VerifyPart(uploadID string, number int, query url.Values) error {
+	exp, err := strconv.ParseInt(query.Get("exp"), 10, 64)
+	if err != nil {
+		return ErrBlobSignature
+	}
+
+	if !hmac.Equal([]byte(s.signPart(uploadID, number, exp)), []byte(query.Get("sig"))) {
+		return ErrBlobSignature
+	}
+
+	if time.Now().Unix() > exp {
+		return ErrBlobExpired
+	}
+
+	return nil
+}
+
+// PutPart сохраняет часть загрузки, повторная загрузка той же части ее перезаписывает
+func (s *FSStore) PutPart(uploadID string, number int, r io.Reader) (etag string, err error) {
+	dir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(dir); err != nil {
+		return "", dto.NotFoundErr("загрузка не найдена")
+	}
+
+	tmp, err := os.CreateTemp(dir, ".part-*")
+	if err != nil {
+		return "", fmt.Errorf("blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := md5.New() //nolint:gosec // etag частей, как у S3
+	_, err = io.Copy(io.MultiWriter(tmp, hash), r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", fmt.Errorf("blob: %w", err)
+	}
+
+	etag = hex.EncodeToString(hash.Sum(nil))
+	name := filepath.Join(dir, strconv.Itoa(number))
+
+	err = os.WriteFile(name+".etag", []byte(etag), 0o644)
+	if err != nil {
+		return "", fmt.Errorf("blob: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), name)
+	if err != nil {
+		return "", fmt.Errorf("blob: %w", err)
+	}
+
+	return etag, nil
+}
+
+func (s *FSStore) ListParts(_ context.Context, _, _, uploadID string) (parts []Part, err error) {
+	dir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return parts, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return parts, dto.NotFoundErr("загрузка не найдена")
+		}
+		return parts, fmt.Errorf("blob: %w", err)
+	}
+
+	for _, e := range entries {
+		number, err := strconv.Atoi(e.Name())
+		if err != nil || e.IsDir() {
+			continue
+		}
+
+		info, err := e.Info()
+		if err != nil {
+			return parts, fmt.Errorf("blob: %w", err)
+		}
+
+		etag, err := os.ReadFile(filepath.Join(dir, e.Name()+".etag"))
+		if err != nil {
+			return parts, fmt.Errorf("blob: %w", err)
+		}
+
+		parts = append(parts, Part{Number: number, ETag: string(etag), Size: info.Size()})
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].Number < parts[j].Number
+	})
+
+	return parts, nil
+}
+
+func (s *FSStore) CompleteMultipart(ctx context.Context, bucket, object, uploadID string, parts []Part) error {
+	dir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return err
+	}
+
+	// части склеиваются во временный файл внутри папки загрузки и переносятся через Put
+	joined, err := os.CreateTemp(dir, ".joined-*")
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+	defer os.Remove(joined.Name())
+
+	for _, p := range parts {
+		err = appendFile(joined, filepath.Join(dir, strconv.Itoa(p.Number)))
+		if err != nil {
+			joined.Close()
+			return err
+		}
+	}
+
+	err = joined.Close()
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	err = s.Put(ctx, bucket, object, joined.Name(), "")
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(dir)
+}
+
+func appendFile(dst io.Writer, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return dto.NotFoundErr("часть загрузки не найдена")
+		}
+		return fmt.Errorf("blob: %w", err)
+	}
+	defer f.Close()
+
+	_, err = io.Copy(dst, f)
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FSStore) AbortMultipart(_ context.Context, _, _, uploadID string) error {
+	dir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return err
+	}
+
+	err = os.RemoveAll(dir)
+	if err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	return nil
+}
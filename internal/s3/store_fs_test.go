@@ -0,0 +1,170 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisch/crm-backend/dto"
+)
+
+func TestFSStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewFSStore(t.TempDir(), "http://localhost:8080/", "secret")
+
+	src := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(src, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Put(ctx, "files", "fed/task/a.txt", src, "text/plain"); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := store.Stat(ctx, "files", "fed/task/a.txt")
+	if err != nil || info.Size != 5 || !strings.HasPrefix(info.ContentType, "text/plain") {
+		t.Errorf("stat = %+v, %v", info, err)
+	}
+
+	dst := filepath.Join(t.TempDir(), "b.txt")
+	if err := store.Get(ctx, "files", "fed/task/a.txt", dst); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := os.ReadFile(dst); string(b) != "hello" {
+		t.Errorf("get = %q", b)
+	}
+
+	list, err := store.List(ctx, "files")
+	if err != nil || len(list) != 1 || list[0].Key != "fed/task/a.txt" {
+		t.Errorf("list = %+v, %v", list, err)
+	}
+
+	if err := store.Delete(ctx, "files", "fed/task/a.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete(ctx, "files", "fed/task/a.txt"); err != nil {
+		t.Errorf("second delete = %v", err)
+	}
+
+	if _, err := store.Stat(ctx, "files", "fed/task/a.txt"); !errors.As(err, &dto.NotFoundError{}) {
+		t.Errorf("stat deleted = %v", err)
+	}
+
+	for _, object := range []string{"../x", "a/../../x", "/x", ""} {
+		if _, err := store.Path("files", object); !errors.Is(err, ErrBlobPath) {
+			t.Errorf("path %q = %v", object, err)
+		}
+	}
+}
+
+func TestFSStoreSignedURL(t *testing.T) {
+	store := NewFSStore(t.TempDir(), "http://localhost:8080", "secret")
+
+	link, err := store.Presign(context.Background(), "files", "fed/отчет 1.pdf", "Отчет.pdf", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Path != BlobRoute+"/files/fed/отчет 1.pdf" {
+		t.Errorf("path = %q", u.Path)
+	}
+
+	name, err := store.Verify("files", "fed/отчет 1.pdf", u.Query())
+	if err != nil || name != "Отчет.pdf" {
+		t.Errorf("verify = %q, %v", name, err)
+	}
+
+	if _, err := store.Verify("files", "fed/other.pdf", u.Query()); !errors.Is(err, ErrBlobSignature) {
+		t.Errorf("other object = %v", err)
+	}
+
+	q := u.Query()
+	q.Set("name", "evil.html")
+	if _, err := store.Verify("files", "fed/отчет 1.pdf", q); !errors.Is(err, ErrBlobSignature) {
+		t.Errorf("tampered name = %v", err)
+	}
+
+	if _, err := NewFSStore("", "", "other").Verify("files", "fed/отчет 1.pdf", u.Query()); !errors.Is(err, ErrBlobSignature) {
+		t.Errorf("other secret = %v", err)
+	}
+
+	expired, _ := store.Presign(context.Background(), "files", "a.pdf", "", -time.Minute)
+	u, _ = url.Parse(expired)
+	if _, err := store.Verify("files", "a.pdf", u.Query()); !errors.Is(err, ErrBlobExpired) {
+		t.Errorf("expired = %v", err)
+	}
+
+	// постоянная ссылка без срока для публичных файлов
+	u, _ = url.Parse(store.URL("", "photos-1.jpg"))
+	if _, err := store.Verify("default", "photos-1.jpg", u.Query()); err != nil || u.Query().Has("exp") {
+		t.Errorf("public url %s: %v", u, err)
+	}
+}
+
+func TestFSStoreMultipart(t *testing.T) {
+	ctx := context.Background()
+	store := NewFSStore(t.TempDir(), "http://localhost:8080", "secret")
+
+	uploadID, err := store.CreateMultipart(ctx, "files", "fed/big.bin", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	link, err := store.PresignPart(ctx, "files", "fed/big.bin", uploadID, 2, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, _ := url.Parse(link)
+	if err := store.VerifyPart(uploadID, 2, u.Query()); err != nil {
+		t.Errorf("verify part = %v", err)
+	}
+	if err := store.VerifyPart(uploadID, 1, u.Query()); !errors.Is(err, ErrBlobSignature) {
+		t.Errorf("other part = %v", err)
+	}
+
+	// части приходят в любом порядке, повторная загрузка перезаписывает часть
+	for _, p := range []struct {
+		n    int
+		data string
+	}{{2, "world"}, {1, "oops"}, {1, "hello "}} {
+		if _, err := store.PutPart(uploadID, p.n, strings.NewReader(p.data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	parts, err := store.ListParts(ctx, "files", "fed/big.bin", uploadID)
+	if err != nil || len(parts) != 2 || parts[0].Number != 1 || parts[0].Size != 6 || parts[0].ETag == "" {
+		t.Fatalf("parts = %+v, %v", parts, err)
+	}
+
+	if err := store.CompleteMultipart(ctx, "files", "fed/big.bin", uploadID, parts); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := filepath.Join(t.TempDir(), "big.bin")
+	if err := store.Get(ctx, "files", "fed/big.bin", dst); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := os.ReadFile(dst); string(b) != "hello world" {
+		t.Errorf("joined = %q", b)
+	}
+
+	if _, err := store.ListParts(ctx, "files", "fed/big.bin", uploadID); !errors.As(err, &dto.NotFoundError{}) {
+		t.Errorf("parts after complete = %v", err)
+	}
+
+	if _, err := store.PutPart("../../etc", 1, strings.NewReader("x")); !errors.As(err, &dto.NotFoundError{}) {
+		t.Errorf("bad upload id = %v", err)
+	}
+}
@@ -0,0 +1,289 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/krisch/crm-backend/dto"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/sirupsen/logrus"
+)
+
+type MinioStore struct {
+	endpoint        string
+	accessKeyID     string
+	secretAccessKey string
+	location        string // "ru-central1"
+	useSSL          bool
+}
+
+func NewMinioStore(endpoint, accessKeyID, secretAccessKey, location string, useSSL bool) *MinioStore {
+	return &MinioStore{
+		endpoint:        endpoint,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		location:        location,
+		useSSL:          useSSL,
+	}
+}
+
+func (s *MinioStore) client() (*minio.Client, error) {
+	client, err := minio.New(s.endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(s.accessKeyID, s.secretAccessKey, ""),
+		Secure: s.useSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("S3: %w", err)
+	}
+
+	return client, nil
+}
+
+func (s *MinioStore) ensureBucket(ctx context.Context, client *minio.Client, bucket string) error {
+	err := client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{Region: s.location})
+	if err != nil {
+		exists, errBucketExists := client.BucketExists(ctx, bucket)
+		if errBucketExists != nil || !exists {
+			return fmt.Errorf("S3: %w", err)
+		}
+	} else {
+		logrus.Infof("S3: successfully created %s\n", bucket)
+	}
+
+	return nil
+}
+
+func (s *MinioStore) Put(ctx context.Context, bucket, object, filePath, contentType string) error {
+	client, err := s.client()
+	if err != nil {
+		return err
+	}
+
+	err = s.ensureBucket(ctx, client, bucket)
+	if err != nil {
+		return err
+	}
+
+	info, err := client.FPutObject(ctx, bucket, object, filePath, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return fmt.Errorf("S3: %w", err)
+	}
+
+	logrus.Debugf("successfully uploaded %s of size %d\n", object, info.Size)
+
+	return nil
+}
+
+func (s *MinioStore) Get(ctx context.Context, bucket, object, filePath string) error {
+	client, err := s.client()
+	if err != nil {
+		return err
+	}
+
+	err = client.FGetObject(ctx, bucket, object, filePath, minio.GetObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("S3: %w", err)
+	}
+
+	return nil
+}
+
+func (s *MinioStore) Open(ctx context.Context, bucket, object string) (io.ReadCloser, error) {
+	client, err := s.client()
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := client.GetObject(ctx, bucket, object, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("S3: %w", err)
+	}
+
+	return obj, nil
+}
+
+func (s *MinioStore) Delete(ctx context.Context, bucket, object string) error {
+	client, err := s.client()
+	if err != nil {
+		return err
+	}
+
+	err = client.RemoveObject(ctx, bucket, object, minio.RemoveObjectOptions{
+		ForceDelete: true,
+	})
+	if err != nil {
+		return fmt.Errorf("S3: %w", err)
+	}
+
+	return nil
+}
+
+func (s *MinioStore) Stat(ctx context.Context, bucket, object string) (info ObjectInfo, err error) {
+	client, err := s.client()
+	if err != nil {
+		return info, err
+	}
+
+	stat, err := client.StatObject(ctx, bucket, object, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return info, dto.NotFoundErr("файл не найден")
+		}
+		return info, fmt.Errorf("S3: %w", err)
+	}
+
+	return ObjectInfo{
+		Key:         stat.Key,
+		Size:        stat.Size,
+		ContentType: stat.ContentType,
+		ModTime:     stat.LastModified,
+	}, nil
+}
+
+func (s *MinioStore) List(ctx context.Context, bucket string) (res []ObjectInfo, err error) {
+	client, err := s.client()
+	if err != nil {
+		return res, err
+	}
+
+	for obj := range client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			return res, fmt.Errorf("S3: %w", obj.Err)
+		}
+
+		res = append(res, ObjectInfo{
+			Key:         obj.Key,
+			Size:        obj.Size,
+			ContentType: obj.ContentType,
+			ModTime:     obj.LastModified,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *MinioStore) Presign(ctx context.Context, bucket, object, name string, ttl time.Duration) (string, error) {
+	client, err := s.client()
+	if err != nil {
+		return "", err
+	}
+
+	reqParams := make(url.Values)
+	reqParams.Set("response-content-disposition", fmt.Sprintf("filename=\"%q\"", name))
+
+	presignedURL, err := client.PresignedGetObject(ctx, bucket, object, ttl, reqParams)
+	if err != nil {
+		return "", fmt.Errorf("S3: %w", err)
+	}
+
+	return presignedURL.String(), nil
+}
+
+func (s *MinioStore) URL(bucket, object string) string {
+	return fmt.Sprintf("https://%s.%s/%s", bucket, s.endpoint, object)
+}
+
+func (s *MinioStore) CreateMultipart(ctx context.Context, bucket, object, contentType string) (string, error) {
+	client, err := s.client()
+	if err != nil {
+		return "", err
+	}
+
+	err = s.ensureBucket(ctx, client, bucket)
+	if err != nil {
+		return "", err
+	}
+
+	uploadID, err := minio.Core{Client: client}.NewMultipartUpload(ctx, bucket, object, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return "", fmt.Errorf("S3: %w", err)
+	}
+
+	return uploadID, nil
+}
+
+func (s *MinioStore) PresignPart(ctx context.Context, bucket, object, uploadID string, number int, ttl time.Duration) (string, error) {
+	client, err := s.client()
+	if err != nil {
+		return "", err
+	}
+
+	reqParams := make(url.Values)
+	reqParams.Set("uploadId", uploadID)
+	reqParams.Set("partNumber", strconv.Itoa(number))
+
+	presignedURL, err := client.Presign(ctx, http.MethodPut, bucket, object, ttl, reqParams)
+	if err != nil {
+		return "", fmt.Errorf("S3: %w", err)
+	}
+
+	return presignedURL.String(), nil
+}
+
+func (s *MinioStore) ListParts(ctx context.Context, bucket, object, uploadID string) (parts []Part, err error) {
+	client, err := s.client()
+	if err != nil {
+		return parts, err
+	}
+
+	core := minio.Core{Client: client}
+
+	marker := 0
+	for {
+		res, err := core.ListObjectParts(ctx, bucket, object, uploadID, marker, 1000)
+		if err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchUpload" {
+				return parts, dto.NotFoundErr("загрузка не найдена")
+			}
+			return parts, fmt.Errorf("S3: %w", err)
+		}
+
+		for _, p := range res.ObjectParts {
+			parts = append(parts, Part{Number: p.PartNumber, ETag: p.ETag, Size: p.Size})
+		}
+
+		if !res.IsTruncated {
+			return parts, nil
+		}
+		marker = res.NextPartNumberMarker
+	}
+}
+
+func (s *MinioStore) CompleteMultipart(ctx context.Context, bucket, object, uploadID string, parts []Part) error {
+	client, err := s.client()
+	if err != nil {
+		return err
+	}
+
+	complete := make([]minio.CompletePart, 0, len(parts))
+	for _, p := range parts {
+		complete = append(complete, minio.CompletePart{PartNumber: p.Number, ETag: p.ETag})
+	}
+
+	_, err = minio.Core{Client: client}.CompleteMultipartUpload(ctx, bucket, object, uploadID, complete, minio.PutObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("S3: %w", err)
+	}
+
+	return nil
+}
+
+func (s *MinioStore) AbortMultipart(ctx context.Context, bucket, object, uploadID string) error {
+	client, err := s.client()
+	if err != nil {
+		return err
+	}
+
+	err = minio.Core{Client: client}.AbortMultipartUpload(ctx, bucket, object, uploadID)
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchUpload" {
+		return fmt.Errorf("S3: %w", err)
+	}
+
+	return nil
+}
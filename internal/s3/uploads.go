@@ -0,0 +1,527 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	UploadStatusActive     = "active"
+	UploadStatusCompleting = "completing"
+	UploadStatusCompleted  = "completed"
+	UploadStatusFailed     = "failed"
+	UploadStatusAborted    = "aborted"
+
+	// UploadPartSize - размер части по умолчанию, S3 требует не меньше 5 МБ для всех частей кроме последней
+	UploadPartSize = 16 << 20
+	UploadMaxParts = 10000
+
+	// UploadSessionTTL - через сколько после последнего обращения незавершенная загрузка удаляется
+	UploadSessionTTL = 24 * time.Hour
+	// UploadCompletingTTL - через сколько завершение загрузки считается прерванным (например, рестартом)
+	UploadCompletingTTL = 30 * time.Minute
+	// UploadPartTTL - время жизни ссылок на загрузку частей, за новыми клиент приходит повторно
+	UploadPartTTL = time.Hour
+
+	DefaultMaxUploadSize = 5 << 30
+
+	uploadSniffSize = 64 << 10
+)
+
+// запрещены исполняемые файлы: их нельзя безопасно открыть из задачи
+var (
+	errExecutableUpload = errors.New("исполняемые файлы загружать нельзя")
+
+	blockedUploadExts = []string{
+		".exe", ".msi", ".bat", ".cmd", ".com", ".scr", ".pif", ".cpl",
+		".vbs", ".vbe", ".ps1", ".jar", ".apk", ".dll", ".sys",
+	}
+	blockedUploadMimes = []string{
+		"application/vnd.microsoft.portable-executable",
+		"application/x-msdownload",
+		"application/x-dosexec",
+		"application/x-ms-installer",
+		"application/x-msi",
+		"application/x-elf",
+		"application/x-mach-binary",
+		"application/java-archive",
+		"application/vnd.android.package-archive",
+	}
+)
+
+// UploadRequest - параметры новой составной загрузки, CommentUUID задается для вложений комментария
+type UploadRequest struct {
+	FederationUUID uuid.UUID
+	TaskUUID       uuid.UUID
+	CommentUUID    *uuid.UUID
+
+	Name     string
+	Size     int64
+	MimeType string
+
+	UserUUID uuid.UUID
+}
+
+// UploadPart - часть загрузки: уже загруженная (Uploaded) или ссылка для ее загрузки
+type UploadPart struct {
+	Number   int
+	Size     int64
+	ETag     string
+	Uploaded bool
+	URL      string
+}
+
+// UploadState - загрузка и состояние ее частей, по нему клиент продолжает прерванную загрузку
+type UploadState struct {
+	Session UploadSession
+	Parts   []UploadPart
+}
+
+// ValidateUpload проверяет имя, размер и тип файла до начала загрузки
+func ValidateUpload(name string, size, maxSize int64, mime string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("не указано имя файла")
+	}
+
+	if utf8.RuneCountInString(name) > 50 {
+		return errors.New("имя файла длиннее 50 символов")
+	}
+
+	if size <= 0 {
+		return errors.New("не указан размер файла")
+	}
+
+	if maxSize > 0 && size > maxSize {
+		return fmt.Errorf("файл больше %d МБ", maxSize>>20)
+	}
+
+	if lo.Contains(blockedUploadExts, strings.ToLower(helpers.FileExt(name))) {
+		return errExecutableUpload
+	}
+
+	return ValidateUploadMime(mime)
+}
+
+// ValidateUploadMime отклоняет исполняемые файлы по типу содержимого
+func ValidateUploadMime(mime string) error {
+	if mime == "" {
+		return nil
+	}
+
+	mime = strings.ToLower(strings.TrimSpace(strings.Split(mime, ";")[0]))
+	if lo.Contains(blockedUploadMimes, mime) {
+		return errExecutableUpload
+	}
+
+	// у mimetype подтипы наследуются от родителя, например варианты exe
+	for m := mimetype.Lookup(mime); m != nil; m = m.Parent() {
+		if lo.Contains(blockedUploadMimes, m.String()) {
+			return errExecutableUpload
+		}
+	}
+
+	return nil
+}
+
+// UploadPartLayout возвращает размер части и их количество: части растут, если файл не влезает в UploadMaxParts
+func UploadPartLayout(size int64) (partSize int64, count int) {
+	partSize = UploadPartSize
+	if size > partSize*UploadMaxParts {
+		partSize = (size + UploadMaxParts - 1) / UploadMaxParts
+		// округляем до мегабайта вверх
+		partSize = (partSize + 1<<20 - 1) &^ (1<<20 - 1)
+	}
+
+	count = int((size + partSize - 1) / partSize)
+
+	return partSize, count
+}
+
+func (session UploadSession) partSize(number int) int64 {
+	if number == session.PartsCount {
+		return session.Size - session.PartSize*int64(session.PartsCount-1)
+	}
+
+	return session.PartSize
+}
+
+// CreateUpload начинает составную загрузку файла в задачу или комментарий
+func (s3 *ServicePrivate) CreateUpload(req UploadRequest) (state UploadState, err error) {
+	err = ValidateUpload(req.Name, req.Size, s3.maxUploadSize, req.MimeType)
+	if err != nil {
+		return state, err
+	}
+
+	partSize, count := UploadPartLayout(req.Size)
+
+	ext := helpers.FileExt(req.Name)
+	objectName := fmt.Sprintf("%s/task/%s/%s%s", req.FederationUUID, req.TaskUUID, uuid.New().String(), ext)
+
+	uploadID, err := s3.store.CreateMultipart(context.Background(), s3.bucketName, objectName, req.MimeType)
+	if err != nil {
+		return state, err
+	}
+
+	session := UploadSession{
+		UUID:           uuid.New(),
+		FederationUUID: req.FederationUUID,
+		TaskUUID:       req.TaskUUID,
+		CommentUUID:    req.CommentUUID,
+
+		Name:       strings.TrimSpace(req.Name),
+		Size:       req.Size,
+		MimeType:   req.MimeType,
+		PartSize:   partSize,
+		PartsCount: count,
+
+		BucketName: s3.bucketName,
+		ObjectName: objectName,
+		UploadID:   uploadID,
+		Status:     UploadStatusActive,
+
+		CreatedBy: req.UserUUID,
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(UploadSessionTTL),
+	}
+
+	err = s3.repo.CreateUploadSession(session)
+	if err != nil {
+		return state, err
+	}
+
+	return s3.uploadState(session, nil)
+}
+
+// GetUpload возвращает состояние загрузки со свежими ссылками на недостающие части и продлевает ее
+func (s3 *ServicePrivate) GetUpload(taskUUID, sessionUUID, userUUID uuid.UUID) (state UploadState, err error) {
+	session, err := s3.userUploadSession(taskUUID, sessionUUID, userUUID)
+	if err != nil {
+		return state, err
+	}
+
+	if session.Status != UploadStatusActive {
+		return UploadState{Session: session}, nil
+	}
+
+	parts, err := s3.store.ListParts(context.Background(), session.BucketName, session.ObjectName, session.UploadID)
+	if err != nil {
+		return state, err
+	}
+
+	session.ExpiresAt = time.Now().Add(UploadSessionTTL)
+	err = s3.repo.ExtendUploadSession(session.UUID, session.ExpiresAt)
+	if err != nil {
+		return state, err
+	}
+
+	return s3.uploadState(session, parts)
+}
+
+func (s3 *ServicePrivate) uploadState(session UploadSession, uploaded []Part) (state UploadState, err error) {
+	byNumber := lo.KeyBy(uploaded, func(p Part) int { return p.Number })
+
+	state.Session = session
+	for n := 1; n <= session.PartsCount; n++ {
+		part := UploadPart{Number: n, Size: session.partSize(n)}
+
+		if p, ok := byNumber[n]; ok && p.Size == part.Size {
+			part.Uploaded = true
+			part.ETag = p.ETag
+		} else {
+			part.URL, err = s3.store.PresignPart(context.Background(), session.BucketName, session.ObjectName, session.UploadID, n, UploadPartTTL)
+			if err != nil {
+				return state, err
+			}
+		}
+
+		state.Parts = append(state.Parts, part)
+	}
+
+	return state, nil
+}
+
+// CompleteUpload собирает загруженные части, проверяет файл и добавляет его в задачу или комментарий.
+// Повторный вызов для завершенной загрузки возвращает тот же файл.
+func (s3 *ServicePrivate) CompleteUpload(taskUUID, sessionUUID, userUUID uuid.UUID) (file File, err error) {
+	session, err := s3.userUploadSession(taskUUID, sessionUUID, userUUID)
+	if err != nil {
+		return file, err
+	}
+
+	switch session.Status {
+	case UploadStatusCompleted:
+		if session.FileUUID == nil {
+			return file, dto.NotFoundErr("файл не найден")
+		}
+		return s3.repo.GetFile(*session.FileUUID)
+	case UploadStatusActive:
+	case UploadStatusFailed:
+		return file, fmt.Errorf("загрузка отклонена: %s", session.Error)
+	default:
+		return file, errors.New("загрузка уже завершена")
+	}
+
+	ctx := context.Background()
+
+	parts, err := s3.store.ListParts(ctx, session.BucketName, session.ObjectName, session.UploadID)
+	if err != nil {
+		return file, err
+	}
+
+	byNumber := lo.KeyBy(parts, func(p Part) int { return p.Number })
+
+	complete := make([]Part, 0, session.PartsCount)
+	missing := []int{}
+	for n := 1; n <= session.PartsCount; n++ {
+		p, ok := byNumber[n]
+		if !ok || p.Size != session.partSize(n) {
+			missing = append(missing, n)
+			continue
+		}
+		complete = append(complete, p)
+	}
+
+	if len(missing) > 0 {
+		return file, fmt.Errorf("не загружены части: %v", missing)
+	}
+
+	ok, err := s3.repo.SetUploadStatus(session.UUID, UploadStatusActive, UploadStatusCompleting, "", nil)
+	if err != nil {
+		return file, err
+	}
+	if !ok {
+		return file, errors.New("загрузка уже завершается")
+	}
+
+	err = s3.store.CompleteMultipart(ctx, session.BucketName, session.ObjectName, session.UploadID, complete)
+	if err != nil {
+		// части остались в хранилище, загрузку можно завершить повторно
+		if _, rerr := s3.repo.SetUploadStatus(session.UUID, UploadStatusCompleting, UploadStatusActive, "", nil); rerr != nil {
+			logrus.Error(rerr)
+		}
+		return file, err
+	}
+
+	file, err = s3.registerUpload(ctx, session)
+	if err != nil {
+		if _, rerr := s3.repo.SetUploadStatus(session.UUID, UploadStatusCompleting, UploadStatusFailed, err.Error(), nil); rerr != nil {
+			logrus.Error(rerr)
+		}
+		if derr := s3.store.Delete(ctx, session.BucketName, session.ObjectName); derr != nil {
+			logrus.Warn(derr)
+		}
+		return file, err
+	}
+
+	_, err = s3.repo.SetUploadStatus(session.UUID, UploadStatusCompleting, UploadStatusCompleted, "", &file.UUID)
+
+	return file, err
+}
+
+// registerUpload проверяет собранный объект и создает по нему файл или новую версию файла задачи
+func (s3 *ServicePrivate) registerUpload(ctx context.Context, session UploadSession) (file File, err error) {
+	info, err := s3.store.Stat(ctx, session.BucketName, session.ObjectName)
+	if err != nil {
+		return file, err
+	}
+
+	if info.Size != session.Size {
+		return file, fmt.Errorf("размер файла %d не совпадает с заявленным %d", info.Size, session.Size)
+	}
+
+	mime, width, height, err := s3.sniffObject(ctx, session.BucketName, session.ObjectName)
+	if err != nil {
+		return file, err
+	}
+
+	err = ValidateUploadMime(mime)
+	if err != nil {
+		return file, err
+	}
+
+	file = File{
+		UUID: uuid.New(),
+
+		Type:     "task",
+		TypeUUID: session.TaskUUID,
+
+		Name:       session.Name,
+		ObjectName: session.ObjectName,
+		Size:       info.Size,
+		Ext:        helpers.FileExt(session.Name),
+
+		ImgWidth:  width,
+		ImgHeight: height,
+
+		MimeType:   mime,
+		BucketName: session.BucketName,
+		Endpoint:   s3.endpoint,
+		CreatedBy:  session.CreatedBy,
+	}
+
+	if session.CommentUUID != nil {
+		file.Type = "comment"
+		file.TypeUUID = *session.CommentUUID
+		file.TaskUUID = session.TaskUUID
+
+		return s3.registerFile(file)
+	}
+
+	// файл с таким же именем в задаче становится новой версией, как при обычной загрузке
+	exist, err := s3.repo.FindTaskFileByName(session.TaskUUID, session.Name)
+	if err != nil {
+		if errors.As(err, &dto.NotFoundError{}) {
+			return s3.registerFile(file)
+		}
+		return file, err
+	}
+
+	return s3.registerVersion(exist, FileVersion{
+		UUID:     uuid.New(),
+		FileUUID: exist.UUID,
+
+		Name:       file.Name,
+		ObjectName: file.ObjectName,
+		Size:       file.Size,
+		Ext:        file.Ext,
+		MimeType:   file.MimeType,
+		ImgWidth:   file.ImgWidth,
+		ImgHeight:  file.ImgHeight,
+
+		CreatedBy: file.CreatedBy,
+	})
+}
+
+// sniffObject определяет тип по началу объекта и размеры картинки, не скачивая файл целиком
+func (s3 *ServicePrivate) sniffObject(ctx context.Context, bucket, object string) (mime string, width, height int, err error) {
+	r, err := s3.store.Open(ctx, bucket, object)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	defer r.Close()
+
+	head, err := io.ReadAll(io.LimitReader(r, uploadSniffSize))
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("S3: %w", err)
+	}
+
+	mime = mimetype.Detect(head).String()
+
+	if helpers.FileMimeIsImage(mime) {
+		cfg, _, err := image.DecodeConfig(io.MultiReader(bytes.NewReader(head), r))
+		if err == nil {
+			width, height = cfg.Width, cfg.Height
+		}
+	}
+
+	return mime, width, height, nil
+}
+
+// AbortUpload отменяет загрузку и удаляет загруженные части
+func (s3 *ServicePrivate) AbortUpload(taskUUID, sessionUUID, userUUID uuid.UUID) error {
+	session, err := s3.userUploadSession(taskUUID, sessionUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if session.Status != UploadStatusActive {
+		return errors.New("загрузка уже завершена")
+	}
+
+	return s3.abortUpload(session)
+}
+
+func (s3 *ServicePrivate) abortUpload(session UploadSession) error {
+	ok, err := s3.repo.SetUploadStatus(session.UUID, UploadStatusActive, UploadStatusAborted, "", nil)
+	if err != nil || !ok {
+		return err
+	}
+
+	return s3.store.AbortMultipart(context.Background(), session.BucketName, session.ObjectName, session.UploadID)
+}
+
+// CleanupUploads отменяет брошенные загрузки и разбирает прерванные завершения, возвращает их количество
+func (s3 *ServicePrivate) CleanupUploads() (int, error) {
+	sessions, err := s3.repo.GetExpiredUploadSessions(time.Now(), 100)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, session := range sessions {
+		if session.Status == UploadStatusCompleting {
+			err = s3.recoverUpload(session)
+		} else {
+			err = s3.abortUpload(session)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(sessions), nil
+}
+
+// recoverUpload закрывает загрузку, завершение которой прервалось: если файл успел создаться, загрузка
+// завершена, иначе она отклоняется, а части и собранный объект удаляются
+func (s3 *ServicePrivate) recoverUpload(session UploadSession) error {
+	fileUUID, err := s3.repo.FindObjectFile(session.ObjectName)
+	if err == nil {
+		_, err = s3.repo.SetUploadStatus(session.UUID, UploadStatusCompleting, UploadStatusCompleted, "", &fileUUID)
+		return err
+	}
+
+	if !errors.As(err, &dto.NotFoundError{}) {
+		return err
+	}
+
+	ok, err := s3.repo.SetUploadStatus(session.UUID, UploadStatusCompleting, UploadStatusFailed, "завершение загрузки прервано", nil)
+	if err != nil || !ok {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if err := s3.store.AbortMultipart(ctx, session.BucketName, session.ObjectName, session.UploadID); err != nil {
+		logrus.Warn(err)
+	}
+	if err := s3.store.Delete(ctx, session.BucketName, session.ObjectName); err != nil {
+		logrus.Warn(err)
+	}
+
+	return nil
+}
+
+// MaxPartSize - наибольший размер части для файла предельного размера
+func (s3 *ServicePrivate) MaxPartSize() int64 {
+	partSize, _ := UploadPartLayout(s3.maxUploadSize)
+	return partSize
+}
+
+func (s3 *ServicePrivate) userUploadSession(taskUUID, sessionUUID, userUUID uuid.UUID) (session UploadSession, err error) {
+	session, err = s3.repo.GetUploadSession(sessionUUID)
+	if err != nil {
+		return session, err
+	}
+
+	// чужие загрузки не видны
+	if session.TaskUUID != taskUUID || session.CreatedBy != userUUID {
+		return session, dto.NotFoundErr("загрузка не найдена")
+	}
+
+	return session, nil
+}
@@ -0,0 +1,59 @@
+package s3
+
+import "testing"
+
+func TestValidateUpload(t *testing.T) {
+	cases := []struct {
+		name string
+		size int64
+		mime string
+		ok   bool
+	}{
+		{"report.pdf", 10, "application/pdf", true},
+		{"photo.JPG", 10, "", true},
+		{"", 10, "", false},
+		{"report.pdf", 0, "", false},
+		{"report.pdf", 101, "", false},
+		{"setup.EXE", 10, "", false},
+		{"setup.bin", 10, "application/x-msdownload", false},
+		{"setup.bin", 10, "application/vnd.microsoft.portable-executable; charset=binary", false},
+		{"очень длинное имя файла, которое не влезет в пятьдесят символов.txt", 10, "", false},
+	}
+
+	for _, c := range cases {
+		err := ValidateUpload(c.name, c.size, 100, c.mime)
+		if (err == nil) != c.ok {
+			t.Errorf("%q %d %q: %v", c.name, c.size, c.mime, err)
+		}
+	}
+}
+
+func TestUploadPartLayout(t *testing.T) {
+	cases := []struct {
+		size     int64
+		partSize int64
+		count    int
+	}{
+		{1, UploadPartSize, 1},
+		{UploadPartSize, UploadPartSize, 1},
+		{UploadPartSize + 1, UploadPartSize, 2},
+		{UploadPartSize * UploadMaxParts, UploadPartSize, UploadMaxParts},
+		{UploadPartSize*UploadMaxParts + 1, 17 << 20, 9412},
+	}
+
+	for _, c := range cases {
+		partSize, count := UploadPartLayout(c.size)
+		if partSize != c.partSize || count != c.count {
+			t.Errorf("%d: got %d x %d, want %d x %d", c.size, partSize, count, c.partSize, c.count)
+		}
+
+		if count > UploadMaxParts {
+			t.Errorf("%d: too many parts %d", c.size, count)
+		}
+	}
+
+	session := UploadSession{Size: UploadPartSize*2 + 5, PartSize: UploadPartSize, PartsCount: 3}
+	if session.partSize(1) != UploadPartSize || session.partSize(3) != 5 {
+		t.Errorf("part sizes = %d, %d", session.partSize(1), session.partSize(3))
+	}
+}
@@ -0,0 +1,20 @@
+package search
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// IBackend - поисковый движок (postgres tsvector по умолчанию, позже elastic)
+type IBackend interface {
+	Index(ctx context.Context, docs []domain.SearchDocument) error
+	Delete(ctx context.Context, uids []uuid.UUID) error
+	DeleteByTask(ctx context.Context, taskUUID uuid.UUID) error
+	Search(ctx context.Context, query domain.SearchQuery) ([]domain.SearchHit, int64, error)
+}
+
+type IDictionary interface {
+	GetUserCompanies(userUUID uuid.UUID) []uuid.UUID
+}
@@ -0,0 +1,65 @@
+package search
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func New(repo *Repository, backend IBackend, dict IDictionary) *Service {
+	return &Service{
+		repo:    repo,
+		backend: backend,
+		dict:    dict,
+	}
+}
+
+// IndexTask переиндексирует задачу вместе с комментариями и файлами
+func (s *Service) IndexTask(ctx context.Context, uid uuid.UUID) error {
+	docs, removed, deleted, err := s.repo.TaskDocuments(uid)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		return s.backend.DeleteByTask(ctx, uid)
+	}
+
+	err = s.backend.Delete(ctx, removed)
+	if err != nil {
+		return err
+	}
+
+	return s.backend.Index(ctx, docs)
+}
+
+// IndexFile переиндексирует задачу, к которой относится файл
+func (s *Service) IndexFile(ctx context.Context, fileUUID uuid.UUID) error {
+	taskUUID, err := s.repo.FileTaskUUID(fileUUID)
+	if err != nil {
+		return err
+	}
+
+	return s.IndexTask(ctx, taskUUID)
+}
+
+func (s *Service) IndexCatalogData(ctx context.Context, uid uuid.UUID) error {
+	doc, deleted, err := s.repo.CatalogDataDocument(uid)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		return s.backend.Delete(ctx, []uuid.UUID{uid})
+	}
+
+	return s.backend.Index(ctx, []domain.SearchDocument{doc})
+}
+
+// Search ищет только по компаниям, в которых состоит пользователь
+func (s *Service) Search(ctx context.Context, userUUID uuid.UUID, query domain.SearchQuery) ([]domain.SearchHit, int64, error) {
+	query.CompanyUUIDs = s.dict.GetUserCompanies(userUUID)
+
+	return s.backend.Search(ctx, query)
+}
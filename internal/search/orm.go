@@ -0,0 +1,73 @@
+package search
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type Document struct {
+	EntityUUID uuid.UUID  `gorm:"type:uuid;primary_key:true"`
+	EntityType string     `gorm:"type:varchar(20);not null"`
+	TaskUUID   *uuid.UUID `gorm:"type:uuid;"`
+
+	FederationUUID uuid.UUID  `gorm:"type:uuid;not null"`
+	CompanyUUID    uuid.UUID  `gorm:"type:uuid;not null"`
+	ProjectUUID    *uuid.UUID `gorm:"type:uuid;"`
+
+	Title string `gorm:"type:text;default:'';not null"`
+	Body  string `gorm:"type:text;default:'';not null"`
+
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (d *Document) TableName() string {
+	return "search_documents"
+}
+
+type Hit struct {
+	Document
+
+	Highlight string  `gorm:"->"`
+	Rank      float64 `gorm:"->"`
+	Total     int64   `gorm:"->"`
+}
+
+type taskRow struct {
+	UUID           uuid.UUID
+	Name           string
+	Description    string
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
+
+type commentRow struct {
+	UUID      uuid.UUID
+	Comment   string
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+	HiddenAt  *time.Time
+}
+
+type fileRow struct {
+	UUID        uuid.UUID
+	Name        string
+	TextContent string
+	CreatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+type catalogDataRow struct {
+	UUID           uuid.UUID
+	ID             *int64
+	CatalogName    string
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	Fields         datatypes.JSONMap
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
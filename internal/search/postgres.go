@@ -0,0 +1,151 @@
+package search
+
+import (
+	"context"
+	"html"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
+	"gorm.io/gorm/clause"
+)
+
+// маркеры совпадений в ts_headline, заменяются на <mark> после экранирования текста
+const (
+	startSel = "\uE000"
+	stopSel  = "\uE001"
+)
+
+// PostgresBackend - поиск по tsvector (русская и английская конфигурации)
+type PostgresBackend struct {
+	gorm *postgres.GDB
+}
+
+func NewPostgresBackend(db *postgres.GDB) *PostgresBackend {
+	return &PostgresBackend{
+		gorm: db,
+	}
+}
+
+func (b *PostgresBackend) Index(_ context.Context, docs []domain.SearchDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	orms := lo.Map(docs, func(d domain.SearchDocument, _ int) Document {
+		return Document{
+			EntityUUID:     d.EntityUUID,
+			EntityType:     d.EntityType,
+			TaskUUID:       d.TaskUUID,
+			FederationUUID: d.FederationUUID,
+			CompanyUUID:    d.CompanyUUID,
+			ProjectUUID:    d.ProjectUUID,
+			Title:          d.Title,
+			Body:           d.Body,
+			UpdatedAt:      d.UpdatedAt,
+		}
+	})
+
+	return b.gorm.DB.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "entity_uuid"}},
+			DoUpdates: clause.AssignmentColumns([]string{"entity_type", "task_uuid", "federation_uuid", "company_uuid", "project_uuid", "title", "body", "updated_at"}),
+		}).
+		Create(&orms).
+		Error
+}
+
+func (b *PostgresBackend) Delete(_ context.Context, uids []uuid.UUID) error {
+	if len(uids) == 0 {
+		return nil
+	}
+
+	return b.gorm.DB.Where("entity_uuid in (?)", uids).Delete(&Document{}).Error
+}
+
+func (b *PostgresBackend) DeleteByTask(_ context.Context, taskUUID uuid.UUID) error {
+	return b.gorm.DB.Where("task_uuid = ?", taskUUID).Delete(&Document{}).Error
+}
+
+func (b *PostgresBackend) Search(_ context.Context, q domain.SearchQuery) (hits []domain.SearchHit, total int64, err error) {
+	if len(q.CompanyUUIDs) == 0 {
+		return hits, 0, nil
+	}
+
+	where, filter := searchFilter(q)
+
+	args := append([]interface{}{q.Query, q.Query}, filter...)
+	args = append(args, q.Limit, q.Offset, "StartSel="+startSel+", StopSel="+stopSel+", MaxFragments=2, MaxWords=20, MinWords=5")
+
+	// ts_headline дорогой, поэтому считается только для строк отданной страницы
+	orms := []Hit{}
+	err = b.gorm.DB.
+		Raw(`with q as (select websearch_to_tsquery('russian', ?) || websearch_to_tsquery('english', ?) as query),
+			page as (
+				select d.*, ts_rank(d.tsv, q.query) as rank, count(*) over() as total
+				from search_documents d, q
+				where `+where+`
+				order by rank desc, d.updated_at desc
+				limit ? offset ?
+			)
+			select page.*, ts_headline('russian', page.title || ' ' || page.body, q.query, ?) as highlight
+			from page, q
+			order by page.rank desc, page.updated_at desc`, args...).
+		Scan(&orms).
+		Error
+	if err != nil {
+		return hits, 0, err
+	}
+
+	for _, o := range orms {
+		total = o.Total
+
+		hits = append(hits, domain.SearchHit{
+			EntityType:     o.EntityType,
+			EntityUUID:     o.EntityUUID,
+			TaskUUID:       o.TaskUUID,
+			FederationUUID: o.FederationUUID,
+			ProjectUUID:    o.ProjectUUID,
+			Title:          o.Title,
+			Highlight:      highlight(o.Highlight),
+			Rank:           o.Rank,
+			UpdatedAt:      o.UpdatedAt,
+		})
+	}
+
+	return hits, total, nil
+}
+
+// searchFilter - условие на документы страницы: совпадение с запросом и доступные компании,
+// затем необязательные фильтры по федерации, проекту и типам
+func searchFilter(q domain.SearchQuery) (string, []interface{}) {
+	where := []string{"d.tsv @@ q.query", "d.company_uuid in (?)"}
+	args := []interface{}{q.CompanyUUIDs}
+
+	if q.FederationUUID != nil {
+		where = append(where, "d.federation_uuid = ?")
+		args = append(args, *q.FederationUUID)
+	}
+
+	if q.ProjectUUID != nil {
+		where = append(where, "d.project_uuid = ?")
+		args = append(args, *q.ProjectUUID)
+	}
+
+	if len(q.Types) > 0 {
+		where = append(where, "d.entity_type in (?)")
+		args = append(args, q.Types)
+	}
+
+	return strings.Join(where, " and "), args
+}
+
+func highlight(s string) string {
+	s = html.EscapeString(s)
+	s = strings.ReplaceAll(s, startSel, "<mark>")
+	s = strings.ReplaceAll(s, stopSel, "</mark>")
+
+	return s
+}
@@ -0,0 +1,188 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Repository читает исходные данные для индексации
+type Repository struct {
+	gorm      *postgres.GDB
+	histogram *prometheus.HistogramVec
+}
+
+func NewRepository(db *postgres.GDB, metrics *helpers.MetricsCounters) *Repository {
+	return &Repository{
+		gorm:      db,
+		histogram: metrics.RepoHistogram,
+	}
+}
+
+func (r *Repository) storeTime(name string, time *helpers.Time) {
+	func() { r.histogram.WithLabelValues(name).Observe(time.Secondsf()) }()
+}
+
+func tm() *helpers.Time {
+	return helpers.NewTime()
+}
+
+// TaskDocuments возвращает документы задачи, её комментариев и файлов.
+// removed - удалённые или скрытые сущности, которые нужно убрать из индекса.
+func (r *Repository) TaskDocuments(uid uuid.UUID) (docs []domain.SearchDocument, removed []uuid.UUID, deleted bool, err error) {
+	defer r.storeTime("TaskDocuments", tm())
+
+	task := taskRow{}
+	res := r.gorm.DB.
+		Raw("select uuid, name, description, federation_uuid, company_uuid, project_uuid, updated_at, deleted_at from tasks where uuid = ?", uid).
+		Scan(&task)
+	if res.Error != nil {
+		return nil, nil, false, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return nil, nil, false, dto.NotFoundErr("задача не найдена")
+	}
+
+	if task.DeletedAt != nil {
+		return nil, nil, true, nil
+	}
+
+	doc := func(tp string, entityUUID uuid.UUID, title, body string) domain.SearchDocument {
+		return domain.SearchDocument{
+			EntityType:     tp,
+			EntityUUID:     entityUUID,
+			TaskUUID:       &task.UUID,
+			FederationUUID: task.FederationUUID,
+			CompanyUUID:    task.CompanyUUID,
+			ProjectUUID:    &task.ProjectUUID,
+			Title:          title,
+			Body:           body,
+			UpdatedAt:      task.UpdatedAt,
+		}
+	}
+
+	docs = append(docs, doc(domain.SearchTypeTask, task.UUID, task.Name, task.Description))
+
+	comments := []commentRow{}
+	err = r.gorm.DB.
+		Raw("select uuid, comment, updated_at, deleted_at, hidden_at from comments where task_uuid = ?", uid).
+		Scan(&comments).
+		Error
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	commentUUIDs := []uuid.UUID{}
+	for _, c := range comments {
+		commentUUIDs = append(commentUUIDs, c.UUID)
+
+		if c.DeletedAt != nil || c.HiddenAt != nil {
+			removed = append(removed, c.UUID)
+			continue
+		}
+
+		d := doc(domain.SearchTypeComment, c.UUID, task.Name, c.Comment)
+		d.UpdatedAt = c.UpdatedAt
+		docs = append(docs, d)
+	}
+
+	files := []fileRow{}
+	err = r.gorm.DB.
+		Raw("select uuid, name, text_content, created_at, deleted_at from files where (type = 'task' and type_uuid = ?) or (type = 'comment' and type_uuid in (?))", uid, append(commentUUIDs, uuid.Nil)).
+		Scan(&files).
+		Error
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	for _, f := range files {
+		if f.DeletedAt != nil {
+			removed = append(removed, f.UUID)
+			continue
+		}
+
+		d := doc(domain.SearchTypeFile, f.UUID, f.Name, f.TextContent)
+		d.UpdatedAt = f.CreatedAt
+		docs = append(docs, d)
+	}
+
+	return docs, removed, false, nil
+}
+
+// CatalogDataDocument возвращает документ строки справочника, deleted - строка удалена
+func (r *Repository) CatalogDataDocument(uid uuid.UUID) (doc domain.SearchDocument, deleted bool, err error) {
+	defer r.storeTime("CatalogDataDocument", tm())
+
+	row := catalogDataRow{}
+	res := r.gorm.DB.
+		Raw(`select cd.uuid, cd.id, c.name as catalog_name, cd.federation_uuid, cd.company_uuid, cd.fields, cd.updated_at, coalesce(cd.deleted_at, c.deleted_at) as deleted_at
+			from catalog_data cd join catalogs c on c.uuid = cd.catalog_uuid where cd.uuid = ?`, uid).
+		Scan(&row)
+	if res.Error != nil {
+		return doc, false, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return doc, false, dto.NotFoundErr("запись справочника не найдена")
+	}
+
+	if row.DeletedAt != nil {
+		return doc, true, nil
+	}
+
+	title := row.CatalogName
+	if row.ID != nil {
+		title = fmt.Sprintf("%s #%d", row.CatalogName, *row.ID)
+	}
+
+	keys := make([]string, 0, len(row.Fields))
+	for k := range row.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := []string{}
+	for _, k := range keys {
+		if v := row.Fields[k]; v != nil {
+			values = append(values, fmt.Sprint(v))
+		}
+	}
+
+	return domain.SearchDocument{
+		EntityType:     domain.SearchTypeCatalog,
+		EntityUUID:     row.UUID,
+		FederationUUID: row.FederationUUID,
+		CompanyUUID:    row.CompanyUUID,
+		Title:          title,
+		Body:           strings.Join(values, " "),
+		UpdatedAt:      row.UpdatedAt,
+	}, false, nil
+}
+
+// FileTaskUUID возвращает задачу, к которой прикреплен файл напрямую или через комментарий
+func (r *Repository) FileTaskUUID(fileUUID uuid.UUID) (uid uuid.UUID, err error) {
+	defer r.storeTime("FileTaskUUID", tm())
+
+	res := r.gorm.DB.
+		Raw(`select case when f.type = 'task' then f.type_uuid else c.task_uuid end
+			from files f left join comments c on f.type = 'comment' and c.uuid = f.type_uuid
+			where f.uuid = ? and f.type in ('task', 'comment')`, fileUUID).
+		Scan(&uid)
+	if res.Error != nil {
+		return uid, res.Error
+	}
+
+	if res.RowsAffected == 0 || uid == uuid.Nil {
+		return uid, dto.NotFoundErr("файл не найден")
+	}
+
+	return uid, nil
+}
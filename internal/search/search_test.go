@@ -0,0 +1,78 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestHighlight(t *testing.T) {
+	got := highlight("<b>счет</b> " + startSel + "Ромашка" + stopSel + " & <mark>")
+	want := "&lt;b&gt;счет&lt;/b&gt; <mark>Ромашка</mark> &amp; &lt;mark&gt;"
+
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestSearchFilter(t *testing.T) {
+	companies := []uuid.UUID{uuid.New()}
+	federation, project := uuid.New(), uuid.New()
+
+	where, args := searchFilter(domain.SearchQuery{Query: "счет", CompanyUUIDs: companies})
+	if where != "d.tsv @@ q.query and d.company_uuid in (?)" || len(args) != 1 {
+		t.Errorf("plain: %q %v", where, args)
+	}
+
+	where, args = searchFilter(domain.SearchQuery{
+		Query:          "счет",
+		CompanyUUIDs:   companies,
+		FederationUUID: &federation,
+		ProjectUUID:    &project,
+		Types:          []string{domain.SearchTypeTask},
+	})
+	if where != "d.tsv @@ q.query and d.company_uuid in (?) and d.federation_uuid = ? and d.project_uuid = ? and d.entity_type in (?)" {
+		t.Errorf("filters: %q", where)
+	}
+	if len(args) != 4 || args[1] != federation || args[2] != project {
+		t.Errorf("filters args: %v", args)
+	}
+}
+
+type dictStub map[uuid.UUID][]uuid.UUID
+
+func (d dictStub) GetUserCompanies(userUUID uuid.UUID) []uuid.UUID {
+	return d[userUUID]
+}
+
+type backendStub struct {
+	IBackend
+	query domain.SearchQuery
+}
+
+func (b *backendStub) Search(_ context.Context, q domain.SearchQuery) ([]domain.SearchHit, int64, error) {
+	b.query = q
+	return nil, 0, nil
+}
+
+func TestSearchCompanies(t *testing.T) {
+	user, company := uuid.New(), uuid.New()
+	backend := &backendStub{}
+
+	s := New(nil, backend, dictStub{user: {company}})
+
+	_, _, err := s.Search(context.Background(), user, domain.SearchQuery{Query: "счет", CompanyUUIDs: []uuid.UUID{uuid.New()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backend.query.CompanyUUIDs) != 1 || backend.query.CompanyUUIDs[0] != company {
+		t.Errorf("companies not scoped to user: %v", backend.query.CompanyUUIDs)
+	}
+
+	hits, total, err := NewPostgresBackend(nil).Search(context.Background(), domain.SearchQuery{Query: "счет"})
+	if err != nil || len(hits) != 0 || total != 0 {
+		t.Errorf("user without companies: %v %d %v", hits, total, err)
+	}
+}
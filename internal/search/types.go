@@ -0,0 +1,7 @@
+package search
+
+type Service struct {
+	repo    *Repository
+	backend IBackend
+	dict    IDictionary
+}
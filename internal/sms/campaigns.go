@@ -0,0 +1,483 @@
+package sms
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	CampaignDraft     = "draft"
+	CampaignScheduled = "scheduled"
+	CampaignSending   = "sending"
+	CampaignDone      = "done"
+	CampaignCanceled  = "canceled"
+)
+
+const (
+	RecipientPending  = "pending"
+	RecipientSending  = "sending"
+	RecipientSent     = "sent"
+	RecipientFailed   = "failed"
+	RecipientExcluded = "excluded"
+)
+
+const (
+	SourceAgents  = "agents"
+	SourceCatalog = "catalog"
+	SourceCSV     = "csv"
+)
+
+const (
+	// DefaultRateLimit - sms в минуту, если компания не задала свой лимит
+	DefaultRateLimit = 60
+	// CampaignTick - как часто воркер проверяет рассылки
+	CampaignTick = 10 * time.Second
+	// RecipientStuck - через сколько получатель в sending считается брошенным упавшим воркером
+	RecipientStuck = 10 * time.Minute
+)
+
+var placeholderRe = regexp.MustCompile(`\{\{\s*([\p{L}\p{N}_. -]+?)\s*\}\}`)
+
+// RenderTemplate подставляет в {{ключ}} значения vars, неизвестные ключи заменяются пустой строкой
+func RenderTemplate(tpl string, vars map[string]string) string {
+	return placeholderRe.ReplaceAllStringFunc(tpl, func(m string) string {
+		key := placeholderRe.FindStringSubmatch(m)[1]
+		if v, ok := vars[key]; ok {
+			return v
+		}
+
+		return vars[strings.ToLower(key)]
+	})
+}
+
+// NormalizePhone оставляет в номере только цифры, российский 8XXXXXXXXXX приводится к 7XXXXXXXXXX
+func NormalizePhone(phone string) (string, bool) {
+	digits := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+
+	if len(digits) == 11 && digits[0] == '8' {
+		digits = "7" + digits[1:]
+	}
+
+	if len(digits) == 10 && digits[0] == '9' {
+		digits = "7" + digits
+	}
+
+	return digits, len(digits) >= 10 && len(digits) <= 15
+}
+
+var phoneKeys = []string{"phone", "телефон", "tel", "mobile", "номер"}
+
+// IsPhoneKey - похоже ли имя колонки, поля или типа контакта на телефон
+func IsPhoneKey(name string) bool {
+	return lo.Contains(phoneKeys, strings.ToLower(strings.TrimSpace(name)))
+}
+
+// ParseRecipientsCSV читает получателей из csv с заголовком. Номер берется из колонки phone (или телефон),
+// остальные колонки доступны в шаблоне по имени. Разделитель - запятая или точка с запятой.
+func ParseRecipientsCSV(r io.Reader) ([]domain.SmsRecipient, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	text := strings.TrimPrefix(string(data), "\ufeff")
+
+	firstLine, _, _ := strings.Cut(text, "\n")
+
+	cr := csv.NewReader(strings.NewReader(text))
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		cr.Comma = ';'
+	}
+
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("csv: %w", err)
+	}
+
+	if len(rows) < 2 {
+		return nil, errors.New("csv: нет получателей")
+	}
+
+	header := make([]string, len(rows[0]))
+	phoneCol := -1
+	for i, h := range rows[0] {
+		header[i] = strings.ToLower(strings.TrimSpace(h))
+		if phoneCol < 0 && IsPhoneKey(header[i]) {
+			phoneCol = i
+		}
+	}
+
+	if phoneCol < 0 {
+		return nil, errors.New("csv: нет колонки phone")
+	}
+
+	recipients := make([]domain.SmsRecipient, 0, len(rows)-1)
+
+	for _, row := range rows[1:] {
+		if phoneCol >= len(row) || strings.TrimSpace(row[phoneCol]) == "" {
+			continue
+		}
+
+		vars := map[string]string{}
+		for i, v := range row {
+			if i < len(header) && header[i] != "" {
+				vars[header[i]] = strings.TrimSpace(v)
+			}
+		}
+
+		recipients = append(recipients, domain.SmsRecipient{
+			Phone: vars[header[phoneCol]],
+			Name:  vars["name"],
+			Vars:  vars,
+		})
+	}
+
+	return recipients, nil
+}
+
+// Stoplist - номера из стоп-листа провайдера, если провайдер его поддерживает
+func (c *Service) Stoplist(ctx context.Context, opts Options) (map[string]bool, error) {
+	res := map[string]bool{}
+
+	provider, err := c.Provider(opts)
+	if err != nil {
+		return res, err
+	}
+
+	sl, ok := provider.(Stoplister)
+	if !ok {
+		return res, nil
+	}
+
+	rsp, err := sl.StoplistGet(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	for phone := range rsp.Stoplist {
+		if p, ok := NormalizePhone(phone); ok {
+			res[p] = true
+		}
+	}
+
+	return res, nil
+}
+
+// prepareRecipients нормализует номера, убирает повторы, подставляет шаблон и исключает стоп-лист
+func prepareRecipients(tpl string, recipients []domain.SmsRecipient, stoplist map[string]bool) []domain.SmsRecipient {
+	seen := map[string]bool{}
+	res := make([]domain.SmsRecipient, 0, len(recipients))
+
+	for _, rc := range recipients {
+		phone, ok := NormalizePhone(rc.Phone)
+		if !ok || seen[phone] {
+			continue
+		}
+		seen[phone] = true
+
+		vars := map[string]string{}
+		for k, v := range rc.Vars {
+			vars[k] = v
+		}
+		vars["phone"] = phone
+		if _, ok := vars["name"]; !ok {
+			vars["name"] = rc.Name
+		}
+
+		rc.Phone = phone
+		rc.Vars = vars
+		rc.Text = RenderTemplate(tpl, vars)
+		rc.Status = RecipientPending
+
+		if stoplist[phone] {
+			rc.Status = RecipientExcluded
+		}
+
+		res = append(res, rc)
+	}
+
+	return res
+}
+
+// CreateCampaign сохраняет черновик рассылки с получателями
+func (c *Service) CreateCampaign(ctx context.Context, opts Options, cmp *domain.SmsCampaign, recipients []domain.SmsRecipient) error {
+	if strings.TrimSpace(cmp.Template) == "" {
+		return errors.New("шаблон рассылки пуст")
+	}
+
+	if cmp.UUID == uuid.Nil {
+		cmp.UUID = uuid.New()
+	}
+
+	cmp.Status = CampaignDraft
+
+	err := c.repo.CreateCampaign(cmp)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.AddRecipients(ctx, opts, cmp.UUID, recipients)
+
+	return err
+}
+
+// AddRecipients добавляет получателей в черновик рассылки, номера из стоп-листа сразу исключаются
+func (c *Service) AddRecipients(ctx context.Context, opts Options, uid uuid.UUID, recipients []domain.SmsRecipient) (int64, error) {
+	cmp, err := c.repo.GetCampaign(uid)
+	if err != nil {
+		return 0, err
+	}
+
+	if cmp.Status != CampaignDraft {
+		return 0, errors.New("получателей можно добавить только в черновик рассылки")
+	}
+
+	stoplist, err := c.Stoplist(ctx, opts)
+	if err != nil {
+		logrus.WithField("campaign", uid).Warn("sms stoplist: ", err)
+	}
+
+	return c.repo.AddRecipients(uid, prepareRecipients(cmp.Template, recipients, stoplist))
+}
+
+func (c *Service) GetCampaign(_ context.Context, uid uuid.UUID) (domain.SmsCampaign, error) {
+	cmp, err := c.repo.GetCampaign(uid)
+	if err != nil {
+		return cmp, err
+	}
+
+	cmp.Report, err = c.repo.CampaignReport(uid)
+
+	return cmp, err
+}
+
+func (c *Service) GetCampaigns(_ context.Context, companyUUID uuid.UUID, offset, limit int) ([]domain.SmsCampaign, int64, error) {
+	dms, total, err := c.repo.GetCampaigns(companyUUID, offset, limit)
+	if err != nil {
+		return dms, total, err
+	}
+
+	for i := range dms {
+		dms[i].Report, err = c.repo.CampaignReport(dms[i].UUID)
+		if err != nil {
+			return dms, total, err
+		}
+	}
+
+	return dms, total, nil
+}
+
+func (c *Service) GetRecipients(_ context.Context, uid uuid.UUID, state *string, offset, limit int) ([]domain.SmsRecipient, int64, error) {
+	return c.repo.GetRecipients(uid, state, offset, limit)
+}
+
+// CampaignCost оценивает стоимость рассылки: для каждого уникального текста спрашивается цена
+// на один номер и умножается на число получателей с этим текстом
+func (c *Service) CampaignCost(ctx context.Context, opts Options, uid uuid.UUID) (Response, error) {
+	provider, err := c.Provider(opts)
+	if err != nil {
+		return Response{}, err
+	}
+
+	texts, err := c.repo.CampaignTexts(uid)
+	if err != nil {
+		return Response{}, err
+	}
+
+	res := Response{Status: 100}
+
+	for _, t := range texts {
+		rsp, err := provider.Cost(ctx, &domain.Sms{To: t.Phone, Text: t.Text, From: opts.From})
+		if err != nil {
+			return res, err
+		}
+
+		res.Cost += rsp.Cost * float32(t.Count)
+		res.Count += rsp.Count * t.Count
+	}
+
+	return res, nil
+}
+
+// StartCampaign ставит черновик в очередь, без sendAt рассылка начнется сразу
+func (c *Service) StartCampaign(_ context.Context, uid uuid.UUID, sendAt *time.Time) error {
+	pending, err := c.repo.CountRecipients(uid, []string{RecipientPending})
+	if err != nil {
+		return err
+	}
+
+	if pending == 0 {
+		return errors.New("в рассылке нет получателей")
+	}
+
+	at := time.Now()
+	if sendAt != nil && sendAt.After(at) {
+		at = *sendAt
+	}
+
+	n, err := c.repo.SetCampaignStatus(uid, []string{CampaignDraft}, CampaignScheduled, map[string]interface{}{"send_at": at})
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return errors.New("запустить можно только черновик рассылки")
+	}
+
+	return nil
+}
+
+func (c *Service) CancelCampaign(_ context.Context, uid uuid.UUID) error {
+	n, err := c.repo.SetCampaignStatus(uid, []string{CampaignDraft, CampaignScheduled, CampaignSending}, CampaignCanceled, map[string]interface{}{"finished_at": time.Now()})
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return errors.New("рассылка уже завершена")
+	}
+
+	return nil
+}
+
+// ProcessCampaigns отправляет очередную порцию сообщений по рассылкам, время которых наступило.
+// За минуту компания отправляет не больше своего RateLimit sms, включая одиночные.
+func (c *Service) ProcessCampaigns(ctx context.Context) (sent int, err error) {
+	if c.resolveOptions == nil {
+		return 0, errors.New("sms: не задано получение настроек компании")
+	}
+
+	now := time.Now()
+
+	campaigns, err := c.repo.GetDueCampaigns(now)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, cmp := range campaigns {
+		n, err := c.processCampaign(ctx, cmp, now)
+		sent += n
+
+		if err != nil {
+			logrus.WithField("campaign", cmp.UUID).Error("sms campaign: ", err)
+		}
+	}
+
+	return sent, nil
+}
+
+func (c *Service) processCampaign(ctx context.Context, cmp domain.SmsCampaign, now time.Time) (sent int, err error) {
+	opts, err := c.resolveOptions(cmp.CompanyUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	limit := opts.RateLimit
+	if limit <= 0 {
+		limit = DefaultRateLimit
+	}
+
+	used, err := c.repo.CountSentSince(cmp.CompanyUUID, now.Add(-time.Minute))
+	if err != nil {
+		return 0, err
+	}
+
+	budget := limit - int(used)
+	if budget <= 0 {
+		return 0, nil
+	}
+
+	if cmp.Status == CampaignScheduled {
+		_, err = c.repo.SetCampaignStatus(cmp.UUID, []string{CampaignScheduled}, CampaignSending, map[string]interface{}{"started_at": now})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// стоп-лист мог пополниться с момента создания рассылки
+	stoplist, err := c.Stoplist(ctx, opts)
+	if err != nil {
+		logrus.WithField("campaign", cmp.UUID).Warn("sms stoplist: ", err)
+	}
+
+	_, err = c.repo.ExcludeRecipients(cmp.UUID, lo.Keys(stoplist))
+	if err != nil {
+		return 0, err
+	}
+
+	recipients, err := c.repo.ClaimRecipients(cmp.UUID, now.Add(-RecipientStuck), budget)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, rc := range recipients {
+		// рассылку могли отменить, пока отправлялась порция: оставшиеся получатели возвращаются в очередь
+		current, err := c.repo.GetCampaign(cmp.UUID)
+		if err != nil || current.Status != CampaignSending {
+			_, rerr := c.repo.ReleaseRecipients(lo.Map(recipients[i:], func(r domain.SmsRecipient, _ int) uuid.UUID { return r.UUID }))
+			if rerr != nil {
+				return sent, rerr
+			}
+
+			return sent, err
+		}
+
+		s := &domain.Sms{
+			UUID:           uuid.New(),
+			FederationUUID: cmp.FederationUUID,
+			CompanyUUID:    cmp.CompanyUUID,
+			CreatedBy:      cmp.CreatedBy,
+			CreatedByUUID:  cmp.CreatedByUUID,
+			To:             rc.Phone,
+			Text:           rc.Text,
+		}
+
+		at := time.Now()
+		rc.SentAt = &at
+
+		res, err := c.Send(ctx, opts, s)
+		if err != nil {
+			rc.Status = RecipientFailed
+			rc.Error = err.Error()
+		} else {
+			rc.Status = RecipientSent
+			rc.Cost = float64(res.Cost)
+			rc.SmsUUID = &s.UUID
+			sent++
+		}
+
+		if err := c.repo.SaveRecipientResult(rc); err != nil {
+			return sent, err
+		}
+	}
+
+	left, err := c.repo.CountRecipients(cmp.UUID, []string{RecipientPending, RecipientSending})
+	if err != nil {
+		return sent, err
+	}
+
+	if left == 0 {
+		_, err = c.repo.SetCampaignStatus(cmp.UUID, []string{CampaignSending}, CampaignDone, map[string]interface{}{"finished_at": time.Now()})
+	}
+
+	return sent, err
+}
@@ -0,0 +1,82 @@
+package sms
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	got := RenderTemplate("Здравствуйте, {{ name }}! Ваш заказ {{Order}} готов. {{missing}}", map[string]string{
+		"name":  "Иван",
+		"order": "42",
+	})
+
+	if want := "Здравствуйте, Иван! Ваш заказ 42 готов. "; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	cases := map[string]string{
+		"+7 (999) 123-45-67": "79991234567",
+		"89991234567":        "79991234567",
+		"9991234567":         "79991234567",
+		"+44 20 7946 0958":   "442079460958",
+	}
+
+	for in, want := range cases {
+		got, ok := NormalizePhone(in)
+		if !ok || got != want {
+			t.Errorf("NormalizePhone(%q) = %q, %v, want %q", in, got, ok, want)
+		}
+	}
+
+	if _, ok := NormalizePhone("12345"); ok {
+		t.Error("short number should be invalid")
+	}
+}
+
+func TestParseRecipientsCSV(t *testing.T) {
+	data := "\ufeffName;Телефон;Order\nИван;+7 999 123-45-67;42\nПетр;;43\n"
+
+	recipients, err := ParseRecipientsCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recipients) != 1 {
+		t.Fatalf("len = %d, want 1", len(recipients))
+	}
+
+	rc := recipients[0]
+	if rc.Phone != "+7 999 123-45-67" || rc.Name != "Иван" || rc.Vars["order"] != "42" {
+		t.Errorf("unexpected recipient %+v", rc)
+	}
+
+	if _, err := ParseRecipientsCSV(strings.NewReader("name,order\na,1\n")); err == nil {
+		t.Error("csv without phone column should fail")
+	}
+}
+
+func TestPrepareRecipients(t *testing.T) {
+	res := prepareRecipients("{{name}}, код {{code}}", []domain.SmsRecipient{
+		{Phone: "89991234567", Name: "Иван", Vars: map[string]string{"code": "1"}},
+		{Phone: "+7 999 123 45 67", Name: "Дубль"},
+		{Phone: "79990000000", Name: "Петр", Vars: map[string]string{"code": "2"}},
+		{Phone: "abc"},
+	}, map[string]bool{"79990000000": true})
+
+	if len(res) != 2 {
+		t.Fatalf("len = %d, want 2", len(res))
+	}
+
+	if res[0].Text != "Иван, код 1" || res[0].Status != RecipientPending {
+		t.Errorf("unexpected %+v", res[0])
+	}
+
+	if res[1].Status != RecipientExcluded {
+		t.Errorf("stop-listed phone should be excluded, got %s", res[1].Status)
+	}
+}
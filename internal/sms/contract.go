@@ -1,10 +1,21 @@
 package sms
 
-import "github.com/krisch/crm-backend/domain"
+import (
+	"context"
 
-type ISMS interface {
-	SmsSend(p *domain.Sms) (Response, error)
-	SmsStatus(id string) (Response, error)
-	SmsCost(p *domain.Sms) (Response, error)
-	MyBalance() (Response, error)
+	"github.com/krisch/crm-backend/domain"
+)
+
+// SmsProvider - адаптер sms шлюза. Статусы в Response.Status приводятся к кодам sms.ru (см. codeStatus).
+type SmsProvider interface {
+	Name() string
+	Send(ctx context.Context, p *domain.Sms) (Response, error)
+	Status(ctx context.Context, id string) (Response, error)
+	Cost(ctx context.Context, p *domain.Sms) (Response, error)
+	Balance(ctx context.Context) (Response, error)
+}
+
+// Stoplister - провайдер со своим стоп-листом, номера из Response.Stoplist не получают рассылки
+type Stoplister interface {
+	StoplistGet(ctx context.Context) (Response, error)
 }
@@ -0,0 +1,150 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+const ProviderFake = "fake"
+
+// Fake - провайдер в памяти для тестов и разработки, ничего никуда не отправляет
+type Fake struct {
+	mu sync.Mutex
+
+	// PartCost - стоимость одной части сообщения
+	PartCost float32
+	// State - код статуса, который вернет Status, по умолчанию 103 (доставлено)
+	State int
+	// Err - ошибка, которую вернет Send
+	Err error
+	// Stoplist - номера, которые вернет StoplistGet
+	Stoplist map[string]string
+
+	seq    int
+	sent   []domain.Sms
+	status map[string]int
+}
+
+func NewFake() *Fake {
+	return &Fake{
+		PartCost: 1,
+		State:    103,
+		Stoplist: map[string]string{},
+		status:   map[string]int{},
+	}
+}
+
+func (f *Fake) Name() string {
+	return ProviderFake
+}
+
+func (f *Fake) Send(_ context.Context, p *domain.Sms) (Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.Err != nil {
+		return Response{}, f.Err
+	}
+
+	res := Response{Status: 100}
+
+	messages := p.Multi
+	if len(messages) == 0 {
+		messages = map[string]string{p.To: p.Text}
+	}
+
+	for to, text := range messages {
+		f.seq++
+		id := fmt.Sprintf("fake-%d", f.seq)
+
+		s := *p
+		s.To = to
+		s.Text = text
+		s.Multi = nil
+		s.ProviderID = id
+
+		f.sent = append(f.sent, s)
+		f.status[id] = f.State
+
+		parts := smsParts(text)
+		res.Ids = append(res.Ids, id)
+		res.Count += parts
+		res.Cost += float32(parts) * f.PartCost
+	}
+
+	return res, nil
+}
+
+func (f *Fake) Status(_ context.Context, id string) (Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status, ok := f.status[id]
+	if !ok {
+		return Response{Status: -1, Ids: []string{id}}, nil
+	}
+
+	return Response{Status: status, Ids: []string{id}}, nil
+}
+
+func (f *Fake) Cost(_ context.Context, p *domain.Sms) (Response, error) {
+	parts := smsParts(p.Text)
+
+	return Response{Status: 100, Count: parts, Cost: float32(parts) * f.PartCost}, nil
+}
+
+func (f *Fake) Balance(_ context.Context) (Response, error) {
+	return Response{Status: 100}, nil
+}
+
+func (f *Fake) StoplistGet(_ context.Context) (Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stoplist := make(map[string]string, len(f.Stoplist))
+	for phone, text := range f.Stoplist {
+		stoplist[phone] = text
+	}
+
+	return Response{Status: 100, Stoplist: stoplist}, nil
+}
+
+// Sent возвращает отправленные сообщения
+func (f *Fake) Sent() []domain.Sms {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]domain.Sms{}, f.sent...)
+}
+
+// SetStatus меняет статус доставки сообщения
+func (f *Fake) SetStatus(id string, status int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.status[id] = status
+}
+
+// smsParts - количество частей сообщения: 70 символов кириллицы или 160 латиницы,
+// длинные сообщения режутся по 67 и 153
+func smsParts(text string) int {
+	n := utf8.RuneCountInString(text)
+
+	single, multi := 160, 153
+	for _, r := range text {
+		if r > 127 {
+			single, multi = 70, 67
+			break
+		}
+	}
+
+	if n <= single {
+		return 1
+	}
+
+	return (n + multi - 1) / multi
+}
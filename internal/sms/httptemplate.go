@@ -0,0 +1,215 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+const ProviderHTTP = "http"
+
+// HTTPTemplate - провайдер для шлюзов с простым http api.
+// В URL, StatusURL и Body подставляются {{to}}, {{text}}, {{from}} и {{id}}:
+// в url значения экранируются как query, в теле - по ContentType (json или form).
+type HTTPTemplate struct {
+	HTTP *http.Client
+
+	Method      string
+	URL         string
+	Body        string
+	ContentType string
+	Headers     map[string]string
+
+	// IDRegexp - первая группа выражения достает id сообщения из ответа
+	IDRegexp *regexp.Regexp
+	// SuccessRegexp - если задано, ответ без совпадения считается ошибкой
+	SuccessRegexp *regexp.Regexp
+
+	StatusURL       string
+	DeliveredRegexp *regexp.Regexp
+	FailedRegexp    *regexp.Regexp
+}
+
+// NewHTTPTemplate собирает провайдер из параметров компании:
+// url, method, body, content_type, headers ("Key: Value" построчно), id_regexp, success_regexp,
+// status_url, delivered_regexp, failed_regexp.
+func NewHTTPTemplate(client *http.Client, params map[string]string) (*HTTPTemplate, error) {
+	if params["url"] == "" {
+		return nil, errors.New("http провайдер: не задан url")
+	}
+
+	c := &HTTPTemplate{
+		HTTP:        client,
+		Method:      strings.ToUpper(params["method"]),
+		URL:         params["url"],
+		Body:        params["body"],
+		ContentType: params["content_type"],
+		StatusURL:   params["status_url"],
+		Headers:     map[string]string{},
+	}
+
+	if c.Method == "" {
+		c.Method = http.MethodGet
+		if c.Body != "" {
+			c.Method = http.MethodPost
+		}
+	}
+
+	for _, line := range strings.Split(params["headers"], "\n") {
+		k, v, ok := strings.Cut(line, ":")
+		if ok && strings.TrimSpace(k) != "" {
+			c.Headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+
+	var err error
+	for key, re := range map[string]**regexp.Regexp{
+		"id_regexp":        &c.IDRegexp,
+		"success_regexp":   &c.SuccessRegexp,
+		"delivered_regexp": &c.DeliveredRegexp,
+		"failed_regexp":    &c.FailedRegexp,
+	} {
+		if params[key] == "" {
+			continue
+		}
+
+		*re, err = regexp.Compile(params[key])
+		if err != nil {
+			return nil, fmt.Errorf("http провайдер: %s: %w", key, err)
+		}
+	}
+
+	return c, nil
+}
+
+func (c *HTTPTemplate) Name() string {
+	return ProviderHTTP
+}
+
+func (c *HTTPTemplate) Send(ctx context.Context, p *domain.Sms) (Response, error) {
+	messages := p.Multi
+	if len(messages) == 0 {
+		messages = map[string]string{p.To: p.Text}
+	}
+
+	res := Response{Status: 100}
+
+	for to, text := range messages {
+		vars := map[string]string{"to": to, "text": text, "from": p.From}
+
+		body, err := c.do(ctx, c.Method, c.URL, c.Body, vars)
+		if err != nil {
+			return res, err
+		}
+
+		if c.SuccessRegexp != nil && !c.SuccessRegexp.MatchString(body) {
+			return res, fmt.Errorf("http провайдер: неуспешный ответ: %.200s", body)
+		}
+
+		id := ""
+		if c.IDRegexp != nil {
+			if m := c.IDRegexp.FindStringSubmatch(body); len(m) > 1 {
+				id = m[1]
+			}
+		}
+
+		res.Ids = append(res.Ids, id)
+		res.Count += smsParts(text)
+	}
+
+	return res, nil
+}
+
+func (c *HTTPTemplate) Status(ctx context.Context, id string) (Response, error) {
+	if c.StatusURL == "" {
+		return Response{}, ErrNotSupported
+	}
+
+	body, err := c.do(ctx, http.MethodGet, c.StatusURL, "", map[string]string{"id": id})
+	if err != nil {
+		return Response{}, err
+	}
+
+	res := Response{Status: 102, Ids: []string{id}}
+
+	switch {
+	case c.DeliveredRegexp != nil && c.DeliveredRegexp.MatchString(body):
+		res.Status = 103
+	case c.FailedRegexp != nil && c.FailedRegexp.MatchString(body):
+		res.Status = 107
+	}
+
+	return res, nil
+}
+
+func (c *HTTPTemplate) Cost(_ context.Context, p *domain.Sms) (Response, error) {
+	return Response{Status: 100, Count: smsParts(p.Text)}, nil
+}
+
+func (c *HTTPTemplate) Balance(_ context.Context) (Response, error) {
+	return Response{}, ErrNotSupported
+}
+
+func (c *HTTPTemplate) do(ctx context.Context, method, rawURL, body string, vars map[string]string) (string, error) {
+	u := render(rawURL, vars, url.QueryEscape)
+
+	var reqBody io.Reader = http.NoBody
+	if body != "" {
+		escape := url.QueryEscape
+		if strings.Contains(c.ContentType, "json") {
+			escape = jsonEscape
+		}
+		reqBody = strings.NewReader(render(body, vars, escape))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return "", err
+	}
+
+	if c.ContentType != "" {
+		req.Header.Set("Content-Type", c.ContentType)
+	}
+
+	for k, v := range c.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("http провайдер: %d: %.200s", resp.StatusCode, b)
+	}
+
+	return string(b), nil
+}
+
+func render(tpl string, vars map[string]string, escape func(string) string) string {
+	for k, v := range vars {
+		tpl = strings.ReplaceAll(tpl, "{{"+k+"}}", escape(v))
+	}
+
+	return tpl
+}
+
+func jsonEscape(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b[1 : len(b)-1])
+}
@@ -1,19 +1,16 @@
 package sms
 
 import (
-	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
-	"regexp"
-	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
 )
 
 var codeStatus = map[int]string{
@@ -57,8 +54,22 @@ var codeStatus = map[int]string{
 var (
 	errInternal   = errors.New("internal error")
 	errNoResponse = errors.New("something went wrong")
+
+	ErrNotSupported = errors.New("операция не поддерживается провайдером")
 )
 
+// PartialSendError - провайдер принял сообщения на номера Sent и отказал на остальных
+type PartialSendError struct {
+	Sent []string
+	Err  error
+}
+
+func (e *PartialSendError) Error() string {
+	return fmt.Sprintf("отправлено сообщений: %d, остальные не отправлены: %s", len(e.Sent), e.Err)
+}
+
+func (e *PartialSendError) Unwrap() error { return e.Err }
+
 func New(repo *Repository) *Service {
 	return NewWithHTTP(&http.Client{}, repo)
 }
@@ -69,6 +80,7 @@ func NewWithHTTP(client *http.Client, repo *Repository) *Service {
 		HTTP:   client,
 
 		repo: repo,
+		fake: NewFake(),
 	}
 
 	return c
@@ -105,310 +117,100 @@ func NewMulti(sms ...*domain.Sms) *domain.Sms {
 	}
 }
 
-func (c *Service) makeRequest(endpoint, id string, params url.Values) (Response, []string, error) {
-	params.Set("api_id", id)
-	aPIURL := c.APIURL + endpoint + "?" + params.Encode()
-
-	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, aPIURL, http.NoBody)
-	if err != nil {
-		return Response{}, nil, err
-	}
-
-	resp, err := c.HTTP.Do(req)
-	if err != nil {
-		return Response{}, nil, err
-	}
-	defer resp.Body.Close()
-
-	sc := bufio.NewScanner(resp.Body)
-	var lines []string
-	for sc.Scan() {
-		lines = append(lines, sc.Text())
-	}
-
-	if err := sc.Err(); err != nil {
-		return Response{}, nil, errInternal
-	}
-
-	if len(lines) == 0 {
-		return Response{}, nil, errNoResponse
-	}
-
-	status, err := strconv.Atoi(lines[0])
-	if err != nil {
-		return Response{}, nil, errInternal
-	}
-
-	if status >= 200 {
-		msg := fmt.Sprintf("Code: %d; Status: %s", status, codeStatus[status])
-		return Response{}, nil, errors.New(msg)
-	}
-
-	res := Response{Status: status}
-	return res, lines, nil
-}
-
 func (c *Service) StoreSms(s *domain.Sms) error {
 	return c.repo.Create(s)
 }
 
-func (c *Service) SmsSend(id string, p *domain.Sms) (Response, error) {
-	params := url.Values{}
-
-	params.Set("from", p.From)
-
-	params.Set("to", p.To)
-	if len(p.Multi) > 0 {
-		for to, text := range p.Multi {
-			key := fmt.Sprintf("multi[%s]", to)
-			params.Add(key, text)
-		}
-	} else {
-		params.Set("to", p.To)
-		params.Set("text", p.Text)
-	}
-
-	if len(p.From) > 0 {
-		params.Set("from", p.From)
-	}
-
-	if p.PartnerID > 0 {
-		val := strconv.Itoa(p.PartnerID)
-		params.Set("partner_id", val)
-	}
-
-	if p.Test {
-		params.Set("test", "1")
-	}
-
-	if p.Time.After(time.Now()) {
-		val := strconv.FormatInt(p.Time.Unix(), 10)
-		params.Set("time", val)
-	}
-
-	if p.Translit {
-		params.Set("translit", "1")
-	}
-
-	res, lines, err := c.makeRequest("/sms/send", id, params)
-	if err != nil {
-		return Response{}, err
-	}
-
-	var ids []string
-	re := regexp.MustCompile("^balance=")
-
-	for i := 1; i < len(lines); i++ {
-		isBalance := re.MatchString(lines[i])
-
-		if isBalance {
-			str := re.ReplaceAllString(lines[i], "")
-			balance, err := strconv.ParseFloat(str, 32)
-			if err != nil {
-				return Response{}, errInternal
-			}
-			res.Balance = float32(balance)
-		} else {
-			ids = append(ids, lines[i])
-		}
-	}
-
-	res.Ids = ids
-	return res, nil
-}
-
-func (c *Service) SmsStatus(id string) (Response, error) {
-	params := url.Values{}
-	params.Set("id", id)
-
-	res, _, err := c.makeRequest("/sms/status", id, params)
-	if err != nil {
-		return Response{}, err
-	}
-
-	return res, nil
-}
-
-func (c *Service) SmsCost(id string, p *domain.Sms) (Response, error) {
-	params := url.Values{}
-	params.Set("from", p.From)
-	params.Set("to", p.To)
-	params.Set("text", p.Text)
-	if p.Translit {
-		params.Set("translit", "1")
-	}
-
-	res, lines, err := c.makeRequest("/sms/cost", id, params)
-	if err != nil {
-		return Response{}, err
-	}
-
-	cost, err := strconv.ParseFloat(lines[1], 32)
-	if err != nil {
-		return Response{}, errInternal
-	}
-
-	count, err := strconv.Atoi(lines[2])
-	if err != nil {
-		return Response{}, errInternal
-	}
-
-	res.Cost = float32(cost)
-	res.Count = count
-
-	return res, nil
-}
-
-func (c *Service) MyBalance(id string) (Response, error) {
-	res, lines, err := c.makeRequest("/my/balance", id, url.Values{})
-	if err != nil {
-		return Response{}, err
-	}
+// Options - выбранный компанией провайдер и его параметры
+type Options struct {
+	Provider string
+	API      string
+	From     string
+	Params   map[string]string
 
-	balance, err := strconv.ParseFloat(lines[1], 32)
-	if err != nil {
-		return Response{}, errInternal
-	}
-
-	res.Balance = float32(balance)
-	return res, nil
+	// RateLimit - сколько sms в минуту компания может отправлять рассылками
+	RateLimit int
 }
 
-// MyLimit checks the limit.
-func (c *Service) MyLimit(id string) (Response, error) {
-	res, lines, err := c.makeRequest("/my/limit", id, url.Values{})
-	if err != nil {
-		return Response{}, err
-	}
-
-	limit, err := strconv.Atoi(lines[1])
-	if err != nil {
-		return Response{}, errInternal
-	}
-
-	limitSent, err := strconv.Atoi(lines[2])
-	if err != nil {
-		return Response{}, errInternal
+// Provider создает провайдер по настройкам компании, пустой провайдер - sms.ru
+func (c *Service) Provider(opts Options) (SmsProvider, error) {
+	switch opts.Provider {
+	case "", ProviderSmsRu:
+		return NewSmsRu(c.HTTP, c.APIURL, opts.API), nil
+	case ProviderSMPP:
+		if opts.Params["addr"] == "" {
+			return nil, errors.New("smpp провайдер: не задан addr")
+		}
+		return NewSMPP(opts.Params["addr"], opts.Params["system_id"], opts.Params["password"], opts.Params["system_type"]), nil
+	case ProviderHTTP:
+		return NewHTTPTemplate(c.HTTP, opts.Params)
+	case ProviderFake:
+		return c.fake, nil
 	}
 
-	res.Limit = limit
-	res.LimitSent = limitSent
-	return res, nil
+	return nil, fmt.Errorf("неизвестный sms провайдер: %s", opts.Provider)
 }
 
-// MySenders receives the list of senders.
-func (c *Service) MySenders(id string) (Response, error) {
-	res, lines, err := c.makeRequest("/my/senders", id, url.Values{})
-	if err != nil {
-		return Response{}, err
-	}
-
-	var senders []string
-	for i := 1; i < len(lines); i++ {
-		senders = append(senders, lines[i])
-	}
-
-	res.Senders = senders
-	return res, nil
+// Fake - общий для сервиса провайдер в памяти
+func (c *Service) Fake() *Fake {
+	return c.fake
 }
 
-// StoplistGet receives the stoplist.
-func (c *Service) StoplistGet(id string) (Response, error) {
-	res, lines, err := c.makeRequest("/stoplist/get", id, url.Values{})
+// Send отправляет сообщение через провайдер компании и сохраняет его с id провайдера
+func (c *Service) Send(ctx context.Context, opts Options, s *domain.Sms) (Response, error) {
+	provider, err := c.Provider(opts)
 	if err != nil {
 		return Response{}, err
 	}
 
-	stoplist := make(map[string]string)
-	for i := 1; i < len(lines); i++ {
-		re := regexp.MustCompile(";")
-		str := re.Split(lines[i], 2)
-
-		stoplist[str[0]] = str[1]
+	if s.From == "" {
+		s.From = opts.From
 	}
 
-	res.Stoplist = stoplist
-	return res, nil
-}
-
-func (c *Service) StoplistAdd(id, phone, text string) (Response, error) {
-	params := url.Values{}
-	params.Set("stoplist_phone", phone)
-	params.Set("stoplist_text", text)
-
-	res, _, err := c.makeRequest("/stoplist/add", id, params)
-	if err != nil {
-		return Response{}, err
-	}
-
-	return res, nil
-}
+	res, err := provider.Send(ctx, s)
 
-// StoplistDel will delete the phone number from stoplist
-//
-// phone is phone number.
-func (c *Service) StoplistDel(id, phone string) (Response, error) {
-	params := url.Values{}
-	params.Set("stoplist_phone", phone)
+	// принятые провайдером сообщения оплачены и будут доставлены: сохраняем их id,
+	// чтобы получать статусы, а вызывающий по ошибке видит, на какие номера повторять отправку
+	var partial *PartialSendError
+	if errors.As(err, &partial) {
+		s.Multi = lo.PickByKeys(s.Multi, partial.Sent)
+		if serr := c.storeSent(s, provider.Name(), res); serr != nil {
+			return res, serr
+		}
 
-	res, _, err := c.makeRequest("/stoplist/del", id, params)
-	if err != nil {
-		return Response{}, err
+		return res, err
 	}
 
-	return res, nil
-}
-
-// CallbackGet receives the callbacks from service.
-func (c *Service) CallbackGet(id string) (Response, error) {
-	res, lines, err := c.makeRequest("/callback/get", id, url.Values{})
 	if err != nil {
-		return Response{}, err
-	}
-
-	var callbacks []string
-	for i := 1; i < len(lines); i++ {
-		callbacks = append(callbacks, lines[i])
+		return res, err
 	}
 
-	res.Callbacks = callbacks
-	return res, nil
+	return res, c.storeSent(s, provider.Name(), res)
 }
 
-func (c *Service) CallbackAdd(id, cbURL string) (Response, error) {
-	params := url.Values{}
-	params.Set("url", cbURL)
+func (c *Service) storeSent(s *domain.Sms, provider string, res Response) error {
+	now := time.Now()
 
-	res, lines, err := c.makeRequest("/callback/add", id, params)
-	if err != nil {
-		return Response{}, err
+	s.Provider = provider
+	if len(res.Ids) > 0 {
+		s.ProviderID = res.Ids[0]
+		s.ProviderIDs = res.Ids
 	}
 
-	var callbacks []string
-	for i := 1; i < len(lines); i++ {
-		callbacks = append(callbacks, lines[i])
-	}
+	s.Status = StateSent
+	s.StatusCode = res.Status
+	s.StatusAt = &now
 
-	res.Callbacks = callbacks
-	return res, nil
+	return c.StoreSms(s)
 }
 
-func (c *Service) CallbackDel(id, cbURL string) (Response, error) {
-	params := url.Values{}
-	params.Set("url", cbURL)
-
-	res, lines, err := c.makeRequest("/callback/del", id, params)
+func (c *Service) Cost(ctx context.Context, opts Options, s *domain.Sms) (Response, error) {
+	provider, err := c.Provider(opts)
 	if err != nil {
 		return Response{}, err
 	}
 
-	var callbacks []string
-	for i := 1; i < len(lines); i++ {
-		callbacks = append(callbacks, lines[i])
-	}
-
-	res.Callbacks = callbacks
-	return res, nil
+	return provider.Cost(ctx, s)
 }
 
 func (c *Service) GetSms(ctx context.Context, filter dto.SmsFilterDTO) ([]domain.Sms, int64, error) {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/datatypes"
 )
 
@@ -23,6 +24,18 @@ type sms struct {
 	Status string  `gorm:"type:varchar(100);default:'';not null;"`
 	Cost   float64 `gorm:"type:float;default:0;not null;"`
 
+	Provider   string `gorm:"type:varchar(50);default:'';not null;"`
+	ProviderID string `gorm:"type:varchar(100);default:'';not null;"`
+
+	ProviderIDs pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
+
+	StatusCode int        `gorm:"type:int;default:0;not null;"`
+	ErrorCode  int        `gorm:"type:int;default:0;not null;"`
+	StatusAt   *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	TaskUUID     *uuid.UUID `gorm:"type:uuid;"`
+	TemplateUUID *uuid.UUID `gorm:"type:uuid;"`
+
 	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
@@ -31,3 +44,86 @@ type sms struct {
 
 	Total int64 `gorm:"->"`
 }
+
+type smsCampaign struct {
+	UUID uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;unique:true"`
+
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;"`
+	CompanyUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Name     string `gorm:"type:varchar(100);default:'';not null;"`
+	Template string `gorm:"type:text;default:'';not null;"`
+
+	Source     string     `gorm:"type:varchar(20);default:'';not null;"`
+	SourceUUID *uuid.UUID `gorm:"type:uuid;"`
+
+	Status     string     `gorm:"type:varchar(20);default:'draft';not null;"`
+	SendAt     *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	StartedAt  *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	FinishedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	Total int64 `gorm:"->"`
+}
+
+func (smsCampaign) TableName() string {
+	return "sms_campaigns"
+}
+
+type smsTemplate struct {
+	UUID uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;unique:true"`
+
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;"`
+	CompanyUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Name       string `gorm:"type:varchar(100);default:'';not null;"`
+	Text       string `gorm:"type:text;default:'';not null;"`
+	PhoneField string `gorm:"type:varchar(100);default:'';not null;"`
+
+	ProjectUUID *uuid.UUID `gorm:"type:uuid;"`
+	OnStatus    *int       `gorm:"type:int;"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+func (smsTemplate) TableName() string {
+	return "sms_templates"
+}
+
+type smsCampaignRecipient struct {
+	UUID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;unique:true"`
+	CampaignUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Phone string            `gorm:"type:varchar(20);default:'';not null;"`
+	Name  string            `gorm:"type:varchar(255);default:'';not null;"`
+	Vars  datatypes.JSONMap `gorm:"type:jsonb;default:'{}';not null;"`
+	Text  string            `gorm:"type:text;default:'';not null;"`
+
+	Status string  `gorm:"type:varchar(20);default:'pending';not null;"`
+	Error  string  `gorm:"type:text;default:'';not null;"`
+	Cost   float64 `gorm:"type:float;default:0;not null;"`
+
+	SmsUUID   *uuid.UUID `gorm:"type:uuid;"`
+	SentAt    *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	ClaimedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+
+	Delivery string `gorm:"->"`
+	Total    int64  `gorm:"->"`
+}
+
+func (smsCampaignRecipient) TableName() string {
+	return "sms_campaign_recipients"
+}
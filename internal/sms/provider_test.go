@@ -0,0 +1,167 @@
+package sms
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestSmsParts(t *testing.T) {
+	cases := []struct {
+		text string
+		want int
+	}{
+		{"hello", 1},
+		{strings.Repeat("a", 160), 1},
+		{strings.Repeat("a", 161), 2},
+		{strings.Repeat("я", 70), 1},
+		{strings.Repeat("я", 71), 2},
+		{strings.Repeat("я", 135), 3},
+	}
+
+	for _, c := range cases {
+		if got := smsParts(c.text); got != c.want {
+			t.Errorf("smsParts(%d runes) = %d, want %d", len([]rune(c.text)), got, c.want)
+		}
+	}
+}
+
+func TestFake(t *testing.T) {
+	f := NewFake()
+
+	res, err := f.Send(context.Background(), &domain.Sms{To: "79990000000", Text: "привет"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Ids) != 1 || res.Count != 1 || len(f.Sent()) != 1 {
+		t.Fatalf("unexpected response %+v", res)
+	}
+
+	f.SetStatus(res.Ids[0], 107)
+
+	st, err := f.Status(context.Background(), res.Ids[0])
+	if err != nil || st.Status != 107 {
+		t.Fatalf("status = %d, %v", st.Status, err)
+	}
+}
+
+func TestHTTPTemplate(t *testing.T) {
+	var body string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/status" {
+			_, _ = io.WriteString(w, "state=DELIVRD")
+			return
+		}
+
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		_, _ = io.WriteString(w, `{"ok":true,"id":"42"}`)
+	}))
+	defer srv.Close()
+
+	p, err := NewHTTPTemplate(srv.Client(), map[string]string{
+		"url":              srv.URL + "/send",
+		"body":             `{"to":"{{to}}","text":"{{text}}"}`,
+		"content_type":     "application/json",
+		"id_regexp":        `"id":"(\w+)"`,
+		"success_regexp":   `"ok":true`,
+		"status_url":       srv.URL + "/status?id={{id}}",
+		"delivered_regexp": "DELIVRD",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := p.Send(context.Background(), &domain.Sms{To: "79990000000", Text: `"кавычки"`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"to":"79990000000","text":"\"кавычки\""}`; body != want {
+		t.Errorf("body = %s, want %s", body, want)
+	}
+
+	if len(res.Ids) != 1 || res.Ids[0] != "42" {
+		t.Fatalf("ids = %v", res.Ids)
+	}
+
+	st, err := p.Status(context.Background(), "42")
+	if err != nil || st.Status != 103 {
+		t.Fatalf("status = %d, %v", st.Status, err)
+	}
+}
+
+func TestSMPPPartialSend(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	// SMSC принимает первое сообщение и отказывает на втором
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		submits := 0
+		for {
+			header := make([]byte, 16)
+			if _, err := io.ReadFull(conn, header); err != nil {
+				return
+			}
+
+			body := make([]byte, binary.BigEndian.Uint32(header)-16)
+			if _, err := io.ReadFull(conn, body); err != nil {
+				return
+			}
+
+			var status uint32
+			var resp []byte
+
+			switch binary.BigEndian.Uint32(header[4:]) {
+			case smppBindTransmitter:
+				resp = []byte("smsc\x00")
+			case smppSubmitSm:
+				submits++
+				if submits == 1 {
+					resp = []byte("id-1\x00")
+				} else {
+					status = 0x45
+				}
+			default:
+				return
+			}
+
+			out := make([]byte, 16)
+			binary.BigEndian.PutUint32(out[0:], uint32(16+len(resp)))
+			binary.BigEndian.PutUint32(out[4:], binary.BigEndian.Uint32(header[4:])|0x80000000)
+			binary.BigEndian.PutUint32(out[8:], status)
+			copy(out[12:], header[12:])
+			_, _ = conn.Write(append(out, resp...))
+		}
+	}()
+
+	c := NewSMPP(ln.Addr().String(), "id", "pass", "")
+	res, err := c.Send(context.Background(), &domain.Sms{From: "shop", Multi: map[string]string{"79990000002": "b", "79990000001": "a"}})
+
+	var partial *PartialSendError
+	if !errors.As(err, &partial) {
+		t.Fatalf("want partial error, got %v", err)
+	}
+	if len(partial.Sent) != 1 || partial.Sent[0] != "79990000001" || len(res.Ids) != 1 || res.Ids[0] != "id-1" {
+		t.Fatalf("sent %v, ids %v", partial.Sent, res.Ids)
+	}
+}
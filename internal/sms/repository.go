@@ -2,13 +2,18 @@ package sms
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -31,9 +36,56 @@ func (r *Repository) Create(s *domain.Sms) error {
 		To:             s.To,
 		Text:           s.Text,
 		From:           s.From,
+		Provider:       s.Provider,
+		ProviderID:     s.ProviderID,
+		ProviderIDs:    s.ProviderIDs,
+		Status:         s.Status,
+		StatusCode:     s.StatusCode,
+		ErrorCode:      s.ErrorCode,
+		StatusAt:       s.StatusAt,
+		TaskUUID:       s.TaskUUID,
+		TemplateUUID:   s.TemplateUUID,
 	}).Error
 }
 
+// UpdateStatus обновляет статус доставки сообщения компании по любому из его id провайдера. Доставленные и недоставленные
+// сообщения не трогаются, чтобы опоздавший отчет не откатил итоговый статус.
+func (r *Repository) UpdateStatus(companyUUID uuid.UUID, provider, providerID, state string, code, errorCode int, at time.Time) (int64, error) {
+	res := r.gorm.DB.Model(&sms{}).
+		Where("company_uuid = ? and provider = ?", companyUUID, provider).
+		Where("provider_ids @> array[?]::text[]", providerID).
+		Where("status not in ?", []string{StateDelivered, StateFailed}).
+		Updates(map[string]interface{}{
+			"status":      state,
+			"status_code": code,
+			"error_code":  errorCode,
+			"status_at":   at,
+			"updated_at":  time.Now(),
+		})
+
+	return res.RowsAffected, res.Error
+}
+
+// GetPending - отправленные после since сообщения без итогового статуса, которые не проверялись с before
+func (r *Repository) GetPending(since, before time.Time, limit int) (dms []domain.Sms, err error) {
+	orms := []sms{}
+
+	err = r.gorm.DB.
+		Where("status = ? and provider_id <> ''", StateSent).
+		Where("created_at > ? and coalesce(status_at, created_at) < ?", since, before).
+		Where("deleted_at is null").
+		Order("coalesce(status_at, created_at)").
+		Limit(limit).
+		Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	return helpers.Map(orms, func(item sms, i int) domain.Sms {
+		return toDomain(item)
+	}), nil
+}
+
 func (r *Repository) GetSms(_ context.Context, filter dto.SmsFilterDTO) (dms []domain.Sms, total int64, err error) {
 	orms := []sms{}
 
@@ -49,6 +101,18 @@ func (r *Repository) GetSms(_ context.Context, filter dto.SmsFilterDTO) (dms []d
 		query = query.Where("created_by = ?", filter.MyEmail)
 	}
 
+	if filter.State != nil {
+		query = query.Where("status = ?", *filter.State)
+	}
+
+	if filter.Status != nil {
+		query = query.Where("status_code = ?", *filter.Status)
+	}
+
+	if filter.Phones != nil {
+		query = query.Where(`"to" in ?`, filter.Phones)
+	}
+
 	if filter.Limit != nil {
 		query = query.Limit(*filter.Limit)
 	} else {
@@ -82,22 +146,505 @@ func (r *Repository) GetSms(_ context.Context, filter dto.SmsFilterDTO) (dms []d
 	}
 
 	dms = helpers.Map(orms, func(item sms, i int) domain.Sms {
-		return domain.Sms{
-			UUID:           item.UUID,
-			FederationUUID: item.FederationUUID,
-			CompanyUUID:    item.CompanyUUID,
+		return toDomain(item)
+	})
+
+	return dms, total, nil
+}
+
+func toDomain(item sms) domain.Sms {
+	return domain.Sms{
+		UUID:           item.UUID,
+		FederationUUID: item.FederationUUID,
+		CompanyUUID:    item.CompanyUUID,
 
-			CreatedBy:     item.CreatedBy,
-			CreatedByUUID: item.CreatedByUUID,
+		CreatedBy:     item.CreatedBy,
+		CreatedByUUID: item.CreatedByUUID,
 
-			To:   item.To,
-			From: item.From,
-			Text: item.Text,
+		To:   item.To,
+		From: item.From,
+		Text: item.Text,
+
+		Provider:    item.Provider,
+		ProviderID:  item.ProviderID,
+		ProviderIDs: item.ProviderIDs,
+
+		Status:     item.Status,
+		StatusCode: item.StatusCode,
+		ErrorCode:  item.ErrorCode,
+		StatusAt:   item.StatusAt,
+
+		TaskUUID:     item.TaskUUID,
+		TemplateUUID: item.TemplateUUID,
+
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+func (r *Repository) CreateCampaign(c *domain.SmsCampaign) error {
+	return r.gorm.DB.Create(&smsCampaign{
+		UUID:           c.UUID,
+		FederationUUID: c.FederationUUID,
+		CompanyUUID:    c.CompanyUUID,
+		CreatedBy:      c.CreatedBy,
+		CreatedByUUID:  c.CreatedByUUID,
+		Name:           c.Name,
+		Template:       c.Template,
+		Source:         c.Source,
+		SourceUUID:     c.SourceUUID,
+		Status:         c.Status,
+		SendAt:         c.SendAt,
+	}).Error
+}
+
+func (r *Repository) GetCampaign(uid uuid.UUID) (c domain.SmsCampaign, err error) {
+	orm := smsCampaign{}
+
+	res := r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Limit(1).
+		Find(&orm)
+	if res.Error != nil {
+		return c, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return c, dto.NotFoundErr("рассылка не найдена")
+	}
+
+	return campaignToDomain(orm), nil
+}
+
+func (r *Repository) GetCampaigns(companyUUID uuid.UUID, offset, limit int) (dms []domain.SmsCampaign, total int64, err error) {
+	orms := []smsCampaign{}
+
+	err = r.gorm.DB.
+		Select("*, count(*) OVER() AS total").
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Order("created_at desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&orms).Error
+	if err != nil {
+		return dms, -1, err
+	}
 
-			CreatedAt: item.CreatedAt,
-			UpdatedAt: item.UpdatedAt,
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	return helpers.Map(orms, func(item smsCampaign, i int) domain.SmsCampaign {
+		return campaignToDomain(item)
+	}), total, nil
+}
+
+// GetDueCampaigns - рассылки, время отправки которых наступило
+func (r *Repository) GetDueCampaigns(now time.Time) (dms []domain.SmsCampaign, err error) {
+	orms := []smsCampaign{}
+
+	err = r.gorm.DB.
+		Where("status in ?", []string{CampaignScheduled, CampaignSending}).
+		Where("send_at <= ?", now).
+		Where("deleted_at is null").
+		Order("send_at").
+		Find(&orms).Error
+
+	return helpers.Map(orms, func(item smsCampaign, i int) domain.SmsCampaign {
+		return campaignToDomain(item)
+	}), err
+}
+
+// SetCampaignStatus меняет статус рассылки, если текущий статус входит в from
+func (r *Repository) SetCampaignStatus(uid uuid.UUID, from []string, to string, values map[string]interface{}) (int64, error) {
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+
+	values["status"] = to
+	values["updated_at"] = time.Now()
+
+	res := r.gorm.DB.Model(&smsCampaign{}).
+		Where("uuid = ? and status in ?", uid, from).
+		Updates(values)
+
+	return res.RowsAffected, res.Error
+}
+
+func (r *Repository) AddRecipients(campaignUUID uuid.UUID, recipients []domain.SmsRecipient) (int64, error) {
+	if len(recipients) == 0 {
+		return 0, nil
+	}
+
+	orms := helpers.Map(recipients, func(item domain.SmsRecipient, i int) smsCampaignRecipient {
+		vars := datatypes.JSONMap{}
+		for k, v := range item.Vars {
+			vars[k] = v
+		}
+
+		return smsCampaignRecipient{
+			UUID:         uuid.New(),
+			CampaignUUID: campaignUUID,
+			Phone:        item.Phone,
+			Name:         item.Name,
+			Vars:         vars,
+			Text:         item.Text,
+			Status:       item.Status,
 		}
 	})
 
-	return dms, total, nil
+	// повторный номер в рассылке пропускается
+	res := r.gorm.DB.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(&orms, 500)
+
+	return res.RowsAffected, res.Error
+}
+
+// CampaignReport считает получателей по статусу отправки и доставки
+func (r *Repository) CampaignReport(uid uuid.UUID) (report domain.SmsCampaignReport, err error) {
+	rows := []struct {
+		Status   string
+		Delivery string
+		Count    int
+		Cost     float64
+	}{}
+
+	err = r.gorm.DB.Raw(`
+		select r.status, coalesce(s.status, '') as delivery, count(*) as count, coalesce(sum(r.cost), 0) as cost
+		from sms_campaign_recipients r
+		left join sms s on s.uuid = r.sms_uuid
+		where r.campaign_uuid = ?
+		group by 1, 2
+	`, uid).Scan(&rows).Error
+	if err != nil {
+		return report, err
+	}
+
+	for _, row := range rows {
+		report.Total += row.Count
+		report.Cost += row.Cost
+
+		switch row.Status {
+		case RecipientPending, RecipientSending:
+			report.Pending += row.Count
+		case RecipientExcluded:
+			report.Excluded += row.Count
+		case RecipientFailed:
+			report.Failed += row.Count
+		case RecipientSent:
+			switch row.Delivery {
+			case StateDelivered:
+				report.Delivered += row.Count
+			case StateFailed:
+				report.Failed += row.Count
+			default:
+				report.Sent += row.Count
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// GetRecipients - получатели рассылки, state - pending, excluded, sent, delivered или failed
+func (r *Repository) GetRecipients(uid uuid.UUID, state *string, offset, limit int) (dms []domain.SmsRecipient, total int64, err error) {
+	orms := []smsCampaignRecipient{}
+
+	query := r.gorm.DB.
+		Table("sms_campaign_recipients r").
+		Select("r.*, coalesce(s.status, '') as delivery, count(*) OVER() AS total").
+		Joins("left join sms s on s.uuid = r.sms_uuid").
+		Where("r.campaign_uuid = ?", uid)
+
+	if state != nil {
+		switch *state {
+		case RecipientPending:
+			query = query.Where("r.status in ?", []string{RecipientPending, RecipientSending})
+		case RecipientSent:
+			query = query.Where("r.status = ? and coalesce(s.status, '') not in ?", RecipientSent, []string{StateDelivered, StateFailed})
+		case StateDelivered:
+			query = query.Where("r.status = ? and s.status = ?", RecipientSent, StateDelivered)
+		case RecipientFailed:
+			query = query.Where("(r.status = ? or s.status = ?)", RecipientFailed, StateFailed)
+		default:
+			query = query.Where("r.status = ?", *state)
+		}
+	}
+
+	err = query.
+		Order("r.created_at, r.phone").
+		Offset(offset).
+		Limit(limit).
+		Scan(&orms).Error
+	if err != nil {
+		return dms, -1, err
+	}
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	return helpers.Map(orms, func(item smsCampaignRecipient, i int) domain.SmsRecipient {
+		return recipientToDomain(item)
+	}), total, nil
+}
+
+// ClaimRecipients помечает до limit ожидающих получателей как отправляемые и возвращает их.
+// Получатели, взятые раньше stuckBefore и так и не отправленные, забираются повторно.
+// SKIP LOCKED не дает двум воркерам взять одних и тех же получателей.
+func (r *Repository) ClaimRecipients(uid uuid.UUID, stuckBefore time.Time, limit int) (dms []domain.SmsRecipient, err error) {
+	orms := []smsCampaignRecipient{}
+
+	err = r.gorm.DB.Raw(`
+		update sms_campaign_recipients set status = ?, claimed_at = now()
+		where uuid in (
+			select uuid from sms_campaign_recipients
+			where campaign_uuid = ? and (status = ? or (status = ? and coalesce(claimed_at, created_at) < ?))
+			order by created_at, phone
+			limit ?
+			for update skip locked
+		)
+		returning *
+	`, RecipientSending, uid, RecipientPending, RecipientSending, stuckBefore, limit).Scan(&orms).Error
+
+	return helpers.Map(orms, func(item smsCampaignRecipient, i int) domain.SmsRecipient {
+		return recipientToDomain(item)
+	}), err
+}
+
+// ReleaseRecipients возвращает взятых, но не отправленных получателей в ожидание
+func (r *Repository) ReleaseRecipients(uids []uuid.UUID) (int64, error) {
+	if len(uids) == 0 {
+		return 0, nil
+	}
+
+	res := r.gorm.DB.Model(&smsCampaignRecipient{}).
+		Where("uuid in ? and status = ?", uids, RecipientSending).
+		Updates(map[string]interface{}{
+			"status":     RecipientPending,
+			"claimed_at": nil,
+		})
+
+	return res.RowsAffected, res.Error
+}
+
+// ExcludeRecipients исключает ожидающих получателей с номерами из стоп-листа
+func (r *Repository) ExcludeRecipients(uid uuid.UUID, phones []string) (int64, error) {
+	if len(phones) == 0 {
+		return 0, nil
+	}
+
+	res := r.gorm.DB.Model(&smsCampaignRecipient{}).
+		Where("campaign_uuid = ? and status = ? and phone in ?", uid, RecipientPending, phones).
+		Updates(map[string]interface{}{
+			"status": RecipientExcluded,
+			"error":  "номер в стоп-листе",
+		})
+
+	return res.RowsAffected, res.Error
+}
+
+func (r *Repository) SaveRecipientResult(rc domain.SmsRecipient) error {
+	return r.gorm.DB.Model(&smsCampaignRecipient{}).
+		Where("uuid = ?", rc.UUID).
+		Updates(map[string]interface{}{
+			"status":   rc.Status,
+			"error":    rc.Error,
+			"cost":     rc.Cost,
+			"sms_uuid": rc.SmsUUID,
+			"sent_at":  rc.SentAt,
+		}).Error
+}
+
+func (r *Repository) CountRecipients(uid uuid.UUID, statuses []string) (count int64, err error) {
+	err = r.gorm.DB.Model(&smsCampaignRecipient{}).
+		Where("campaign_uuid = ? and status in ?", uid, statuses).
+		Count(&count).Error
+
+	return count, err
+}
+
+// CountSentSince - сколько sms компания отправила с since, для ограничения скорости рассылок
+func (r *Repository) CountSentSince(companyUUID uuid.UUID, since time.Time) (count int64, err error) {
+	err = r.gorm.DB.Model(&sms{}).
+		Where("company_uuid = ? and created_at > ?", companyUUID, since).
+		Count(&count).Error
+
+	return count, err
+}
+
+func campaignToDomain(item smsCampaign) domain.SmsCampaign {
+	return domain.SmsCampaign{
+		UUID:           item.UUID,
+		FederationUUID: item.FederationUUID,
+		CompanyUUID:    item.CompanyUUID,
+		CreatedBy:      item.CreatedBy,
+		CreatedByUUID:  item.CreatedByUUID,
+
+		Name:       item.Name,
+		Template:   item.Template,
+		Source:     item.Source,
+		SourceUUID: item.SourceUUID,
+
+		Status:     item.Status,
+		SendAt:     item.SendAt,
+		StartedAt:  item.StartedAt,
+		FinishedAt: item.FinishedAt,
+
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+		DeletedAt: item.DeletedAt,
+	}
+}
+
+func recipientToDomain(item smsCampaignRecipient) domain.SmsRecipient {
+	vars := make(map[string]string, len(item.Vars))
+	for k, v := range item.Vars {
+		vars[k] = fmt.Sprint(v)
+	}
+
+	return domain.SmsRecipient{
+		UUID:         item.UUID,
+		CampaignUUID: item.CampaignUUID,
+
+		Phone: item.Phone,
+		Name:  item.Name,
+		Vars:  vars,
+		Text:  item.Text,
+
+		Status: item.Status,
+		Error:  item.Error,
+		Cost:   item.Cost,
+
+		SmsUUID:  item.SmsUUID,
+		Delivery: item.Delivery,
+		SentAt:   item.SentAt,
+
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+type campaignText struct {
+	Text  string
+	Phone string
+	Count int
+}
+
+// CampaignTexts - уникальные тексты ожидающих получателей с примером номера, для оценки стоимости
+func (r *Repository) CampaignTexts(uid uuid.UUID) (rows []campaignText, err error) {
+	err = r.gorm.DB.Raw(`
+		select text, min(phone) as phone, count(*) as count
+		from sms_campaign_recipients
+		where campaign_uuid = ? and status = ?
+		group by text
+	`, uid, RecipientPending).Scan(&rows).Error
+
+	return rows, err
+}
+
+func (r *Repository) CreateTemplate(t *domain.SmsTemplate) error {
+	return r.gorm.DB.Create(&smsTemplate{
+		UUID:           t.UUID,
+		FederationUUID: t.FederationUUID,
+		CompanyUUID:    t.CompanyUUID,
+		CreatedBy:      t.CreatedBy,
+		CreatedByUUID:  t.CreatedByUUID,
+		Name:           t.Name,
+		Text:           t.Text,
+		PhoneField:     t.PhoneField,
+		ProjectUUID:    t.ProjectUUID,
+		OnStatus:       t.OnStatus,
+	}).Error
+}
+
+func (r *Repository) UpdateTemplate(t domain.SmsTemplate) error {
+	return r.gorm.DB.Model(&smsTemplate{}).
+		Where("uuid = ? and deleted_at is null", t.UUID).
+		Updates(map[string]interface{}{
+			"name":         t.Name,
+			"text":         t.Text,
+			"phone_field":  t.PhoneField,
+			"project_uuid": t.ProjectUUID,
+			"on_status":    t.OnStatus,
+			"updated_at":   time.Now(),
+		}).Error
+}
+
+func (r *Repository) DeleteTemplate(uid uuid.UUID) error {
+	return r.gorm.DB.Model(&smsTemplate{}).
+		Where("uuid = ?", uid).
+		Update("deleted_at", time.Now()).Error
+}
+
+func (r *Repository) GetTemplate(uid uuid.UUID) (t domain.SmsTemplate, err error) {
+	orm := smsTemplate{}
+
+	res := r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Limit(1).
+		Find(&orm)
+	if res.Error != nil {
+		return t, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return t, dto.NotFoundErr("шаблон sms не найден")
+	}
+
+	return templateToDomain(orm), nil
+}
+
+func (r *Repository) GetTemplates(companyUUID uuid.UUID) (dms []domain.SmsTemplate, err error) {
+	orms := []smsTemplate{}
+
+	err = r.gorm.DB.
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Order("name").
+		Find(&orms).Error
+
+	return helpers.Map(orms, func(item smsTemplate, i int) domain.SmsTemplate {
+		return templateToDomain(item)
+	}), err
+}
+
+// GetStatusTemplates - шаблоны, которые отправляются при переходе задачи проекта в статус
+func (r *Repository) GetStatusTemplates(companyUUID, projectUUID uuid.UUID, status int) (dms []domain.SmsTemplate, err error) {
+	orms := []smsTemplate{}
+
+	err = r.gorm.DB.
+		Where("company_uuid = ? and on_status = ?", companyUUID, status).
+		Where("project_uuid is null or project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&orms).Error
+
+	return helpers.Map(orms, func(item smsTemplate, i int) domain.SmsTemplate {
+		return templateToDomain(item)
+	}), err
+}
+
+func templateToDomain(item smsTemplate) domain.SmsTemplate {
+	return domain.SmsTemplate{
+		UUID:           item.UUID,
+		FederationUUID: item.FederationUUID,
+		CompanyUUID:    item.CompanyUUID,
+		CreatedBy:      item.CreatedBy,
+		CreatedByUUID:  item.CreatedByUUID,
+
+		Name:       item.Name,
+		Text:       item.Text,
+		PhoneField: item.PhoneField,
+
+		ProjectUUID: item.ProjectUUID,
+		OnStatus:    item.OnStatus,
+
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+		DeletedAt: item.DeletedAt,
+	}
 }
@@ -0,0 +1,320 @@
+package sms
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"time"
+	"unicode/utf16"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+const ProviderSMPP = "smpp"
+
+const (
+	smppBindTransmitter     uint32 = 0x00000002
+	smppBindTransmitterResp uint32 = 0x80000002
+	smppQuerySm             uint32 = 0x00000003
+	smppQuerySmResp         uint32 = 0x80000003
+	smppSubmitSm            uint32 = 0x00000004
+	smppSubmitSmResp        uint32 = 0x80000004
+	smppUnbind              uint32 = 0x00000006
+	smppGenericNack         uint32 = 0x80000000
+
+	smppMessagePayload uint16 = 0x0424
+
+	smppCodingDefault byte = 0x00
+	smppCodingUCS2    byte = 0x08
+)
+
+// smppStates - message_state из query_sm_resp в коды sms.ru
+var smppStates = map[byte]int{
+	1: 102, // ENROUTE
+	2: 103, // DELIVERED
+	3: 104, // EXPIRED
+	4: 105, // DELETED
+	5: 107, // UNDELIVERABLE
+	6: 101, // ACCEPTED
+	7: -1,  // UNKNOWN
+	8: 108, // REJECTED
+}
+
+// SMPP - клиент SMPP 3.4 в режиме transmitter. На каждую операцию открывается
+// отдельное соединение: bind, запрос, unbind.
+type SMPP struct {
+	Addr       string
+	SystemID   string
+	Password   string
+	SystemType string
+	Timeout    time.Duration
+}
+
+func NewSMPP(addr, systemID, password, systemType string) *SMPP {
+	return &SMPP{
+		Addr:       addr,
+		SystemID:   systemID,
+		Password:   password,
+		SystemType: systemType,
+		Timeout:    10 * time.Second,
+	}
+}
+
+func (c *SMPP) Name() string {
+	return ProviderSMPP
+}
+
+func (c *SMPP) Send(ctx context.Context, p *domain.Sms) (Response, error) {
+	messages := p.Multi
+	if len(messages) == 0 {
+		messages = map[string]string{p.To: p.Text}
+	}
+
+	res := Response{Status: 100}
+	sent := []string{}
+
+	// номера по порядку, чтобы id в res.Ids соответствовали номерам в sent
+	numbers := lo.Keys(messages)
+	sort.Strings(numbers)
+
+	err := c.session(ctx, func(conn *smppConn) error {
+		for _, to := range numbers {
+			body, err := c.submitSm(p.From, to, messages[to])
+			if err != nil {
+				return err
+			}
+
+			resp, err := conn.call(smppSubmitSm, body, smppSubmitSmResp)
+			if err != nil {
+				return err
+			}
+
+			id, _ := cString(resp)
+			res.Ids = append(res.Ids, id)
+			res.Count += smsParts(messages[to])
+			sent = append(sent, to)
+		}
+
+		return nil
+	})
+
+	if err != nil && len(sent) > 0 {
+		return res, &PartialSendError{Sent: sent, Err: err}
+	}
+
+	return res, err
+}
+
+func (c *SMPP) Status(ctx context.Context, id string) (Response, error) {
+	res := Response{Status: -1, Ids: []string{id}}
+
+	err := c.session(ctx, func(conn *smppConn) error {
+		var body bytes.Buffer
+		body.WriteString(id + "\x00")
+		body.Write([]byte{0, 0}) // source_addr_ton, source_addr_npi
+		body.WriteString("\x00")
+
+		resp, err := conn.call(smppQuerySm, body.Bytes(), smppQuerySmResp)
+		if err != nil {
+			return err
+		}
+
+		_, rest := cString(resp) // message_id
+		_, rest = cString(rest)  // final_date
+		if len(rest) == 0 {
+			return errNoResponse
+		}
+
+		if status, ok := smppStates[rest[0]]; ok {
+			res.Status = status
+		}
+
+		return nil
+	})
+
+	return res, err
+}
+
+func (c *SMPP) Cost(_ context.Context, p *domain.Sms) (Response, error) {
+	return Response{Status: 100, Count: smsParts(p.Text)}, nil
+}
+
+func (c *SMPP) Balance(_ context.Context) (Response, error) {
+	return Response{}, ErrNotSupported
+}
+
+func (c *SMPP) submitSm(from, to, text string) ([]byte, error) {
+	coding, msg := smppEncode(text)
+
+	var b bytes.Buffer
+	b.WriteString("\x00") // service_type
+	b.Write([]byte{5, 0}) // source_addr_ton (alphanumeric), source_addr_npi
+	b.WriteString(from + "\x00")
+	b.Write([]byte{1, 1}) // dest_addr_ton (international), dest_addr_npi (isdn)
+	b.WriteString(to + "\x00")
+	b.Write([]byte{0, 0, 0}) // esm_class, protocol_id, priority_flag
+	b.WriteString("\x00")    // schedule_delivery_time
+	b.WriteString("\x00")    // validity_period
+	b.Write([]byte{1, 0})    // registered_delivery (нужен отчет), replace_if_present_flag
+	b.WriteByte(coding)
+	b.WriteByte(0) // sm_default_msg_id
+
+	// длинные сообщения уходят в message_payload, short_message остается пустым
+	if len(msg) <= 140 {
+		b.WriteByte(byte(len(msg)))
+		b.Write(msg)
+		return b.Bytes(), nil
+	}
+
+	if len(msg) > 0xFFFF {
+		return nil, errors.New("smpp: сообщение слишком длинное")
+	}
+
+	b.WriteByte(0)
+	_ = binary.Write(&b, binary.BigEndian, smppMessagePayload)
+	_ = binary.Write(&b, binary.BigEndian, uint16(len(msg)))
+	b.Write(msg)
+
+	return b.Bytes(), nil
+}
+
+func (c *SMPP) session(ctx context.Context, fn func(conn *smppConn) error) error {
+	d := net.Dialer{Timeout: c.Timeout}
+
+	nc, err := d.DialContext(ctx, "tcp", c.Addr)
+	if err != nil {
+		return fmt.Errorf("smpp: %w", err)
+	}
+	defer nc.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = nc.SetDeadline(deadline)
+	} else {
+		_ = nc.SetDeadline(time.Now().Add(c.Timeout))
+	}
+
+	conn := &smppConn{rw: bufio.NewReadWriter(bufio.NewReader(nc), bufio.NewWriter(nc))}
+
+	var bind bytes.Buffer
+	bind.WriteString(c.SystemID + "\x00")
+	bind.WriteString(c.Password + "\x00")
+	bind.WriteString(c.SystemType + "\x00")
+	bind.Write([]byte{0x34, 0, 0}) // interface_version, addr_ton, addr_npi
+	bind.WriteString("\x00")       // address_range
+
+	_, err = conn.call(smppBindTransmitter, bind.Bytes(), smppBindTransmitterResp)
+	if err != nil {
+		return err
+	}
+
+	err = fn(conn)
+
+	// unbind_resp не ждем, соединение все равно закрывается
+	_ = conn.write(smppUnbind, nil)
+
+	return err
+}
+
+type smppConn struct {
+	rw  *bufio.ReadWriter
+	seq uint32
+}
+
+func (c *smppConn) write(cmd uint32, body []byte) error {
+	c.seq++
+
+	header := make([]byte, 16)
+	binary.BigEndian.PutUint32(header[0:], uint32(16+len(body)))
+	binary.BigEndian.PutUint32(header[4:], cmd)
+	binary.BigEndian.PutUint32(header[8:], 0)
+	binary.BigEndian.PutUint32(header[12:], c.seq)
+
+	if _, err := c.rw.Write(header); err != nil {
+		return err
+	}
+
+	if _, err := c.rw.Write(body); err != nil {
+		return err
+	}
+
+	return c.rw.Flush()
+}
+
+// call отправляет pdu и ждет ответ want с тем же sequence_number, остальные pdu пропускаются
+func (c *smppConn) call(cmd uint32, body []byte, want uint32) ([]byte, error) {
+	if err := c.write(cmd, body); err != nil {
+		return nil, fmt.Errorf("smpp: %w", err)
+	}
+
+	seq := c.seq
+
+	for {
+		header := make([]byte, 16)
+		if _, err := io.ReadFull(c.rw, header); err != nil {
+			return nil, fmt.Errorf("smpp: %w", err)
+		}
+
+		length := binary.BigEndian.Uint32(header[0:])
+		respCmd := binary.BigEndian.Uint32(header[4:])
+		status := binary.BigEndian.Uint32(header[8:])
+		respSeq := binary.BigEndian.Uint32(header[12:])
+
+		if length < 16 || length > 64*1024 {
+			return nil, errors.New("smpp: неверная длина pdu")
+		}
+
+		respBody := make([]byte, length-16)
+		if _, err := io.ReadFull(c.rw, respBody); err != nil {
+			return nil, fmt.Errorf("smpp: %w", err)
+		}
+
+		if respSeq != seq || (respCmd != want && respCmd != smppGenericNack) {
+			continue
+		}
+
+		if status != 0 || respCmd == smppGenericNack {
+			return nil, fmt.Errorf("smpp: command 0x%08x status 0x%08x", cmd, status)
+		}
+
+		return respBody, nil
+	}
+}
+
+// smppEncode выбирает кодировку: латиница в GSM 7-bit по умолчанию, иначе UCS2
+func smppEncode(text string) (byte, []byte) {
+	ascii := true
+	for _, r := range text {
+		if r > 127 {
+			ascii = false
+			break
+		}
+	}
+
+	if ascii {
+		return smppCodingDefault, []byte(text)
+	}
+
+	u := utf16.Encode([]rune(text))
+	b := make([]byte, len(u)*2)
+	for i, v := range u {
+		binary.BigEndian.PutUint16(b[i*2:], v)
+	}
+
+	return smppCodingUCS2, b
+}
+
+func cString(b []byte) (string, []byte) {
+	i := bytes.IndexByte(b, 0)
+	if i < 0 {
+		return string(b), nil
+	}
+
+	return string(b[:i]), b[i+1:]
+}
@@ -0,0 +1,364 @@
+package sms
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+const ProviderSmsRu = "smsru"
+
+// SmsRu - провайдер https://sms.ru, api - api_id из личного кабинета
+type SmsRu struct {
+	APIURL string
+	HTTP   *http.Client
+
+	api string
+}
+
+func NewSmsRu(client *http.Client, apiURL, api string) *SmsRu {
+	return &SmsRu{
+		APIURL: apiURL,
+		HTTP:   client,
+		api:    api,
+	}
+}
+
+func (c *SmsRu) Name() string {
+	return ProviderSmsRu
+}
+
+func (c *SmsRu) makeRequest(ctx context.Context, endpoint string, params url.Values) (Response, []string, error) {
+	params.Set("api_id", c.api)
+	aPIURL := c.APIURL + endpoint + "?" + params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, aPIURL, http.NoBody)
+	if err != nil {
+		return Response{}, nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return Response{}, nil, err
+	}
+	defer resp.Body.Close()
+
+	sc := bufio.NewScanner(resp.Body)
+	var lines []string
+	for sc.Scan() {
+		lines = append(lines, sc.Text())
+	}
+
+	if err := sc.Err(); err != nil {
+		return Response{}, nil, errInternal
+	}
+
+	if len(lines) == 0 {
+		return Response{}, nil, errNoResponse
+	}
+
+	status, err := strconv.Atoi(lines[0])
+	if err != nil {
+		return Response{}, nil, errInternal
+	}
+
+	if status >= 200 {
+		msg := fmt.Sprintf("Code: %d; Status: %s", status, codeStatus[status])
+		return Response{}, nil, errors.New(msg)
+	}
+
+	res := Response{Status: status}
+	return res, lines, nil
+}
+
+func (c *SmsRu) Send(ctx context.Context, p *domain.Sms) (Response, error) {
+	params := url.Values{}
+
+	params.Set("from", p.From)
+
+	params.Set("to", p.To)
+	if len(p.Multi) > 0 {
+		for to, text := range p.Multi {
+			key := fmt.Sprintf("multi[%s]", to)
+			params.Add(key, text)
+		}
+	} else {
+		params.Set("to", p.To)
+		params.Set("text", p.Text)
+	}
+
+	if len(p.From) > 0 {
+		params.Set("from", p.From)
+	}
+
+	if p.PartnerID > 0 {
+		val := strconv.Itoa(p.PartnerID)
+		params.Set("partner_id", val)
+	}
+
+	if p.Test {
+		params.Set("test", "1")
+	}
+
+	if p.Time.After(time.Now()) {
+		val := strconv.FormatInt(p.Time.Unix(), 10)
+		params.Set("time", val)
+	}
+
+	if p.Translit {
+		params.Set("translit", "1")
+	}
+
+	res, lines, err := c.makeRequest(ctx, "/sms/send", params)
+	if err != nil {
+		return Response{}, err
+	}
+
+	var ids []string
+	re := regexp.MustCompile("^balance=")
+
+	for i := 1; i < len(lines); i++ {
+		isBalance := re.MatchString(lines[i])
+
+		if isBalance {
+			str := re.ReplaceAllString(lines[i], "")
+			balance, err := strconv.ParseFloat(str, 32)
+			if err != nil {
+				return Response{}, errInternal
+			}
+			res.Balance = float32(balance)
+		} else {
+			ids = append(ids, lines[i])
+		}
+	}
+
+	res.Ids = ids
+	return res, nil
+}
+
+// Status возвращает статус доставки сообщения, первая строка ответа - код запроса, вторая - код статуса
+func (c *SmsRu) Status(ctx context.Context, id string) (Response, error) {
+	params := url.Values{}
+	params.Set("id", id)
+
+	res, lines, err := c.makeRequest(ctx, "/sms/status", params)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(lines) > 1 {
+		status, err := strconv.Atoi(lines[1])
+		if err != nil {
+			return Response{}, errInternal
+		}
+		res.Status = status
+	}
+
+	res.Ids = []string{id}
+
+	return res, nil
+}
+
+func (c *SmsRu) Cost(ctx context.Context, p *domain.Sms) (Response, error) {
+	params := url.Values{}
+	params.Set("from", p.From)
+	params.Set("to", p.To)
+	params.Set("text", p.Text)
+	if p.Translit {
+		params.Set("translit", "1")
+	}
+
+	res, lines, err := c.makeRequest(ctx, "/sms/cost", params)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(lines) < 3 {
+		return Response{}, errNoResponse
+	}
+
+	cost, err := strconv.ParseFloat(lines[1], 32)
+	if err != nil {
+		return Response{}, errInternal
+	}
+
+	count, err := strconv.Atoi(lines[2])
+	if err != nil {
+		return Response{}, errInternal
+	}
+
+	res.Cost = float32(cost)
+	res.Count = count
+
+	return res, nil
+}
+
+func (c *SmsRu) Balance(ctx context.Context) (Response, error) {
+	res, lines, err := c.makeRequest(ctx, "/my/balance", url.Values{})
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(lines) < 2 {
+		return Response{}, errNoResponse
+	}
+
+	balance, err := strconv.ParseFloat(lines[1], 32)
+	if err != nil {
+		return Response{}, errInternal
+	}
+
+	res.Balance = float32(balance)
+	return res, nil
+}
+
+// MyLimit checks the limit.
+func (c *SmsRu) MyLimit(ctx context.Context) (Response, error) {
+	res, lines, err := c.makeRequest(ctx, "/my/limit", url.Values{})
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(lines) < 3 {
+		return Response{}, errNoResponse
+	}
+
+	limit, err := strconv.Atoi(lines[1])
+	if err != nil {
+		return Response{}, errInternal
+	}
+
+	limitSent, err := strconv.Atoi(lines[2])
+	if err != nil {
+		return Response{}, errInternal
+	}
+
+	res.Limit = limit
+	res.LimitSent = limitSent
+	return res, nil
+}
+
+// MySenders receives the list of senders.
+func (c *SmsRu) MySenders(ctx context.Context) (Response, error) {
+	res, lines, err := c.makeRequest(ctx, "/my/senders", url.Values{})
+	if err != nil {
+		return Response{}, err
+	}
+
+	var senders []string
+	for i := 1; i < len(lines); i++ {
+		senders = append(senders, lines[i])
+	}
+
+	res.Senders = senders
+	return res, nil
+}
+
+// StoplistGet receives the stoplist.
+func (c *SmsRu) StoplistGet(ctx context.Context) (Response, error) {
+	res, lines, err := c.makeRequest(ctx, "/stoplist/get", url.Values{})
+	if err != nil {
+		return Response{}, err
+	}
+
+	stoplist := make(map[string]string)
+	for i := 1; i < len(lines); i++ {
+		re := regexp.MustCompile(";")
+		str := re.Split(lines[i], 2)
+
+		if len(str) == 2 {
+			stoplist[str[0]] = str[1]
+		}
+	}
+
+	res.Stoplist = stoplist
+	return res, nil
+}
+
+func (c *SmsRu) StoplistAdd(ctx context.Context, phone, text string) (Response, error) {
+	params := url.Values{}
+	params.Set("stoplist_phone", phone)
+	params.Set("stoplist_text", text)
+
+	res, _, err := c.makeRequest(ctx, "/stoplist/add", params)
+	if err != nil {
+		return Response{}, err
+	}
+
+	return res, nil
+}
+
+// StoplistDel will delete the phone number from stoplist
+//
+// phone is phone number.
+func (c *SmsRu) StoplistDel(ctx context.Context, phone string) (Response, error) {
+	params := url.Values{}
+	params.Set("stoplist_phone", phone)
+
+	res, _, err := c.makeRequest(ctx, "/stoplist/del", params)
+	if err != nil {
+		return Response{}, err
+	}
+
+	return res, nil
+}
+
+// CallbackGet receives the callbacks from service.
+func (c *SmsRu) CallbackGet(ctx context.Context) (Response, error) {
+	res, lines, err := c.makeRequest(ctx, "/callback/get", url.Values{})
+	if err != nil {
+		return Response{}, err
+	}
+
+	var callbacks []string
+	for i := 1; i < len(lines); i++ {
+		callbacks = append(callbacks, lines[i])
+	}
+
+	res.Callbacks = callbacks
+	return res, nil
+}
+
+func (c *SmsRu) CallbackAdd(ctx context.Context, cbURL string) (Response, error) {
+	params := url.Values{}
+	params.Set("url", cbURL)
+
+	res, lines, err := c.makeRequest(ctx, "/callback/add", params)
+	if err != nil {
+		return Response{}, err
+	}
+
+	var callbacks []string
+	for i := 1; i < len(lines); i++ {
+		callbacks = append(callbacks, lines[i])
+	}
+
+	res.Callbacks = callbacks
+	return res, nil
+}
+
+func (c *SmsRu) CallbackDel(ctx context.Context, cbURL string) (Response, error) {
+	params := url.Values{}
+	params.Set("url", cbURL)
+
+	res, lines, err := c.makeRequest(ctx, "/callback/del", params)
+	if err != nil {
+		return Response{}, err
+	}
+
+	var callbacks []string
+	for i := 1; i < len(lines); i++ {
+		callbacks = append(callbacks, lines[i])
+	}
+
+	res.Callbacks = callbacks
+	return res, nil
+}
@@ -0,0 +1,208 @@
+package sms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	StateSent      = "sent"
+	StateDelivered = "delivered"
+	StateFailed    = "failed"
+)
+
+const (
+	// PollBatch - сколько сообщений проверяется за один проход
+	PollBatch = 100
+	// PollMaxAge - сообщения старше не проверяются, статус так и остается sent
+	PollMaxAge = 72 * time.Hour
+)
+
+// CallbackToken - подпись адреса отчетов о доставке для компании и провайдера. Токен одной компании
+// не подходит к адресу другой, поэтому с ним нельзя менять статусы чужих сообщений
+func CallbackToken(secret string, companyUUID uuid.UUID, provider string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(companyUUID.String() + "/" + provider))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CheckCallbackToken - token выдан для этой компании и провайдера, без секрета отчеты не принимаются
+func CheckCallbackToken(secret string, companyUUID uuid.UUID, provider, token string) bool {
+	return secret != "" && hmac.Equal([]byte(token), []byte(CallbackToken(secret, companyUUID, provider)))
+}
+
+// Report - отчет о доставке от провайдера, Code - код статуса sms.ru
+type Report struct {
+	ID   string
+	Code int
+	At   time.Time
+}
+
+// StateByCode приводит код sms.ru к состоянию сообщения. Для неизвестного кода возвращается пустая строка.
+func StateByCode(code int) string {
+	switch {
+	case code >= 100 && code <= 102:
+		return StateSent
+	case code == 103:
+		return StateDelivered
+	case code >= 104:
+		if _, ok := codeStatus[code]; ok {
+			return StateFailed
+		}
+	}
+
+	return ""
+}
+
+// ParseSmsRuCallback разбирает поля data[] из callback sms.ru:
+// "sms_status\n<id>\n<код>\n<unixtime>", остальные типы уведомлений пропускаются
+func ParseSmsRuCallback(data []string) []Report {
+	var reports []Report
+
+	for _, item := range data {
+		lines := strings.Split(strings.ReplaceAll(item, "\r", ""), "\n")
+		if len(lines) < 3 || lines[0] != "sms_status" {
+			continue
+		}
+
+		code, err := strconv.Atoi(strings.TrimSpace(lines[2]))
+		if err != nil {
+			continue
+		}
+
+		r := Report{ID: strings.TrimSpace(lines[1]), Code: code, At: time.Now()}
+
+		if len(lines) > 3 {
+			if ts, err := strconv.ParseInt(strings.TrimSpace(lines[3]), 10, 64); err == nil && ts > 0 {
+				r.At = time.Unix(ts, 0)
+			}
+		}
+
+		reports = append(reports, r)
+	}
+
+	return reports
+}
+
+// OnResolveOptions задает получение настроек sms компании, нужно поллеру статусов
+func (c *Service) OnResolveOptions(fn func(companyUUID uuid.UUID) (Options, error)) {
+	c.resolveOptions = fn
+}
+
+// ApplyReports обновляет статусы сохраненных сообщений компании по отчетам провайдера
+func (c *Service) ApplyReports(_ context.Context, companyUUID uuid.UUID, provider string, reports []Report) (updated int, err error) {
+	if provider == "" {
+		provider = ProviderSmsRu
+	}
+
+	for _, r := range reports {
+		state := StateByCode(r.Code)
+		if state == "" || r.ID == "" {
+			continue
+		}
+
+		errorCode := 0
+		if state == StateFailed {
+			errorCode = r.Code
+		}
+
+		n, err := c.repo.UpdateStatus(companyUUID, provider, r.ID, state, r.Code, errorCode, r.At)
+		if err != nil {
+			return updated, err
+		}
+
+		updated += int(n)
+	}
+
+	return updated, nil
+}
+
+// PollStatuses запрашивает статусы у провайдеров для сообщений, которые не проверялись дольше interval.
+// Провайдеры без запроса статуса (ErrNotSupported) пропускаются.
+func (c *Service) PollStatuses(ctx context.Context, interval time.Duration) (updated int, err error) {
+	if c.resolveOptions == nil {
+		return 0, errors.New("sms: не задано получение настроек компании")
+	}
+
+	now := time.Now()
+
+	pending, err := c.repo.GetPending(now.Add(-PollMaxAge), now.Add(-interval), PollBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	providers := map[string]SmsProvider{}
+
+	for _, s := range pending {
+		key := s.CompanyUUID.String() + "/" + s.Provider
+
+		provider, ok := providers[key]
+		if !ok {
+			provider = c.pollProvider(s.CompanyUUID, s.Provider)
+			providers[key] = provider
+		}
+
+		// без нового статуса только отмечаем время проверки, чтобы сообщение ушло в конец очереди
+		code := s.StatusCode
+
+		if provider != nil {
+			res, err := provider.Status(ctx, s.ProviderID)
+			switch {
+			case errors.Is(err, ErrNotSupported):
+			case err != nil:
+				logrus.WithField("sms", s.UUID).Warn("sms status: ", err)
+			case StateByCode(res.Status) != "":
+				code = res.Status
+			}
+		}
+
+		state := StateByCode(code)
+		if state == "" {
+			state = StateSent
+		}
+
+		errorCode := 0
+		if state == StateFailed {
+			errorCode = code
+		}
+
+		n, err := c.repo.UpdateStatus(s.CompanyUUID, s.Provider, s.ProviderID, state, code, errorCode, now)
+		if err != nil {
+			return updated, err
+		}
+
+		if code != s.StatusCode {
+			updated += int(n)
+		}
+	}
+
+	return updated, nil
+}
+
+func (c *Service) pollProvider(companyUUID uuid.UUID, name string) SmsProvider {
+	opts, err := c.resolveOptions(companyUUID)
+	if err != nil {
+		logrus.WithField("company", companyUUID).Warn("sms status: ", err)
+		return nil
+	}
+
+	opts.Provider = name
+
+	provider, err := c.Provider(opts)
+	if err != nil {
+		logrus.WithField("company", companyUUID).Warn("sms status: ", err)
+		return nil
+	}
+
+	return provider
+}
@@ -0,0 +1,63 @@
+package sms
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestStateByCode(t *testing.T) {
+	cases := map[int]string{
+		-1:  "",
+		100: StateSent,
+		102: StateSent,
+		103: StateDelivered,
+		107: StateFailed,
+		131: StateFailed,
+		150: "",
+	}
+
+	for code, want := range cases {
+		if got := StateByCode(code); got != want {
+			t.Errorf("StateByCode(%d) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestParseSmsRuCallback(t *testing.T) {
+	reports := ParseSmsRuCallback([]string{
+		"sms_status\n000000-10000000\n103\n1718614800",
+		"callcheck_status\n201737-542\n401\n1718614800",
+		"sms_status\r\n000000-10000001\r\n107",
+	})
+
+	if len(reports) != 2 {
+		t.Fatalf("len = %d, want 2", len(reports))
+	}
+
+	if reports[0].ID != "000000-10000000" || reports[0].Code != 103 || reports[0].At.Unix() != 1718614800 {
+		t.Errorf("unexpected report %+v", reports[0])
+	}
+
+	if reports[1].ID != "000000-10000001" || reports[1].Code != 107 {
+		t.Errorf("unexpected report %+v", reports[1])
+	}
+}
+
+func TestCallbackToken(t *testing.T) {
+	company, other := uuid.New(), uuid.New()
+	token := CallbackToken("secret", company, ProviderSmsRu)
+
+	if !CheckCallbackToken("secret", company, ProviderSmsRu, token) {
+		t.Error("own token rejected")
+	}
+	if CheckCallbackToken("secret", other, ProviderSmsRu, token) {
+		t.Error("token of another company accepted")
+	}
+	if CheckCallbackToken("secret", company, ProviderSMPP, token) {
+		t.Error("token of another provider accepted")
+	}
+	if CheckCallbackToken("", company, ProviderSmsRu, CallbackToken("", company, ProviderSmsRu)) {
+		t.Error("accepted without secret")
+	}
+}
@@ -0,0 +1,108 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+)
+
+func (c *Service) CreateTemplate(_ context.Context, t *domain.SmsTemplate) error {
+	if t.UUID == uuid.Nil {
+		t.UUID = uuid.New()
+	}
+
+	return c.repo.CreateTemplate(t)
+}
+
+func (c *Service) UpdateTemplate(_ context.Context, t domain.SmsTemplate) error {
+	return c.repo.UpdateTemplate(t)
+}
+
+func (c *Service) DeleteTemplate(_ context.Context, uid uuid.UUID) error {
+	return c.repo.DeleteTemplate(uid)
+}
+
+func (c *Service) GetTemplate(_ context.Context, uid uuid.UUID) (domain.SmsTemplate, error) {
+	return c.repo.GetTemplate(uid)
+}
+
+func (c *Service) GetTemplates(_ context.Context, companyUUID uuid.UUID) ([]domain.SmsTemplate, error) {
+	return c.repo.GetTemplates(companyUUID)
+}
+
+// StatusTemplates - шаблоны, которые нужно отправить при переходе задачи в статус
+func (c *Service) StatusTemplates(_ context.Context, task domain.Task, status int) ([]domain.SmsTemplate, error) {
+	return c.repo.GetStatusTemplates(task.CompanyUUID, task.ProjectUUID, status)
+}
+
+// TaskVars - значения для шаблона по задаче: {{task.id}}, {{task.name}}, {{task.status}}
+// и пользовательские поля как {{task.<имя поля>}} или {{task.<hash>}}
+func TaskVars(task domain.Task, status string, fields []dto.ProjectFieldDTO) map[string]string {
+	vars := map[string]string{
+		"task.id":     strconv.Itoa(task.ID),
+		"task.uuid":   task.UUID.String(),
+		"task.name":   task.Name,
+		"task.status": status,
+	}
+
+	for _, f := range fields {
+		v, ok := task.Fields[f.Hash]
+		if !ok || v == nil {
+			continue
+		}
+
+		s := fieldString(v)
+		vars["task."+f.Hash] = s
+
+		key := "task." + strings.ToLower(strings.TrimSpace(f.Name))
+		if _, ok := vars[key]; !ok {
+			vars[key] = s
+		}
+	}
+
+	return vars
+}
+
+// TaskPhone достает телефон получателя из поля задачи phoneField (имя или hash),
+// без phoneField - из первого поля, похожего на телефон
+func TaskPhone(task domain.Task, fields []dto.ProjectFieldDTO, phoneField string) string {
+	for _, f := range fields {
+		if phoneField != "" && f.Name != phoneField && f.Hash != phoneField {
+			continue
+		}
+
+		if phoneField == "" && !IsPhoneKey(f.Name) {
+			continue
+		}
+
+		if v, ok := task.Fields[f.Hash]; ok && v != nil {
+			if s := fieldString(v); s != "" {
+				return s
+			}
+		}
+	}
+
+	return ""
+}
+
+func fieldString(v interface{}) string {
+	switch t := v.(type) {
+	case []interface{}:
+		arr := make([]string, 0, len(t))
+		for _, item := range t {
+			if item != nil {
+				arr = append(arr, fmt.Sprint(item))
+			}
+		}
+		return strings.Join(arr, ", ")
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,50 @@
+package sms
+
+import (
+	"testing"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+)
+
+func TestTaskVars(t *testing.T) {
+	task := domain.Task{
+		ID:   42,
+		Name: "Доставка",
+		Fields: map[string]interface{}{
+			"f1": "+7 999 123-45-67",
+			"f2": float64(1500),
+			"f3": []interface{}{"a", "b"},
+		},
+	}
+
+	fields := []dto.ProjectFieldDTO{
+		{Name: "Телефон", Hash: "f1"},
+		{Name: "Сумма заказа", Hash: "f2"},
+		{Name: "Теги", Hash: "f3"},
+		{Name: "Пустое", Hash: "f4"},
+	}
+
+	vars := TaskVars(task, "В работе", fields)
+
+	got := RenderTemplate("Заказ #{{task.id}} «{{task.name}}»: {{task.status}}, {{ task.Сумма заказа }} руб. {{task.f3}}{{task.Пустое}}", vars)
+	if want := "Заказ #42 «Доставка»: В работе, 1500 руб. a, b"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if got := RenderTemplate("{{task.ТЕЛЕФОН}}", vars); got != "+7 999 123-45-67" {
+		t.Errorf("field name case: got %q", got)
+	}
+
+	if got := TaskPhone(task, fields, ""); got != "+7 999 123-45-67" {
+		t.Errorf("phone by name: got %q", got)
+	}
+
+	if got := TaskPhone(task, fields, "f2"); got != "1500" {
+		t.Errorf("phone by hash: got %q", got)
+	}
+
+	if got := TaskPhone(task, fields, "Пустое"); got != "" {
+		t.Errorf("empty field: got %q", got)
+	}
+}
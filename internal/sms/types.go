@@ -2,6 +2,8 @@ package sms
 
 import (
 	"net/http"
+
+	"github.com/google/uuid"
 )
 
 type Service struct {
@@ -10,6 +12,9 @@ type Service struct {
 	Debug  bool
 
 	repo *Repository
+	fake *Fake
+
+	resolveOptions func(companyUUID uuid.UUID) (Options, error)
 }
 
 type Response struct {
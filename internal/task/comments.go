@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
 )
 
@@ -14,6 +15,8 @@ func (s *Service) CreateComment(ctx context.Context, uid uuid.UUID, cm domain.Co
 		return err
 	}
 
+	cm.CommentHTML = s.RenderMarkdown(task.FederationUUID, task.ProjectUUID, cm.Comment)
+
 	err = s.commentService.CreateComment(ctx, cm)
 	if err != nil {
 		return err
@@ -37,6 +40,8 @@ func (s *Service) UpdateComment(ctx context.Context, uid uuid.UUID, cm domain.Co
 		return err
 	}
 
+	cm.CommentHTML = s.RenderMarkdown(task.FederationUUID, task.ProjectUUID, cm.Comment)
+
 	err = s.commentService.UpdateComment(ctx, cm)
 	if err != nil {
 		return err
@@ -54,19 +59,19 @@ func (s *Service) UpdateComment(ctx context.Context, uid uuid.UUID, cm domain.Co
 	return nil
 }
 
-func (s *Service) DeleteComment(ctx context.Context, taskUID, comentUID uuid.UUID, deletedBy string) (err error) {
+func (s *Service) DeleteComment(ctx context.Context, taskUID, comentUID uuid.UUID, deletedBy domain.Creator) (err error) {
 	task, err := s.GetTask(ctx, taskUID, []string{})
 	if err != nil {
 		return err
 	}
 
-	err = s.commentService.DeleteComment(ctx, task.UUID, comentUID, nil)
+	err = s.commentService.DeleteComment(ctx, task.UUID, comentUID, deletedBy)
 	if err != nil {
 		return err
 	}
 
 	notify := lo.Filter(task.People, func(email string, _ int) bool {
-		return email != deletedBy
+		return email != deletedBy.Email
 	})
 
 	err = s.TaskWasUpdatedOrCreated(taskUID, notify)
@@ -76,3 +81,46 @@ func (s *Service) DeleteComment(ctx context.Context, taskUID, comentUID uuid.UUI
 
 	return nil
 }
+
+// HideComment скрывает комментарий задачи, задача переиндексируется без его текста
+func (s *Service) HideComment(ctx context.Context, taskUID, commentUID uuid.UUID, crtr domain.Creator) (err error) {
+	err = s.checkCommentTask(ctx, taskUID, commentUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.commentService.HideComment(ctx, crtr, commentUID)
+	if err != nil {
+		return err
+	}
+
+	return s.TaskWasUpdatedOrCreated(taskUID, []string{})
+}
+
+// RestoreComment возвращает скрытый комментарий задачи
+func (s *Service) RestoreComment(ctx context.Context, taskUID, commentUID uuid.UUID, crtr domain.Creator) (err error) {
+	err = s.checkCommentTask(ctx, taskUID, commentUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.commentService.RestoreComment(ctx, crtr, commentUID)
+	if err != nil {
+		return err
+	}
+
+	return s.TaskWasUpdatedOrCreated(taskUID, []string{})
+}
+
+func (s *Service) checkCommentTask(ctx context.Context, taskUID, commentUID uuid.UUID) error {
+	comment, err := s.commentService.GetComment(ctx, commentUID)
+	if err != nil {
+		return err
+	}
+
+	if comment.TaskUUID != taskUID {
+		return dto.NotFoundErr("комментарий не найден")
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package task
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
 
 func (s *Service) OnTaskUpdatedOrCreated(fn func(uuid.UUID, []string) error) {
 	s.onTaskUpdatedOrCreated = fn
@@ -9,3 +12,8 @@ func (s *Service) OnTaskUpdatedOrCreated(fn func(uuid.UUID, []string) error) {
 func (s *Service) OnOpenTask(fn func(uuid.UUID, string) error) {
 	s.onOpenTask = fn
 }
+
+// OnTaskStatusChanged вызывается после смены статуса задачи, task уже с новым статусом
+func (s *Service) OnTaskStatusChanged(fn func(domain.Creator, domain.Task) error) {
+	s.onTaskStatusChanged = fn
+}
@@ -0,0 +1,76 @@
+package task
+
+import (
+	"fmt"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+)
+
+// checkFieldRules проверяет присланные значения полей по правилам проекта и обязательность
+// полей на текущем статусе задачи. Все нарушения возвращаются одной ошибкой dto.FieldsError
+func (s *Service) checkFieldRules(task domain.Task, projectFields []CompanyFields, filteredFields map[string]interface{}) error {
+	violations := []dto.FieldError{}
+
+	for _, pfield := range projectFields {
+		if domain.FieldDataType(pfield.DataType) == domain.Formula {
+			continue
+		}
+
+		value, ok := filteredFields[pfield.Hash]
+		if !ok {
+			raw, sent := task.RawFields[pfield.Hash]
+			_, stored := task.Fields[pfield.Hash]
+
+			// поле очищено или его нет в задаче
+			empty := sent && raw == nil || !sent && !stored
+			if empty && pfield.Rules.RequiredOn(task.Status, nil) {
+				violations = append(violations, requiredFieldError(pfield.Hash, pfield.Name, task.Status))
+			}
+
+			continue
+		}
+
+		if rule, err := pfield.Rules.Check(value); err != nil {
+			violations = append(violations, dto.FieldError{
+				Hash:    pfield.Hash,
+				Name:    pfield.Name,
+				Rule:    rule,
+				Message: err.Error(),
+			})
+
+			continue
+		}
+
+		if pfield.Rules.Unique {
+			taken, err := s.repo.FieldValueTaken(task.ProjectUUID, task.UUID, pfield.Hash, value)
+			if err != nil {
+				return err
+			}
+
+			if taken {
+				violations = append(violations, dto.FieldError{
+					Hash:    pfield.Hash,
+					Name:    pfield.Name,
+					Rule:    domain.RuleUnique,
+					Message: "значение уже есть в другой задаче проекта",
+				})
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return dto.FieldsError{Fields: violations}
+	}
+
+	return nil
+}
+
+func requiredFieldError(hash, name string, status int) dto.FieldError {
+	return dto.FieldError{
+		Hash:    hash,
+		Name:    name,
+		Rule:    domain.RuleRequired,
+		Message: fmt.Sprintf("поле обязательно на статусе %d", status),
+	}
+}
@@ -0,0 +1,46 @@
+package task
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/formula"
+	"github.com/sirupsen/logrus"
+)
+
+// computeFormulas пересчитывает вычисляемые поля проекта в fields. Формулы, которые
+// ссылаются на поля, не подключенные к проекту, не вычисляются. Поля data и data_array
+// задачи не знают своего справочника, формулы с обращением к ним отклоняет federation.checkFormula
+func (s *Service) computeFormulas(projectUUID uuid.UUID, fields map[string]interface{}) error {
+	projectFields, err := s.repo.GetProjectFields(projectUUID)
+	if err != nil {
+		return err
+	}
+
+	ff := map[string]formula.Field{}
+	defs := []formula.Definition{}
+
+	for _, f := range projectFields {
+		if domain.FieldDataType(f.DataType) == domain.Formula {
+			defs = append(defs, formula.Definition{Hash: f.Hash, Formula: f.Formula})
+			delete(fields, f.Hash)
+			continue
+		}
+
+		if t, ok := formula.FieldType(domain.FieldDataType(f.DataType)); ok {
+			ff[f.Hash] = formula.Field{Type: t}
+		}
+	}
+
+	if len(defs) == 0 {
+		return nil
+	}
+
+	p := formula.Compile(ff, defs)
+	for hash, err := range p.Errors {
+		logrus.WithField("project", projectUUID).WithField("hash", hash).Warn("formula: ", err)
+	}
+
+	p.Run(formula.Env{Values: fields})
+
+	return nil
+}
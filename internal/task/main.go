@@ -35,8 +35,11 @@ type Service struct {
 
 	ttlCache *ttlcache.Cache[string, []dto.TaskDTO]
 
+	linkHosts []string
+
 	onTaskUpdatedOrCreated func(uuid.UUID, []string) error
 	onOpenTask             func(uuid.UUID, string) error
+	onTaskStatusChanged    func(domain.Creator, domain.Task) error
 }
 
 func New(repo *Repository, dict *dictionary.Service, as *activities.Service, ps *profile.Service, cs *comments.Service, storage *s3.ServicePrivate) *Service {
@@ -68,6 +71,14 @@ func (s *Service) TaskWasUpdatedOrCreated(uid uuid.UUID, people []string) error
 	return nil
 }
 
+func (s *Service) TaskStatusWasChanged(crtr domain.Creator, task domain.Task) error {
+	if s.onTaskStatusChanged != nil {
+		return s.onTaskStatusChanged(crtr, task)
+	}
+
+	return nil
+}
+
 func (s *Service) TaskWasOpen(uid uuid.UUID, email string) error {
 	if s.onOpenTask != nil {
 		return s.onOpenTask(uid, email)
@@ -88,6 +99,12 @@ func (s *Service) CreateTask(task domain.Task) (id int, err error) {
 
 	task.Fields = filteredFields
 
+	err = s.computeFormulas(task.ProjectUUID, task.Fields)
+	if err != nil {
+		return id, err
+	}
+	task.DescriptionHTML = s.RenderMarkdown(task.FederationUUID, task.ProjectUUID, task.Description)
+
 	orm, err := s.repo.CreateTask(task, false)
 	if err != nil {
 		return id, err
@@ -121,6 +138,10 @@ func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate
 		return err
 	}
 
+	if task.Fields == nil {
+		task.Fields = map[string]interface{}{}
+	}
+
 	for k, v := range filteredFields {
 		task.Fields[k] = v
 	}
@@ -131,11 +152,22 @@ func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate
 		}
 	}
 
+	if lo.Contains(shouldUpdate, "fields") {
+		err = s.computeFormulas(task.ProjectUUID, task.Fields)
+		if err != nil {
+			return err
+		}
+	}
+
 	oldTask, err := s.GetTask(context.Background(), task.UUID, []string{})
 	if err != nil {
 		return err
 	}
 
+	if lo.Contains(shouldUpdate, "description") {
+		task.DescriptionHTML = s.RenderMarkdown(oldTask.FederationUUID, oldTask.ProjectUUID, task.Description)
+	}
+
 	err = s.repo.UpdateTask(task, shouldUpdate)
 	if err != nil {
 		return err
@@ -175,7 +207,8 @@ func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate
 func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]interface{}, err error) {
 	filteredFields = make(map[string]interface{}, 0)
 
-	if len(task.RawFields) > 0 {
+	// новая задача проверяется всегда: на начальном статусе могут быть обязательные поля
+	if len(task.RawFields) > 0 || task.ID == 0 {
 		projectFields, err := s.repo.GetProjectFields(task.ProjectUUID)
 		if err != nil {
 			return filteredFields, err
@@ -358,6 +391,23 @@ func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]
 						msg := fmt.Sprintf("field %s (%s) should be array", pfield.Name, pfield.Hash)
 						return filteredFields, errors.New(msg)
 					}
+				case domain.Select, domain.MultiSelect:
+					v, err := pfield.Options.SelectValue(domain.FieldDataType(pfield.DataType), value, task.Fields[pfield.Hash])
+					if err != nil {
+						return filteredFields, fmt.Errorf("field %s (%s): %w", pfield.Name, pfield.Hash, err)
+					}
+
+					filteredFields[pfield.Hash] = v
+				case domain.Money:
+					m, err := domain.NewMoney(value)
+					if err != nil {
+						return filteredFields, fmt.Errorf("field %s (%s): %w", pfield.Name, pfield.Hash, err)
+					}
+
+					filteredFields[pfield.Hash] = m
+				case domain.Formula:
+					msg := fmt.Sprintf("field %s (%s) is calculated by formula and can't be set", pfield.Name, pfield.Hash)
+					return filteredFields, errors.New(msg)
 				}
 			}
 		}
@@ -376,6 +426,11 @@ func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]
 
 			return filteredFields, errors.New(msg)
 		}
+
+		err = s.checkFieldRules(task, projectFields, filteredFields)
+		if err != nil {
+			return filteredFields, err
+		}
 	}
 
 	return filteredFields, nil
@@ -507,9 +562,19 @@ func (s *Service) GetTasksNames(ctx context.Context, uid []uuid.UUID) (taskWithN
 	return s.repo.GetTaskNames(ctx, uid)
 }
 
+// GetTasksByEntity - задачи, у которых uid есть в TaskEntities
+func (s *Service) GetTasksByEntity(ctx context.Context, uid uuid.UUID) ([]domain.Task, error) {
+	return s.repo.GetTasksByEntity(ctx, uid)
+}
+
 func (s *Service) GetTasks(ctx context.Context, filter dto.TaskSearchDTO) (dm []domain.Task, total int64, err error) {
 	allowSort := s.GetSortFields(filter.ProjectUUID)
 
+	filter.Fields, err = s.selectFilters(filter.ProjectUUID, filter.Fields)
+	if err != nil {
+		return dm, -1, err
+	}
+
 	dm, total, err = s.repo.GetTasks(ctx, filter, allowSort)
 	if err != nil {
 		return dm, -1, err
@@ -693,18 +758,23 @@ func (s *Service) PatchName(crt domain.Creator, uid uuid.UUID, name string) (err
 func (s *Service) PatchStatus(crtr domain.Creator, project dto.ProjectDTO, task domain.Task, status int, comment string) (stopUUID uuid.UUID, path []string, err error) {
 	stopUUID = uuid.New()
 
-	// @todo: mv to domain
 	fields, _ := s.dict.FindProjectFields(task.ProjectUUID)
+
+	violations := []dto.FieldError{}
 	for _, field := range fields {
-		if field.RequiredOnStatuses != nil {
-			if lo.IndexOf(field.RequiredOnStatuses, status) != -1 {
-				if _, ok := task.Fields[field.Hash]; !ok {
-					return stopUUID, path, fmt.Errorf("field %s (%s) is required", field.Name, field.Hash)
-				}
-			}
+		if !field.Rules.RequiredOn(status, field.RequiredOnStatuses) {
+			continue
+		}
+
+		if _, ok := task.Fields[field.Hash]; !ok {
+			violations = append(violations, requiredFieldError(field.Hash, field.Name, status))
 		}
 	}
 
+	if len(violations) > 0 {
+		return stopUUID, path, dto.FieldsError{Fields: violations}
+	}
+
 	sg, err := domain.NewStatusGraphFromMap(*project.StatusGraph)
 	if err != nil {
 		return stopUUID, path, err
@@ -779,6 +849,11 @@ func (s *Service) PatchStatus(crtr domain.Creator, project dto.ProjectDTO, task
 		return stopUUID, path, err
 	}
 
+	err = s.TaskStatusWasChanged(crtr, task)
+	if err != nil {
+		return stopUUID, path, err
+	}
+
 	return stopUUID, path, err
 }
 
@@ -1026,7 +1101,8 @@ func (s *Service) DeleteTask(crt domain.Creator, uid uuid.UUID) (err error) {
 	return err
 }
 
-func (s *Service) DeleteTaskFile(crt domain.Creator, taskUUID, fileUUID uuid.UUID) (err error) {
+// DeleteTaskFile удаляет файл со всеми версиями, при latestVersion - только последнюю версию
+func (s *Service) DeleteTaskFile(crt domain.Creator, taskUUID, fileUUID uuid.UUID, latestVersion bool) (err error) {
 	files, err := s.storage.GetTaskFiles(taskUUID, false)
 	if err != nil {
 		return err
@@ -1039,6 +1115,17 @@ func (s *Service) DeleteTaskFile(crt domain.Creator, taskUUID, fileUUID uuid.UUI
 		return errors.New("file not found")
 	}
 
+	if latestVersion {
+		err = s.storage.DeleteLatestVersion(file.UUID)
+		if err != nil {
+			return err
+		}
+
+		s.ResetCache(taskUUID)
+
+		return nil
+	}
+
 	err = s.storage.Delete(file.UUID)
 	if err != nil {
 		return err
@@ -1054,6 +1141,12 @@ func (s *Service) DeleteTaskFile(crt domain.Creator, taskUUID, fileUUID uuid.UUI
 	return err
 }
 
+// SmsWasSent записывает отправленное по задаче sms в ленту активности
+func (s *Service) SmsWasSent(crt domain.Creator, taskUUID uuid.UUID, sms domain.Sms, template string) error {
+	_, err := s.as.TaskSmsWasSent(crt, taskUUID, sms, template)
+	return err
+}
+
 func (s *Service) ResetCache(uid uuid.UUID) {
 	s.repo.cache.ClearTask(context.TODO(), uid)
 }
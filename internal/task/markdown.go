@@ -0,0 +1,46 @@
+package task
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/markdown"
+	"github.com/samber/lo"
+)
+
+type markdownResolver struct {
+	s              *Service
+	federationUUID uuid.UUID
+	projectUUID    uuid.UUID
+}
+
+func (r markdownResolver) TaskRef(id int) (string, bool) {
+	uid, err := r.s.repo.FindTaskUUIDByID(r.projectUUID, id)
+	if err != nil {
+		return "", false
+	}
+
+	return uid.String(), true
+}
+
+func (r markdownResolver) Mention(email string) (string, string, bool) {
+	user, found := r.s.dict.FindUser(email)
+	if !found || !lo.Contains(r.s.dict.GetUserFederatons(user.UUID), r.federationUUID) {
+		return "", "", false
+	}
+
+	return user.UUID.String(), strings.TrimSpace(user.Name + " " + user.Lname), true
+}
+
+func (s *Service) SetLinkHosts(hosts []string) {
+	s.linkHosts = hosts
+}
+
+// RenderMarkdown рендерит описание или комментарий задачи, ссылки #123 ищутся в рамках проекта,
+// @упоминания - среди участников федерации
+func (s *Service) RenderMarkdown(federationUUID, projectUUID uuid.UUID, src string) string {
+	return markdown.Render(src, markdown.Options{
+		Hosts:    s.linkHosts,
+		Resolver: markdownResolver{s: s, federationUUID: federationUUID, projectUUID: projectUUID},
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/lib/pq"
 	"gorm.io/datatypes"
 )
@@ -65,7 +66,8 @@ type Task struct {
 
 	FirstOpen FirstOpen `gorm:"->update;type:jsonb;default:'{}';not null;"`
 
-	Description string `gorm:"type:text;default:'';not null" order:""`
+	Description     string `gorm:"type:text;default:'';not null" order:""`
+	DescriptionHTML string `gorm:"type:text;default:'';not null"`
 }
 
 type FirstOpen map[string]time.Time
@@ -138,4 +140,8 @@ type CompanyFields struct {
 	Name        string `gorm:"type:varchar(100);not null;"`
 	DataType    int    `gorm:"type:int;not null;default:0"`
 	CompanyUUID string `gorm:"type:uuid;not null"`
+	Formula     string `gorm:"type:text;not null;default:''"`
+
+	Options domain.FieldOptions `gorm:"type:jsonb;default:'[]';not null;"`
+	Rules   domain.FieldRules   `gorm:"->;type:jsonb;default:'{}';not null;"`
 }
@@ -75,7 +75,8 @@ func (r *Repository) CreateTask(task domain.Task, batch bool) (orm *Task, err er
 
 		FirstOpen: task.FirstOpen,
 
-		Description: task.Description,
+		Description:     task.Description,
+		DescriptionHTML: task.DescriptionHTML,
 	}
 
 	if !batch {
@@ -160,6 +161,7 @@ func (r *Repository) GetProjectFields(projectUUID uuid.UUID) (orm []CompanyField
 	orm = []CompanyFields{}
 
 	err = r.gorm.DB.Model(&orm).
+		Select("company_fields.hash, company_fields.name, company_fields.data_type, company_fields.company_uuid, company_fields.formula, company_fields.options, pf.rules").
 		Joins("left join project_fields pf on pf.company_field_uuid = company_fields.uuid").
 		Where("pf.project_uuid = ?", projectUUID).
 		Where("company_fields.deleted_at is null").
@@ -293,7 +295,8 @@ func (r *Repository) GetTask(_ context.Context, uid uuid.UUID) (dm domain.Task,
 
 		Path: strings.Split(orm.Path, "."),
 
-		Description: orm.Description,
+		Description:     orm.Description,
+		DescriptionHTML: orm.DescriptionHTML,
 
 		CreatedBy:     orm.CreatedBy,
 		CoWorkersBy:   orm.CoWorkersBy,
@@ -374,7 +377,8 @@ func (r *Repository) GetTaskWithDeleted(_ context.Context, uid uuid.UUID) (dm do
 
 		Path: strings.Split(orm.Path, "."),
 
-		Description: orm.Description,
+		Description:     orm.Description,
+		DescriptionHTML: orm.DescriptionHTML,
 
 		CreatedBy:     orm.CreatedBy,
 		CoWorkersBy:   orm.CoWorkersBy,
@@ -421,6 +425,27 @@ func (r *Repository) GetTaskWithDeleted(_ context.Context, uid uuid.UUID) (dm do
 	return dm, nil
 }
 
+// FieldValueTaken - значение value поля hash уже есть у другой задачи проекта
+func (r *Repository) FieldValueTaken(projectUUID, taskUUID uuid.UUID, hash string, value interface{}) (bool, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+
+	var count int64
+
+	err = r.gorm.DB.
+		Model(&Task{}).
+		Where("project_uuid = ?", projectUUID).
+		Where("uuid <> ?", taskUUID).
+		Where("deleted_at is null").
+		Where("fields -> ? = ?::jsonb", hash, string(b)).
+		Count(&count).
+		Error
+
+	return count > 0, err
+}
+
 func (r *Repository) GetTaskNames(_ context.Context, uids []uuid.UUID) (taskWithName []domain.Task, err error) {
 	defer r.storeTime("GetTaskNames", tm())
 
@@ -439,6 +464,34 @@ func (r *Repository) GetTaskNames(_ context.Context, uids []uuid.UUID) (taskWith
 	return taskWithName, nil
 }
 
+func (r *Repository) GetTasksByEntity(_ context.Context, uid uuid.UUID) (dms []domain.Task, err error) {
+	defer r.storeTime("GetTasksByEntity", tm())
+
+	orms := []Task{}
+
+	err = r.gorm.DB.
+		Model(&Task{}).
+		Select("uuid, name, project_uuid, task_entities").
+		Where("jsonb_exists(task_entities, ?)", uid.String()).
+		Where("deleted_at is null").
+		Find(&orms).
+		Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = lo.Map(orms, func(orm Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:         orm.UUID,
+			Name:         orm.Name,
+			ProjectUUID:  orm.ProjectUUID,
+			TaskEntities: orm.TaskEntities,
+		}
+	})
+
+	return dms, nil
+}
+
 func (r *Repository) GetSortFields() []string {
 	st := reflect.TypeOf(Task{})
 
@@ -520,7 +573,9 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 			logrus.Warn(item.Value)
 			logrus.Warn(item.Value)
 			// @todo: add regular to check array
-			if strings.HasPrefix(fmt.Sprintf("%v", item.Value), "@> [") && strings.HasSuffix(fmt.Sprintf("%v", item.Value), "]") {
+			if rng, ok := item.Value.(dto.RangeDTO); ok && item.Operator == dto.FilterRange {
+				query = postgres.WhereFieldRange(query, item.Name, rng.From, rng.To, rng.Currency)
+			} else if strings.HasPrefix(fmt.Sprintf("%v", item.Value), "@> [") && strings.HasSuffix(fmt.Sprintf("%v", item.Value), "]") {
 				v := strings.TrimPrefix(item.Value.(string), "@> ")
 				query = query.Where(" fields->? @> ?", item.Name, v)
 			} else {
@@ -753,12 +808,34 @@ func (r *Repository) UpdateTask(task domain.Task, shouldUpdate []string) (err er
 			err = r.ChangeField(task.UUID, "finish_to", task.FinishTo)
 		case "description":
 			err = r.ChangeField(task.UUID, "description", task.Description)
+			if err == nil {
+				err = r.ChangeField(task.UUID, "description_html", task.DescriptionHTML)
+			}
 		}
 	}
 
 	return err
 }
 
+func (r *Repository) FindTaskUUIDByID(projectUUID uuid.UUID, id int) (uid uuid.UUID, err error) {
+	defer r.storeTime("FindTaskUUIDByID", tm())
+
+	orm := Task{}
+	err = r.gorm.DB.
+		Select("uuid").
+		Where("project_uuid = ?", projectUUID).
+		Where("id = ?", id).
+		Where("deleted_at is null").
+		Take(&orm).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return uid, dto.NotFoundErr("задача не найдена")
+	}
+
+	return orm.UUID, err
+}
+
 func (r *Repository) CheckPath(path []string) (err error) {
 	count := 0
 	err = r.gorm.DB.Raw("select count(uuid) as count from tasks where uuid in (?)", path).Scan(&count).Error
@@ -0,0 +1,56 @@
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// selectFilters приводит фильтры по полям select/multi_select к условиям по хранимому значению.
+// Вариант можно указать названием или id, для multi_select ищутся задачи, где вариант выбран
+func (s *Service) selectFilters(projectUUID uuid.UUID, filters []dto.FilterDTO) ([]dto.FilterDTO, error) {
+	if len(filters) == 0 {
+		return filters, nil
+	}
+
+	fields, _ := s.dict.FindProjectFields(projectUUID)
+	byHash := lo.KeyBy(fields, func(f dto.ProjectFieldDTO) string { return f.Hash })
+
+	res := make([]dto.FilterDTO, 0, len(filters))
+
+	for _, filter := range filters {
+		field, ok := byHash[filter.Name]
+		value, isString := filter.Value.(string)
+		if !ok || !isString || !domain.IsSelect(domain.FieldDataType(field.DataType)) || strings.HasPrefix(value, "@> [") {
+			res = append(res, filter)
+			continue
+		}
+
+		opt, ok := lo.Find(field.Options, func(o domain.FieldOption) bool {
+			return o.Name == value || o.ID == value
+		})
+		if !ok {
+			return res, fmt.Errorf("field %s (%s): нет варианта %q", field.Name, field.Hash, value)
+		}
+
+		filter.Value = opt.Name
+
+		if domain.FieldDataType(field.DataType) == domain.MultiSelect {
+			b, err := json.Marshal([]string{opt.Name})
+			if err != nil {
+				return res, err
+			}
+
+			filter.Value = "@> " + string(b)
+		}
+
+		res = append(res, filter)
+	}
+
+	return res, nil
+}
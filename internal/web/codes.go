@@ -1,5 +1,7 @@
 package web
 
+import "github.com/krisch/crm-backend/dto"
+
 const (
 	CodeInvalidEmail = 1
 )
@@ -14,6 +16,13 @@ type RequestError struct {
 	Message    string
 }
 
+// FieldsValidationError - ошибки кастомных полей задачи по hash поля
+type FieldsValidationError struct {
+	StatusCode int
+	Message    string
+	Fields     []dto.FieldError
+}
+
 func (r *ValidationError) Error() string {
 	if len(r.Errors) == 0 {
 		return "validation error"
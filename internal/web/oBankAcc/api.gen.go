@@ -170,20 +170,32 @@ type ProjectDTOs = dto.ProjectDTOs
 
 // ProjectFieldCreateRequest defines model for ProjectFieldCreateRequest.
 type ProjectFieldCreateRequest struct {
-	DataType           domain.FieldDataType `json:"data_type" validate:"min=0,max=14"`
-	DataUuid           *openapi_types.UUID  `json:"data_uuid,omitempty" validate:"omitempty,uuid"`
-	Description        string               `json:"description" validate:"trim,max=5000"`
-	Icon               string               `json:"icon" validate:"trim,omitempty,lte=50"`
-	Name               string               `json:"name" validate:"trim,name,min=1,max=50"`
-	RequiredOnStatuses []int                `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
+	DataType    domain.FieldDataType `json:"data_type" validate:"min=0,max=18"`
+	DataUuid    *openapi_types.UUID  `json:"data_uuid,omitempty" validate:"omitempty,uuid"`
+	Description string               `json:"description" validate:"trim,max=5000"`
+	// Formula Expression for formula fields (data_type 15), e.g. "price * qty". Task fields don't know the catalog of data and data_array fields, so formulas with lookups like "client.discount" are rejected
+	Formula            *string `json:"formula,omitempty" validate:"omitempty,max=1000"`
+	Icon               string  `json:"icon" validate:"trim,omitempty,lte=50"`
+	Name               string  `json:"name" validate:"trim,name,min=1,max=50"`
+	// OnDelete What to do with a task when a catalog row referenced through this field is deleted, only for data and data_array. Tasks are never deleted with the row
+	OnDelete *string `json:"on_delete,omitempty" validate:"omitempty,oneof=block set_null"`
+	// Options Options of select (16) and multi_select (17) fields in display order, values store option names. Options can't be removed, only archived
+	Options *domain.FieldOptions `json:"options,omitempty"`
+	RequiredOnStatuses []int `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
 }
 
 // ProjectFieldPutRequest defines model for ProjectFieldPutRequest.
 type ProjectFieldPutRequest struct {
-	Description        string `json:"description" validate:"trim,max=5000"`
-	Icon               string `json:"icon" validate:"trim,max=50"`
-	Name               string `json:"name" validate:"trim,name,min=1,max=50"`
-	RequiredOnStatuses []int  `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
+	Description string `json:"description" validate:"trim,max=5000"`
+	// Formula Expression for formula fields (data_type 15), e.g. "price * qty". Task fields don't know the catalog of data and data_array fields, so formulas with lookups like "client.discount" are rejected
+	Formula            *string `json:"formula,omitempty" validate:"omitempty,max=1000"`
+	Icon               string  `json:"icon" validate:"trim,max=50"`
+	Name               string  `json:"name" validate:"trim,name,min=1,max=50"`
+	// OnDelete What to do with a task when a catalog row referenced through this field is deleted, only for data and data_array. Tasks are never deleted with the row
+	OnDelete *string `json:"on_delete,omitempty" validate:"omitempty,oneof=block set_null"`
+	// Options Options of select (16) and multi_select (17) fields in display order, values store option names. Options can't be removed, only archived
+	Options *domain.FieldOptions `json:"options,omitempty"`
+	RequiredOnStatuses []int `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
 }
 
 // ProjectRequestOptions defines model for ProjectRequestOptions.
@@ -274,6 +286,9 @@ type GetCompanyUUIDSmsParams struct {
 	Offset *int  `form:"offset,omitempty" json:"offset,omitempty"`
 	Limit  *int  `form:"limit,omitempty" json:"limit,omitempty"`
 	IsMy   *bool `form:"is_my,omitempty" json:"is_my,omitempty"`
+
+	// State sent, delivered or failed
+	State *string `form:"state,omitempty" json:"state,omitempty"`
 }
 
 // PostCompanyUUIDSmsCostJSONBody defines parameters for PostCompanyUUIDSmsCost.
@@ -284,8 +299,15 @@ type PostCompanyUUIDSmsCostJSONBody struct {
 
 // PostCompanyUUIDSmsOptionsJSONBody defines parameters for PostCompanyUUIDSmsOptions.
 type PostCompanyUUIDSmsOptionsJSONBody struct {
-	Api  string `json:"api"`
-	From string `json:"from"`
+	Api    string             `json:"api"`
+	From   string             `json:"from"`
+	Params *map[string]string `json:"params,omitempty"`
+
+	// Provider smsru (default), smpp, http or fake
+	Provider *string `json:"provider,omitempty"`
+
+	// RateLimit Sms per minute for campaigns
+	RateLimit *int `json:"rate_limit,omitempty"`
 }
 
 // PostCompanyUUIDSmsSendJSONBody defines parameters for PostCompanyUUIDSmsSend.
@@ -335,8 +357,11 @@ type PatchProjectUUIDDescriptionJSONBody struct {
 
 // PostProjectUUIDFieldEntityUUIDJSONBody defines parameters for PostProjectUUIDFieldEntityUUID.
 type PostProjectUUIDFieldEntityUUIDJSONBody struct {
-	RequiredOnStatuses []int  `json:"required_on_statuses"`
-	Style              string `json:"style"`
+	RequiredOnStatuses []int `json:"required_on_statuses"`
+
+	// Rules Value validation rules - pattern, min, max, values, unique, required_from_status
+	Rules *domain.FieldRules `json:"rules,omitempty"`
+	Style string             `json:"style"`
 }
 
 // PatchProjectUUIDGraphJSONBody defines parameters for PatchProjectUUIDGraph.
@@ -1104,6 +1129,13 @@ func (w *ServerInterfaceWrapper) GetCompanyUUIDSms(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_my: %s", err))
 	}
 
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetCompanyUUIDSms(ctx, uUID, params)
 	return err
@@ -2691,12 +2723,15 @@ type PostCompanyUUIDSmsOptionsResponseObject interface {
 	VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDSmsOptions200Response struct {
+type PostCompanyUUIDSmsOptions200JSONResponse struct {
+	CallbackUrl string `json:"callback_url"`
 }
 
-func (response PostCompanyUUIDSmsOptions200Response) VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error {
+func (response PostCompanyUUIDSmsOptions200JSONResponse) VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 type PostCompanyUUIDSmsSendRequestObject struct {
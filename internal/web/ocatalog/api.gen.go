@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 
 	"github.com/krisch/crm-backend/domain"
@@ -30,11 +32,26 @@ type CatalogCreateRequest struct {
 // CatalogDTO defines model for CatalogDTO.
 type CatalogDTO = dto.CatalogDTO
 
+// CatalogDataDuplicateDTO defines model for CatalogDataDuplicateDTO.
+type CatalogDataDuplicateDTO = dto.CatalogDataDuplicateDTO
+
+// CatalogDataHistoryDTO defines model for CatalogDataHistoryDTO.
+type CatalogDataHistoryDTO = dto.CatalogDataHistoryDTO
+
+// CatalogDataUsagesDTO defines model for CatalogDataUsagesDTO.
+type CatalogDataUsagesDTO = dto.CatalogDataUsagesDTO
+
 // CatalogFieldCreateRequest defines model for CatalogFieldCreateRequest.
 type CatalogFieldCreateRequest struct {
-	DataType domain.FieldDataType `json:"data_type" validate:"min=0,max=8"`
+	DataType domain.FieldDataType `json:"data_type" validate:"oneof=0 1 2 3 4 5 6 7 8 15 16 17 18"`
 	DataUuid *openapi_types.UUID  `json:"data_uuid,omitempty" validate:"omitempty,uuid"`
-	Name     string               `json:"name" validate:"trim,name,min=1,max=50"`
+	// Formula Expression for formula fields (data_type 15), e.g. "price * qty" or "client.discount"
+	Formula *string `json:"formula,omitempty" validate:"omitempty,max=1000"`
+	Name    string  `json:"name" validate:"trim,name,min=1,max=50"`
+	// OnDelete What to do with rows referencing a deleted row through this field, only for data and data_array
+	OnDelete *string `json:"on_delete,omitempty" validate:"omitempty,oneof=block cascade set_null"`
+	// Options Options of select (16) and multi_select (17) fields in display order, values store option names. Options can't be removed, only archived
+	Options *domain.FieldOptions `json:"options,omitempty"`
 }
 
 // CatalogFieldDTO defines model for CatalogFieldDTO.
@@ -42,9 +59,18 @@ type CatalogFieldDTO = dto.CatalogFieldDTO
 
 // CatalogFieldPutRequest defines model for CatalogFieldPutRequest.
 type CatalogFieldPutRequest struct {
-	Name string `json:"name" validate:"trim,name,min=1,max=50"`
+	// Formula Expression for formula fields (data_type 15), e.g. "price * qty" or "client.discount"
+	Formula *string `json:"formula,omitempty" validate:"omitempty,max=1000"`
+	Name    string  `json:"name" validate:"trim,name,min=1,max=50"`
+	// OnDelete What to do with rows referencing a deleted row through this field, only for data and data_array
+	OnDelete *string `json:"on_delete,omitempty" validate:"omitempty,oneof=block cascade set_null"`
+	// Options Options of select (16) and multi_select (17) fields in display order, values store option names. Options can't be removed, only archived
+	Options *domain.FieldOptions `json:"options,omitempty"`
 }
 
+// CatalogImportReportDTO defines model for CatalogImportReportDTO.
+type CatalogImportReportDTO = dto.CatalogImportReportDTO
+
 // CatalogNamedFieldCreateRequest defines model for CatalogNamedFieldCreateRequest.
 type CatalogNamedFieldCreateRequest struct {
 	DataType domain.FieldDataType `json:"data_type" validate:"min=0,max=8"`
@@ -58,6 +84,14 @@ type CatalogSearchRequest struct {
 	CompanyUuid openapi_types.UUID `json:"company_uuid" validate:"uuid"`
 }
 
+// DuplicateMatchDTO defines model for DuplicateMatchDTO.
+type DuplicateMatchDTO = dto.DuplicateMatchDTO
+
+// MergeRequest defines model for MergeRequest.
+type MergeRequest struct {
+	DuplicateUuid openapi_types.UUID `json:"duplicate_uuid"`
+}
+
 // NameRequest defines model for NameRequest.
 type NameRequest struct {
 	Name string `json:"name" validate:"trim,name,min=0,max=100"`
@@ -71,8 +105,9 @@ type Uuid = openapi_types.UUID
 
 // GetCatalogUUIDDataParams defines parameters for GetCatalogUUIDData.
 type GetCatalogUUIDDataParams struct {
-	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
-	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Fields JSON object of field filters by hash, e.g. {"hash": "value"}. Numeric and money fields take a range {"from": 1000, "to": 5000, "currency": "RUB"}, bounds are inclusive, money bounds in minor units
 	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 	Order  *string `form:"order,omitempty" json:"order,omitempty"`
 	By     *string `form:"by,omitempty" json:"by,omitempty"`
@@ -83,6 +118,47 @@ type PostCatalogUUIDDataJSONBody struct {
 	Fields map[string]interface{} `json:"fields"`
 }
 
+// GetCatalogUUIDDataDuplicatesParams defines parameters for GetCatalogUUIDDataDuplicates.
+type GetCatalogUUIDDataDuplicatesParams struct {
+	MinScore *int `form:"min_score,omitempty" json:"min_score,omitempty" validate:"omitempty,min=1,max=100"`
+	Limit    *int `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=500"`
+}
+
+// PatchCatalogUUIDDataEntityUUIDJSONBody defines parameters for PatchCatalogUUIDDataEntityUUID.
+type PatchCatalogUUIDDataEntityUUIDJSONBody struct {
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// PutCatalogUUIDDataEntityUUIDJSONBody defines parameters for PutCatalogUUIDDataEntityUUID.
+type PutCatalogUUIDDataEntityUUIDJSONBody struct {
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// GetCatalogUUIDDataEntityUUIDDuplicatesParams defines parameters for GetCatalogUUIDDataEntityUUIDDuplicates.
+type GetCatalogUUIDDataEntityUUIDDuplicatesParams struct {
+	MinScore *int `form:"min_score,omitempty" json:"min_score,omitempty" validate:"omitempty,min=1,max=100"`
+}
+
+// GetCatalogUUIDExportParams defines parameters for GetCatalogUUIDExport.
+type GetCatalogUUIDExportParams struct {
+	Format string `form:"format" json:"format" validate:"oneof=csv xlsx json"`
+	// Fields JSON object of field filters by hash, e.g. {"hash": "value"}. Numeric and money fields take a range {"from": 1000, "to": 5000, "currency": "RUB"}, bounds are inclusive, money bounds in minor units
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty" validate:"trim,min=1,max=500"`
+	Order  *string `form:"order,omitempty" json:"order,omitempty" validate:"trim,min=1,max=30"`
+	By     *string `form:"by,omitempty" json:"by,omitempty" validate:"trim,min=3,max=3"`
+}
+
+// PostCatalogUUIDImportMultipartBody defines parameters for PostCatalogUUIDImport.
+type PostCatalogUUIDImportMultipartBody struct {
+	DryRun  *bool              `json:"dry_run,omitempty"`
+	File    openapi_types.File `json:"file"`
+	Lookup  *string            `json:"lookup,omitempty"`
+	Mapping *string            `json:"mapping,omitempty"`
+}
+
+// PostCatalogUUIDImportMultipartRequestBody defines body for PostCatalogUUIDImport for multipart/form-data ContentType.
+type PostCatalogUUIDImportMultipartRequestBody PostCatalogUUIDImportMultipartBody
+
 // GetCatalogJSONRequestBody defines body for GetCatalog for application/json ContentType.
 type GetCatalogJSONRequestBody = CatalogSearchRequest
 
@@ -92,6 +168,15 @@ type PostCatalogJSONRequestBody = CatalogCreateRequest
 // PostCatalogUUIDDataJSONRequestBody defines body for PostCatalogUUIDData for application/json ContentType.
 type PostCatalogUUIDDataJSONRequestBody PostCatalogUUIDDataJSONBody
 
+// PatchCatalogUUIDDataEntityUUIDJSONRequestBody defines body for PatchCatalogUUIDDataEntityUUID for application/json ContentType.
+type PatchCatalogUUIDDataEntityUUIDJSONRequestBody PatchCatalogUUIDDataEntityUUIDJSONBody
+
+// PutCatalogUUIDDataEntityUUIDJSONRequestBody defines body for PutCatalogUUIDDataEntityUUID for application/json ContentType.
+type PutCatalogUUIDDataEntityUUIDJSONRequestBody PutCatalogUUIDDataEntityUUIDJSONBody
+
+// PostCatalogUUIDDataEntityUUIDMergeJSONRequestBody defines body for PostCatalogUUIDDataEntityUUIDMerge for application/json ContentType.
+type PostCatalogUUIDDataEntityUUIDMergeJSONRequestBody = MergeRequest
+
 // PostCatalogUUIDFieldsJSONRequestBody defines body for PostCatalogUUIDFields for application/json ContentType.
 type PostCatalogUUIDFieldsJSONRequestBody = CatalogFieldCreateRequest
 
@@ -125,6 +210,33 @@ type ServerInterface interface {
 	// (POST /catalog/{UUID}/data)
 	PostCatalogUUIDData(ctx echo.Context, uUID Uuid) error
 
+	// (GET /catalog/{UUID}/data/duplicates)
+	GetCatalogUUIDDataDuplicates(ctx echo.Context, uUID Uuid, params GetCatalogUUIDDataDuplicatesParams) error
+
+	// (DELETE /catalog/{UUID}/data/{entityUUID})
+	DeleteCatalogUUIDDataEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PATCH /catalog/{UUID}/data/{entityUUID})
+	PatchCatalogUUIDDataEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /catalog/{UUID}/data/{entityUUID})
+	PutCatalogUUIDDataEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /catalog/{UUID}/data/{entityUUID}/duplicates)
+	GetCatalogUUIDDataEntityUUIDDuplicates(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetCatalogUUIDDataEntityUUIDDuplicatesParams) error
+
+	// (GET /catalog/{UUID}/data/{entityUUID}/history)
+	GetCatalogUUIDDataEntityUUIDHistory(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /catalog/{UUID}/data/{entityUUID}/merge)
+	PostCatalogUUIDDataEntityUUIDMerge(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /catalog/{UUID}/data/{entityUUID}/usages)
+	GetCatalogUUIDDataEntityUUIDUsages(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /catalog/{UUID}/export)
+	GetCatalogUUIDExport(ctx echo.Context, uUID Uuid, params GetCatalogUUIDExportParams) error
+
 	// (GET /catalog/{UUID}/fields)
 	GetCatalogUUIDFields(ctx echo.Context, uUID Uuid) error
 
@@ -140,6 +252,9 @@ type ServerInterface interface {
 	// (PUT /catalog/{UUID}/fields/{entityUUID})
 	PutCatalogUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (POST /catalog/{UUID}/import)
+	PostCatalogUUIDImport(ctx echo.Context, uUID Uuid) error
+
 	// (PATCH /catalog/{UUID}/name)
 	PatchCatalogUUIDName(ctx echo.Context, uUID Uuid) error
 }
@@ -280,6 +395,279 @@ func (w *ServerInterfaceWrapper) PostCatalogUUIDData(ctx echo.Context) error {
 	return err
 }
 
+// GetCatalogUUIDDataDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatalogUUIDDataDuplicates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCatalogUUIDDataDuplicatesParams
+	// ------------- Optional query parameter "min_score" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_score", ctx.QueryParams(), &params.MinScore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_score: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCatalogUUIDDataDuplicates(ctx, uUID, params)
+	return err
+}
+
+// DeleteCatalogUUIDDataEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCatalogUUIDDataEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCatalogUUIDDataEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PatchCatalogUUIDDataEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCatalogUUIDDataEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchCatalogUUIDDataEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PutCatalogUUIDDataEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCatalogUUIDDataEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutCatalogUUIDDataEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetCatalogUUIDDataEntityUUIDDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatalogUUIDDataEntityUUIDDuplicates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCatalogUUIDDataEntityUUIDDuplicatesParams
+	// ------------- Optional query parameter "min_score" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_score", ctx.QueryParams(), &params.MinScore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_score: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCatalogUUIDDataEntityUUIDDuplicates(ctx, uUID, entityUUID, params)
+	return err
+}
+
+// GetCatalogUUIDDataEntityUUIDHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatalogUUIDDataEntityUUIDHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCatalogUUIDDataEntityUUIDHistory(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostCatalogUUIDDataEntityUUIDMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCatalogUUIDDataEntityUUIDMerge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCatalogUUIDDataEntityUUIDMerge(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetCatalogUUIDDataEntityUUIDUsages converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatalogUUIDDataEntityUUIDUsages(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCatalogUUIDDataEntityUUIDUsages(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetCatalogUUIDExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatalogUUIDExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCatalogUUIDExportParams
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "by", ctx.QueryParams(), &params.By)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter by: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCatalogUUIDExport(ctx, uUID, params)
+	return err
+}
+
 // GetCatalogUUIDFields converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCatalogUUIDFields(ctx echo.Context) error {
 	var err error
@@ -386,6 +774,24 @@ func (w *ServerInterfaceWrapper) PutCatalogUUIDFieldsEntityUUID(ctx echo.Context
 	return err
 }
 
+// PostCatalogUUIDImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCatalogUUIDImport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCatalogUUIDImport(ctx, uUID)
+	return err
+}
+
 // PatchCatalogUUIDName converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchCatalogUUIDName(ctx echo.Context) error {
 	var err error
@@ -438,11 +844,21 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/catalog/:UUID", wrapper.GetCatalogUUID)
 	router.GET(baseURL+"/catalog/:UUID/data", wrapper.GetCatalogUUIDData)
 	router.POST(baseURL+"/catalog/:UUID/data", wrapper.PostCatalogUUIDData)
+	router.GET(baseURL+"/catalog/:UUID/data/duplicates", wrapper.GetCatalogUUIDDataDuplicates)
+	router.DELETE(baseURL+"/catalog/:UUID/data/:entityUUID", wrapper.DeleteCatalogUUIDDataEntityUUID)
+	router.PATCH(baseURL+"/catalog/:UUID/data/:entityUUID", wrapper.PatchCatalogUUIDDataEntityUUID)
+	router.PUT(baseURL+"/catalog/:UUID/data/:entityUUID", wrapper.PutCatalogUUIDDataEntityUUID)
+	router.GET(baseURL+"/catalog/:UUID/data/:entityUUID/duplicates", wrapper.GetCatalogUUIDDataEntityUUIDDuplicates)
+	router.GET(baseURL+"/catalog/:UUID/data/:entityUUID/history", wrapper.GetCatalogUUIDDataEntityUUIDHistory)
+	router.POST(baseURL+"/catalog/:UUID/data/:entityUUID/merge", wrapper.PostCatalogUUIDDataEntityUUIDMerge)
+	router.GET(baseURL+"/catalog/:UUID/data/:entityUUID/usages", wrapper.GetCatalogUUIDDataEntityUUIDUsages)
+	router.GET(baseURL+"/catalog/:UUID/export", wrapper.GetCatalogUUIDExport)
 	router.GET(baseURL+"/catalog/:UUID/fields", wrapper.GetCatalogUUIDFields)
 	router.POST(baseURL+"/catalog/:UUID/fields", wrapper.PostCatalogUUIDFields)
 	router.POST(baseURL+"/catalog/:UUID/fields/named", wrapper.PostCatalogUUIDFieldsNamed)
 	router.DELETE(baseURL+"/catalog/:UUID/fields/:entityUUID", wrapper.DeleteCatalogUUIDFieldsEntityUUID)
 	router.PUT(baseURL+"/catalog/:UUID/fields/:entityUUID", wrapper.PutCatalogUUIDFieldsEntityUUID)
+	router.POST(baseURL+"/catalog/:UUID/import", wrapper.PostCatalogUUIDImport)
 	router.PATCH(baseURL+"/catalog/:UUID/name", wrapper.PatchCatalogUUIDName)
 
 }
@@ -541,32 +957,233 @@ type GetCatalogUUIDData200JSONResponse struct {
 	Headers GetCatalogUUIDData200ResponseHeaders
 }
 
-func (response GetCatalogUUIDData200JSONResponse) VisitGetCatalogUUIDDataResponse(w http.ResponseWriter) error {
+func (response GetCatalogUUIDData200JSONResponse) VisitGetCatalogUUIDDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostCatalogUUIDDataRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCatalogUUIDDataJSONRequestBody
+}
+
+type PostCatalogUUIDDataResponseObject interface {
+	VisitPostCatalogUUIDDataResponse(w http.ResponseWriter) error
+}
+
+type PostCatalogUUIDData200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostCatalogUUIDData200JSONResponse) VisitPostCatalogUUIDDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogUUIDDataDuplicatesRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetCatalogUUIDDataDuplicatesParams
+}
+
+type GetCatalogUUIDDataDuplicatesResponseObject interface {
+	VisitGetCatalogUUIDDataDuplicatesResponse(w http.ResponseWriter) error
+}
+
+type GetCatalogUUIDDataDuplicates200JSONResponse struct {
+	Count int                       `json:"count"`
+	Items []CatalogDataDuplicateDTO `json:"items"`
+	Total int                       `json:"total"`
+}
+
+func (response GetCatalogUUIDDataDuplicates200JSONResponse) VisitGetCatalogUUIDDataDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogUUIDDataEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCatalogUUIDDataEntityUUIDResponseObject interface {
+	VisitDeleteCatalogUUIDDataEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCatalogUUIDDataEntityUUID200Response struct {
+}
+
+func (response DeleteCatalogUUIDDataEntityUUID200Response) VisitDeleteCatalogUUIDDataEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchCatalogUUIDDataEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchCatalogUUIDDataEntityUUIDJSONRequestBody
+}
+
+type PatchCatalogUUIDDataEntityUUIDResponseObject interface {
+	VisitPatchCatalogUUIDDataEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchCatalogUUIDDataEntityUUID200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PatchCatalogUUIDDataEntityUUID200JSONResponse) VisitPatchCatalogUUIDDataEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCatalogUUIDDataEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutCatalogUUIDDataEntityUUIDJSONRequestBody
+}
+
+type PutCatalogUUIDDataEntityUUIDResponseObject interface {
+	VisitPutCatalogUUIDDataEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutCatalogUUIDDataEntityUUID200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PutCatalogUUIDDataEntityUUID200JSONResponse) VisitPutCatalogUUIDDataEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogUUIDDataEntityUUIDDuplicatesRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetCatalogUUIDDataEntityUUIDDuplicatesParams
+}
+
+type GetCatalogUUIDDataEntityUUIDDuplicatesResponseObject interface {
+	VisitGetCatalogUUIDDataEntityUUIDDuplicatesResponse(w http.ResponseWriter) error
+}
+
+type GetCatalogUUIDDataEntityUUIDDuplicates200JSONResponse struct {
+	Count int                       `json:"count"`
+	Items []CatalogDataDuplicateDTO `json:"items"`
+}
+
+func (response GetCatalogUUIDDataEntityUUIDDuplicates200JSONResponse) VisitGetCatalogUUIDDataEntityUUIDDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogUUIDDataEntityUUIDHistoryRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetCatalogUUIDDataEntityUUIDHistoryResponseObject interface {
+	VisitGetCatalogUUIDDataEntityUUIDHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetCatalogUUIDDataEntityUUIDHistory200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []CatalogDataHistoryDTO `json:"items"`
+}
+
+func (response GetCatalogUUIDDataEntityUUIDHistory200JSONResponse) VisitGetCatalogUUIDDataEntityUUIDHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCatalogUUIDDataEntityUUIDMergeRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostCatalogUUIDDataEntityUUIDMergeJSONRequestBody
+}
+
+type PostCatalogUUIDDataEntityUUIDMergeResponseObject interface {
+	VisitPostCatalogUUIDDataEntityUUIDMergeResponse(w http.ResponseWriter) error
+}
+
+type PostCatalogUUIDDataEntityUUIDMerge200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostCatalogUUIDDataEntityUUIDMerge200JSONResponse) VisitPostCatalogUUIDDataEntityUUIDMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogUUIDDataEntityUUIDUsagesRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetCatalogUUIDDataEntityUUIDUsagesResponseObject interface {
+	VisitGetCatalogUUIDDataEntityUUIDUsagesResponse(w http.ResponseWriter) error
+}
+
+type GetCatalogUUIDDataEntityUUIDUsages200JSONResponse CatalogDataUsagesDTO
+
+func (response GetCatalogUUIDDataEntityUUIDUsages200JSONResponse) VisitGetCatalogUUIDDataEntityUUIDUsagesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostCatalogUUIDDataRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCatalogUUIDDataJSONRequestBody
+type GetCatalogUUIDExportRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetCatalogUUIDExportParams
 }
 
-type PostCatalogUUIDDataResponseObject interface {
-	VisitPostCatalogUUIDDataResponse(w http.ResponseWriter) error
+type GetCatalogUUIDExportResponseObject interface {
+	VisitGetCatalogUUIDExportResponse(w http.ResponseWriter) error
 }
 
-type PostCatalogUUIDData200JSONResponse struct {
-	Uuid openapi_types.UUID `json:"uuid"`
+type GetCatalogUUIDExport200ResponseHeaders struct {
+	ContentDisposition string
+	ContentType        string
+	CacheControl       string
 }
 
-func (response PostCatalogUUIDData200JSONResponse) VisitPostCatalogUUIDDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+type GetCatalogUUIDExport200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       GetCatalogUUIDExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetCatalogUUIDExport200ApplicationoctetStreamResponse) VisitGetCatalogUUIDExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
 type GetCatalogUUIDFieldsRequestObject struct {
@@ -674,6 +1291,24 @@ func (response PutCatalogUUIDFieldsEntityUUID200Response) VisitPutCatalogUUIDFie
 	return nil
 }
 
+type PostCatalogUUIDImportRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *multipart.Reader
+}
+
+type PostCatalogUUIDImportResponseObject interface {
+	VisitPostCatalogUUIDImportResponse(w http.ResponseWriter) error
+}
+
+type PostCatalogUUIDImport200JSONResponse CatalogImportReportDTO
+
+func (response PostCatalogUUIDImport200JSONResponse) VisitPostCatalogUUIDImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchCatalogUUIDNameRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchCatalogUUIDNameJSONRequestBody
@@ -712,6 +1347,33 @@ type StrictServerInterface interface {
 	// (POST /catalog/{UUID}/data)
 	PostCatalogUUIDData(ctx context.Context, request PostCatalogUUIDDataRequestObject) (PostCatalogUUIDDataResponseObject, error)
 
+	// (GET /catalog/{UUID}/data/duplicates)
+	GetCatalogUUIDDataDuplicates(ctx context.Context, request GetCatalogUUIDDataDuplicatesRequestObject) (GetCatalogUUIDDataDuplicatesResponseObject, error)
+
+	// (DELETE /catalog/{UUID}/data/{entityUUID})
+	DeleteCatalogUUIDDataEntityUUID(ctx context.Context, request DeleteCatalogUUIDDataEntityUUIDRequestObject) (DeleteCatalogUUIDDataEntityUUIDResponseObject, error)
+
+	// (PATCH /catalog/{UUID}/data/{entityUUID})
+	PatchCatalogUUIDDataEntityUUID(ctx context.Context, request PatchCatalogUUIDDataEntityUUIDRequestObject) (PatchCatalogUUIDDataEntityUUIDResponseObject, error)
+
+	// (PUT /catalog/{UUID}/data/{entityUUID})
+	PutCatalogUUIDDataEntityUUID(ctx context.Context, request PutCatalogUUIDDataEntityUUIDRequestObject) (PutCatalogUUIDDataEntityUUIDResponseObject, error)
+
+	// (GET /catalog/{UUID}/data/{entityUUID}/duplicates)
+	GetCatalogUUIDDataEntityUUIDDuplicates(ctx context.Context, request GetCatalogUUIDDataEntityUUIDDuplicatesRequestObject) (GetCatalogUUIDDataEntityUUIDDuplicatesResponseObject, error)
+
+	// (GET /catalog/{UUID}/data/{entityUUID}/history)
+	GetCatalogUUIDDataEntityUUIDHistory(ctx context.Context, request GetCatalogUUIDDataEntityUUIDHistoryRequestObject) (GetCatalogUUIDDataEntityUUIDHistoryResponseObject, error)
+
+	// (POST /catalog/{UUID}/data/{entityUUID}/merge)
+	PostCatalogUUIDDataEntityUUIDMerge(ctx context.Context, request PostCatalogUUIDDataEntityUUIDMergeRequestObject) (PostCatalogUUIDDataEntityUUIDMergeResponseObject, error)
+
+	// (GET /catalog/{UUID}/data/{entityUUID}/usages)
+	GetCatalogUUIDDataEntityUUIDUsages(ctx context.Context, request GetCatalogUUIDDataEntityUUIDUsagesRequestObject) (GetCatalogUUIDDataEntityUUIDUsagesResponseObject, error)
+
+	// (GET /catalog/{UUID}/export)
+	GetCatalogUUIDExport(ctx context.Context, request GetCatalogUUIDExportRequestObject) (GetCatalogUUIDExportResponseObject, error)
+
 	// (GET /catalog/{UUID}/fields)
 	GetCatalogUUIDFields(ctx context.Context, request GetCatalogUUIDFieldsRequestObject) (GetCatalogUUIDFieldsResponseObject, error)
 
@@ -727,6 +1389,9 @@ type StrictServerInterface interface {
 	// (PUT /catalog/{UUID}/fields/{entityUUID})
 	PutCatalogUUIDFieldsEntityUUID(ctx context.Context, request PutCatalogUUIDFieldsEntityUUIDRequestObject) (PutCatalogUUIDFieldsEntityUUIDResponseObject, error)
 
+	// (POST /catalog/{UUID}/import)
+	PostCatalogUUIDImport(ctx context.Context, request PostCatalogUUIDImportRequestObject) (PostCatalogUUIDImportResponseObject, error)
+
 	// (PATCH /catalog/{UUID}/name)
 	PatchCatalogUUIDName(ctx context.Context, request PatchCatalogUUIDNameRequestObject) (PatchCatalogUUIDNameResponseObject, error)
 }
@@ -908,6 +1573,259 @@ func (sh *strictHandler) PostCatalogUUIDData(ctx echo.Context, uUID Uuid) error
 	return nil
 }
 
+// GetCatalogUUIDDataDuplicates operation middleware
+func (sh *strictHandler) GetCatalogUUIDDataDuplicates(ctx echo.Context, uUID Uuid, params GetCatalogUUIDDataDuplicatesParams) error {
+	var request GetCatalogUUIDDataDuplicatesRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogUUIDDataDuplicates(ctx.Request().Context(), request.(GetCatalogUUIDDataDuplicatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogUUIDDataDuplicates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCatalogUUIDDataDuplicatesResponseObject); ok {
+		return validResponse.VisitGetCatalogUUIDDataDuplicatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCatalogUUIDDataEntityUUID operation middleware
+func (sh *strictHandler) DeleteCatalogUUIDDataEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCatalogUUIDDataEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCatalogUUIDDataEntityUUID(ctx.Request().Context(), request.(DeleteCatalogUUIDDataEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCatalogUUIDDataEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCatalogUUIDDataEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCatalogUUIDDataEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchCatalogUUIDDataEntityUUID operation middleware
+func (sh *strictHandler) PatchCatalogUUIDDataEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchCatalogUUIDDataEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PatchCatalogUUIDDataEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCatalogUUIDDataEntityUUID(ctx.Request().Context(), request.(PatchCatalogUUIDDataEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCatalogUUIDDataEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCatalogUUIDDataEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchCatalogUUIDDataEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutCatalogUUIDDataEntityUUID operation middleware
+func (sh *strictHandler) PutCatalogUUIDDataEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutCatalogUUIDDataEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PutCatalogUUIDDataEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCatalogUUIDDataEntityUUID(ctx.Request().Context(), request.(PutCatalogUUIDDataEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCatalogUUIDDataEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutCatalogUUIDDataEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutCatalogUUIDDataEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCatalogUUIDDataEntityUUIDDuplicates operation middleware
+func (sh *strictHandler) GetCatalogUUIDDataEntityUUIDDuplicates(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetCatalogUUIDDataEntityUUIDDuplicatesParams) error {
+	var request GetCatalogUUIDDataEntityUUIDDuplicatesRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogUUIDDataEntityUUIDDuplicates(ctx.Request().Context(), request.(GetCatalogUUIDDataEntityUUIDDuplicatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogUUIDDataEntityUUIDDuplicates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCatalogUUIDDataEntityUUIDDuplicatesResponseObject); ok {
+		return validResponse.VisitGetCatalogUUIDDataEntityUUIDDuplicatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCatalogUUIDDataEntityUUIDHistory operation middleware
+func (sh *strictHandler) GetCatalogUUIDDataEntityUUIDHistory(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetCatalogUUIDDataEntityUUIDHistoryRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogUUIDDataEntityUUIDHistory(ctx.Request().Context(), request.(GetCatalogUUIDDataEntityUUIDHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogUUIDDataEntityUUIDHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCatalogUUIDDataEntityUUIDHistoryResponseObject); ok {
+		return validResponse.VisitGetCatalogUUIDDataEntityUUIDHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCatalogUUIDDataEntityUUIDMerge operation middleware
+func (sh *strictHandler) PostCatalogUUIDDataEntityUUIDMerge(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostCatalogUUIDDataEntityUUIDMergeRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PostCatalogUUIDDataEntityUUIDMergeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCatalogUUIDDataEntityUUIDMerge(ctx.Request().Context(), request.(PostCatalogUUIDDataEntityUUIDMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCatalogUUIDDataEntityUUIDMerge")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCatalogUUIDDataEntityUUIDMergeResponseObject); ok {
+		return validResponse.VisitPostCatalogUUIDDataEntityUUIDMergeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCatalogUUIDDataEntityUUIDUsages operation middleware
+func (sh *strictHandler) GetCatalogUUIDDataEntityUUIDUsages(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetCatalogUUIDDataEntityUUIDUsagesRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogUUIDDataEntityUUIDUsages(ctx.Request().Context(), request.(GetCatalogUUIDDataEntityUUIDUsagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogUUIDDataEntityUUIDUsages")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCatalogUUIDDataEntityUUIDUsagesResponseObject); ok {
+		return validResponse.VisitGetCatalogUUIDDataEntityUUIDUsagesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCatalogUUIDExport operation middleware
+func (sh *strictHandler) GetCatalogUUIDExport(ctx echo.Context, uUID Uuid, params GetCatalogUUIDExportParams) error {
+	var request GetCatalogUUIDExportRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogUUIDExport(ctx.Request().Context(), request.(GetCatalogUUIDExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogUUIDExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCatalogUUIDExportResponseObject); ok {
+		return validResponse.VisitGetCatalogUUIDExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetCatalogUUIDFields operation middleware
 func (sh *strictHandler) GetCatalogUUIDFields(ctx echo.Context, uUID Uuid) error {
 	var request GetCatalogUUIDFieldsRequestObject
@@ -1053,6 +1971,37 @@ func (sh *strictHandler) PutCatalogUUIDFieldsEntityUUID(ctx echo.Context, uUID U
 	return nil
 }
 
+// PostCatalogUUIDImport operation middleware
+func (sh *strictHandler) PostCatalogUUIDImport(ctx echo.Context, uUID Uuid) error {
+	var request PostCatalogUUIDImportRequestObject
+
+	request.UUID = uUID
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCatalogUUIDImport(ctx.Request().Context(), request.(PostCatalogUUIDImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCatalogUUIDImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCatalogUUIDImportResponseObject); ok {
+		return validResponse.VisitPostCatalogUUIDImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchCatalogUUIDName operation middleware
 func (sh *strictHandler) PatchCatalogUUIDName(ctx echo.Context, uUID Uuid) error {
 	var request PatchCatalogUUIDNameRequestObject
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
 	"net/http"
 	"time"
 
@@ -22,11 +23,17 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// ActivityDTO defines model for ActivityDTO.
+type ActivityDTO = dto.ActivityDTO
+
 // AddGroupRequest defines model for AddGroupRequest.
 type AddGroupRequest struct {
 	Name string `json:"name" validate:"trim,name,min=3,max=100"`
 }
 
+// AgentCommentDTO defines model for AgentCommentDTO.
+type AgentCommentDTO = dto.AgentCommentDTO
+
 // AgentCreateRequest defines model for AgentCreateRequest.
 type AgentCreateRequest struct {
 	CompanyUuid *openapi_types.UUID `json:"company_uuid,omitempty" validate:"omitempty,uuid"`
@@ -40,6 +47,18 @@ type AgentCreateRequest struct {
 // AgentDTO defines model for AgentDTO.
 type AgentDTO = dto.AgentDTO
 
+// AgentDuplicateDTO defines model for AgentDuplicateDTO.
+type AgentDuplicateDTO = dto.AgentDuplicateDTO
+
+// AgentLegalEntityRequest defines model for AgentLegalEntityRequest.
+type AgentLegalEntityRequest struct {
+	Address *string `json:"address,omitempty" validate:"omitempty,max=500"`
+	Inn     string  `json:"inn" validate:"trim,min=10,max=12"`
+	Kpp     *string `json:"kpp,omitempty" validate:"omitempty,max=9"`
+	Name    string  `json:"name" validate:"trim,min=1,max=250"`
+	Ogrn    *string `json:"ogrn,omitempty" validate:"omitempty,max=15"`
+}
+
 // AgentPatchRequest defines model for AgentPatchRequest.
 type AgentPatchRequest struct {
 	Contacts []struct {
@@ -49,6 +68,9 @@ type AgentPatchRequest struct {
 	Name string `json:"name" validate:"trim,name,min=3,max=100"`
 }
 
+// AgentTimelineItemDTO defines model for AgentTimelineItemDTO.
+type AgentTimelineItemDTO = dto.AgentTimelineItemDTO
+
 // Ans defines model for Ans.
 type Ans struct {
 	Changed *bool `json:"Changed,omitempty"`
@@ -87,6 +109,80 @@ type CompanyPriorityCreateRequest struct {
 // CompanyPriorityDTO defines model for CompanyPriorityDTO.
 type CompanyPriorityDTO = dto.CompanyPriorityDTO
 
+// DealDTO defines model for DealDTO.
+type DealDTO = dto.DealDTO
+
+// DealForecastDTO defines model for DealForecastDTO.
+type DealForecastDTO = dto.DealForecastDTO
+
+// DealPipelineDTO defines model for DealPipelineDTO.
+type DealPipelineDTO = dto.DealPipelineDTO
+
+// DealPipelineRequest defines model for DealPipelineRequest.
+type DealPipelineRequest struct {
+	Name   string             `json:"name" validate:"trim,min=1,max=100"`
+	Sort   *int               `json:"sort,omitempty"`
+	Stages []DealStageRequest `json:"stages" validate:"min=1,max=30,dive"`
+}
+
+// DealRequest defines model for DealRequest.
+type DealRequest struct {
+	AgentUuid    *openapi_types.UUID   `json:"agent_uuid,omitempty"`
+	Amount       *int64                `json:"amount,omitempty" validate:"omitempty,min=0"`
+	CloseAt      *time.Time            `json:"close_at,omitempty"`
+	Currency     *string               `json:"currency,omitempty"`
+	Description  *string               `json:"description,omitempty" validate:"omitempty,max=10000"`
+	Name         string                `json:"name" validate:"trim,min=1,max=50"`
+	PipelineUuid *openapi_types.UUID   `json:"pipeline_uuid,omitempty"`
+	Priority     *int                  `json:"priority,omitempty" validate:"omitempty,min=0,max=100"`
+	Probability  *int                  `json:"probability,omitempty" validate:"omitempty,min=0,max=100"`
+	StageUuid    *openapi_types.UUID   `json:"stage_uuid,omitempty"`
+	Tags         *[]string             `json:"tags,omitempty" validate:"omitempty,max=20,dive,trim,min=1,max=50"`
+	TaskUuids    *[]openapi_types.UUID `json:"task_uuids,omitempty" validate:"omitempty,max=100"`
+}
+
+// DealStageRequest defines model for DealStageRequest.
+type DealStageRequest struct {
+	Color       *string             `json:"color,omitempty"`
+	Name        string              `json:"name" validate:"trim,min=1,max=100"`
+	Probability int                 `json:"probability" validate:"min=0,max=100"`
+	Status      *int                `json:"status,omitempty" validate:"omitempty,min=0,max=2"`
+	Uuid        *openapi_types.UUID `json:"uuid,omitempty"`
+}
+
+// DuplicateMatchDTO defines model for DuplicateMatchDTO.
+type DuplicateMatchDTO = dto.DuplicateMatchDTO
+
+// EmailBrandingDTO defines model for EmailBrandingDTO.
+type EmailBrandingDTO = dto.EmailBrandingDTO
+
+// EmailBrandingRequest defines model for EmailBrandingRequest.
+type EmailBrandingRequest struct {
+	// BackgroundColor #rrggbb
+	BackgroundColor *string `json:"background_color,omitempty"`
+	Footer          *string `json:"footer,omitempty" validate:"omitempty,max=1000"`
+
+	// Locale Default locale of emails, ru or en
+	Locale  *string `json:"locale,omitempty"`
+	LogoUrl *string `json:"logo_url,omitempty" validate:"omitempty,max=500"`
+
+	// PrimaryColor #rrggbb
+	PrimaryColor *string `json:"primary_color,omitempty"`
+}
+
+// EmailTemplateDTO defines model for EmailTemplateDTO.
+type EmailTemplateDTO = dto.EmailTemplateDTO
+
+// EmailTemplateRequest defines model for EmailTemplateRequest.
+type EmailTemplateRequest struct {
+	// Body HTML template of message content: {{ .Code }} for confirmation and reset, {{ .ID }} and {{ .Name }} for task_ack
+	Body   string `json:"body" validate:"trim,min=1,max=20000"`
+	Locale string `json:"locale" validate:"oneof=ru en"`
+
+	// Subject Subject template, e.g. Re: {{ .Name }}
+	Subject string `json:"subject" validate:"trim,min=1,max=200"`
+}
+
 // FederationAddUserRequest defines model for FederationAddUserRequest.
 type FederationAddUserRequest struct {
 	UserUuid openapi_types.UUID `json:"user_uuid" validate:"uuid"`
@@ -118,6 +214,11 @@ type InviteCreateRequest struct {
 // InviteDTO defines model for InviteDTO.
 type InviteDTO = dto.InviteDTO
 
+// MergeRequest defines model for MergeRequest.
+type MergeRequest struct {
+	DuplicateUuid openapi_types.UUID `json:"duplicate_uuid"`
+}
+
 // NameRequest defines model for NameRequest.
 type NameRequest struct {
 	Name string `json:"name" validate:"trim,name,min=0,max=100"`
@@ -170,20 +271,32 @@ type ProjectDTOs = dto.ProjectDTOs
 
 // ProjectFieldCreateRequest defines model for ProjectFieldCreateRequest.
 type ProjectFieldCreateRequest struct {
-	DataType           domain.FieldDataType `json:"data_type" validate:"min=0,max=14"`
-	DataUuid           *openapi_types.UUID  `json:"data_uuid,omitempty" validate:"omitempty,uuid"`
-	Description        string               `json:"description" validate:"trim,max=5000"`
-	Icon               string               `json:"icon" validate:"trim,omitempty,lte=50"`
-	Name               string               `json:"name" validate:"trim,name,min=1,max=50"`
+	DataType    domain.FieldDataType `json:"data_type" validate:"min=0,max=18"`
+	DataUuid    *openapi_types.UUID  `json:"data_uuid,omitempty" validate:"omitempty,uuid"`
+	Description string               `json:"description" validate:"trim,max=5000"`
+	// Formula Expression for formula fields (data_type 15), e.g. "price * qty". Task fields don't know the catalog of data and data_array fields, so formulas with lookups like "client.discount" are rejected
+	Formula *string `json:"formula,omitempty" validate:"omitempty,max=1000"`
+	Icon    string  `json:"icon" validate:"trim,omitempty,lte=50"`
+	Name    string  `json:"name" validate:"trim,name,min=1,max=50"`
+	// OnDelete What to do with a task when a catalog row referenced through this field is deleted, only for data and data_array. Tasks are never deleted with the row
+	OnDelete *string `json:"on_delete,omitempty" validate:"omitempty,oneof=block set_null"`
+	// Options Options of select (16) and multi_select (17) fields in display order, values store option names. Options can't be removed, only archived
+	Options            *domain.FieldOptions `json:"options,omitempty"`
 	RequiredOnStatuses []int                `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
 }
 
 // ProjectFieldPutRequest defines model for ProjectFieldPutRequest.
 type ProjectFieldPutRequest struct {
-	Description        string `json:"description" validate:"trim,max=5000"`
-	Icon               string `json:"icon" validate:"trim,max=50"`
-	Name               string `json:"name" validate:"trim,name,min=1,max=50"`
-	RequiredOnStatuses []int  `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
+	Description string `json:"description" validate:"trim,max=5000"`
+	// Formula Expression for formula fields (data_type 15), e.g. "price * qty". Task fields don't know the catalog of data and data_array fields, so formulas with lookups like "client.discount" are rejected
+	Formula *string `json:"formula,omitempty" validate:"omitempty,max=1000"`
+	Icon    string  `json:"icon" validate:"trim,max=50"`
+	Name    string  `json:"name" validate:"trim,name,min=1,max=50"`
+	// OnDelete What to do with a task when a catalog row referenced through this field is deleted, only for data and data_array. Tasks are never deleted with the row
+	OnDelete *string `json:"on_delete,omitempty" validate:"omitempty,oneof=block set_null"`
+	// Options Options of select (16) and multi_select (17) fields in display order, values store option names. Options can't be removed, only archived
+	Options            *domain.FieldOptions `json:"options,omitempty"`
+	RequiredOnStatuses []int                `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
 }
 
 // ProjectRequestOptions defines model for ProjectRequestOptions.
@@ -221,9 +334,35 @@ type SearchUserRequest struct {
 	Search         string              `json:"search" validate:"trim,min=1,max=200"`
 }
 
+// SmsCampaignDTO defines model for SmsCampaignDTO.
+type SmsCampaignDTO = dto.SmsCampaignDTO
+
 // SmsDTO defines model for SmsDTO.
 type SmsDTO = dto.SmsDTO
 
+// SmsRecipientDTO defines model for SmsRecipientDTO.
+type SmsRecipientDTO = dto.SmsRecipientDTO
+
+// SmsTemplateDTO defines model for SmsTemplateDTO.
+type SmsTemplateDTO = dto.SmsTemplateDTO
+
+// SmsTemplateRequest defines model for SmsTemplateRequest.
+type SmsTemplateRequest struct {
+	Name string `json:"name" validate:"trim,min=1,max=100"`
+
+	// OnStatus Send automatically when task enters this status
+	OnStatus *int `json:"on_status,omitempty"`
+
+	// PhoneField Task field name or hash with recipient phone
+	PhoneField *string `json:"phone_field,omitempty"`
+
+	// ProjectUuid Limit status trigger to project
+	ProjectUuid *openapi_types.UUID `json:"project_uuid,omitempty"`
+
+	// Text Text with placeholders: {{task.id}}, {{task.name}}, {{task.status}}, {{task.<field name or hash>}}
+	Text string `json:"text" validate:"trim,min=1,max=1000"`
+}
+
 // SurveyCreateRequest defines model for SurveyCreateRequest.
 type SurveyCreateRequest struct {
 	Body map[string]interface{} `json:"body"`
@@ -248,6 +387,9 @@ type UUIDResponse struct {
 	Uuid openapi_types.UUID `json:"uuid"`
 }
 
+// UploadDTO defines model for UploadDTO.
+type UploadDTO = dto.UploadDTO
+
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
@@ -257,12 +399,46 @@ type EntityName = string
 // EntityUUID defines model for entityUUID.
 type EntityUUID = openapi_types.UUID
 
+// FileUUID defines model for fileUUID.
+type FileUUID = openapi_types.UUID
+
 // UserUUID defines model for userUUID.
 type UserUUID = openapi_types.UUID
 
 // Uuid defines model for uuid.
 type Uuid = openapi_types.UUID
 
+// GetCompanyUUIDDealsParams defines parameters for GetCompanyUUIDDeals.
+type GetCompanyUUIDDealsParams struct {
+	PipelineUuid *openapi_types.UUID `form:"pipeline_uuid,omitempty" json:"pipeline_uuid,omitempty"`
+	StageUuid    *openapi_types.UUID `form:"stage_uuid,omitempty" json:"stage_uuid,omitempty"`
+	AgentUuid    *openapi_types.UUID `form:"agent_uuid,omitempty" json:"agent_uuid,omitempty"`
+	TaskUuid     *openapi_types.UUID `form:"task_uuid,omitempty" json:"task_uuid,omitempty"`
+	Status       *int                `form:"status,omitempty" json:"status,omitempty" validate:"omitempty,min=0,max=2"`
+	Name         *string             `form:"name,omitempty" json:"name,omitempty" validate:"omitempty,max=50"`
+	Offset       *int                `form:"offset,omitempty" json:"offset,omitempty" validate:"omitempty,min=0"`
+	Limit        *int                `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=200"`
+}
+
+// GetCompanyUUIDDealsForecastParams defines parameters for GetCompanyUUIDDealsForecast.
+type GetCompanyUUIDDealsForecastParams struct {
+	PipelineUuid *openapi_types.UUID `form:"pipeline_uuid,omitempty" json:"pipeline_uuid,omitempty"`
+	From         *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+	To           *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetCompanyUUIDDealsEntityUUIDActivityParams defines parameters for GetCompanyUUIDDealsEntityUUIDActivity.
+type GetCompanyUUIDDealsEntityUUIDActivityParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PutCompanyUUIDDealsEntityUUIDStageJSONBody defines parameters for PutCompanyUUIDDealsEntityUUIDStage.
+type PutCompanyUUIDDealsEntityUUIDStageJSONBody struct {
+	PipelineUuid *openapi_types.UUID `json:"pipeline_uuid,omitempty"`
+	StageUuid    openapi_types.UUID  `json:"stage_uuid"`
+}
+
 // PatchCompanyUUIDPrioritiesEntityUUIDJSONBody defines parameters for PatchCompanyUUIDPrioritiesEntityUUID.
 type PatchCompanyUUIDPrioritiesEntityUUIDJSONBody struct {
 	Color string `json:"color" validate:"color"`
@@ -274,6 +450,52 @@ type GetCompanyUUIDSmsParams struct {
 	Offset *int  `form:"offset,omitempty" json:"offset,omitempty"`
 	Limit  *int  `form:"limit,omitempty" json:"limit,omitempty"`
 	IsMy   *bool `form:"is_my,omitempty" json:"is_my,omitempty"`
+
+	// State sent, delivered or failed
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// GetCompanyUUIDSmsCampaignsParams defines parameters for GetCompanyUUIDSmsCampaigns.
+type GetCompanyUUIDSmsCampaignsParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostCompanyUUIDSmsCampaignsJSONBody defines parameters for PostCompanyUUIDSmsCampaigns.
+type PostCompanyUUIDSmsCampaignsJSONBody struct {
+	CatalogUuid *openapi_types.UUID `json:"catalog_uuid,omitempty"`
+	Name        string              `json:"name" validate:"trim,min=1,max=100"`
+
+	// PhoneField Catalog field name or hash with phone
+	PhoneField *string `json:"phone_field,omitempty"`
+
+	// Source agents, catalog or csv
+	Source string `json:"source" validate:"oneof=agents catalog csv"`
+
+	// Template Text with {{placeholders}}, e.g. {{name}}, {{phone}} or catalog field / csv column names
+	Template string `json:"template" validate:"trim,min=1,max=1000"`
+}
+
+// PostCompanyUUIDSmsCampaignsEntityUUIDCsvMultipartBody defines parameters for PostCompanyUUIDSmsCampaignsEntityUUIDCsv.
+type PostCompanyUUIDSmsCampaignsEntityUUIDCsvMultipartBody struct {
+	File *openapi_types.File `json:"file,omitempty"`
+}
+
+// PostCompanyUUIDSmsCampaignsEntityUUIDCsvMultipartRequestBody defines body for PostCompanyUUIDSmsCampaignsEntityUUIDCsv for multipart/form-data ContentType.
+type PostCompanyUUIDSmsCampaignsEntityUUIDCsvMultipartRequestBody PostCompanyUUIDSmsCampaignsEntityUUIDCsvMultipartBody
+
+// GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsParams defines parameters for GetCompanyUUIDSmsCampaignsEntityUUIDRecipients.
+type GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// State pending, excluded, sent, delivered or failed
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// PostCompanyUUIDSmsCampaignsEntityUUIDStartJSONBody defines parameters for PostCompanyUUIDSmsCampaignsEntityUUIDStart.
+type PostCompanyUUIDSmsCampaignsEntityUUIDStartJSONBody struct {
+	SendAt *time.Time `json:"send_at,omitempty"`
 }
 
 // PostCompanyUUIDSmsCostJSONBody defines parameters for PostCompanyUUIDSmsCost.
@@ -284,8 +506,15 @@ type PostCompanyUUIDSmsCostJSONBody struct {
 
 // PostCompanyUUIDSmsOptionsJSONBody defines parameters for PostCompanyUUIDSmsOptions.
 type PostCompanyUUIDSmsOptionsJSONBody struct {
-	Api  string `json:"api"`
-	From string `json:"from"`
+	Api    string             `json:"api"`
+	From   string             `json:"from"`
+	Params *map[string]string `json:"params,omitempty"`
+
+	// Provider smsru (default), smpp, http or fake
+	Provider *string `json:"provider,omitempty"`
+
+	// RateLimit Sms per minute for campaigns
+	RateLimit *int `json:"rate_limit,omitempty"`
 }
 
 // PostCompanyUUIDSmsSendJSONBody defines parameters for PostCompanyUUIDSmsSend.
@@ -301,10 +530,78 @@ type PostCompanyUUIDSmsSendParams struct {
 
 // GetFederationUUIDAgentParams defines parameters for GetFederationUUIDAgent.
 type GetFederationUUIDAgentParams struct {
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Phone  *string `form:"phone,omitempty" json:"phone,omitempty" validate:"omitempty,max=30"`
+	Email  *string `form:"email,omitempty" json:"email,omitempty" validate:"omitempty,max=100"`
+}
+
+// GetFederationUUIDAgentDuplicatesParams defines parameters for GetFederationUUIDAgentDuplicates.
+type GetFederationUUIDAgentDuplicatesParams struct {
+	CompanyUuid *openapi_types.UUID `form:"company_uuid,omitempty" json:"company_uuid,omitempty"`
+	MinScore    *int                `form:"min_score,omitempty" json:"min_score,omitempty" validate:"omitempty,min=1,max=100"`
+	Limit       *int                `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=500"`
+}
+
+// GetFederationUUIDAgentEntityUUIDActivityParams defines parameters for GetFederationUUIDAgentEntityUUIDActivity.
+type GetFederationUUIDAgentEntityUUIDActivityParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetFederationUUIDAgentEntityUUIDCommentsParams defines parameters for GetFederationUUIDAgentEntityUUIDComments.
+type GetFederationUUIDAgentEntityUUIDCommentsParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty" validate:"omitempty,min=0"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=200"`
+}
+
+// PostFederationUUIDAgentEntityUUIDCommentsJSONBody defines parameters for PostFederationUUIDAgentEntityUUIDComments.
+type PostFederationUUIDAgentEntityUUIDCommentsJSONBody struct {
+	Text string `json:"text" validate:"trim,min=1,max=5000"`
+}
+
+// GetFederationUUIDAgentEntityUUIDDuplicatesParams defines parameters for GetFederationUUIDAgentEntityUUIDDuplicates.
+type GetFederationUUIDAgentEntityUUIDDuplicatesParams struct {
+	MinScore *int `form:"min_score,omitempty" json:"min_score,omitempty" validate:"omitempty,min=1,max=100"`
+}
+
+// PostFederationUUIDAgentEntityUUIDFilesMultipartBody defines parameters for PostFederationUUIDAgentEntityUUIDFiles.
+type PostFederationUUIDAgentEntityUUIDFilesMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// PostFederationUUIDAgentEntityUUIDFilesMultipartRequestBody defines body for PostFederationUUIDAgentEntityUUIDFiles for multipart/form-data ContentType.
+type PostFederationUUIDAgentEntityUUIDFilesMultipartRequestBody PostFederationUUIDAgentEntityUUIDFilesMultipartBody
+
+// PutFederationUUIDAgentEntityUUIDLegalEntitiesJSONBody defines parameters for PutFederationUUIDAgentEntityUUIDLegalEntities.
+type PutFederationUUIDAgentEntityUUIDLegalEntitiesJSONBody struct {
+	Items []AgentLegalEntityRequest `json:"items" validate:"max=20,dive"`
+}
+
+// PutFederationUUIDAgentEntityUUIDTasksJSONBody defines parameters for PutFederationUUIDAgentEntityUUIDTasks.
+type PutFederationUUIDAgentEntityUUIDTasksJSONBody struct {
+	TaskUuids []openapi_types.UUID `json:"task_uuids" validate:"max=500"`
+}
+
+// GetFederationUUIDAgentEntityUUIDTimelineParams defines parameters for GetFederationUUIDAgentEntityUUIDTimeline.
+type GetFederationUUIDAgentEntityUUIDTimelineParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty" validate:"omitempty,min=0,max=1000"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=100"`
+}
+
+// DeleteFederationUUIDEmailTemplatesEntityNameParams defines parameters for DeleteFederationUUIDEmailTemplatesEntityName.
+type DeleteFederationUUIDEmailTemplatesEntityNameParams struct {
+	Locale string `form:"locale" json:"locale"`
+}
+
+// PostFederationUUIDEmailTemplatesEntityNamePreviewJSONBody defines parameters for PostFederationUUIDEmailTemplatesEntityNamePreview.
+type PostFederationUUIDEmailTemplatesEntityNamePreviewJSONBody struct {
+	Body    *string                 `json:"body,omitempty"`
+	Data    *map[string]interface{} `json:"data,omitempty"`
+	Locale  string                  `json:"locale"`
+	Subject *string                 `json:"subject,omitempty"`
+}
+
 // GetFederationUUIDProjectParams defines parameters for GetFederationUUIDProject.
 type GetFederationUUIDProjectParams struct {
 	Limit       *int                `form:"limit,omitempty" json:"limit,omitempty"`
@@ -335,8 +632,11 @@ type PatchProjectUUIDDescriptionJSONBody struct {
 
 // PostProjectUUIDFieldEntityUUIDJSONBody defines parameters for PostProjectUUIDFieldEntityUUID.
 type PostProjectUUIDFieldEntityUUIDJSONBody struct {
-	RequiredOnStatuses []int  `json:"required_on_statuses"`
-	Style              string `json:"style"`
+	RequiredOnStatuses []int `json:"required_on_statuses"`
+
+	// Rules Value validation rules - pattern, min, max, values, unique, required_from_status
+	Rules *domain.FieldRules `json:"rules,omitempty"`
+	Style string             `json:"style"`
 }
 
 // PatchProjectUUIDGraphJSONBody defines parameters for PatchProjectUUIDGraph.
@@ -365,6 +665,21 @@ type PatchTagUUIDJSONBody struct {
 // PostCompanyJSONRequestBody defines body for PostCompany for application/json ContentType.
 type PostCompanyJSONRequestBody = FederationCreateCompanyRequest
 
+// PostCompanyUUIDDealsJSONRequestBody defines body for PostCompanyUUIDDeals for application/json ContentType.
+type PostCompanyUUIDDealsJSONRequestBody = DealRequest
+
+// PostCompanyUUIDDealsPipelinesJSONRequestBody defines body for PostCompanyUUIDDealsPipelines for application/json ContentType.
+type PostCompanyUUIDDealsPipelinesJSONRequestBody = DealPipelineRequest
+
+// PutCompanyUUIDDealsPipelinesEntityUUIDJSONRequestBody defines body for PutCompanyUUIDDealsPipelinesEntityUUID for application/json ContentType.
+type PutCompanyUUIDDealsPipelinesEntityUUIDJSONRequestBody = DealPipelineRequest
+
+// PutCompanyUUIDDealsEntityUUIDJSONRequestBody defines body for PutCompanyUUIDDealsEntityUUID for application/json ContentType.
+type PutCompanyUUIDDealsEntityUUIDJSONRequestBody = DealRequest
+
+// PutCompanyUUIDDealsEntityUUIDStageJSONRequestBody defines body for PutCompanyUUIDDealsEntityUUIDStage for application/json ContentType.
+type PutCompanyUUIDDealsEntityUUIDStageJSONRequestBody PutCompanyUUIDDealsEntityUUIDStageJSONBody
+
 // PostCompanyUUIDFieldsJSONRequestBody defines body for PostCompanyUUIDFields for application/json ContentType.
 type PostCompanyUUIDFieldsJSONRequestBody = ProjectFieldCreateRequest
 
@@ -386,6 +701,12 @@ type PostCompanyUUIDPrioritiesJSONRequestBody = CompanyPriorityCreateRequest
 // PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody defines body for PatchCompanyUUIDPrioritiesEntityUUID for application/json ContentType.
 type PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody PatchCompanyUUIDPrioritiesEntityUUIDJSONBody
 
+// PostCompanyUUIDSmsCampaignsJSONRequestBody defines body for PostCompanyUUIDSmsCampaigns for application/json ContentType.
+type PostCompanyUUIDSmsCampaignsJSONRequestBody PostCompanyUUIDSmsCampaignsJSONBody
+
+// PostCompanyUUIDSmsCampaignsEntityUUIDStartJSONRequestBody defines body for PostCompanyUUIDSmsCampaignsEntityUUIDStart for application/json ContentType.
+type PostCompanyUUIDSmsCampaignsEntityUUIDStartJSONRequestBody PostCompanyUUIDSmsCampaignsEntityUUIDStartJSONBody
+
 // PostCompanyUUIDSmsCostJSONRequestBody defines body for PostCompanyUUIDSmsCost for application/json ContentType.
 type PostCompanyUUIDSmsCostJSONRequestBody PostCompanyUUIDSmsCostJSONBody
 
@@ -395,6 +716,12 @@ type PostCompanyUUIDSmsOptionsJSONRequestBody PostCompanyUUIDSmsOptionsJSONBody
 // PostCompanyUUIDSmsSendJSONRequestBody defines body for PostCompanyUUIDSmsSend for application/json ContentType.
 type PostCompanyUUIDSmsSendJSONRequestBody PostCompanyUUIDSmsSendJSONBody
 
+// PostCompanyUUIDSmsTemplatesJSONRequestBody defines body for PostCompanyUUIDSmsTemplates for application/json ContentType.
+type PostCompanyUUIDSmsTemplatesJSONRequestBody = SmsTemplateRequest
+
+// PutCompanyUUIDSmsTemplatesEntityUUIDJSONRequestBody defines body for PutCompanyUUIDSmsTemplatesEntityUUID for application/json ContentType.
+type PutCompanyUUIDSmsTemplatesEntityUUIDJSONRequestBody = SmsTemplateRequest
+
 // PostCompanyUUIDUserJSONRequestBody defines body for PostCompanyUUIDUser for application/json ContentType.
 type PostCompanyUUIDUserJSONRequestBody = CompanyAddUserRequest
 
@@ -407,6 +734,27 @@ type PostFederationUUIDAgentJSONRequestBody = AgentCreateRequest
 // PatchFederationUUIDAgentEntityUUIDJSONRequestBody defines body for PatchFederationUUIDAgentEntityUUID for application/json ContentType.
 type PatchFederationUUIDAgentEntityUUIDJSONRequestBody = AgentPatchRequest
 
+// PostFederationUUIDAgentEntityUUIDCommentsJSONRequestBody defines body for PostFederationUUIDAgentEntityUUIDComments for application/json ContentType.
+type PostFederationUUIDAgentEntityUUIDCommentsJSONRequestBody PostFederationUUIDAgentEntityUUIDCommentsJSONBody
+
+// PutFederationUUIDAgentEntityUUIDLegalEntitiesJSONRequestBody defines body for PutFederationUUIDAgentEntityUUIDLegalEntities for application/json ContentType.
+type PutFederationUUIDAgentEntityUUIDLegalEntitiesJSONRequestBody PutFederationUUIDAgentEntityUUIDLegalEntitiesJSONBody
+
+// PostFederationUUIDAgentEntityUUIDMergeJSONRequestBody defines body for PostFederationUUIDAgentEntityUUIDMerge for application/json ContentType.
+type PostFederationUUIDAgentEntityUUIDMergeJSONRequestBody = MergeRequest
+
+// PutFederationUUIDAgentEntityUUIDTasksJSONRequestBody defines body for PutFederationUUIDAgentEntityUUIDTasks for application/json ContentType.
+type PutFederationUUIDAgentEntityUUIDTasksJSONRequestBody PutFederationUUIDAgentEntityUUIDTasksJSONBody
+
+// PutFederationUUIDEmailBrandingJSONRequestBody defines body for PutFederationUUIDEmailBranding for application/json ContentType.
+type PutFederationUUIDEmailBrandingJSONRequestBody = EmailBrandingRequest
+
+// PutFederationUUIDEmailTemplatesEntityNameJSONRequestBody defines body for PutFederationUUIDEmailTemplatesEntityName for application/json ContentType.
+type PutFederationUUIDEmailTemplatesEntityNameJSONRequestBody = EmailTemplateRequest
+
+// PostFederationUUIDEmailTemplatesEntityNamePreviewJSONRequestBody defines body for PostFederationUUIDEmailTemplatesEntityNamePreview for application/json ContentType.
+type PostFederationUUIDEmailTemplatesEntityNamePreviewJSONRequestBody PostFederationUUIDEmailTemplatesEntityNamePreviewJSONBody
+
 // PostFederationUUIDInviteJSONRequestBody defines body for PostFederationUUIDInvite for application/json ContentType.
 type PostFederationUUIDInviteJSONRequestBody = InviteCreateRequest
 
@@ -491,6 +839,42 @@ type ServerInterface interface {
 	// (GET /company/{UUID})
 	GetCompanyUUID(ctx echo.Context, uUID Uuid) error
 
+	// (GET /company/{UUID}/deals)
+	GetCompanyUUIDDeals(ctx echo.Context, uUID Uuid, params GetCompanyUUIDDealsParams) error
+
+	// (POST /company/{UUID}/deals)
+	PostCompanyUUIDDeals(ctx echo.Context, uUID Uuid) error
+
+	// (GET /company/{UUID}/deals/forecast)
+	GetCompanyUUIDDealsForecast(ctx echo.Context, uUID Uuid, params GetCompanyUUIDDealsForecastParams) error
+
+	// (GET /company/{UUID}/deals/pipelines)
+	GetCompanyUUIDDealsPipelines(ctx echo.Context, uUID Uuid) error
+
+	// (POST /company/{UUID}/deals/pipelines)
+	PostCompanyUUIDDealsPipelines(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /company/{UUID}/deals/pipelines/{entityUUID})
+	DeleteCompanyUUIDDealsPipelinesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /company/{UUID}/deals/pipelines/{entityUUID})
+	PutCompanyUUIDDealsPipelinesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (DELETE /company/{UUID}/deals/{entityUUID})
+	DeleteCompanyUUIDDealsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /company/{UUID}/deals/{entityUUID})
+	GetCompanyUUIDDealsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /company/{UUID}/deals/{entityUUID})
+	PutCompanyUUIDDealsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /company/{UUID}/deals/{entityUUID}/activity)
+	GetCompanyUUIDDealsEntityUUIDActivity(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetCompanyUUIDDealsEntityUUIDActivityParams) error
+
+	// (PUT /company/{UUID}/deals/{entityUUID}/stage)
+	PutCompanyUUIDDealsEntityUUIDStage(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (GET /company/{UUID}/fields)
 	GetCompanyUUIDFields(ctx echo.Context, uUID Uuid) error
 
@@ -536,6 +920,30 @@ type ServerInterface interface {
 	// (GET /company/{UUID}/sms)
 	GetCompanyUUIDSms(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsParams) error
 
+	// (GET /company/{UUID}/sms/campaigns)
+	GetCompanyUUIDSmsCampaigns(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsCampaignsParams) error
+
+	// (POST /company/{UUID}/sms/campaigns)
+	PostCompanyUUIDSmsCampaigns(ctx echo.Context, uUID Uuid) error
+
+	// (GET /company/{UUID}/sms/campaigns/{entityUUID})
+	GetCompanyUUIDSmsCampaignsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /company/{UUID}/sms/campaigns/{entityUUID}/cancel)
+	PostCompanyUUIDSmsCampaignsEntityUUIDCancel(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /company/{UUID}/sms/campaigns/{entityUUID}/cost)
+	GetCompanyUUIDSmsCampaignsEntityUUIDCost(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /company/{UUID}/sms/campaigns/{entityUUID}/csv)
+	PostCompanyUUIDSmsCampaignsEntityUUIDCsv(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /company/{UUID}/sms/campaigns/{entityUUID}/recipients)
+	GetCompanyUUIDSmsCampaignsEntityUUIDRecipients(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsParams) error
+
+	// (POST /company/{UUID}/sms/campaigns/{entityUUID}/start)
+	PostCompanyUUIDSmsCampaignsEntityUUIDStart(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (POST /company/{UUID}/sms/cost)
 	PostCompanyUUIDSmsCost(ctx echo.Context, uUID Uuid) error
 
@@ -545,6 +953,18 @@ type ServerInterface interface {
 	// (POST /company/{UUID}/sms/send)
 	PostCompanyUUIDSmsSend(ctx echo.Context, uUID Uuid, params PostCompanyUUIDSmsSendParams) error
 
+	// (GET /company/{UUID}/sms/templates)
+	GetCompanyUUIDSmsTemplates(ctx echo.Context, uUID Uuid) error
+
+	// (POST /company/{UUID}/sms/templates)
+	PostCompanyUUIDSmsTemplates(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /company/{UUID}/sms/templates/{entityUUID})
+	DeleteCompanyUUIDSmsTemplatesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /company/{UUID}/sms/templates/{entityUUID})
+	PutCompanyUUIDSmsTemplatesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (POST /company/{UUID}/user)
 	PostCompanyUUIDUser(ctx echo.Context, uUID Uuid) error
 
@@ -566,12 +986,75 @@ type ServerInterface interface {
 	// (POST /federation/{UUID}/agent)
 	PostFederationUUIDAgent(ctx echo.Context, uUID Uuid) error
 
+	// (GET /federation/{UUID}/agent/duplicates)
+	GetFederationUUIDAgentDuplicates(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentDuplicatesParams) error
+
 	// (DELETE /federation/{UUID}/agent/{entityUUID})
 	DeleteFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (GET /federation/{UUID}/agent/{entityUUID})
+	GetFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (PATCH /federation/{UUID}/agent/{entityUUID})
 	PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (GET /federation/{UUID}/agent/{entityUUID}/activity)
+	GetFederationUUIDAgentEntityUUIDActivity(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDActivityParams) error
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/comments)
+	GetFederationUUIDAgentEntityUUIDComments(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDCommentsParams) error
+
+	// (POST /federation/{UUID}/agent/{entityUUID}/comments)
+	PostFederationUUIDAgentEntityUUIDComments(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (DELETE /federation/{UUID}/agent/{entityUUID}/comments/{commentUUID})
+	DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, commentUUID openapi_types.UUID) error
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/duplicates)
+	GetFederationUUIDAgentEntityUUIDDuplicates(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDDuplicatesParams) error
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/files)
+	GetFederationUUIDAgentEntityUUIDFiles(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /federation/{UUID}/agent/{entityUUID}/files)
+	PostFederationUUIDAgentEntityUUIDFiles(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (DELETE /federation/{UUID}/agent/{entityUUID}/files/{fileUUID})
+	DeleteFederationUUIDAgentEntityUUIDFilesFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/files/{fileUUID})
+	GetFederationUUIDAgentEntityUUIDFilesFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error
+
+	// (PUT /federation/{UUID}/agent/{entityUUID}/legal_entities)
+	PutFederationUUIDAgentEntityUUIDLegalEntities(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /federation/{UUID}/agent/{entityUUID}/merge)
+	PostFederationUUIDAgentEntityUUIDMerge(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /federation/{UUID}/agent/{entityUUID}/tasks)
+	PutFederationUUIDAgentEntityUUIDTasks(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/timeline)
+	GetFederationUUIDAgentEntityUUIDTimeline(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDTimelineParams) error
+
+	// (GET /federation/{UUID}/email/branding)
+	GetFederationUUIDEmailBranding(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /federation/{UUID}/email/branding)
+	PutFederationUUIDEmailBranding(ctx echo.Context, uUID Uuid) error
+
+	// (GET /federation/{UUID}/email/templates)
+	GetFederationUUIDEmailTemplates(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /federation/{UUID}/email/templates/{entityName})
+	DeleteFederationUUIDEmailTemplatesEntityName(ctx echo.Context, uUID Uuid, entityName EntityName, params DeleteFederationUUIDEmailTemplatesEntityNameParams) error
+
+	// (PUT /federation/{UUID}/email/templates/{entityName})
+	PutFederationUUIDEmailTemplatesEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error
+
+	// (POST /federation/{UUID}/email/templates/{entityName}/preview)
+	PostFederationUUIDEmailTemplatesEntityNamePreview(ctx echo.Context, uUID Uuid, entityName EntityName) error
+
 	// (GET /federation/{UUID}/invite)
 	GetFederationUUIDInvite(ctx echo.Context, uUID Uuid) error
 
@@ -659,6 +1142,9 @@ type ServerInterface interface {
 	// (PATCH /project/{UUID}/description)
 	PatchProjectUUIDDescription(ctx echo.Context, uUID Uuid) error
 
+	// (GET /project/{UUID}/email)
+	GetProjectUUIDEmail(ctx echo.Context, uUID Uuid) error
+
 	// (DELETE /project/{UUID}/field/{entityUUID})
 	DeleteProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
@@ -760,8 +1246,8 @@ func (w *ServerInterfaceWrapper) GetCompanyUUID(ctx echo.Context) error {
 	return err
 }
 
-// GetCompanyUUIDFields converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCompanyUUIDFields(ctx echo.Context) error {
+// GetCompanyUUIDDeals converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDDeals(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -773,13 +1259,71 @@ func (w *ServerInterfaceWrapper) GetCompanyUUIDFields(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCompanyUUIDDealsParams
+	// ------------- Optional query parameter "pipeline_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pipeline_uuid", ctx.QueryParams(), &params.PipelineUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pipeline_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "stage_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stage_uuid", ctx.QueryParams(), &params.StageUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stage_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "agent_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "agent_uuid", ctx.QueryParams(), &params.AgentUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter agent_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "task_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "task_uuid", ctx.QueryParams(), &params.TaskUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCompanyUUIDFields(ctx, uUID)
+	err = w.Handler.GetCompanyUUIDDeals(ctx, uUID, params)
 	return err
 }
 
-// PostCompanyUUIDFields converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCompanyUUIDFields(ctx echo.Context) error {
+// PostCompanyUUIDDeals converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDDeals(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -792,12 +1336,12 @@ func (w *ServerInterfaceWrapper) PostCompanyUUIDFields(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCompanyUUIDFields(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDDeals(ctx, uUID)
 	return err
 }
 
-// DeleteCompanyUUIDFieldsEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCompanyUUIDFieldsEntityUUID(ctx echo.Context) error {
+// GetCompanyUUIDDealsForecast converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDDealsForecast(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -807,23 +1351,38 @@ func (w *ServerInterfaceWrapper) DeleteCompanyUUIDFieldsEntityUUID(ctx echo.Cont
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCompanyUUIDDealsForecastParams
+	// ------------- Optional query parameter "pipeline_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pipeline_uuid", ctx.QueryParams(), &params.PipelineUuid)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pipeline_uuid: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCompanyUUIDFieldsEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetCompanyUUIDDealsForecast(ctx, uUID, params)
 	return err
 }
 
-// PutCompanyUUIDFieldsEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PutCompanyUUIDFieldsEntityUUID(ctx echo.Context) error {
+// GetCompanyUUIDDealsPipelines converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDDealsPipelines(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -833,23 +1392,15 @@ func (w *ServerInterfaceWrapper) PutCompanyUUIDFieldsEntityUUID(ctx echo.Context
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutCompanyUUIDFieldsEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetCompanyUUIDDealsPipelines(ctx, uUID)
 	return err
 }
 
-// GetCompanyUUIDGroup converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCompanyUUIDGroup(ctx echo.Context) error {
+// PostCompanyUUIDDealsPipelines converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDDealsPipelines(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -862,12 +1413,12 @@ func (w *ServerInterfaceWrapper) GetCompanyUUIDGroup(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCompanyUUIDGroup(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDDealsPipelines(ctx, uUID)
 	return err
 }
 
-// PostCompanyUUIDGroup converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCompanyUUIDGroup(ctx echo.Context) error {
+// DeleteCompanyUUIDDealsPipelinesEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCompanyUUIDDealsPipelinesEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -877,15 +1428,23 @@ func (w *ServerInterfaceWrapper) PostCompanyUUIDGroup(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCompanyUUIDGroup(ctx, uUID)
+	err = w.Handler.DeleteCompanyUUIDDealsPipelinesEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeleteCompanyUUIDGroupEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCompanyUUIDGroupEntityUUID(ctx echo.Context) error {
+// PutCompanyUUIDDealsPipelinesEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCompanyUUIDDealsPipelinesEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -906,12 +1465,12 @@ func (w *ServerInterfaceWrapper) DeleteCompanyUUIDGroupEntityUUID(ctx echo.Conte
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCompanyUUIDGroupEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PutCompanyUUIDDealsPipelinesEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchCompanyUUIDGroupEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchCompanyUUIDGroupEntityUUID(ctx echo.Context) error {
+// DeleteCompanyUUIDDealsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCompanyUUIDDealsEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -932,12 +1491,12 @@ func (w *ServerInterfaceWrapper) PatchCompanyUUIDGroupEntityUUID(ctx echo.Contex
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchCompanyUUIDGroupEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeleteCompanyUUIDDealsEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchCompanyUUIDName converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchCompanyUUIDName(ctx echo.Context) error {
+// GetCompanyUUIDDealsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDDealsEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -947,33 +1506,23 @@ func (w *ServerInterfaceWrapper) PatchCompanyUUIDName(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchCompanyUUIDName(ctx, uUID)
-	return err
-}
-
-// GetCompanyUUIDPriorities converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCompanyUUIDPriorities(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCompanyUUIDPriorities(ctx, uUID)
+	err = w.Handler.GetCompanyUUIDDealsEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostCompanyUUIDPriorities converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCompanyUUIDPriorities(ctx echo.Context) error {
+// PutCompanyUUIDDealsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCompanyUUIDDealsEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -983,15 +1532,23 @@ func (w *ServerInterfaceWrapper) PostCompanyUUIDPriorities(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCompanyUUIDPriorities(ctx, uUID)
+	err = w.Handler.PutCompanyUUIDDealsEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeleteCompanyUUIDPrioritiesEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCompanyUUIDPrioritiesEntityUUID(ctx echo.Context) error {
+// GetCompanyUUIDDealsEntityUUIDActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDDealsEntityUUIDActivity(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1011,13 +1568,29 @@ func (w *ServerInterfaceWrapper) DeleteCompanyUUIDPrioritiesEntityUUID(ctx echo.
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCompanyUUIDDealsEntityUUIDActivityParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCompanyUUIDPrioritiesEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetCompanyUUIDDealsEntityUUIDActivity(ctx, uUID, entityUUID, params)
 	return err
 }
 
-// PatchCompanyUUIDPrioritiesEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchCompanyUUIDPrioritiesEntityUUID(ctx echo.Context) error {
+// PutCompanyUUIDDealsEntityUUIDStage converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCompanyUUIDDealsEntityUUIDStage(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1038,12 +1611,12 @@ func (w *ServerInterfaceWrapper) PatchCompanyUUIDPrioritiesEntityUUID(ctx echo.C
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchCompanyUUIDPrioritiesEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PutCompanyUUIDDealsEntityUUIDStage(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetCompanyUUIDProjectCatalogEntityName converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context) error {
+// GetCompanyUUIDFields converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDFields(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1053,23 +1626,15 @@ func (w *ServerInterfaceWrapper) GetCompanyUUIDProjectCatalogEntityName(ctx echo
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityName" -------------
-	var entityName EntityName
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCompanyUUIDProjectCatalogEntityName(ctx, uUID, entityName)
+	err = w.Handler.GetCompanyUUIDFields(ctx, uUID)
 	return err
 }
 
-// GetCompanyUUIDSms converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCompanyUUIDSms(ctx echo.Context) error {
+// PostCompanyUUIDFields converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDFields(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1081,36 +1646,13 @@ func (w *ServerInterfaceWrapper) GetCompanyUUIDSms(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCompanyUUIDSmsParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
-	// ------------- Optional query parameter "is_my" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "is_my", ctx.QueryParams(), &params.IsMy)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_my: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCompanyUUIDSms(ctx, uUID, params)
+	err = w.Handler.PostCompanyUUIDFields(ctx, uUID)
 	return err
 }
 
-// PostCompanyUUIDSmsCost converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsCost(ctx echo.Context) error {
+// DeleteCompanyUUIDFieldsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCompanyUUIDFieldsEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1120,15 +1662,23 @@ func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsCost(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCompanyUUIDSmsCost(ctx, uUID)
+	err = w.Handler.DeleteCompanyUUIDFieldsEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostCompanyUUIDSmsOptions converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsOptions(ctx echo.Context) error {
+// PutCompanyUUIDFieldsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCompanyUUIDFieldsEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1138,15 +1688,23 @@ func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsOptions(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCompanyUUIDSmsOptions(ctx, uUID)
+	err = w.Handler.PutCompanyUUIDFieldsEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostCompanyUUIDSmsSend converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsSend(ctx echo.Context) error {
+// GetCompanyUUIDGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDGroup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1158,33 +1716,13 @@ func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsSend(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostCompanyUUIDSmsSendParams
-
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "Mock-Sms" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Mock-Sms")]; found {
-		var MockSms string
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Mock-Sms, got %d", n))
-		}
-
-		err = runtime.BindStyledParameterWithLocation("simple", false, "Mock-Sms", runtime.ParamLocationHeader, valueList[0], &MockSms)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Mock-Sms: %s", err))
-		}
-
-		params.MockSms = &MockSms
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCompanyUUIDSmsSend(ctx, uUID, params)
+	err = w.Handler.GetCompanyUUIDGroup(ctx, uUID)
 	return err
 }
 
-// PostCompanyUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCompanyUUIDUser(ctx echo.Context) error {
+// PostCompanyUUIDGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDGroup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1197,12 +1735,12 @@ func (w *ServerInterfaceWrapper) PostCompanyUUIDUser(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCompanyUUIDUser(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDGroup(ctx, uUID)
 	return err
 }
 
-// DeleteCompanyUUIDUserUserUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCompanyUUIDUserUserUUID(ctx echo.Context) error {
+// DeleteCompanyUUIDGroupEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCompanyUUIDGroupEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1212,34 +1750,23 @@ func (w *ServerInterfaceWrapper) DeleteCompanyUUIDUserUserUUID(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "userUUID" -------------
-	var userUUID UserUUID
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCompanyUUIDUserUserUUID(ctx, uUID, userUUID)
-	return err
-}
-
-// PostFederation converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFederation(ctx echo.Context) error {
-	var err error
-
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFederation(ctx)
+	err = w.Handler.DeleteCompanyUUIDGroupEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeleteFederationUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteFederationUUID(ctx echo.Context) error {
+// PatchCompanyUUIDGroupEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCompanyUUIDGroupEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1249,15 +1776,23 @@ func (w *ServerInterfaceWrapper) DeleteFederationUUID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteFederationUUID(ctx, uUID)
+	err = w.Handler.PatchCompanyUUIDGroupEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetFederationUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetFederationUUID(ctx echo.Context) error {
+// PatchCompanyUUIDName converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCompanyUUIDName(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1270,12 +1805,12 @@ func (w *ServerInterfaceWrapper) GetFederationUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetFederationUUID(ctx, uUID)
+	err = w.Handler.PatchCompanyUUIDName(ctx, uUID)
 	return err
 }
 
-// GetFederationUUIDAgent converts echo context to params.
-func (w *ServerInterfaceWrapper) GetFederationUUIDAgent(ctx echo.Context) error {
+// GetCompanyUUIDPriorities converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDPriorities(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1287,29 +1822,13 @@ func (w *ServerInterfaceWrapper) GetFederationUUIDAgent(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFederationUUIDAgentParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetFederationUUIDAgent(ctx, uUID, params)
+	err = w.Handler.GetCompanyUUIDPriorities(ctx, uUID)
 	return err
 }
 
-// PostFederationUUIDAgent converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFederationUUIDAgent(ctx echo.Context) error {
+// PostCompanyUUIDPriorities converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDPriorities(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1322,12 +1841,12 @@ func (w *ServerInterfaceWrapper) PostFederationUUIDAgent(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFederationUUIDAgent(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDPriorities(ctx, uUID)
 	return err
 }
 
-// DeleteFederationUUIDAgentEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context) error {
+// DeleteCompanyUUIDPrioritiesEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCompanyUUIDPrioritiesEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1348,12 +1867,12 @@ func (w *ServerInterfaceWrapper) DeleteFederationUUIDAgentEntityUUID(ctx echo.Co
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteFederationUUIDAgentEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeleteCompanyUUIDPrioritiesEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchFederationUUIDAgentEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchFederationUUIDAgentEntityUUID(ctx echo.Context) error {
+// PatchCompanyUUIDPrioritiesEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCompanyUUIDPrioritiesEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1374,12 +1893,12 @@ func (w *ServerInterfaceWrapper) PatchFederationUUIDAgentEntityUUID(ctx echo.Con
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchFederationUUIDAgentEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PatchCompanyUUIDPrioritiesEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetFederationUUIDInvite converts echo context to params.
-func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error {
+// GetCompanyUUIDProjectCatalogEntityName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1389,15 +1908,23 @@ func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityName" -------------
+	var entityName EntityName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetFederationUUIDInvite(ctx, uUID)
+	err = w.Handler.GetCompanyUUIDProjectCatalogEntityName(ctx, uUID, entityName)
 	return err
 }
 
-// PostFederationUUIDInvite converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFederationUUIDInvite(ctx echo.Context) error {
+// GetCompanyUUIDSms converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDSms(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1409,57 +1936,43 @@ func (w *ServerInterfaceWrapper) PostFederationUUIDInvite(ctx echo.Context) erro
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFederationUUIDInvite(ctx, uUID)
-	return err
-}
-
-// DeleteFederationUUIDInviteEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCompanyUUIDSmsParams
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "is_my" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteFederationUUIDInviteEntityUUID(ctx, uUID, entityUUID)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "is_my", ctx.QueryParams(), &params.IsMy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_my: %s", err))
+	}
 
-// PatchFederationUUIDName converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchFederationUUIDName(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Optional query parameter "state" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchFederationUUIDName(ctx, uUID)
+	err = w.Handler.GetCompanyUUIDSms(ctx, uUID, params)
 	return err
 }
 
-// GetFederationUUIDProject converts echo context to params.
-func (w *ServerInterfaceWrapper) GetFederationUUIDProject(ctx echo.Context) error {
+// GetCompanyUUIDSmsCampaigns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDSmsCampaigns(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1472,14 +1985,7 @@ func (w *ServerInterfaceWrapper) GetFederationUUIDProject(ctx echo.Context) erro
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFederationUUIDProjectParams
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
+	var params GetCompanyUUIDSmsCampaignsParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -1487,20 +1993,20 @@ func (w *ServerInterfaceWrapper) GetFederationUUIDProject(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "company_uuid" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetFederationUUIDProject(ctx, uUID, params)
+	err = w.Handler.GetCompanyUUIDSmsCampaigns(ctx, uUID, params)
 	return err
 }
 
-// PostFederationUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFederationUUIDUser(ctx echo.Context) error {
+// PostCompanyUUIDSmsCampaigns converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsCampaigns(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1513,12 +2019,12 @@ func (w *ServerInterfaceWrapper) PostFederationUUIDUser(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFederationUUIDUser(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDSmsCampaigns(ctx, uUID)
 	return err
 }
 
-// DeleteFederationUUIDUserUserUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteFederationUUIDUserUserUUID(ctx echo.Context) error {
+// GetCompanyUUIDSmsCampaignsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDSmsCampaignsEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1528,23 +2034,23 @@ func (w *ServerInterfaceWrapper) DeleteFederationUUIDUserUserUUID(ctx echo.Conte
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "userUUID" -------------
-	var userUUID UserUUID
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteFederationUUIDUserUserUUID(ctx, uUID, userUUID)
+	err = w.Handler.GetCompanyUUIDSmsCampaignsEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeleteGroupUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
+// PostCompanyUUIDSmsCampaignsEntityUUIDCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsCampaignsEntityUUIDCancel(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1554,15 +2060,23 @@ func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteGroupUUIDUser(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDSmsCampaignsEntityUUIDCancel(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetGroupUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroupUUIDUser(ctx echo.Context) error {
+// GetCompanyUUIDSmsCampaignsEntityUUIDCost converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDSmsCampaignsEntityUUIDCost(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1572,15 +2086,23 @@ func (w *ServerInterfaceWrapper) GetGroupUUIDUser(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroupUUIDUser(ctx, uUID)
+	err = w.Handler.GetCompanyUUIDSmsCampaignsEntityUUIDCost(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostGroupUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostGroupUUIDUser(ctx echo.Context) error {
+// PostCompanyUUIDSmsCampaignsEntityUUIDCsv converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsCampaignsEntityUUIDCsv(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1590,70 +2112,72 @@ func (w *ServerInterfaceWrapper) PostGroupUUIDUser(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostGroupUUIDUser(ctx, uUID)
-	return err
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-// DeleteLegalEntities converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteLegalEntities(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteLegalEntities(ctx)
+	err = w.Handler.PostCompanyUUIDSmsCampaignsEntityUUIDCsv(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetLegalEntities converts echo context to params.
-func (w *ServerInterfaceWrapper) GetLegalEntities(ctx echo.Context) error {
+// GetCompanyUUIDSmsCampaignsEntityUUIDRecipients converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDSmsCampaignsEntityUUIDRecipients(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetLegalEntities(ctx)
-	return err
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-// PatchLegalEntities converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchLegalEntities(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchLegalEntities(ctx)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsParams
+	// ------------- Optional query parameter "offset" -------------
 
-// PostLegalEntities converts echo context to params.
-func (w *ServerInterfaceWrapper) PostLegalEntities(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostLegalEntities(ctx)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-// PostPermissions converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPermissions(ctx echo.Context) error {
-	var err error
+	// ------------- Optional query parameter "state" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPermissions(ctx)
+	err = w.Handler.GetCompanyUUIDSmsCampaignsEntityUUIDRecipients(ctx, uUID, entityUUID, params)
 	return err
 }
 
-// DeletePermissionsUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeletePermissionsUUID(ctx echo.Context) error {
+// PostCompanyUUIDSmsCampaignsEntityUUIDStart converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsCampaignsEntityUUIDStart(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1663,15 +2187,23 @@ func (w *ServerInterfaceWrapper) DeletePermissionsUUID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeletePermissionsUUID(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDSmsCampaignsEntityUUIDStart(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetPermissionsUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPermissionsUUID(ctx echo.Context) error {
+// PostCompanyUUIDSmsCost converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsCost(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1684,23 +2216,12 @@ func (w *ServerInterfaceWrapper) GetPermissionsUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPermissionsUUID(ctx, uUID)
-	return err
-}
-
-// PostProfileSurvey converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileSurvey(ctx echo.Context) error {
-	var err error
-
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileSurvey(ctx)
+	err = w.Handler.PostCompanyUUIDSmsCost(ctx, uUID)
 	return err
 }
 
-// DeleteProfileSurveyUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProfileSurveyUUID(ctx echo.Context) error {
+// PostCompanyUUIDSmsOptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsOptions(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1713,12 +2234,12 @@ func (w *ServerInterfaceWrapper) DeleteProfileSurveyUUID(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProfileSurveyUUID(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDSmsOptions(ctx, uUID)
 	return err
 }
 
-// GetProfileSurveyUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProfileSurveyUUID(ctx echo.Context) error {
+// PostCompanyUUIDSmsSend converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsSend(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1730,24 +2251,33 @@ func (w *ServerInterfaceWrapper) GetProfileSurveyUUID(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProfileSurveyUUID(ctx, uUID)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostCompanyUUIDSmsSendParams
 
-// PostProject converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProject(ctx echo.Context) error {
-	var err error
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Mock-Sms" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Mock-Sms")]; found {
+		var MockSms string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Mock-Sms, got %d", n))
+		}
 
-	ctx.Set(BearerAuthScopes, []string{})
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Mock-Sms", runtime.ParamLocationHeader, valueList[0], &MockSms)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Mock-Sms: %s", err))
+		}
+
+		params.MockSms = &MockSms
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProject(ctx)
+	err = w.Handler.PostCompanyUUIDSmsSend(ctx, uUID, params)
 	return err
 }
 
-// DeleteProjectUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUID(ctx echo.Context) error {
+// GetCompanyUUIDSmsTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDSmsTemplates(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1760,12 +2290,12 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUID(ctx, uUID)
+	err = w.Handler.GetCompanyUUIDSmsTemplates(ctx, uUID)
 	return err
 }
 
-// GetProjectUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUID(ctx echo.Context) error {
+// PostCompanyUUIDSmsTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDSmsTemplates(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1778,12 +2308,12 @@ func (w *ServerInterfaceWrapper) GetProjectUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUID(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDSmsTemplates(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
+// DeleteCompanyUUIDSmsTemplatesEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCompanyUUIDSmsTemplatesEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1793,15 +2323,23 @@ func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUID(ctx, uUID)
+	err = w.Handler.DeleteCompanyUUIDSmsTemplatesEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetProjectUUIDCatalog converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
+// PutCompanyUUIDSmsTemplatesEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCompanyUUIDSmsTemplatesEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1811,15 +2349,23 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDCatalog(ctx, uUID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutCompanyUUIDSmsTemplatesEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostProjectUUIDCatalog converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error {
+// PostCompanyUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDUser(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1832,12 +2378,12 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDCatalog(ctx, uUID)
+	err = w.Handler.PostCompanyUUIDUser(ctx, uUID)
 	return err
 }
 
-// GetProjectUUIDCatalogEntityName converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Context) error {
+// DeleteCompanyUUIDUserUserUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCompanyUUIDUserUserUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1847,23 +2393,34 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Contex
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityName" -------------
-	var entityName EntityName
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID UserUUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDCatalogEntityName(ctx, uUID, entityName)
+	err = w.Handler.DeleteCompanyUUIDUserUserUUID(ctx, uUID, userUUID)
 	return err
 }
 
-// DeleteProjectUUIDCatalogEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context) error {
+// PostFederation converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederation(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFederation(ctx)
+	return err
+}
+
+// DeleteFederationUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1873,23 +2430,15 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Con
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDCatalogEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeleteFederationUUID(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUIDDescription converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) error {
+// GetFederationUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1902,12 +2451,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) e
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDDescription(ctx, uUID)
+	err = w.Handler.GetFederationUUID(ctx, uUID)
 	return err
 }
 
-// DeleteProjectUUIDFieldEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context) error {
+// GetFederationUUIDAgent converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgent(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1917,49 +2466,45 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Conte
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDAgentParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-// PostProjectUUIDFieldEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Optional query parameter "phone" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindQueryParameter("form", true, false, "phone", ctx.QueryParams(), &params.Phone)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter phone: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	// ------------- Optional query parameter "email" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	err = runtime.BindQueryParameter("form", true, false, "email", ctx.QueryParams(), &params.Email)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetFederationUUIDAgent(ctx, uUID, params)
 	return err
 }
 
-// PatchProjectUUIDGraph converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
+// PostFederationUUIDAgent converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDAgent(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1972,12 +2517,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDGraph(ctx, uUID)
+	err = w.Handler.PostFederationUUIDAgent(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUIDName converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
+// GetFederationUUIDAgentDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentDuplicates(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1989,31 +2534,36 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDName(ctx, uUID)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDAgentDuplicatesParams
+	// ------------- Optional query parameter "company_uuid" -------------
 
-// PatchProjectUUIDOptions converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	err = runtime.BindQueryParameter("form", true, false, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	// ------------- Optional query parameter "min_score" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_score", ctx.QueryParams(), &params.MinScore)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_score: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDOptions(ctx, uUID)
+	err = w.Handler.GetFederationUUIDAgentDuplicates(ctx, uUID, params)
 	return err
 }
 
-// GetProjectUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
+// DeleteFederationUUIDAgentEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2023,15 +2573,23 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDStatus(ctx, uUID)
+	err = w.Handler.DeleteFederationUUIDAgentEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostProjectUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
+// GetFederationUUIDAgentEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2041,15 +2599,23 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDStatus(ctx, uUID)
+	err = w.Handler.GetFederationUUIDAgentEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeleteProjectUUIDStatusEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+// PatchFederationUUIDAgentEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFederationUUIDAgentEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2070,12 +2636,12 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Cont
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PatchFederationUUIDAgentEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchProjectUUIDStatusEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+// GetFederationUUIDAgentEntityUUIDActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentEntityUUIDActivity(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2095,13 +2661,29 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Conte
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDAgentEntityUUIDActivityParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetFederationUUIDAgentEntityUUIDActivity(ctx, uUID, entityUUID, params)
 	return err
 }
 
-// PostProjectUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
+// GetFederationUUIDAgentEntityUUIDComments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentEntityUUIDComments(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2111,15 +2693,39 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDAgentEntityUUIDCommentsParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDUser(ctx, uUID)
+	err = w.Handler.GetFederationUUIDAgentEntityUUIDComments(ctx, uUID, entityUUID, params)
 	return err
 }
 
-// DeleteProjectUUIDUserUserUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context) error {
+// PostFederationUUIDAgentEntityUUIDComments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDAgentEntityUUIDComments(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2129,54 +2735,57 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "userUUID" -------------
-	var userUUID UserUUID
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDUserUserUUID(ctx, uUID, userUUID)
+	err = w.Handler.PostFederationUUIDAgentEntityUUIDComments(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetTag converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTag(ctx echo.Context) error {
+// DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTagParams
-	// ------------- Required query parameter "company_uuid" -------------
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindQueryParameter("form", true, true, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTag(ctx, params)
-	return err
-}
+	// ------------- Path parameter "commentUUID" -------------
+	var commentUUID openapi_types.UUID
 
-// PostTag converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTag(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "commentUUID", runtime.ParamLocationPath, ctx.Param("commentUUID"), &commentUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentUUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTag(ctx)
+	err = w.Handler.DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID(ctx, uUID, entityUUID, commentUUID)
 	return err
 }
 
-// DeleteTagUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTagUUID(ctx echo.Context) error {
+// GetFederationUUIDAgentEntityUUIDDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentEntityUUIDDuplicates(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2186,15 +2795,32 @@ func (w *ServerInterfaceWrapper) DeleteTagUUID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTagUUID(ctx, uUID)
-	return err
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDAgentEntityUUIDDuplicatesParams
+	// ------------- Optional query parameter "min_score" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_score", ctx.QueryParams(), &params.MinScore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_score: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDAgentEntityUUIDDuplicates(ctx, uUID, entityUUID, params)
+	return err
 }
 
-// PatchTagUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTagUUID(ctx echo.Context) error {
+// GetFederationUUIDAgentEntityUUIDFiles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentEntityUUIDFiles(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2204,2577 +2830,6157 @@ func (w *ServerInterfaceWrapper) PatchTagUUID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTagUUID(ctx, uUID)
+	err = w.Handler.GetFederationUUIDAgentEntityUUIDFiles(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
+// PostFederationUUIDAgentEntityUUIDFiles converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDAgentEntityUUIDFiles(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUser(ctx)
+	err = w.Handler.PostFederationUUIDAgentEntityUUIDFiles(ctx, uUID, entityUUID)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// DeleteFederationUUIDAgentEntityUUIDFilesFileUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDAgentEntityUUIDFilesFileUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
-	router.POST(baseURL+"/company", wrapper.PostCompany)
-	router.DELETE(baseURL+"/company/:UUID", wrapper.DeleteCompanyUUID)
-	router.GET(baseURL+"/company/:UUID", wrapper.GetCompanyUUID)
-	router.GET(baseURL+"/company/:UUID/fields", wrapper.GetCompanyUUIDFields)
-	router.POST(baseURL+"/company/:UUID/fields", wrapper.PostCompanyUUIDFields)
-	router.DELETE(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.DeleteCompanyUUIDFieldsEntityUUID)
-	router.PUT(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.PutCompanyUUIDFieldsEntityUUID)
-	router.GET(baseURL+"/company/:UUID/group", wrapper.GetCompanyUUIDGroup)
-	router.POST(baseURL+"/company/:UUID/group", wrapper.PostCompanyUUIDGroup)
-	router.DELETE(baseURL+"/company/:UUID/group/:entityUUID", wrapper.DeleteCompanyUUIDGroupEntityUUID)
-	router.PATCH(baseURL+"/company/:UUID/group/:entityUUID", wrapper.PatchCompanyUUIDGroupEntityUUID)
-	router.PATCH(baseURL+"/company/:UUID/name", wrapper.PatchCompanyUUIDName)
-	router.GET(baseURL+"/company/:UUID/priorities", wrapper.GetCompanyUUIDPriorities)
-	router.POST(baseURL+"/company/:UUID/priorities", wrapper.PostCompanyUUIDPriorities)
-	router.DELETE(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.DeleteCompanyUUIDPrioritiesEntityUUID)
-	router.PATCH(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.PatchCompanyUUIDPrioritiesEntityUUID)
-	router.GET(baseURL+"/company/:UUID/project/catalog/:entityName", wrapper.GetCompanyUUIDProjectCatalogEntityName)
-	router.GET(baseURL+"/company/:UUID/sms", wrapper.GetCompanyUUIDSms)
-	router.POST(baseURL+"/company/:UUID/sms/cost", wrapper.PostCompanyUUIDSmsCost)
-	router.POST(baseURL+"/company/:UUID/sms/options", wrapper.PostCompanyUUIDSmsOptions)
-	router.POST(baseURL+"/company/:UUID/sms/send", wrapper.PostCompanyUUIDSmsSend)
-	router.POST(baseURL+"/company/:UUID/user", wrapper.PostCompanyUUIDUser)
-	router.DELETE(baseURL+"/company/:UUID/user/:userUUID", wrapper.DeleteCompanyUUIDUserUserUUID)
-	router.POST(baseURL+"/federation", wrapper.PostFederation)
-	router.DELETE(baseURL+"/federation/:UUID", wrapper.DeleteFederationUUID)
-	router.GET(baseURL+"/federation/:UUID", wrapper.GetFederationUUID)
-	router.GET(baseURL+"/federation/:UUID/agent", wrapper.GetFederationUUIDAgent)
-	router.POST(baseURL+"/federation/:UUID/agent", wrapper.PostFederationUUIDAgent)
-	router.DELETE(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.DeleteFederationUUIDAgentEntityUUID)
-	router.PATCH(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.PatchFederationUUIDAgentEntityUUID)
-	router.GET(baseURL+"/federation/:UUID/invite", wrapper.GetFederationUUIDInvite)
-	router.POST(baseURL+"/federation/:UUID/invite", wrapper.PostFederationUUIDInvite)
-	router.DELETE(baseURL+"/federation/:UUID/invite/:entityUUID", wrapper.DeleteFederationUUIDInviteEntityUUID)
-	router.PATCH(baseURL+"/federation/:UUID/name", wrapper.PatchFederationUUIDName)
-	router.GET(baseURL+"/federation/:UUID/project", wrapper.GetFederationUUIDProject)
-	router.POST(baseURL+"/federation/:UUID/user", wrapper.PostFederationUUIDUser)
-	router.DELETE(baseURL+"/federation/:UUID/user/:userUUID", wrapper.DeleteFederationUUIDUserUserUUID)
-	router.DELETE(baseURL+"/group/:UUID/user", wrapper.DeleteGroupUUIDUser)
-	router.GET(baseURL+"/group/:UUID/user", wrapper.GetGroupUUIDUser)
-	router.POST(baseURL+"/group/:UUID/user", wrapper.PostGroupUUIDUser)
-	router.DELETE(baseURL+"/legal_entities", wrapper.DeleteLegalEntities)
-	router.GET(baseURL+"/legal_entities", wrapper.GetLegalEntities)
-	router.PATCH(baseURL+"/legal_entities", wrapper.PatchLegalEntities)
-	router.POST(baseURL+"/legal_entities", wrapper.PostLegalEntities)
-	router.POST(baseURL+"/permissions", wrapper.PostPermissions)
-	router.DELETE(baseURL+"/permissions/:UUID", wrapper.DeletePermissionsUUID)
-	router.GET(baseURL+"/permissions/:UUID", wrapper.GetPermissionsUUID)
-	router.POST(baseURL+"/profile/survey", wrapper.PostProfileSurvey)
-	router.DELETE(baseURL+"/profile/survey/:UUID", wrapper.DeleteProfileSurveyUUID)
-	router.GET(baseURL+"/profile/survey/:UUID", wrapper.GetProfileSurveyUUID)
-	router.POST(baseURL+"/project", wrapper.PostProject)
-	router.DELETE(baseURL+"/project/:UUID", wrapper.DeleteProjectUUID)
-	router.GET(baseURL+"/project/:UUID", wrapper.GetProjectUUID)
-	router.PATCH(baseURL+"/project/:UUID", wrapper.PatchProjectUUID)
-	router.GET(baseURL+"/project/:UUID/catalog", wrapper.GetProjectUUIDCatalog)
-	router.POST(baseURL+"/project/:UUID/catalog", wrapper.PostProjectUUIDCatalog)
-	router.GET(baseURL+"/project/:UUID/catalog/:entityName", wrapper.GetProjectUUIDCatalogEntityName)
-	router.DELETE(baseURL+"/project/:UUID/catalog/:entityUUID", wrapper.DeleteProjectUUIDCatalogEntityUUID)
-	router.PATCH(baseURL+"/project/:UUID/description", wrapper.PatchProjectUUIDDescription)
-	router.DELETE(baseURL+"/project/:UUID/field/:entityUUID", wrapper.DeleteProjectUUIDFieldEntityUUID)
-	router.POST(baseURL+"/project/:UUID/field/:entityUUID", wrapper.PostProjectUUIDFieldEntityUUID)
-	router.PATCH(baseURL+"/project/:UUID/graph", wrapper.PatchProjectUUIDGraph)
-	router.PATCH(baseURL+"/project/:UUID/name", wrapper.PatchProjectUUIDName)
-	router.PATCH(baseURL+"/project/:UUID/options", wrapper.PatchProjectUUIDOptions)
-	router.GET(baseURL+"/project/:UUID/status", wrapper.GetProjectUUIDStatus)
-	router.POST(baseURL+"/project/:UUID/status", wrapper.PostProjectUUIDStatus)
-	router.DELETE(baseURL+"/project/:UUID/status/:entityUUID", wrapper.DeleteProjectUUIDStatusEntityUUID)
-	router.PATCH(baseURL+"/project/:UUID/status/:entityUUID", wrapper.PatchProjectUUIDStatusEntityUUID)
-	router.POST(baseURL+"/project/:UUID/user", wrapper.PostProjectUUIDUser)
-	router.DELETE(baseURL+"/project/:UUID/user/:userUUID", wrapper.DeleteProjectUUIDUserUserUUID)
-	router.GET(baseURL+"/tag", wrapper.GetTag)
-	router.POST(baseURL+"/tag", wrapper.PostTag)
-	router.DELETE(baseURL+"/tag/:UUID", wrapper.DeleteTagUUID)
-	router.PATCH(baseURL+"/tag/:UUID", wrapper.PatchTagUUID)
-	router.GET(baseURL+"/user", wrapper.GetUser)
+	// ------------- Path parameter "fileUUID" -------------
+	var fileUUID FileUUID
 
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "fileUUID", runtime.ParamLocationPath, ctx.Param("fileUUID"), &fileUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fileUUID: %s", err))
+	}
 
-type PostCompanyRequestObject struct {
-	Body *PostCompanyJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostCompanyResponseObject interface {
-	VisitPostCompanyResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteFederationUUIDAgentEntityUUIDFilesFileUUID(ctx, uUID, entityUUID, fileUUID)
+	return err
 }
 
-type PostCompany200JSONResponse UUIDResponse
-
-func (response PostCompany200JSONResponse) VisitPostCompanyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetFederationUUIDAgentEntityUUIDFilesFileUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentEntityUUIDFilesFileUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteCompanyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-type DeleteCompanyUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type DeleteCompanyUUID200Response struct {
-}
+	// ------------- Path parameter "fileUUID" -------------
+	var fileUUID FileUUID
 
-func (response DeleteCompanyUUID200Response) VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "fileUUID", runtime.ParamLocationPath, ctx.Param("fileUUID"), &fileUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fileUUID: %s", err))
+	}
 
-type GetCompanyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetCompanyUUIDResponseObject interface {
-	VisitGetCompanyUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDAgentEntityUUIDFilesFileUUID(ctx, uUID, entityUUID, fileUUID)
+	return err
 }
 
-type GetCompanyUUID200JSONResponse CompanyDTO
+// PutFederationUUIDAgentEntityUUIDLegalEntities converts echo context to params.
+func (w *ServerInterfaceWrapper) PutFederationUUIDAgentEntityUUIDLegalEntities(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response GetCompanyUUID200JSONResponse) VisitGetCompanyUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-type GetCompanyUUIDFieldsRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type GetCompanyUUIDFieldsResponseObject interface {
-	VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetCompanyUUIDFields200JSONResponse struct {
-	Count int               `json:"count"`
-	Items []CompanyFieldDTO `json:"items"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutFederationUUIDAgentEntityUUIDLegalEntities(ctx, uUID, entityUUID)
+	return err
 }
 
-func (response GetCompanyUUIDFields200JSONResponse) VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostFederationUUIDAgentEntityUUIDMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDAgentEntityUUIDMerge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PostCompanyUUIDFieldsRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDFieldsJSONRequestBody
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-type PostCompanyUUIDFieldsResponseObject interface {
-	VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error
-}
-
-type PostCompanyUUIDFields200JSONResponse struct {
-	Hash            string               `json:"hash"`
-	Icon            string               `json:"icon"`
-	Type            domain.FieldDataType `json:"type"`
-	TypeDescription string               `json:"type_description"`
-	TypeUuid        *openapi_types.UUID  `json:"type_uuid,omitempty"`
-	Uuid            string               `json:"uuid"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-func (response PostCompanyUUIDFields200JSONResponse) VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFederationUUIDAgentEntityUUIDMerge(ctx, uUID, entityUUID)
+	return err
 }
 
-type DeleteCompanyUUIDFieldsEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+// PutFederationUUIDAgentEntityUUIDTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) PutFederationUUIDAgentEntityUUIDTasks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteCompanyUUIDFieldsEntityUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteCompanyUUIDFieldsEntityUUID200Response struct {
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-func (response DeleteCompanyUUIDFieldsEntityUUID200Response) VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type PutCompanyUUIDFieldsEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PutCompanyUUIDFieldsEntityUUIDResponseObject interface {
-	VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutFederationUUIDAgentEntityUUIDTasks(ctx, uUID, entityUUID)
+	return err
 }
 
-type PutCompanyUUIDFieldsEntityUUID200Response struct {
-}
+// GetFederationUUIDAgentEntityUUIDTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDAgentEntityUUIDTimeline(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response PutCompanyUUIDFieldsEntityUUID200Response) VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetCompanyUUIDGroupRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-type GetCompanyUUIDGroupResponseObject interface {
-	VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type GetCompanyUUIDGroup200JSONResponse struct {
-	Count int        `json:"count"`
-	Items []GroupDTO `json:"items"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response GetCompanyUUIDGroup200JSONResponse) VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDAgentEntityUUIDTimelineParams
+	// ------------- Optional query parameter "offset" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-type PostCompanyUUIDGroupRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDGroupJSONRequestBody
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PostCompanyUUIDGroupResponseObject interface {
-	VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-type PostCompanyUUIDGroup200JSONResponse UUIDResponse
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDAgentEntityUUIDTimeline(ctx, uUID, entityUUID, params)
+	return err
+}
 
-func (response PostCompanyUUIDGroup200JSONResponse) VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetFederationUUIDEmailBranding converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDEmailBranding(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteCompanyUUIDGroupEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteCompanyUUIDGroupEntityUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDEmailBranding(ctx, uUID)
+	return err
 }
 
-type DeleteCompanyUUIDGroupEntityUUID200Response struct {
-}
+// PutFederationUUIDEmailBranding converts echo context to params.
+func (w *ServerInterfaceWrapper) PutFederationUUIDEmailBranding(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response DeleteCompanyUUIDGroupEntityUUID200Response) VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PatchCompanyUUIDGroupEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PatchCompanyUUIDGroupEntityUUIDResponseObject interface {
-	VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutFederationUUIDEmailBranding(ctx, uUID)
+	return err
 }
 
-type PatchCompanyUUIDGroupEntityUUID200Response struct {
-}
+// GetFederationUUIDEmailTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDEmailTemplates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response PatchCompanyUUIDGroupEntityUUID200Response) VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PatchCompanyUUIDNameRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchCompanyUUIDNameJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PatchCompanyUUIDNameResponseObject interface {
-	VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDEmailTemplates(ctx, uUID)
+	return err
 }
 
-type PatchCompanyUUIDName200Response struct {
-}
+// DeleteFederationUUIDEmailTemplatesEntityName converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDEmailTemplatesEntityName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response PatchCompanyUUIDName200Response) VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetCompanyUUIDPrioritiesRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	// ------------- Path parameter "entityName" -------------
+	var entityName EntityName
 
-type GetCompanyUUIDPrioritiesResponseObject interface {
-	VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+	}
 
-type GetCompanyUUIDPriorities200JSONResponse struct {
-	Count int                  `json:"count"`
-	Items []CompanyPriorityDTO `json:"items"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response GetCompanyUUIDPriorities200JSONResponse) VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteFederationUUIDEmailTemplatesEntityNameParams
+	// ------------- Required query parameter "locale" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "locale", ctx.QueryParams(), &params.Locale)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter locale: %s", err))
+	}
 
-type PostCompanyUUIDPrioritiesRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDPrioritiesJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteFederationUUIDEmailTemplatesEntityName(ctx, uUID, entityName, params)
+	return err
 }
 
-type PostCompanyUUIDPrioritiesResponseObject interface {
-	VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
-}
+// PutFederationUUIDEmailTemplatesEntityName converts echo context to params.
+func (w *ServerInterfaceWrapper) PutFederationUUIDEmailTemplatesEntityName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostCompanyUUIDPriorities200JSONResponse UUIDResponse
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response PostCompanyUUIDPriorities200JSONResponse) VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "entityName" -------------
+	var entityName EntityName
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+	}
 
-type DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutFederationUUIDEmailTemplatesEntityName(ctx, uUID, entityName)
+	return err
 }
 
-type DeleteCompanyUUIDPrioritiesEntityUUID200Response struct {
-}
-
-func (response DeleteCompanyUUIDPrioritiesEntityUUID200Response) VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type PatchCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
-}
+// PostFederationUUIDEmailTemplatesEntityNamePreview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDEmailTemplatesEntityNamePreview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PatchCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
-	VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PatchCompanyUUIDPrioritiesEntityUUID200Response struct {
-}
+	// ------------- Path parameter "entityName" -------------
+	var entityName EntityName
 
-func (response PatchCompanyUUIDPrioritiesEntityUUID200Response) VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+	}
 
-type GetCompanyUUIDProjectCatalogEntityNameRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityName EntityName `json:"entityName"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetCompanyUUIDProjectCatalogEntityNameResponseObject interface {
-	VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFederationUUIDEmailTemplatesEntityNamePreview(ctx, uUID, entityName)
+	return err
 }
 
-type GetCompanyUUIDProjectCatalogEntityName200JSONResponse struct {
-	Count int                     `json:"count"`
-	Items []ProjectCatalogDataDTO `json:"items"`
-}
+// GetFederationUUIDInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response GetCompanyUUIDProjectCatalogEntityName200JSONResponse) VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetCompanyUUIDSmsRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetCompanyUUIDSmsParams
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDInvite(ctx, uUID)
+	return err
 }
 
-type GetCompanyUUIDSmsResponseObject interface {
-	VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error
-}
+// PostFederationUUIDInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDInvite(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetCompanyUUIDSms200JSONResponse struct {
-	Count int      `json:"count"`
-	Items []SmsDTO `json:"items"`
-	Total int64    `json:"total"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response GetCompanyUUIDSms200JSONResponse) VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFederationUUIDInvite(ctx, uUID)
+	return err
 }
 
-type PostCompanyUUIDSmsCostRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDSmsCostJSONRequestBody
-}
+// DeleteFederationUUIDInviteEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostCompanyUUIDSmsCostResponseObject interface {
-	VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PostCompanyUUIDSmsCost200JSONResponse map[string]interface{}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-func (response PostCompanyUUIDSmsCost200JSONResponse) VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostCompanyUUIDSmsOptionsRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDSmsOptionsJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteFederationUUIDInviteEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-type PostCompanyUUIDSmsOptionsResponseObject interface {
-	VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error
-}
+// PatchFederationUUIDName converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFederationUUIDName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostCompanyUUIDSmsOptions200Response struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response PostCompanyUUIDSmsOptions200Response) VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostCompanyUUIDSmsSendRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params PostCompanyUUIDSmsSendParams
-	Body   *PostCompanyUUIDSmsSendJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchFederationUUIDName(ctx, uUID)
+	return err
 }
 
-type PostCompanyUUIDSmsSendResponseObject interface {
-	VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error
-}
+// GetFederationUUIDProject converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostCompanyUUIDSmsSend200JSONResponse map[string]interface{}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response PostCompanyUUIDSmsSend200JSONResponse) VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDProjectParams
+	// ------------- Optional query parameter "limit" -------------
 
-type PostCompanyUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDUserJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-type PostCompanyUUIDUserResponseObject interface {
-	VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type PostCompanyUUIDUser200JSONResponse UUIDResponse
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-func (response PostCompanyUUIDUser200JSONResponse) VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "company_uuid" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
 
-type DeleteCompanyUUIDUserUserUUIDRequestObject struct {
-	UUID     Uuid     `json:"UUID"`
-	UserUUID UserUUID `json:"userUUID"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDProject(ctx, uUID, params)
+	return err
 }
 
-type DeleteCompanyUUIDUserUserUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error
-}
+// PostFederationUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteCompanyUUIDUserUserUUID200Response struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response DeleteCompanyUUIDUserUserUUID200Response) VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostFederationRequestObject struct {
-	Body *PostFederationJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFederationUUIDUser(ctx, uUID)
+	return err
 }
 
-type PostFederationResponseObject interface {
-	VisitPostFederationResponse(w http.ResponseWriter) error
-}
+// DeleteFederationUUIDUserUserUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDUserUserUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostFederation200JSONResponse UUIDResponse
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response PostFederation200JSONResponse) VisitPostFederationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID UserUUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+	}
 
-type DeleteFederationUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteFederationUUIDResponseObject interface {
-	VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteFederationUUIDUserUserUUID(ctx, uUID, userUUID)
+	return err
 }
 
-type DeleteFederationUUID200Response struct {
-}
+// DeleteGroupUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response DeleteFederationUUID200Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteFederationUUID401Response struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response DeleteFederationUUID401Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteGroupUUIDUser(ctx, uUID)
+	return err
 }
 
-type GetFederationUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// GetGroupUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupUUIDUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetFederationUUIDResponseObject interface {
-	VisitGetFederationUUIDResponse(w http.ResponseWriter) error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroupUUIDUser(ctx, uUID)
+	return err
 }
 
-type GetFederationUUID200JSONResponse FederationDTO
+// PostGroupUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGroupUUIDUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response GetFederationUUID200JSONResponse) VisitGetFederationUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetFederationUUIDAgentRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetFederationUUIDAgentParams
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGroupUUIDUser(ctx, uUID)
+	return err
 }
 
-type GetFederationUUIDAgentResponseObject interface {
-	VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error
-}
+// DeleteLegalEntities converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteLegalEntities(ctx echo.Context) error {
+	var err error
 
-type GetFederationUUIDAgent200JSONResponse struct {
-	Count int        `json:"count"`
-	Items []AgentDTO `json:"items"`
-	Total int64      `json:"total"`
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteLegalEntities(ctx)
+	return err
 }
 
-func (response GetFederationUUIDAgent200JSONResponse) VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetLegalEntities converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLegalEntities(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostFederationUUIDAgentRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDAgentJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLegalEntities(ctx)
+	return err
 }
 
-type PostFederationUUIDAgentResponseObject interface {
-	VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error
+// PatchLegalEntities converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchLegalEntities(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchLegalEntities(ctx)
+	return err
 }
 
-type PostFederationUUIDAgent200JSONResponse UUIDResponse
+// PostLegalEntities converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLegalEntities(ctx echo.Context) error {
+	var err error
 
-func (response PostFederationUUIDAgent200JSONResponse) VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLegalEntities(ctx)
+	return err
 }
 
-type DeleteFederationUUIDAgentEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+// PostPermissions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPermissions(ctx echo.Context) error {
+	var err error
 
-type DeleteFederationUUIDAgentEntityUUIDResponseObject interface {
-	VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteFederationUUIDAgentEntityUUID200Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPermissions(ctx)
+	return err
 }
 
-func (response DeleteFederationUUIDAgentEntityUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// DeletePermissionsUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePermissionsUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PatchFederationUUIDAgentEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchFederationUUIDAgentEntityUUIDJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PatchFederationUUIDAgentEntityUUIDResponseObject interface {
-	VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PatchFederationUUIDAgentEntityUUID200Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePermissionsUUID(ctx, uUID)
+	return err
 }
 
-func (response PatchFederationUUIDAgentEntityUUID200Response) VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// GetPermissionsUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPermissionsUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetFederationUUIDInviteRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetFederationUUIDInviteResponseObject interface {
-	VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetFederationUUIDInvite200JSONResponse struct {
-	Count int         `json:"count"`
-	Items []InviteDTO `json:"items"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPermissionsUUID(ctx, uUID)
+	return err
 }
 
-func (response GetFederationUUIDInvite200JSONResponse) VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostProfileSurvey converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileSurvey(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostFederationUUIDInviteRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDInviteJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileSurvey(ctx)
+	return err
 }
 
-type PostFederationUUIDInviteResponseObject interface {
-	VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error
-}
+// DeleteProfileSurveyUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileSurveyUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostFederationUUIDInvite200JSONResponse UUIDResponse
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response PostFederationUUIDInvite200JSONResponse) VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfileSurveyUUID(ctx, uUID)
+	return err
 }
 
-type DeleteFederationUUIDInviteEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+// GetProfileSurveyUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileSurveyUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteFederationUUIDInviteEntityUUIDResponseObject interface {
-	VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteFederationUUIDInviteEntityUUID200Response struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response DeleteFederationUUIDInviteEntityUUID200Response) VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileSurveyUUID(ctx, uUID)
+	return err
 }
 
-type PatchFederationUUIDNameRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchFederationUUIDNameJSONRequestBody
-}
+// PostProject converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProject(ctx echo.Context) error {
+	var err error
 
-type PatchFederationUUIDNameResponseObject interface {
-	VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PatchFederationUUIDName200Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProject(ctx)
+	return err
 }
 
-func (response PatchFederationUUIDName200Response) VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// DeleteProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetFederationUUIDProjectRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetFederationUUIDProjectParams
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetFederationUUIDProjectResponseObject interface {
-	VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetFederationUUIDProject200JSONResponse struct {
-	Count int          `json:"count"`
-	Items []ProjectDTO `json:"items"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUID(ctx, uUID)
+	return err
 }
 
-func (response GetFederationUUIDProject200JSONResponse) VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PostFederationUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDUserJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostFederationUUIDUserResponseObject interface {
-	VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUID(ctx, uUID)
+	return err
 }
 
-type PostFederationUUIDUser200JSONResponse UUIDResponse
-
-func (response PostFederationUUIDUser200JSONResponse) VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PatchProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteFederationUUIDUserUserUUIDRequestObject struct {
-	UUID     Uuid     `json:"UUID"`
-	UserUUID UserUUID `json:"userUUID"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteFederationUUIDUserUserUUIDResponseObject interface {
-	VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteFederationUUIDUserUserUUID200Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUID(ctx, uUID)
+	return err
 }
 
-func (response DeleteFederationUUIDUserUserUUID200Response) VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// GetProjectUUIDCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteGroupUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *DeleteGroupUUIDUserJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteGroupUUIDUserResponseObject interface {
-	VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteGroupUUIDUser200Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDCatalog(ctx, uUID)
+	return err
 }
 
-func (response DeleteGroupUUIDUser200Response) VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// PostProjectUUIDCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetGroupUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetGroupUUIDUserResponseObject interface {
-	VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetGroupUUIDUser200JSONResponse struct {
-	Count int       `json:"count"`
-	Items []UserDTO `json:"items"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDCatalog(ctx, uUID)
+	return err
 }
 
-func (response GetGroupUUIDUser200JSONResponse) VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetProjectUUIDCatalogEntityName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostGroupUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostGroupUUIDUserJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PostGroupUUIDUserResponseObject interface {
-	VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "entityName" -------------
+	var entityName EntityName
 
-type PostGroupUUIDUser200Response struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+	}
 
-func (response PostGroupUUIDUser200Response) VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteLegalEntitiesRequestObject struct {
-	Body *DeleteLegalEntitiesJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDCatalogEntityName(ctx, uUID, entityName)
+	return err
 }
 
-type DeleteLegalEntitiesResponseObject interface {
-	VisitDeleteLegalEntitiesResponse(w http.ResponseWriter) error
-}
+// DeleteProjectUUIDCatalogEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteLegalEntities200JSONResponse Ans
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response DeleteLegalEntities200JSONResponse) VisitDeleteLegalEntitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type GetLegalEntitiesRequestObject struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetLegalEntitiesResponseObject interface {
-	VisitGetLegalEntitiesResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDCatalogEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-type GetLegalEntities200JSONResponse []BankAccountDTO
+// PatchProjectUUIDDescription converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response GetLegalEntities200JSONResponse) VisitGetLegalEntitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PatchLegalEntitiesRequestObject struct {
-	Body *PatchLegalEntitiesJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDDescription(ctx, uUID)
+	return err
 }
 
-type PatchLegalEntitiesResponseObject interface {
-	VisitPatchLegalEntitiesResponse(w http.ResponseWriter) error
-}
+// GetProjectUUIDEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDEmail(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PatchLegalEntities200JSONResponse Ans
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response PatchLegalEntities200JSONResponse) VisitPatchLegalEntitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDEmail(ctx, uUID)
+	return err
 }
 
-type PostLegalEntitiesRequestObject struct {
-	Body *PostLegalEntitiesJSONRequestBody
-}
-
-type PostLegalEntitiesResponseObject interface {
-	VisitPostLegalEntitiesResponse(w http.ResponseWriter) error
-}
+// DeleteProjectUUIDFieldEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostLegalEntities201JSONResponse Ans
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response PostLegalEntities201JSONResponse) VisitPostLegalEntitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type PostPermissionsRequestObject struct {
-	Body *PostPermissionsJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostPermissionsResponseObject interface {
-	VisitPostPermissionsResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-type PostPermissions200JSONResponse struct {
-	UpdatedAt time.Time          `json:"updated_at"`
-	UserUuid  openapi_types.UUID `json:"user_uuid"`
-}
+// PostProjectUUIDFieldEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response PostPermissions200JSONResponse) VisitPostPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-type DeletePermissionsUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type DeletePermissionsUUIDResponseObject interface {
-	VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeletePermissionsUUID200Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-func (response DeletePermissionsUUID200Response) VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// PatchProjectUUIDGraph converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetPermissionsUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetPermissionsUUIDResponseObject interface {
-	VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetPermissionsUUID200JSONResponse struct {
-	CreatedAt      time.Time          `json:"created_at"`
-	FederationUuid openapi_types.UUID `json:"federation_uuid"`
-	Rules          PermissionRulesDTO `json:"rules"`
-	UpdatedAt      time.Time          `json:"updated_at"`
-	UserUuid       openapi_types.UUID `json:"user_uuid"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDGraph(ctx, uUID)
+	return err
 }
 
-func (response GetPermissionsUUID200JSONResponse) VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PatchProjectUUIDName converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PostProfileSurveyRequestObject struct {
-	Body *PostProfileSurveyJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostProfileSurveyResponseObject interface {
-	VisitPostProfileSurveyResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDName(ctx, uUID)
+	return err
 }
 
-type PostProfileSurvey200JSONResponse UUIDResponse
-
-func (response PostProfileSurvey200JSONResponse) VisitPostProfileSurveyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PatchProjectUUIDOptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteProfileSurveyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteProfileSurveyUUIDResponseObject interface {
-	VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDOptions(ctx, uUID)
+	return err
 }
 
-type DeleteProfileSurveyUUID200Response struct {
-}
+// GetProjectUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response DeleteProfileSurveyUUID200Response) VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetProfileSurveyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetProfileSurveyUUIDResponseObject interface {
-	VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDStatus(ctx, uUID)
+	return err
 }
 
-type GetProfileSurveyUUID200JSONResponse SurveyDTO
+// PostProjectUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response GetProfileSurveyUUID200JSONResponse) VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostProjectRequestObject struct {
-	Body *PostProjectJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDStatus(ctx, uUID)
+	return err
 }
 
-type PostProjectResponseObject interface {
-	VisitPostProjectResponse(w http.ResponseWriter) error
-}
+// DeleteProjectUUIDStatusEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostProject200JSONResponse UUIDResponse
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response PostProject200JSONResponse) VisitPostProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type DeleteProjectUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteProjectUUIDResponseObject interface {
-	VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-type DeleteProjectUUID200Response struct {
-}
+// PatchProjectUUIDStatusEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response DeleteProjectUUID200Response) VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetProjectUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-type GetProjectUUIDResponseObject interface {
-	VisitGetProjectUUIDResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type GetProjectUUID200JSONResponse ProjectDTO
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response GetProjectUUID200JSONResponse) VisitGetProjectUUIDResponse(w http.ResponseWriter) error {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostProjectUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDUser(ctx, uUID)
+	return err
+}
+
+// DeleteProjectUUIDUserUserUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID UserUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDUserUserUUID(ctx, uUID, userUUID)
+	return err
+}
+
+// GetTag converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagParams
+	// ------------- Required query parameter "company_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTag(ctx, params)
+	return err
+}
+
+// PostTag converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTag(ctx)
+	return err
+}
+
+// DeleteTagUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTagUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTagUUID(ctx, uUID)
+	return err
+}
+
+// PatchTagUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTagUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTagUUID(ctx, uUID)
+	return err
+}
+
+// GetUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUser(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/company", wrapper.PostCompany)
+	router.DELETE(baseURL+"/company/:UUID", wrapper.DeleteCompanyUUID)
+	router.GET(baseURL+"/company/:UUID", wrapper.GetCompanyUUID)
+	router.GET(baseURL+"/company/:UUID/deals", wrapper.GetCompanyUUIDDeals)
+	router.POST(baseURL+"/company/:UUID/deals", wrapper.PostCompanyUUIDDeals)
+	router.GET(baseURL+"/company/:UUID/deals/forecast", wrapper.GetCompanyUUIDDealsForecast)
+	router.GET(baseURL+"/company/:UUID/deals/pipelines", wrapper.GetCompanyUUIDDealsPipelines)
+	router.POST(baseURL+"/company/:UUID/deals/pipelines", wrapper.PostCompanyUUIDDealsPipelines)
+	router.DELETE(baseURL+"/company/:UUID/deals/pipelines/:entityUUID", wrapper.DeleteCompanyUUIDDealsPipelinesEntityUUID)
+	router.PUT(baseURL+"/company/:UUID/deals/pipelines/:entityUUID", wrapper.PutCompanyUUIDDealsPipelinesEntityUUID)
+	router.DELETE(baseURL+"/company/:UUID/deals/:entityUUID", wrapper.DeleteCompanyUUIDDealsEntityUUID)
+	router.GET(baseURL+"/company/:UUID/deals/:entityUUID", wrapper.GetCompanyUUIDDealsEntityUUID)
+	router.PUT(baseURL+"/company/:UUID/deals/:entityUUID", wrapper.PutCompanyUUIDDealsEntityUUID)
+	router.GET(baseURL+"/company/:UUID/deals/:entityUUID/activity", wrapper.GetCompanyUUIDDealsEntityUUIDActivity)
+	router.PUT(baseURL+"/company/:UUID/deals/:entityUUID/stage", wrapper.PutCompanyUUIDDealsEntityUUIDStage)
+	router.GET(baseURL+"/company/:UUID/fields", wrapper.GetCompanyUUIDFields)
+	router.POST(baseURL+"/company/:UUID/fields", wrapper.PostCompanyUUIDFields)
+	router.DELETE(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.DeleteCompanyUUIDFieldsEntityUUID)
+	router.PUT(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.PutCompanyUUIDFieldsEntityUUID)
+	router.GET(baseURL+"/company/:UUID/group", wrapper.GetCompanyUUIDGroup)
+	router.POST(baseURL+"/company/:UUID/group", wrapper.PostCompanyUUIDGroup)
+	router.DELETE(baseURL+"/company/:UUID/group/:entityUUID", wrapper.DeleteCompanyUUIDGroupEntityUUID)
+	router.PATCH(baseURL+"/company/:UUID/group/:entityUUID", wrapper.PatchCompanyUUIDGroupEntityUUID)
+	router.PATCH(baseURL+"/company/:UUID/name", wrapper.PatchCompanyUUIDName)
+	router.GET(baseURL+"/company/:UUID/priorities", wrapper.GetCompanyUUIDPriorities)
+	router.POST(baseURL+"/company/:UUID/priorities", wrapper.PostCompanyUUIDPriorities)
+	router.DELETE(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.DeleteCompanyUUIDPrioritiesEntityUUID)
+	router.PATCH(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.PatchCompanyUUIDPrioritiesEntityUUID)
+	router.GET(baseURL+"/company/:UUID/project/catalog/:entityName", wrapper.GetCompanyUUIDProjectCatalogEntityName)
+	router.GET(baseURL+"/company/:UUID/sms", wrapper.GetCompanyUUIDSms)
+	router.GET(baseURL+"/company/:UUID/sms/campaigns", wrapper.GetCompanyUUIDSmsCampaigns)
+	router.POST(baseURL+"/company/:UUID/sms/campaigns", wrapper.PostCompanyUUIDSmsCampaigns)
+	router.GET(baseURL+"/company/:UUID/sms/campaigns/:entityUUID", wrapper.GetCompanyUUIDSmsCampaignsEntityUUID)
+	router.POST(baseURL+"/company/:UUID/sms/campaigns/:entityUUID/cancel", wrapper.PostCompanyUUIDSmsCampaignsEntityUUIDCancel)
+	router.GET(baseURL+"/company/:UUID/sms/campaigns/:entityUUID/cost", wrapper.GetCompanyUUIDSmsCampaignsEntityUUIDCost)
+	router.POST(baseURL+"/company/:UUID/sms/campaigns/:entityUUID/csv", wrapper.PostCompanyUUIDSmsCampaignsEntityUUIDCsv)
+	router.GET(baseURL+"/company/:UUID/sms/campaigns/:entityUUID/recipients", wrapper.GetCompanyUUIDSmsCampaignsEntityUUIDRecipients)
+	router.POST(baseURL+"/company/:UUID/sms/campaigns/:entityUUID/start", wrapper.PostCompanyUUIDSmsCampaignsEntityUUIDStart)
+	router.POST(baseURL+"/company/:UUID/sms/cost", wrapper.PostCompanyUUIDSmsCost)
+	router.POST(baseURL+"/company/:UUID/sms/options", wrapper.PostCompanyUUIDSmsOptions)
+	router.POST(baseURL+"/company/:UUID/sms/send", wrapper.PostCompanyUUIDSmsSend)
+	router.GET(baseURL+"/company/:UUID/sms/templates", wrapper.GetCompanyUUIDSmsTemplates)
+	router.POST(baseURL+"/company/:UUID/sms/templates", wrapper.PostCompanyUUIDSmsTemplates)
+	router.DELETE(baseURL+"/company/:UUID/sms/templates/:entityUUID", wrapper.DeleteCompanyUUIDSmsTemplatesEntityUUID)
+	router.PUT(baseURL+"/company/:UUID/sms/templates/:entityUUID", wrapper.PutCompanyUUIDSmsTemplatesEntityUUID)
+	router.POST(baseURL+"/company/:UUID/user", wrapper.PostCompanyUUIDUser)
+	router.DELETE(baseURL+"/company/:UUID/user/:userUUID", wrapper.DeleteCompanyUUIDUserUserUUID)
+	router.POST(baseURL+"/federation", wrapper.PostFederation)
+	router.DELETE(baseURL+"/federation/:UUID", wrapper.DeleteFederationUUID)
+	router.GET(baseURL+"/federation/:UUID", wrapper.GetFederationUUID)
+	router.GET(baseURL+"/federation/:UUID/agent", wrapper.GetFederationUUIDAgent)
+	router.POST(baseURL+"/federation/:UUID/agent", wrapper.PostFederationUUIDAgent)
+	router.GET(baseURL+"/federation/:UUID/agent/duplicates", wrapper.GetFederationUUIDAgentDuplicates)
+	router.DELETE(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.DeleteFederationUUIDAgentEntityUUID)
+	router.GET(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.GetFederationUUIDAgentEntityUUID)
+	router.PATCH(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.PatchFederationUUIDAgentEntityUUID)
+	router.GET(baseURL+"/federation/:UUID/agent/:entityUUID/activity", wrapper.GetFederationUUIDAgentEntityUUIDActivity)
+	router.GET(baseURL+"/federation/:UUID/agent/:entityUUID/comments", wrapper.GetFederationUUIDAgentEntityUUIDComments)
+	router.POST(baseURL+"/federation/:UUID/agent/:entityUUID/comments", wrapper.PostFederationUUIDAgentEntityUUIDComments)
+	router.DELETE(baseURL+"/federation/:UUID/agent/:entityUUID/comments/:commentUUID", wrapper.DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID)
+	router.GET(baseURL+"/federation/:UUID/agent/:entityUUID/duplicates", wrapper.GetFederationUUIDAgentEntityUUIDDuplicates)
+	router.GET(baseURL+"/federation/:UUID/agent/:entityUUID/files", wrapper.GetFederationUUIDAgentEntityUUIDFiles)
+	router.POST(baseURL+"/federation/:UUID/agent/:entityUUID/files", wrapper.PostFederationUUIDAgentEntityUUIDFiles)
+	router.DELETE(baseURL+"/federation/:UUID/agent/:entityUUID/files/:fileUUID", wrapper.DeleteFederationUUIDAgentEntityUUIDFilesFileUUID)
+	router.GET(baseURL+"/federation/:UUID/agent/:entityUUID/files/:fileUUID", wrapper.GetFederationUUIDAgentEntityUUIDFilesFileUUID)
+	router.PUT(baseURL+"/federation/:UUID/agent/:entityUUID/legal_entities", wrapper.PutFederationUUIDAgentEntityUUIDLegalEntities)
+	router.POST(baseURL+"/federation/:UUID/agent/:entityUUID/merge", wrapper.PostFederationUUIDAgentEntityUUIDMerge)
+	router.PUT(baseURL+"/federation/:UUID/agent/:entityUUID/tasks", wrapper.PutFederationUUIDAgentEntityUUIDTasks)
+	router.GET(baseURL+"/federation/:UUID/agent/:entityUUID/timeline", wrapper.GetFederationUUIDAgentEntityUUIDTimeline)
+	router.GET(baseURL+"/federation/:UUID/email/branding", wrapper.GetFederationUUIDEmailBranding)
+	router.PUT(baseURL+"/federation/:UUID/email/branding", wrapper.PutFederationUUIDEmailBranding)
+	router.GET(baseURL+"/federation/:UUID/email/templates", wrapper.GetFederationUUIDEmailTemplates)
+	router.DELETE(baseURL+"/federation/:UUID/email/templates/:entityName", wrapper.DeleteFederationUUIDEmailTemplatesEntityName)
+	router.PUT(baseURL+"/federation/:UUID/email/templates/:entityName", wrapper.PutFederationUUIDEmailTemplatesEntityName)
+	router.POST(baseURL+"/federation/:UUID/email/templates/:entityName/preview", wrapper.PostFederationUUIDEmailTemplatesEntityNamePreview)
+	router.GET(baseURL+"/federation/:UUID/invite", wrapper.GetFederationUUIDInvite)
+	router.POST(baseURL+"/federation/:UUID/invite", wrapper.PostFederationUUIDInvite)
+	router.DELETE(baseURL+"/federation/:UUID/invite/:entityUUID", wrapper.DeleteFederationUUIDInviteEntityUUID)
+	router.PATCH(baseURL+"/federation/:UUID/name", wrapper.PatchFederationUUIDName)
+	router.GET(baseURL+"/federation/:UUID/project", wrapper.GetFederationUUIDProject)
+	router.POST(baseURL+"/federation/:UUID/user", wrapper.PostFederationUUIDUser)
+	router.DELETE(baseURL+"/federation/:UUID/user/:userUUID", wrapper.DeleteFederationUUIDUserUserUUID)
+	router.DELETE(baseURL+"/group/:UUID/user", wrapper.DeleteGroupUUIDUser)
+	router.GET(baseURL+"/group/:UUID/user", wrapper.GetGroupUUIDUser)
+	router.POST(baseURL+"/group/:UUID/user", wrapper.PostGroupUUIDUser)
+	router.DELETE(baseURL+"/legal_entities", wrapper.DeleteLegalEntities)
+	router.GET(baseURL+"/legal_entities", wrapper.GetLegalEntities)
+	router.PATCH(baseURL+"/legal_entities", wrapper.PatchLegalEntities)
+	router.POST(baseURL+"/legal_entities", wrapper.PostLegalEntities)
+	router.POST(baseURL+"/permissions", wrapper.PostPermissions)
+	router.DELETE(baseURL+"/permissions/:UUID", wrapper.DeletePermissionsUUID)
+	router.GET(baseURL+"/permissions/:UUID", wrapper.GetPermissionsUUID)
+	router.POST(baseURL+"/profile/survey", wrapper.PostProfileSurvey)
+	router.DELETE(baseURL+"/profile/survey/:UUID", wrapper.DeleteProfileSurveyUUID)
+	router.GET(baseURL+"/profile/survey/:UUID", wrapper.GetProfileSurveyUUID)
+	router.POST(baseURL+"/project", wrapper.PostProject)
+	router.DELETE(baseURL+"/project/:UUID", wrapper.DeleteProjectUUID)
+	router.GET(baseURL+"/project/:UUID", wrapper.GetProjectUUID)
+	router.PATCH(baseURL+"/project/:UUID", wrapper.PatchProjectUUID)
+	router.GET(baseURL+"/project/:UUID/catalog", wrapper.GetProjectUUIDCatalog)
+	router.POST(baseURL+"/project/:UUID/catalog", wrapper.PostProjectUUIDCatalog)
+	router.GET(baseURL+"/project/:UUID/catalog/:entityName", wrapper.GetProjectUUIDCatalogEntityName)
+	router.DELETE(baseURL+"/project/:UUID/catalog/:entityUUID", wrapper.DeleteProjectUUIDCatalogEntityUUID)
+	router.PATCH(baseURL+"/project/:UUID/description", wrapper.PatchProjectUUIDDescription)
+	router.GET(baseURL+"/project/:UUID/email", wrapper.GetProjectUUIDEmail)
+	router.DELETE(baseURL+"/project/:UUID/field/:entityUUID", wrapper.DeleteProjectUUIDFieldEntityUUID)
+	router.POST(baseURL+"/project/:UUID/field/:entityUUID", wrapper.PostProjectUUIDFieldEntityUUID)
+	router.PATCH(baseURL+"/project/:UUID/graph", wrapper.PatchProjectUUIDGraph)
+	router.PATCH(baseURL+"/project/:UUID/name", wrapper.PatchProjectUUIDName)
+	router.PATCH(baseURL+"/project/:UUID/options", wrapper.PatchProjectUUIDOptions)
+	router.GET(baseURL+"/project/:UUID/status", wrapper.GetProjectUUIDStatus)
+	router.POST(baseURL+"/project/:UUID/status", wrapper.PostProjectUUIDStatus)
+	router.DELETE(baseURL+"/project/:UUID/status/:entityUUID", wrapper.DeleteProjectUUIDStatusEntityUUID)
+	router.PATCH(baseURL+"/project/:UUID/status/:entityUUID", wrapper.PatchProjectUUIDStatusEntityUUID)
+	router.POST(baseURL+"/project/:UUID/user", wrapper.PostProjectUUIDUser)
+	router.DELETE(baseURL+"/project/:UUID/user/:userUUID", wrapper.DeleteProjectUUIDUserUserUUID)
+	router.GET(baseURL+"/tag", wrapper.GetTag)
+	router.POST(baseURL+"/tag", wrapper.PostTag)
+	router.DELETE(baseURL+"/tag/:UUID", wrapper.DeleteTagUUID)
+	router.PATCH(baseURL+"/tag/:UUID", wrapper.PatchTagUUID)
+	router.GET(baseURL+"/user", wrapper.GetUser)
+
+}
+
+type PostCompanyRequestObject struct {
+	Body *PostCompanyJSONRequestBody
+}
+
+type PostCompanyResponseObject interface {
+	VisitPostCompanyResponse(w http.ResponseWriter) error
+}
+
+type PostCompany200JSONResponse UUIDResponse
+
+func (response PostCompany200JSONResponse) VisitPostCompanyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteCompanyUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUID200Response struct {
+}
+
+func (response DeleteCompanyUUID200Response) VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDResponseObject interface {
+	VisitGetCompanyUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUID200JSONResponse CompanyDTO
+
+func (response GetCompanyUUID200JSONResponse) VisitGetCompanyUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDDealsRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetCompanyUUIDDealsParams
+}
+
+type GetCompanyUUIDDealsResponseObject interface {
+	VisitGetCompanyUUIDDealsResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDDeals200JSONResponse struct {
+	Count int       `json:"count"`
+	Items []DealDTO `json:"items"`
+	Total int64     `json:"total"`
+}
+
+func (response GetCompanyUUIDDeals200JSONResponse) VisitGetCompanyUUIDDealsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDDealsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDDealsJSONRequestBody
+}
+
+type PostCompanyUUIDDealsResponseObject interface {
+	VisitPostCompanyUUIDDealsResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDDeals200JSONResponse DealDTO
+
+func (response PostCompanyUUIDDeals200JSONResponse) VisitPostCompanyUUIDDealsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDDealsForecastRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetCompanyUUIDDealsForecastParams
+}
+
+type GetCompanyUUIDDealsForecastResponseObject interface {
+	VisitGetCompanyUUIDDealsForecastResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDDealsForecast200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []DealForecastDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDDealsForecast200JSONResponse) VisitGetCompanyUUIDDealsForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDDealsPipelinesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDDealsPipelinesResponseObject interface {
+	VisitGetCompanyUUIDDealsPipelinesResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDDealsPipelines200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []DealPipelineDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDDealsPipelines200JSONResponse) VisitGetCompanyUUIDDealsPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDDealsPipelinesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDDealsPipelinesJSONRequestBody
+}
+
+type PostCompanyUUIDDealsPipelinesResponseObject interface {
+	VisitPostCompanyUUIDDealsPipelinesResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDDealsPipelines200JSONResponse DealPipelineDTO
+
+func (response PostCompanyUUIDDealsPipelines200JSONResponse) VisitPostCompanyUUIDDealsPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDDealsPipelinesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCompanyUUIDDealsPipelinesEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDDealsPipelinesEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDDealsPipelinesEntityUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDDealsPipelinesEntityUUID200Response) VisitDeleteCompanyUUIDDealsPipelinesEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutCompanyUUIDDealsPipelinesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutCompanyUUIDDealsPipelinesEntityUUIDJSONRequestBody
+}
+
+type PutCompanyUUIDDealsPipelinesEntityUUIDResponseObject interface {
+	VisitPutCompanyUUIDDealsPipelinesEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutCompanyUUIDDealsPipelinesEntityUUID200JSONResponse DealPipelineDTO
+
+func (response PutCompanyUUIDDealsPipelinesEntityUUID200JSONResponse) VisitPutCompanyUUIDDealsPipelinesEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDDealsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCompanyUUIDDealsEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDDealsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDDealsEntityUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDDealsEntityUUID200Response) VisitDeleteCompanyUUIDDealsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDDealsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetCompanyUUIDDealsEntityUUIDResponseObject interface {
+	VisitGetCompanyUUIDDealsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDDealsEntityUUID200JSONResponse DealDTO
+
+func (response GetCompanyUUIDDealsEntityUUID200JSONResponse) VisitGetCompanyUUIDDealsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCompanyUUIDDealsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutCompanyUUIDDealsEntityUUIDJSONRequestBody
+}
+
+type PutCompanyUUIDDealsEntityUUIDResponseObject interface {
+	VisitPutCompanyUUIDDealsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutCompanyUUIDDealsEntityUUID200JSONResponse DealDTO
+
+func (response PutCompanyUUIDDealsEntityUUID200JSONResponse) VisitPutCompanyUUIDDealsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDDealsEntityUUIDActivityRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetCompanyUUIDDealsEntityUUIDActivityParams
+}
+
+type GetCompanyUUIDDealsEntityUUIDActivityResponseObject interface {
+	VisitGetCompanyUUIDDealsEntityUUIDActivityResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDDealsEntityUUIDActivity200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []ActivityDTO `json:"items"`
+	Total int64         `json:"total"`
+}
+
+func (response GetCompanyUUIDDealsEntityUUIDActivity200JSONResponse) VisitGetCompanyUUIDDealsEntityUUIDActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCompanyUUIDDealsEntityUUIDStageRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutCompanyUUIDDealsEntityUUIDStageJSONRequestBody
+}
+
+type PutCompanyUUIDDealsEntityUUIDStageResponseObject interface {
+	VisitPutCompanyUUIDDealsEntityUUIDStageResponse(w http.ResponseWriter) error
+}
+
+type PutCompanyUUIDDealsEntityUUIDStage200JSONResponse DealDTO
+
+func (response PutCompanyUUIDDealsEntityUUIDStage200JSONResponse) VisitPutCompanyUUIDDealsEntityUUIDStageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDFieldsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDFieldsResponseObject interface {
+	VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDFields200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []CompanyFieldDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDFields200JSONResponse) VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDFieldsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDFieldsJSONRequestBody
+}
+
+type PostCompanyUUIDFieldsResponseObject interface {
+	VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDFields200JSONResponse struct {
+	Hash            string               `json:"hash"`
+	Icon            string               `json:"icon"`
+	Type            domain.FieldDataType `json:"type"`
+	TypeDescription string               `json:"type_description"`
+	TypeUuid        *openapi_types.UUID  `json:"type_uuid,omitempty"`
+	Uuid            string               `json:"uuid"`
+}
+
+func (response PostCompanyUUIDFields200JSONResponse) VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDFieldsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCompanyUUIDFieldsEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDFieldsEntityUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDFieldsEntityUUID200Response) VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutCompanyUUIDFieldsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
+}
+
+type PutCompanyUUIDFieldsEntityUUIDResponseObject interface {
+	VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutCompanyUUIDFieldsEntityUUID200Response struct {
+}
+
+func (response PutCompanyUUIDFieldsEntityUUID200Response) VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDGroupRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDGroupResponseObject interface {
+	VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDGroup200JSONResponse struct {
+	Count int        `json:"count"`
+	Items []GroupDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDGroup200JSONResponse) VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDGroupRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDGroupJSONRequestBody
+}
+
+type PostCompanyUUIDGroupResponseObject interface {
+	VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDGroup200JSONResponse UUIDResponse
+
+func (response PostCompanyUUIDGroup200JSONResponse) VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDGroupEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCompanyUUIDGroupEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDGroupEntityUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDGroupEntityUUID200Response) VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchCompanyUUIDGroupEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
+}
+
+type PatchCompanyUUIDGroupEntityUUIDResponseObject interface {
+	VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchCompanyUUIDGroupEntityUUID200Response struct {
+}
+
+func (response PatchCompanyUUIDGroupEntityUUID200Response) VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchCompanyUUIDNameRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchCompanyUUIDNameJSONRequestBody
+}
+
+type PatchCompanyUUIDNameResponseObject interface {
+	VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error
+}
+
+type PatchCompanyUUIDName200Response struct {
+}
+
+func (response PatchCompanyUUIDName200Response) VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDPrioritiesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDPrioritiesResponseObject interface {
+	VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDPriorities200JSONResponse struct {
+	Count int                  `json:"count"`
+	Items []CompanyPriorityDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDPriorities200JSONResponse) VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDPrioritiesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDPrioritiesJSONRequestBody
+}
+
+type PostCompanyUUIDPrioritiesResponseObject interface {
+	VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDPriorities200JSONResponse UUIDResponse
+
+func (response PostCompanyUUIDPriorities200JSONResponse) VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDPrioritiesEntityUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDPrioritiesEntityUUID200Response) VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
+}
+
+type PatchCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
+	VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchCompanyUUIDPrioritiesEntityUUID200Response struct {
+}
+
+func (response PatchCompanyUUIDPrioritiesEntityUUID200Response) VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDProjectCatalogEntityNameRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityName EntityName `json:"entityName"`
+}
+
+type GetCompanyUUIDProjectCatalogEntityNameResponseObject interface {
+	VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDProjectCatalogEntityName200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []ProjectCatalogDataDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDProjectCatalogEntityName200JSONResponse) VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDSmsRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetCompanyUUIDSmsParams
+}
+
+type GetCompanyUUIDSmsResponseObject interface {
+	VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDSms200JSONResponse struct {
+	Count int      `json:"count"`
+	Items []SmsDTO `json:"items"`
+	Total int64    `json:"total"`
+}
+
+func (response GetCompanyUUIDSms200JSONResponse) VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDSmsCampaignsRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetCompanyUUIDSmsCampaignsParams
+}
+
+type GetCompanyUUIDSmsCampaignsResponseObject interface {
+	VisitGetCompanyUUIDSmsCampaignsResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDSmsCampaigns200JSONResponse struct {
+	Count int              `json:"count"`
+	Items []SmsCampaignDTO `json:"items"`
+	Total int64            `json:"total"`
+}
+
+func (response GetCompanyUUIDSmsCampaigns200JSONResponse) VisitGetCompanyUUIDSmsCampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDSmsCampaignsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDSmsCampaignsJSONRequestBody
+}
+
+type PostCompanyUUIDSmsCampaignsResponseObject interface {
+	VisitPostCompanyUUIDSmsCampaignsResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsCampaigns200JSONResponse SmsCampaignDTO
+
+func (response PostCompanyUUIDSmsCampaigns200JSONResponse) VisitPostCompanyUUIDSmsCampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDSmsCampaignsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetCompanyUUIDSmsCampaignsEntityUUIDResponseObject interface {
+	VisitGetCompanyUUIDSmsCampaignsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDSmsCampaignsEntityUUID200JSONResponse SmsCampaignDTO
+
+func (response GetCompanyUUIDSmsCampaignsEntityUUID200JSONResponse) VisitGetCompanyUUIDSmsCampaignsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDSmsCampaignsEntityUUIDCancelRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type PostCompanyUUIDSmsCampaignsEntityUUIDCancelResponseObject interface {
+	VisitPostCompanyUUIDSmsCampaignsEntityUUIDCancelResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsCampaignsEntityUUIDCancel200Response struct {
+}
+
+func (response PostCompanyUUIDSmsCampaignsEntityUUIDCancel200Response) VisitPostCompanyUUIDSmsCampaignsEntityUUIDCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDSmsCampaignsEntityUUIDCostRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetCompanyUUIDSmsCampaignsEntityUUIDCostResponseObject interface {
+	VisitGetCompanyUUIDSmsCampaignsEntityUUIDCostResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDSmsCampaignsEntityUUIDCost200JSONResponse map[string]interface{}
+
+func (response GetCompanyUUIDSmsCampaignsEntityUUIDCost200JSONResponse) VisitGetCompanyUUIDSmsCampaignsEntityUUIDCostResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDSmsCampaignsEntityUUIDCsvRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *multipart.Reader
+}
+
+type PostCompanyUUIDSmsCampaignsEntityUUIDCsvResponseObject interface {
+	VisitPostCompanyUUIDSmsCampaignsEntityUUIDCsvResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsCampaignsEntityUUIDCsv200JSONResponse struct {
+	Added int64 `json:"added"`
+}
+
+func (response PostCompanyUUIDSmsCampaignsEntityUUIDCsv200JSONResponse) VisitPostCompanyUUIDSmsCampaignsEntityUUIDCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsParams
+}
+
+type GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsResponseObject interface {
+	VisitGetCompanyUUIDSmsCampaignsEntityUUIDRecipientsResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDSmsCampaignsEntityUUIDRecipients200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []SmsRecipientDTO `json:"items"`
+	Total int64             `json:"total"`
+}
+
+func (response GetCompanyUUIDSmsCampaignsEntityUUIDRecipients200JSONResponse) VisitGetCompanyUUIDSmsCampaignsEntityUUIDRecipientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDSmsCampaignsEntityUUIDStartRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostCompanyUUIDSmsCampaignsEntityUUIDStartJSONRequestBody
+}
+
+type PostCompanyUUIDSmsCampaignsEntityUUIDStartResponseObject interface {
+	VisitPostCompanyUUIDSmsCampaignsEntityUUIDStartResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsCampaignsEntityUUIDStart200Response struct {
+}
+
+func (response PostCompanyUUIDSmsCampaignsEntityUUIDStart200Response) VisitPostCompanyUUIDSmsCampaignsEntityUUIDStartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostCompanyUUIDSmsCostRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDSmsCostJSONRequestBody
+}
+
+type PostCompanyUUIDSmsCostResponseObject interface {
+	VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsCost200JSONResponse map[string]interface{}
+
+func (response PostCompanyUUIDSmsCost200JSONResponse) VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDSmsOptionsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDSmsOptionsJSONRequestBody
+}
+
+type PostCompanyUUIDSmsOptionsResponseObject interface {
+	VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsOptions200JSONResponse struct {
+	CallbackUrl string `json:"callback_url"`
+}
+
+func (response PostCompanyUUIDSmsOptions200JSONResponse) VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDSmsSendRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params PostCompanyUUIDSmsSendParams
+	Body   *PostCompanyUUIDSmsSendJSONRequestBody
+}
+
+type PostCompanyUUIDSmsSendResponseObject interface {
+	VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsSend200JSONResponse map[string]interface{}
+
+func (response PostCompanyUUIDSmsSend200JSONResponse) VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDSmsTemplatesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDSmsTemplatesResponseObject interface {
+	VisitGetCompanyUUIDSmsTemplatesResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDSmsTemplates200JSONResponse struct {
+	Count int              `json:"count"`
+	Items []SmsTemplateDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDSmsTemplates200JSONResponse) VisitGetCompanyUUIDSmsTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDSmsTemplatesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDSmsTemplatesJSONRequestBody
+}
+
+type PostCompanyUUIDSmsTemplatesResponseObject interface {
+	VisitPostCompanyUUIDSmsTemplatesResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDSmsTemplates200JSONResponse SmsTemplateDTO
+
+func (response PostCompanyUUIDSmsTemplates200JSONResponse) VisitPostCompanyUUIDSmsTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDSmsTemplatesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteCompanyUUIDSmsTemplatesEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDSmsTemplatesEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDSmsTemplatesEntityUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDSmsTemplatesEntityUUID200Response) VisitDeleteCompanyUUIDSmsTemplatesEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutCompanyUUIDSmsTemplatesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutCompanyUUIDSmsTemplatesEntityUUIDJSONRequestBody
+}
+
+type PutCompanyUUIDSmsTemplatesEntityUUIDResponseObject interface {
+	VisitPutCompanyUUIDSmsTemplatesEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutCompanyUUIDSmsTemplatesEntityUUID200JSONResponse SmsTemplateDTO
+
+func (response PutCompanyUUIDSmsTemplatesEntityUUID200JSONResponse) VisitPutCompanyUUIDSmsTemplatesEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDUserJSONRequestBody
+}
+
+type PostCompanyUUIDUserResponseObject interface {
+	VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDUser200JSONResponse UUIDResponse
+
+func (response PostCompanyUUIDUser200JSONResponse) VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCompanyUUIDUserUserUUIDRequestObject struct {
+	UUID     Uuid     `json:"UUID"`
+	UserUUID UserUUID `json:"userUUID"`
+}
+
+type DeleteCompanyUUIDUserUserUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteCompanyUUIDUserUserUUID200Response struct {
+}
+
+func (response DeleteCompanyUUIDUserUserUUID200Response) VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostFederationRequestObject struct {
+	Body *PostFederationJSONRequestBody
+}
+
+type PostFederationResponseObject interface {
+	VisitPostFederationResponse(w http.ResponseWriter) error
+}
+
+type PostFederation200JSONResponse UUIDResponse
+
+func (response PostFederation200JSONResponse) VisitPostFederationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteFederationUUIDResponseObject interface {
+	VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUID200Response struct {
+}
+
+func (response DeleteFederationUUID200Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteFederationUUID401Response struct {
+}
+
+func (response DeleteFederationUUID401Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetFederationUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDResponseObject interface {
+	VisitGetFederationUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUID200JSONResponse FederationDTO
+
+func (response GetFederationUUID200JSONResponse) VisitGetFederationUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDAgentParams
+}
+
+type GetFederationUUIDAgentResponseObject interface {
+	VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgent200JSONResponse struct {
+	Count int        `json:"count"`
+	Items []AgentDTO `json:"items"`
+	Total int64      `json:"total"`
+}
+
+func (response GetFederationUUIDAgent200JSONResponse) VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDAgentRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDAgentJSONRequestBody
+}
+
+type PostFederationUUIDAgentResponseObject interface {
+	VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDAgent200JSONResponse UUIDResponse
+
+func (response PostFederationUUIDAgent200JSONResponse) VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentDuplicatesRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDAgentDuplicatesParams
+}
+
+type GetFederationUUIDAgentDuplicatesResponseObject interface {
+	VisitGetFederationUUIDAgentDuplicatesResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentDuplicates200JSONResponse struct {
+	Count int                 `json:"count"`
+	Items []AgentDuplicateDTO `json:"items"`
+	Total int                 `json:"total"`
+}
+
+func (response GetFederationUUIDAgentDuplicates200JSONResponse) VisitGetFederationUUIDAgentDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDAgentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteFederationUUIDAgentEntityUUIDResponseObject interface {
+	VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDAgentEntityUUID200Response struct {
+}
+
+func (response DeleteFederationUUIDAgentEntityUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetFederationUUIDAgentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetFederationUUIDAgentEntityUUIDResponseObject interface {
+	VisitGetFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentEntityUUID200JSONResponse AgentDTO
+
+func (response GetFederationUUIDAgentEntityUUID200JSONResponse) VisitGetFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchFederationUUIDAgentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchFederationUUIDAgentEntityUUIDJSONRequestBody
+}
+
+type PatchFederationUUIDAgentEntityUUIDResponseObject interface {
+	VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchFederationUUIDAgentEntityUUID200Response struct {
+}
+
+func (response PatchFederationUUIDAgentEntityUUID200Response) VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetFederationUUIDAgentEntityUUIDActivityRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetFederationUUIDAgentEntityUUIDActivityParams
+}
+
+type GetFederationUUIDAgentEntityUUIDActivityResponseObject interface {
+	VisitGetFederationUUIDAgentEntityUUIDActivityResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentEntityUUIDActivity200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []ActivityDTO `json:"items"`
+	Total int64         `json:"total"`
+}
+
+func (response GetFederationUUIDAgentEntityUUIDActivity200JSONResponse) VisitGetFederationUUIDAgentEntityUUIDActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentEntityUUIDCommentsRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetFederationUUIDAgentEntityUUIDCommentsParams
+}
+
+type GetFederationUUIDAgentEntityUUIDCommentsResponseObject interface {
+	VisitGetFederationUUIDAgentEntityUUIDCommentsResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentEntityUUIDComments200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []AgentCommentDTO `json:"items"`
+	Total int64             `json:"total"`
+}
+
+func (response GetFederationUUIDAgentEntityUUIDComments200JSONResponse) VisitGetFederationUUIDAgentEntityUUIDCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDAgentEntityUUIDCommentsRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostFederationUUIDAgentEntityUUIDCommentsJSONRequestBody
+}
+
+type PostFederationUUIDAgentEntityUUIDCommentsResponseObject interface {
+	VisitPostFederationUUIDAgentEntityUUIDCommentsResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDAgentEntityUUIDComments200JSONResponse AgentCommentDTO
+
+func (response PostFederationUUIDAgentEntityUUIDComments200JSONResponse) VisitPostFederationUUIDAgentEntityUUIDCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDRequestObject struct {
+	UUID        Uuid               `json:"UUID"`
+	EntityUUID  EntityUUID         `json:"entityUUID"`
+	CommentUUID openapi_types.UUID `json:"commentUUID"`
+}
+
+type DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDResponseObject interface {
+	VisitDeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID200Response struct {
+}
+
+func (response DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetFederationUUIDAgentEntityUUIDDuplicatesRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetFederationUUIDAgentEntityUUIDDuplicatesParams
+}
+
+type GetFederationUUIDAgentEntityUUIDDuplicatesResponseObject interface {
+	VisitGetFederationUUIDAgentEntityUUIDDuplicatesResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentEntityUUIDDuplicates200JSONResponse struct {
+	Count int                 `json:"count"`
+	Items []AgentDuplicateDTO `json:"items"`
+}
+
+func (response GetFederationUUIDAgentEntityUUIDDuplicates200JSONResponse) VisitGetFederationUUIDAgentEntityUUIDDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentEntityUUIDFilesRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetFederationUUIDAgentEntityUUIDFilesResponseObject interface {
+	VisitGetFederationUUIDAgentEntityUUIDFilesResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentEntityUUIDFiles200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []UploadDTO `json:"items"`
+}
+
+func (response GetFederationUUIDAgentEntityUUIDFiles200JSONResponse) VisitGetFederationUUIDAgentEntityUUIDFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDAgentEntityUUIDFilesRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *multipart.Reader
+}
+
+type PostFederationUUIDAgentEntityUUIDFilesResponseObject interface {
+	VisitPostFederationUUIDAgentEntityUUIDFilesResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDAgentEntityUUIDFiles200JSONResponse UploadDTO
+
+func (response PostFederationUUIDAgentEntityUUIDFiles200JSONResponse) VisitPostFederationUUIDAgentEntityUUIDFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	FileUUID   FileUUID   `json:"fileUUID"`
+}
+
+type DeleteFederationUUIDAgentEntityUUIDFilesFileUUIDResponseObject interface {
+	VisitDeleteFederationUUIDAgentEntityUUIDFilesFileUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDAgentEntityUUIDFilesFileUUID200Response struct {
+}
+
+func (response DeleteFederationUUIDAgentEntityUUIDFilesFileUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDFilesFileUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	FileUUID   FileUUID   `json:"fileUUID"`
+}
+
+type GetFederationUUIDAgentEntityUUIDFilesFileUUIDResponseObject interface {
+	VisitGetFederationUUIDAgentEntityUUIDFilesFileUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentEntityUUIDFilesFileUUID302ResponseHeaders struct {
+	Location string
+}
+
+type GetFederationUUIDAgentEntityUUIDFilesFileUUID302Response struct {
+	Headers GetFederationUUIDAgentEntityUUIDFilesFileUUID302ResponseHeaders
+}
+
+func (response GetFederationUUIDAgentEntityUUIDFilesFileUUID302Response) VisitGetFederationUUIDAgentEntityUUIDFilesFileUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type PutFederationUUIDAgentEntityUUIDLegalEntitiesRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutFederationUUIDAgentEntityUUIDLegalEntitiesJSONRequestBody
+}
+
+type PutFederationUUIDAgentEntityUUIDLegalEntitiesResponseObject interface {
+	VisitPutFederationUUIDAgentEntityUUIDLegalEntitiesResponse(w http.ResponseWriter) error
+}
+
+type PutFederationUUIDAgentEntityUUIDLegalEntities200JSONResponse AgentDTO
+
+func (response PutFederationUUIDAgentEntityUUIDLegalEntities200JSONResponse) VisitPutFederationUUIDAgentEntityUUIDLegalEntitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDAgentEntityUUIDMergeRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostFederationUUIDAgentEntityUUIDMergeJSONRequestBody
+}
+
+type PostFederationUUIDAgentEntityUUIDMergeResponseObject interface {
+	VisitPostFederationUUIDAgentEntityUUIDMergeResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDAgentEntityUUIDMerge200JSONResponse AgentDTO
+
+func (response PostFederationUUIDAgentEntityUUIDMerge200JSONResponse) VisitPostFederationUUIDAgentEntityUUIDMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutFederationUUIDAgentEntityUUIDTasksRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutFederationUUIDAgentEntityUUIDTasksJSONRequestBody
+}
+
+type PutFederationUUIDAgentEntityUUIDTasksResponseObject interface {
+	VisitPutFederationUUIDAgentEntityUUIDTasksResponse(w http.ResponseWriter) error
+}
+
+type PutFederationUUIDAgentEntityUUIDTasks200JSONResponse AgentDTO
+
+func (response PutFederationUUIDAgentEntityUUIDTasks200JSONResponse) VisitPutFederationUUIDAgentEntityUUIDTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentEntityUUIDTimelineRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetFederationUUIDAgentEntityUUIDTimelineParams
+}
+
+type GetFederationUUIDAgentEntityUUIDTimelineResponseObject interface {
+	VisitGetFederationUUIDAgentEntityUUIDTimelineResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgentEntityUUIDTimeline200JSONResponse struct {
+	Count int                    `json:"count"`
+	Items []AgentTimelineItemDTO `json:"items"`
+	Total int64                  `json:"total"`
+}
+
+func (response GetFederationUUIDAgentEntityUUIDTimeline200JSONResponse) VisitGetFederationUUIDAgentEntityUUIDTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDEmailBrandingRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDEmailBrandingResponseObject interface {
+	VisitGetFederationUUIDEmailBrandingResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDEmailBranding200JSONResponse EmailBrandingDTO
+
+func (response GetFederationUUIDEmailBranding200JSONResponse) VisitGetFederationUUIDEmailBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutFederationUUIDEmailBrandingRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PutFederationUUIDEmailBrandingJSONRequestBody
+}
+
+type PutFederationUUIDEmailBrandingResponseObject interface {
+	VisitPutFederationUUIDEmailBrandingResponse(w http.ResponseWriter) error
+}
+
+type PutFederationUUIDEmailBranding200JSONResponse EmailBrandingDTO
+
+func (response PutFederationUUIDEmailBranding200JSONResponse) VisitPutFederationUUIDEmailBrandingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDEmailTemplatesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDEmailTemplatesResponseObject interface {
+	VisitGetFederationUUIDEmailTemplatesResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDEmailTemplates200JSONResponse struct {
+	Count int                `json:"count"`
+	Items []EmailTemplateDTO `json:"items"`
+}
+
+func (response GetFederationUUIDEmailTemplates200JSONResponse) VisitGetFederationUUIDEmailTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDEmailTemplatesEntityNameRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityName EntityName `json:"entityName"`
+	Params     DeleteFederationUUIDEmailTemplatesEntityNameParams
+}
+
+type DeleteFederationUUIDEmailTemplatesEntityNameResponseObject interface {
+	VisitDeleteFederationUUIDEmailTemplatesEntityNameResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDEmailTemplatesEntityName200Response struct {
+}
+
+func (response DeleteFederationUUIDEmailTemplatesEntityName200Response) VisitDeleteFederationUUIDEmailTemplatesEntityNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutFederationUUIDEmailTemplatesEntityNameRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityName EntityName `json:"entityName"`
+	Body       *PutFederationUUIDEmailTemplatesEntityNameJSONRequestBody
+}
+
+type PutFederationUUIDEmailTemplatesEntityNameResponseObject interface {
+	VisitPutFederationUUIDEmailTemplatesEntityNameResponse(w http.ResponseWriter) error
+}
+
+type PutFederationUUIDEmailTemplatesEntityName200JSONResponse EmailTemplateDTO
+
+func (response PutFederationUUIDEmailTemplatesEntityName200JSONResponse) VisitPutFederationUUIDEmailTemplatesEntityNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDEmailTemplatesEntityNamePreviewRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityName EntityName `json:"entityName"`
+	Body       *PostFederationUUIDEmailTemplatesEntityNamePreviewJSONRequestBody
+}
+
+type PostFederationUUIDEmailTemplatesEntityNamePreviewResponseObject interface {
+	VisitPostFederationUUIDEmailTemplatesEntityNamePreviewResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDEmailTemplatesEntityNamePreview200JSONResponse struct {
+	Html    string `json:"html"`
+	Subject string `json:"subject"`
+}
+
+func (response PostFederationUUIDEmailTemplatesEntityNamePreview200JSONResponse) VisitPostFederationUUIDEmailTemplatesEntityNamePreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDInviteRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDInviteResponseObject interface {
+	VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDInvite200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []InviteDTO `json:"items"`
+}
+
+func (response GetFederationUUIDInvite200JSONResponse) VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDInviteRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDInviteJSONRequestBody
+}
+
+type PostFederationUUIDInviteResponseObject interface {
+	VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDInvite200JSONResponse UUIDResponse
+
+func (response PostFederationUUIDInvite200JSONResponse) VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDInviteEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteFederationUUIDInviteEntityUUIDResponseObject interface {
+	VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDInviteEntityUUID200Response struct {
+}
+
+func (response DeleteFederationUUIDInviteEntityUUID200Response) VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchFederationUUIDNameRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchFederationUUIDNameJSONRequestBody
+}
+
+type PatchFederationUUIDNameResponseObject interface {
+	VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error
+}
+
+type PatchFederationUUIDName200Response struct {
+}
+
+func (response PatchFederationUUIDName200Response) VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetFederationUUIDProjectRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDProjectParams
+}
+
+type GetFederationUUIDProjectResponseObject interface {
+	VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDProject200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []ProjectDTO `json:"items"`
+}
+
+func (response GetFederationUUIDProject200JSONResponse) VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDUserJSONRequestBody
+}
+
+type PostFederationUUIDUserResponseObject interface {
+	VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDUser200JSONResponse UUIDResponse
+
+func (response PostFederationUUIDUser200JSONResponse) VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDUserUserUUIDRequestObject struct {
+	UUID     Uuid     `json:"UUID"`
+	UserUUID UserUUID `json:"userUUID"`
+}
+
+type DeleteFederationUUIDUserUserUUIDResponseObject interface {
+	VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDUserUserUUID200Response struct {
+}
+
+func (response DeleteFederationUUIDUserUserUUID200Response) VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteGroupUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *DeleteGroupUUIDUserJSONRequestBody
+}
+
+type DeleteGroupUUIDUserResponseObject interface {
+	VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type DeleteGroupUUIDUser200Response struct {
+}
+
+func (response DeleteGroupUUIDUser200Response) VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetGroupUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetGroupUUIDUserResponseObject interface {
+	VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type GetGroupUUIDUser200JSONResponse struct {
+	Count int       `json:"count"`
+	Items []UserDTO `json:"items"`
+}
+
+func (response GetGroupUUIDUser200JSONResponse) VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostGroupUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostGroupUUIDUserJSONRequestBody
+}
+
+type PostGroupUUIDUserResponseObject interface {
+	VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type PostGroupUUIDUser200Response struct {
+}
+
+func (response PostGroupUUIDUser200Response) VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteLegalEntitiesRequestObject struct {
+	Body *DeleteLegalEntitiesJSONRequestBody
+}
+
+type DeleteLegalEntitiesResponseObject interface {
+	VisitDeleteLegalEntitiesResponse(w http.ResponseWriter) error
+}
+
+type DeleteLegalEntities200JSONResponse Ans
+
+func (response DeleteLegalEntities200JSONResponse) VisitDeleteLegalEntitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLegalEntitiesRequestObject struct {
+}
+
+type GetLegalEntitiesResponseObject interface {
+	VisitGetLegalEntitiesResponse(w http.ResponseWriter) error
+}
+
+type GetLegalEntities200JSONResponse []BankAccountDTO
+
+func (response GetLegalEntities200JSONResponse) VisitGetLegalEntitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchLegalEntitiesRequestObject struct {
+	Body *PatchLegalEntitiesJSONRequestBody
+}
+
+type PatchLegalEntitiesResponseObject interface {
+	VisitPatchLegalEntitiesResponse(w http.ResponseWriter) error
+}
+
+type PatchLegalEntities200JSONResponse Ans
+
+func (response PatchLegalEntities200JSONResponse) VisitPatchLegalEntitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLegalEntitiesRequestObject struct {
+	Body *PostLegalEntitiesJSONRequestBody
+}
+
+type PostLegalEntitiesResponseObject interface {
+	VisitPostLegalEntitiesResponse(w http.ResponseWriter) error
+}
+
+type PostLegalEntities201JSONResponse Ans
+
+func (response PostLegalEntities201JSONResponse) VisitPostLegalEntitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPermissionsRequestObject struct {
+	Body *PostPermissionsJSONRequestBody
+}
+
+type PostPermissionsResponseObject interface {
+	VisitPostPermissionsResponse(w http.ResponseWriter) error
+}
+
+type PostPermissions200JSONResponse struct {
+	UpdatedAt time.Time          `json:"updated_at"`
+	UserUuid  openapi_types.UUID `json:"user_uuid"`
+}
+
+func (response PostPermissions200JSONResponse) VisitPostPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionsUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeletePermissionsUUIDResponseObject interface {
+	VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeletePermissionsUUID200Response struct {
+}
+
+func (response DeletePermissionsUUID200Response) VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetPermissionsUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetPermissionsUUIDResponseObject interface {
+	VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetPermissionsUUID200JSONResponse struct {
+	CreatedAt      time.Time          `json:"created_at"`
+	FederationUuid openapi_types.UUID `json:"federation_uuid"`
+	Rules          PermissionRulesDTO `json:"rules"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+	UserUuid       openapi_types.UUID `json:"user_uuid"`
+}
+
+func (response GetPermissionsUUID200JSONResponse) VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileSurveyRequestObject struct {
+	Body *PostProfileSurveyJSONRequestBody
+}
+
+type PostProfileSurveyResponseObject interface {
+	VisitPostProfileSurveyResponse(w http.ResponseWriter) error
+}
+
+type PostProfileSurvey200JSONResponse UUIDResponse
+
+func (response PostProfileSurvey200JSONResponse) VisitPostProfileSurveyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfileSurveyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteProfileSurveyUUIDResponseObject interface {
+	VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfileSurveyUUID200Response struct {
+}
+
+func (response DeleteProfileSurveyUUID200Response) VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProfileSurveyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProfileSurveyUUIDResponseObject interface {
+	VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetProfileSurveyUUID200JSONResponse SurveyDTO
+
+func (response GetProfileSurveyUUID200JSONResponse) VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectRequestObject struct {
+	Body *PostProjectJSONRequestBody
+}
+
+type PostProjectResponseObject interface {
+	VisitPostProjectResponse(w http.ResponseWriter) error
+}
+
+type PostProject200JSONResponse UUIDResponse
+
+func (response PostProject200JSONResponse) VisitPostProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteProjectUUIDResponseObject interface {
+	VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUID200Response struct {
+}
+
+func (response DeleteProjectUUID200Response) VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDResponseObject interface {
+	VisitGetProjectUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUID200JSONResponse ProjectDTO
+
+func (response GetProjectUUID200JSONResponse) VisitGetProjectUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDJSONRequestBody
+}
+
+type PatchProjectUUIDResponseObject interface {
+	VisitPatchProjectUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUID200Response struct {
+}
+
+func (response PatchProjectUUID200Response) VisitPatchProjectUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProjectUUIDCatalogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDCatalogResponseObject interface {
+	VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDCatalog200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []ProjectCatalogDataDTO `json:"items"`
+}
+
+func (response GetProjectUUIDCatalog200JSONResponse) VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDCatalogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDCatalogJSONRequestBody
+}
+
+type PostProjectUUIDCatalogResponseObject interface {
+	VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDCatalog200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostProjectUUIDCatalog200JSONResponse) VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDCatalogEntityNameRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityName EntityName `json:"entityName"`
+}
+
+type GetProjectUUIDCatalogEntityNameResponseObject interface {
+	VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDCatalogEntityName200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []ProjectCatalogDataDTO `json:"items"`
+}
+
+func (response GetProjectUUIDCatalogEntityName200JSONResponse) VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDCatalogEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDCatalogEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDCatalogEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDCatalogEntityUUID200Response) VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDDescriptionRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDDescriptionJSONRequestBody
+}
+
+type PatchProjectUUIDDescriptionResponseObject interface {
+	VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDDescription200Response struct {
+}
+
+func (response PatchProjectUUIDDescription200Response) VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProjectUUIDEmailRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDEmailResponseObject interface {
+	VisitGetProjectUUIDEmailResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDEmail200JSONResponse struct {
+	Email string `json:"email"`
+}
+
+func (response GetProjectUUIDEmail200JSONResponse) VisitGetProjectUUIDEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDFieldEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDFieldEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDFieldEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDFieldEntityUUID200Response) VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProjectUUIDFieldEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostProjectUUIDFieldEntityUUIDJSONRequestBody
+}
+
+type PostProjectUUIDFieldEntityUUIDResponseObject interface {
+	VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDFieldEntityUUID200Response struct {
+}
+
+func (response PostProjectUUIDFieldEntityUUID200Response) VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDGraphRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDGraphJSONRequestBody
+}
+
+type PatchProjectUUIDGraphResponseObject interface {
+	VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDGraph200JSONResponse map[string]interface{}
+
+func (response PatchProjectUUIDGraph200JSONResponse) VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProjectUUIDNameRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDNameJSONRequestBody
+}
+
+type PatchProjectUUIDNameResponseObject interface {
+	VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDName200Response struct {
+}
+
+func (response PatchProjectUUIDName200Response) VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDOptionsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDOptionsJSONRequestBody
+}
+
+type PatchProjectUUIDOptionsResponseObject interface {
+	VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDOptions200Response struct {
+}
+
+func (response PatchProjectUUIDOptions200Response) VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProjectUUIDStatusRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDStatusResponseObject interface {
+	VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDStatus200JSONResponse struct {
+	Count int                `json:"count"`
+	Items []ProjectStatusDTO `json:"items"`
+}
+
+func (response GetProjectUUIDStatus200JSONResponse) VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDStatusRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDStatusJSONRequestBody
+}
+
+type PostProjectUUIDStatusResponseObject interface {
+	VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDStatus200JSONResponse UUIDResponse
+
+func (response PostProjectUUIDStatus200JSONResponse) VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDStatusEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDStatusEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDStatusEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDStatusEntityUUID200Response) VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDStatusEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchProjectUUIDStatusEntityUUIDJSONRequestBody
+}
+
+type PatchProjectUUIDStatusEntityUUIDResponseObject interface {
+	VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDStatusEntityUUID200Response struct {
+}
+
+func (response PatchProjectUUIDStatusEntityUUID200Response) VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProjectUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDUserJSONRequestBody
+}
+
+type PostProjectUUIDUserResponseObject interface {
+	VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDUser200JSONResponse UUIDResponse
+
+func (response PostProjectUUIDUser200JSONResponse) VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDUserUserUUIDRequestObject struct {
+	UUID     Uuid     `json:"UUID"`
+	UserUUID UserUUID `json:"userUUID"`
+}
+
+type DeleteProjectUUIDUserUserUUIDResponseObject interface {
+	VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDUserUserUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDUserUserUUID200Response) VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTagRequestObject struct {
+	Params GetTagParams
+}
+
+type GetTagResponseObject interface {
+	VisitGetTagResponse(w http.ResponseWriter) error
+}
+
+type GetTag200JSONResponse struct {
+	Count int      `json:"count"`
+	Items []TagDTO `json:"items"`
+}
+
+func (response GetTag200JSONResponse) VisitGetTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTagRequestObject struct {
+	Body *PostTagJSONRequestBody
+}
+
+type PostTagResponseObject interface {
+	VisitPostTagResponse(w http.ResponseWriter) error
+}
+
+type PostTag200JSONResponse UUIDResponse
+
+func (response PostTag200JSONResponse) VisitPostTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTagUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteTagUUIDResponseObject interface {
+	VisitDeleteTagUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTagUUID200Response struct {
+}
+
+func (response DeleteTagUUID200Response) VisitDeleteTagUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchTagUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTagUUIDJSONRequestBody
+}
+
+type PatchTagUUIDResponseObject interface {
+	VisitPatchTagUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchTagUUID200Response struct {
+}
+
+func (response PatchTagUUID200Response) VisitPatchTagUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetUserRequestObject struct {
+	Body *GetUserJSONRequestBody
+}
+
+type GetUserResponseObject interface {
+	VisitGetUserResponse(w http.ResponseWriter) error
+}
+
+type GetUser200JSONResponse struct {
+	Count int       `json:"count"`
+	Items []UserDTO `json:"items"`
+}
+
+func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (POST /company)
+	PostCompany(ctx context.Context, request PostCompanyRequestObject) (PostCompanyResponseObject, error)
+
+	// (DELETE /company/{UUID})
+	DeleteCompanyUUID(ctx context.Context, request DeleteCompanyUUIDRequestObject) (DeleteCompanyUUIDResponseObject, error)
+
+	// (GET /company/{UUID})
+	GetCompanyUUID(ctx context.Context, request GetCompanyUUIDRequestObject) (GetCompanyUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/deals)
+	GetCompanyUUIDDeals(ctx context.Context, request GetCompanyUUIDDealsRequestObject) (GetCompanyUUIDDealsResponseObject, error)
+
+	// (POST /company/{UUID}/deals)
+	PostCompanyUUIDDeals(ctx context.Context, request PostCompanyUUIDDealsRequestObject) (PostCompanyUUIDDealsResponseObject, error)
+
+	// (GET /company/{UUID}/deals/forecast)
+	GetCompanyUUIDDealsForecast(ctx context.Context, request GetCompanyUUIDDealsForecastRequestObject) (GetCompanyUUIDDealsForecastResponseObject, error)
+
+	// (GET /company/{UUID}/deals/pipelines)
+	GetCompanyUUIDDealsPipelines(ctx context.Context, request GetCompanyUUIDDealsPipelinesRequestObject) (GetCompanyUUIDDealsPipelinesResponseObject, error)
+
+	// (POST /company/{UUID}/deals/pipelines)
+	PostCompanyUUIDDealsPipelines(ctx context.Context, request PostCompanyUUIDDealsPipelinesRequestObject) (PostCompanyUUIDDealsPipelinesResponseObject, error)
+
+	// (DELETE /company/{UUID}/deals/pipelines/{entityUUID})
+	DeleteCompanyUUIDDealsPipelinesEntityUUID(ctx context.Context, request DeleteCompanyUUIDDealsPipelinesEntityUUIDRequestObject) (DeleteCompanyUUIDDealsPipelinesEntityUUIDResponseObject, error)
+
+	// (PUT /company/{UUID}/deals/pipelines/{entityUUID})
+	PutCompanyUUIDDealsPipelinesEntityUUID(ctx context.Context, request PutCompanyUUIDDealsPipelinesEntityUUIDRequestObject) (PutCompanyUUIDDealsPipelinesEntityUUIDResponseObject, error)
+
+	// (DELETE /company/{UUID}/deals/{entityUUID})
+	DeleteCompanyUUIDDealsEntityUUID(ctx context.Context, request DeleteCompanyUUIDDealsEntityUUIDRequestObject) (DeleteCompanyUUIDDealsEntityUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/deals/{entityUUID})
+	GetCompanyUUIDDealsEntityUUID(ctx context.Context, request GetCompanyUUIDDealsEntityUUIDRequestObject) (GetCompanyUUIDDealsEntityUUIDResponseObject, error)
+
+	// (PUT /company/{UUID}/deals/{entityUUID})
+	PutCompanyUUIDDealsEntityUUID(ctx context.Context, request PutCompanyUUIDDealsEntityUUIDRequestObject) (PutCompanyUUIDDealsEntityUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/deals/{entityUUID}/activity)
+	GetCompanyUUIDDealsEntityUUIDActivity(ctx context.Context, request GetCompanyUUIDDealsEntityUUIDActivityRequestObject) (GetCompanyUUIDDealsEntityUUIDActivityResponseObject, error)
+
+	// (PUT /company/{UUID}/deals/{entityUUID}/stage)
+	PutCompanyUUIDDealsEntityUUIDStage(ctx context.Context, request PutCompanyUUIDDealsEntityUUIDStageRequestObject) (PutCompanyUUIDDealsEntityUUIDStageResponseObject, error)
+
+	// (GET /company/{UUID}/fields)
+	GetCompanyUUIDFields(ctx context.Context, request GetCompanyUUIDFieldsRequestObject) (GetCompanyUUIDFieldsResponseObject, error)
+
+	// (POST /company/{UUID}/fields)
+	PostCompanyUUIDFields(ctx context.Context, request PostCompanyUUIDFieldsRequestObject) (PostCompanyUUIDFieldsResponseObject, error)
+
+	// (DELETE /company/{UUID}/fields/{entityUUID})
+	DeleteCompanyUUIDFieldsEntityUUID(ctx context.Context, request DeleteCompanyUUIDFieldsEntityUUIDRequestObject) (DeleteCompanyUUIDFieldsEntityUUIDResponseObject, error)
+
+	// (PUT /company/{UUID}/fields/{entityUUID})
+	PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request PutCompanyUUIDFieldsEntityUUIDRequestObject) (PutCompanyUUIDFieldsEntityUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/group)
+	GetCompanyUUIDGroup(ctx context.Context, request GetCompanyUUIDGroupRequestObject) (GetCompanyUUIDGroupResponseObject, error)
+
+	// (POST /company/{UUID}/group)
+	PostCompanyUUIDGroup(ctx context.Context, request PostCompanyUUIDGroupRequestObject) (PostCompanyUUIDGroupResponseObject, error)
+
+	// (DELETE /company/{UUID}/group/{entityUUID})
+	DeleteCompanyUUIDGroupEntityUUID(ctx context.Context, request DeleteCompanyUUIDGroupEntityUUIDRequestObject) (DeleteCompanyUUIDGroupEntityUUIDResponseObject, error)
+
+	// (PATCH /company/{UUID}/group/{entityUUID})
+	PatchCompanyUUIDGroupEntityUUID(ctx context.Context, request PatchCompanyUUIDGroupEntityUUIDRequestObject) (PatchCompanyUUIDGroupEntityUUIDResponseObject, error)
+
+	// (PATCH /company/{UUID}/name)
+	PatchCompanyUUIDName(ctx context.Context, request PatchCompanyUUIDNameRequestObject) (PatchCompanyUUIDNameResponseObject, error)
+
+	// (GET /company/{UUID}/priorities)
+	GetCompanyUUIDPriorities(ctx context.Context, request GetCompanyUUIDPrioritiesRequestObject) (GetCompanyUUIDPrioritiesResponseObject, error)
+
+	// (POST /company/{UUID}/priorities)
+	PostCompanyUUIDPriorities(ctx context.Context, request PostCompanyUUIDPrioritiesRequestObject) (PostCompanyUUIDPrioritiesResponseObject, error)
+
+	// (DELETE /company/{UUID}/priorities/{entityUUID})
+	DeleteCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject) (DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+
+	// (PATCH /company/{UUID}/priorities/{entityUUID})
+	PatchCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject) (PatchCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/project/catalog/{entityName})
+	GetCompanyUUIDProjectCatalogEntityName(ctx context.Context, request GetCompanyUUIDProjectCatalogEntityNameRequestObject) (GetCompanyUUIDProjectCatalogEntityNameResponseObject, error)
+
+	// (GET /company/{UUID}/sms)
+	GetCompanyUUIDSms(ctx context.Context, request GetCompanyUUIDSmsRequestObject) (GetCompanyUUIDSmsResponseObject, error)
+
+	// (GET /company/{UUID}/sms/campaigns)
+	GetCompanyUUIDSmsCampaigns(ctx context.Context, request GetCompanyUUIDSmsCampaignsRequestObject) (GetCompanyUUIDSmsCampaignsResponseObject, error)
+
+	// (POST /company/{UUID}/sms/campaigns)
+	PostCompanyUUIDSmsCampaigns(ctx context.Context, request PostCompanyUUIDSmsCampaignsRequestObject) (PostCompanyUUIDSmsCampaignsResponseObject, error)
+
+	// (GET /company/{UUID}/sms/campaigns/{entityUUID})
+	GetCompanyUUIDSmsCampaignsEntityUUID(ctx context.Context, request GetCompanyUUIDSmsCampaignsEntityUUIDRequestObject) (GetCompanyUUIDSmsCampaignsEntityUUIDResponseObject, error)
+
+	// (POST /company/{UUID}/sms/campaigns/{entityUUID}/cancel)
+	PostCompanyUUIDSmsCampaignsEntityUUIDCancel(ctx context.Context, request PostCompanyUUIDSmsCampaignsEntityUUIDCancelRequestObject) (PostCompanyUUIDSmsCampaignsEntityUUIDCancelResponseObject, error)
+
+	// (GET /company/{UUID}/sms/campaigns/{entityUUID}/cost)
+	GetCompanyUUIDSmsCampaignsEntityUUIDCost(ctx context.Context, request GetCompanyUUIDSmsCampaignsEntityUUIDCostRequestObject) (GetCompanyUUIDSmsCampaignsEntityUUIDCostResponseObject, error)
+
+	// (POST /company/{UUID}/sms/campaigns/{entityUUID}/csv)
+	PostCompanyUUIDSmsCampaignsEntityUUIDCsv(ctx context.Context, request PostCompanyUUIDSmsCampaignsEntityUUIDCsvRequestObject) (PostCompanyUUIDSmsCampaignsEntityUUIDCsvResponseObject, error)
+
+	// (GET /company/{UUID}/sms/campaigns/{entityUUID}/recipients)
+	GetCompanyUUIDSmsCampaignsEntityUUIDRecipients(ctx context.Context, request GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsRequestObject) (GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsResponseObject, error)
+
+	// (POST /company/{UUID}/sms/campaigns/{entityUUID}/start)
+	PostCompanyUUIDSmsCampaignsEntityUUIDStart(ctx context.Context, request PostCompanyUUIDSmsCampaignsEntityUUIDStartRequestObject) (PostCompanyUUIDSmsCampaignsEntityUUIDStartResponseObject, error)
+
+	// (POST /company/{UUID}/sms/cost)
+	PostCompanyUUIDSmsCost(ctx context.Context, request PostCompanyUUIDSmsCostRequestObject) (PostCompanyUUIDSmsCostResponseObject, error)
+
+	// (POST /company/{UUID}/sms/options)
+	PostCompanyUUIDSmsOptions(ctx context.Context, request PostCompanyUUIDSmsOptionsRequestObject) (PostCompanyUUIDSmsOptionsResponseObject, error)
+
+	// (POST /company/{UUID}/sms/send)
+	PostCompanyUUIDSmsSend(ctx context.Context, request PostCompanyUUIDSmsSendRequestObject) (PostCompanyUUIDSmsSendResponseObject, error)
+
+	// (GET /company/{UUID}/sms/templates)
+	GetCompanyUUIDSmsTemplates(ctx context.Context, request GetCompanyUUIDSmsTemplatesRequestObject) (GetCompanyUUIDSmsTemplatesResponseObject, error)
+
+	// (POST /company/{UUID}/sms/templates)
+	PostCompanyUUIDSmsTemplates(ctx context.Context, request PostCompanyUUIDSmsTemplatesRequestObject) (PostCompanyUUIDSmsTemplatesResponseObject, error)
+
+	// (DELETE /company/{UUID}/sms/templates/{entityUUID})
+	DeleteCompanyUUIDSmsTemplatesEntityUUID(ctx context.Context, request DeleteCompanyUUIDSmsTemplatesEntityUUIDRequestObject) (DeleteCompanyUUIDSmsTemplatesEntityUUIDResponseObject, error)
+
+	// (PUT /company/{UUID}/sms/templates/{entityUUID})
+	PutCompanyUUIDSmsTemplatesEntityUUID(ctx context.Context, request PutCompanyUUIDSmsTemplatesEntityUUIDRequestObject) (PutCompanyUUIDSmsTemplatesEntityUUIDResponseObject, error)
+
+	// (POST /company/{UUID}/user)
+	PostCompanyUUIDUser(ctx context.Context, request PostCompanyUUIDUserRequestObject) (PostCompanyUUIDUserResponseObject, error)
+
+	// (DELETE /company/{UUID}/user/{userUUID})
+	DeleteCompanyUUIDUserUserUUID(ctx context.Context, request DeleteCompanyUUIDUserUserUUIDRequestObject) (DeleteCompanyUUIDUserUserUUIDResponseObject, error)
+
+	// (POST /federation)
+	PostFederation(ctx context.Context, request PostFederationRequestObject) (PostFederationResponseObject, error)
+
+	// (DELETE /federation/{UUID})
+	DeleteFederationUUID(ctx context.Context, request DeleteFederationUUIDRequestObject) (DeleteFederationUUIDResponseObject, error)
+
+	// (GET /federation/{UUID})
+	GetFederationUUID(ctx context.Context, request GetFederationUUIDRequestObject) (GetFederationUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/agent)
+	GetFederationUUIDAgent(ctx context.Context, request GetFederationUUIDAgentRequestObject) (GetFederationUUIDAgentResponseObject, error)
+
+	// (POST /federation/{UUID}/agent)
+	PostFederationUUIDAgent(ctx context.Context, request PostFederationUUIDAgentRequestObject) (PostFederationUUIDAgentResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/duplicates)
+	GetFederationUUIDAgentDuplicates(ctx context.Context, request GetFederationUUIDAgentDuplicatesRequestObject) (GetFederationUUIDAgentDuplicatesResponseObject, error)
+
+	// (DELETE /federation/{UUID}/agent/{entityUUID})
+	DeleteFederationUUIDAgentEntityUUID(ctx context.Context, request DeleteFederationUUIDAgentEntityUUIDRequestObject) (DeleteFederationUUIDAgentEntityUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/{entityUUID})
+	GetFederationUUIDAgentEntityUUID(ctx context.Context, request GetFederationUUIDAgentEntityUUIDRequestObject) (GetFederationUUIDAgentEntityUUIDResponseObject, error)
+
+	// (PATCH /federation/{UUID}/agent/{entityUUID})
+	PatchFederationUUIDAgentEntityUUID(ctx context.Context, request PatchFederationUUIDAgentEntityUUIDRequestObject) (PatchFederationUUIDAgentEntityUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/activity)
+	GetFederationUUIDAgentEntityUUIDActivity(ctx context.Context, request GetFederationUUIDAgentEntityUUIDActivityRequestObject) (GetFederationUUIDAgentEntityUUIDActivityResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/comments)
+	GetFederationUUIDAgentEntityUUIDComments(ctx context.Context, request GetFederationUUIDAgentEntityUUIDCommentsRequestObject) (GetFederationUUIDAgentEntityUUIDCommentsResponseObject, error)
+
+	// (POST /federation/{UUID}/agent/{entityUUID}/comments)
+	PostFederationUUIDAgentEntityUUIDComments(ctx context.Context, request PostFederationUUIDAgentEntityUUIDCommentsRequestObject) (PostFederationUUIDAgentEntityUUIDCommentsResponseObject, error)
+
+	// (DELETE /federation/{UUID}/agent/{entityUUID}/comments/{commentUUID})
+	DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID(ctx context.Context, request DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDRequestObject) (DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/duplicates)
+	GetFederationUUIDAgentEntityUUIDDuplicates(ctx context.Context, request GetFederationUUIDAgentEntityUUIDDuplicatesRequestObject) (GetFederationUUIDAgentEntityUUIDDuplicatesResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/files)
+	GetFederationUUIDAgentEntityUUIDFiles(ctx context.Context, request GetFederationUUIDAgentEntityUUIDFilesRequestObject) (GetFederationUUIDAgentEntityUUIDFilesResponseObject, error)
+
+	// (POST /federation/{UUID}/agent/{entityUUID}/files)
+	PostFederationUUIDAgentEntityUUIDFiles(ctx context.Context, request PostFederationUUIDAgentEntityUUIDFilesRequestObject) (PostFederationUUIDAgentEntityUUIDFilesResponseObject, error)
+
+	// (DELETE /federation/{UUID}/agent/{entityUUID}/files/{fileUUID})
+	DeleteFederationUUIDAgentEntityUUIDFilesFileUUID(ctx context.Context, request DeleteFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject) (DeleteFederationUUIDAgentEntityUUIDFilesFileUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/files/{fileUUID})
+	GetFederationUUIDAgentEntityUUIDFilesFileUUID(ctx context.Context, request GetFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject) (GetFederationUUIDAgentEntityUUIDFilesFileUUIDResponseObject, error)
+
+	// (PUT /federation/{UUID}/agent/{entityUUID}/legal_entities)
+	PutFederationUUIDAgentEntityUUIDLegalEntities(ctx context.Context, request PutFederationUUIDAgentEntityUUIDLegalEntitiesRequestObject) (PutFederationUUIDAgentEntityUUIDLegalEntitiesResponseObject, error)
+
+	// (POST /federation/{UUID}/agent/{entityUUID}/merge)
+	PostFederationUUIDAgentEntityUUIDMerge(ctx context.Context, request PostFederationUUIDAgentEntityUUIDMergeRequestObject) (PostFederationUUIDAgentEntityUUIDMergeResponseObject, error)
+
+	// (PUT /federation/{UUID}/agent/{entityUUID}/tasks)
+	PutFederationUUIDAgentEntityUUIDTasks(ctx context.Context, request PutFederationUUIDAgentEntityUUIDTasksRequestObject) (PutFederationUUIDAgentEntityUUIDTasksResponseObject, error)
+
+	// (GET /federation/{UUID}/agent/{entityUUID}/timeline)
+	GetFederationUUIDAgentEntityUUIDTimeline(ctx context.Context, request GetFederationUUIDAgentEntityUUIDTimelineRequestObject) (GetFederationUUIDAgentEntityUUIDTimelineResponseObject, error)
+
+	// (GET /federation/{UUID}/email/branding)
+	GetFederationUUIDEmailBranding(ctx context.Context, request GetFederationUUIDEmailBrandingRequestObject) (GetFederationUUIDEmailBrandingResponseObject, error)
+
+	// (PUT /federation/{UUID}/email/branding)
+	PutFederationUUIDEmailBranding(ctx context.Context, request PutFederationUUIDEmailBrandingRequestObject) (PutFederationUUIDEmailBrandingResponseObject, error)
+
+	// (GET /federation/{UUID}/email/templates)
+	GetFederationUUIDEmailTemplates(ctx context.Context, request GetFederationUUIDEmailTemplatesRequestObject) (GetFederationUUIDEmailTemplatesResponseObject, error)
+
+	// (DELETE /federation/{UUID}/email/templates/{entityName})
+	DeleteFederationUUIDEmailTemplatesEntityName(ctx context.Context, request DeleteFederationUUIDEmailTemplatesEntityNameRequestObject) (DeleteFederationUUIDEmailTemplatesEntityNameResponseObject, error)
+
+	// (PUT /federation/{UUID}/email/templates/{entityName})
+	PutFederationUUIDEmailTemplatesEntityName(ctx context.Context, request PutFederationUUIDEmailTemplatesEntityNameRequestObject) (PutFederationUUIDEmailTemplatesEntityNameResponseObject, error)
+
+	// (POST /federation/{UUID}/email/templates/{entityName}/preview)
+	PostFederationUUIDEmailTemplatesEntityNamePreview(ctx context.Context, request PostFederationUUIDEmailTemplatesEntityNamePreviewRequestObject) (PostFederationUUIDEmailTemplatesEntityNamePreviewResponseObject, error)
+
+	// (GET /federation/{UUID}/invite)
+	GetFederationUUIDInvite(ctx context.Context, request GetFederationUUIDInviteRequestObject) (GetFederationUUIDInviteResponseObject, error)
+
+	// (POST /federation/{UUID}/invite)
+	PostFederationUUIDInvite(ctx context.Context, request PostFederationUUIDInviteRequestObject) (PostFederationUUIDInviteResponseObject, error)
+
+	// (DELETE /federation/{UUID}/invite/{entityUUID})
+	DeleteFederationUUIDInviteEntityUUID(ctx context.Context, request DeleteFederationUUIDInviteEntityUUIDRequestObject) (DeleteFederationUUIDInviteEntityUUIDResponseObject, error)
+
+	// (PATCH /federation/{UUID}/name)
+	PatchFederationUUIDName(ctx context.Context, request PatchFederationUUIDNameRequestObject) (PatchFederationUUIDNameResponseObject, error)
+
+	// (GET /federation/{UUID}/project)
+	GetFederationUUIDProject(ctx context.Context, request GetFederationUUIDProjectRequestObject) (GetFederationUUIDProjectResponseObject, error)
+
+	// (POST /federation/{UUID}/user)
+	PostFederationUUIDUser(ctx context.Context, request PostFederationUUIDUserRequestObject) (PostFederationUUIDUserResponseObject, error)
+
+	// (DELETE /federation/{UUID}/user/{userUUID})
+	DeleteFederationUUIDUserUserUUID(ctx context.Context, request DeleteFederationUUIDUserUserUUIDRequestObject) (DeleteFederationUUIDUserUserUUIDResponseObject, error)
+
+	// (DELETE /group/{UUID}/user)
+	DeleteGroupUUIDUser(ctx context.Context, request DeleteGroupUUIDUserRequestObject) (DeleteGroupUUIDUserResponseObject, error)
+
+	// (GET /group/{UUID}/user)
+	GetGroupUUIDUser(ctx context.Context, request GetGroupUUIDUserRequestObject) (GetGroupUUIDUserResponseObject, error)
+
+	// (POST /group/{UUID}/user)
+	PostGroupUUIDUser(ctx context.Context, request PostGroupUUIDUserRequestObject) (PostGroupUUIDUserResponseObject, error)
+	// Delete user by id
+	// (DELETE /legal_entities)
+	DeleteLegalEntities(ctx context.Context, request DeleteLegalEntitiesRequestObject) (DeleteLegalEntitiesResponseObject, error)
+	// Get All Bank Accounts
+	// (GET /legal_entities)
+	GetLegalEntities(ctx context.Context, request GetLegalEntitiesRequestObject) (GetLegalEntitiesResponseObject, error)
+	// Change users data
+	// (PATCH /legal_entities)
+	PatchLegalEntities(ctx context.Context, request PatchLegalEntitiesRequestObject) (PatchLegalEntitiesResponseObject, error)
+	// Create new Bank Account
+	// (POST /legal_entities)
+	PostLegalEntities(ctx context.Context, request PostLegalEntitiesRequestObject) (PostLegalEntitiesResponseObject, error)
+
+	// (POST /permissions)
+	PostPermissions(ctx context.Context, request PostPermissionsRequestObject) (PostPermissionsResponseObject, error)
+
+	// (DELETE /permissions/{UUID})
+	DeletePermissionsUUID(ctx context.Context, request DeletePermissionsUUIDRequestObject) (DeletePermissionsUUIDResponseObject, error)
+
+	// (GET /permissions/{UUID})
+	GetPermissionsUUID(ctx context.Context, request GetPermissionsUUIDRequestObject) (GetPermissionsUUIDResponseObject, error)
+
+	// (POST /profile/survey)
+	PostProfileSurvey(ctx context.Context, request PostProfileSurveyRequestObject) (PostProfileSurveyResponseObject, error)
+
+	// (DELETE /profile/survey/{UUID})
+	DeleteProfileSurveyUUID(ctx context.Context, request DeleteProfileSurveyUUIDRequestObject) (DeleteProfileSurveyUUIDResponseObject, error)
+
+	// (GET /profile/survey/{UUID})
+	GetProfileSurveyUUID(ctx context.Context, request GetProfileSurveyUUIDRequestObject) (GetProfileSurveyUUIDResponseObject, error)
+
+	// (POST /project)
+	PostProject(ctx context.Context, request PostProjectRequestObject) (PostProjectResponseObject, error)
+
+	// (DELETE /project/{UUID})
+	DeleteProjectUUID(ctx context.Context, request DeleteProjectUUIDRequestObject) (DeleteProjectUUIDResponseObject, error)
+
+	// (GET /project/{UUID})
+	GetProjectUUID(ctx context.Context, request GetProjectUUIDRequestObject) (GetProjectUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID})
+	PatchProjectUUID(ctx context.Context, request PatchProjectUUIDRequestObject) (PatchProjectUUIDResponseObject, error)
+
+	// (GET /project/{UUID}/catalog)
+	GetProjectUUIDCatalog(ctx context.Context, request GetProjectUUIDCatalogRequestObject) (GetProjectUUIDCatalogResponseObject, error)
+
+	// (POST /project/{UUID}/catalog)
+	PostProjectUUIDCatalog(ctx context.Context, request PostProjectUUIDCatalogRequestObject) (PostProjectUUIDCatalogResponseObject, error)
+
+	// (GET /project/{UUID}/catalog/{entityName})
+	GetProjectUUIDCatalogEntityName(ctx context.Context, request GetProjectUUIDCatalogEntityNameRequestObject) (GetProjectUUIDCatalogEntityNameResponseObject, error)
+
+	// (DELETE /project/{UUID}/catalog/{entityUUID})
+	DeleteProjectUUIDCatalogEntityUUID(ctx context.Context, request DeleteProjectUUIDCatalogEntityUUIDRequestObject) (DeleteProjectUUIDCatalogEntityUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID}/description)
+	PatchProjectUUIDDescription(ctx context.Context, request PatchProjectUUIDDescriptionRequestObject) (PatchProjectUUIDDescriptionResponseObject, error)
+
+	// (GET /project/{UUID}/email)
+	GetProjectUUIDEmail(ctx context.Context, request GetProjectUUIDEmailRequestObject) (GetProjectUUIDEmailResponseObject, error)
+
+	// (DELETE /project/{UUID}/field/{entityUUID})
+	DeleteProjectUUIDFieldEntityUUID(ctx context.Context, request DeleteProjectUUIDFieldEntityUUIDRequestObject) (DeleteProjectUUIDFieldEntityUUIDResponseObject, error)
+
+	// (POST /project/{UUID}/field/{entityUUID})
+	PostProjectUUIDFieldEntityUUID(ctx context.Context, request PostProjectUUIDFieldEntityUUIDRequestObject) (PostProjectUUIDFieldEntityUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID}/graph)
+	PatchProjectUUIDGraph(ctx context.Context, request PatchProjectUUIDGraphRequestObject) (PatchProjectUUIDGraphResponseObject, error)
+
+	// (PATCH /project/{UUID}/name)
+	PatchProjectUUIDName(ctx context.Context, request PatchProjectUUIDNameRequestObject) (PatchProjectUUIDNameResponseObject, error)
+
+	// (PATCH /project/{UUID}/options)
+	PatchProjectUUIDOptions(ctx context.Context, request PatchProjectUUIDOptionsRequestObject) (PatchProjectUUIDOptionsResponseObject, error)
+
+	// (GET /project/{UUID}/status)
+	GetProjectUUIDStatus(ctx context.Context, request GetProjectUUIDStatusRequestObject) (GetProjectUUIDStatusResponseObject, error)
+
+	// (POST /project/{UUID}/status)
+	PostProjectUUIDStatus(ctx context.Context, request PostProjectUUIDStatusRequestObject) (PostProjectUUIDStatusResponseObject, error)
+
+	// (DELETE /project/{UUID}/status/{entityUUID})
+	DeleteProjectUUIDStatusEntityUUID(ctx context.Context, request DeleteProjectUUIDStatusEntityUUIDRequestObject) (DeleteProjectUUIDStatusEntityUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID}/status/{entityUUID})
+	PatchProjectUUIDStatusEntityUUID(ctx context.Context, request PatchProjectUUIDStatusEntityUUIDRequestObject) (PatchProjectUUIDStatusEntityUUIDResponseObject, error)
+
+	// (POST /project/{UUID}/user)
+	PostProjectUUIDUser(ctx context.Context, request PostProjectUUIDUserRequestObject) (PostProjectUUIDUserResponseObject, error)
+
+	// (DELETE /project/{UUID}/user/{userUUID})
+	DeleteProjectUUIDUserUserUUID(ctx context.Context, request DeleteProjectUUIDUserUserUUIDRequestObject) (DeleteProjectUUIDUserUserUUIDResponseObject, error)
+
+	// (GET /tag)
+	GetTag(ctx context.Context, request GetTagRequestObject) (GetTagResponseObject, error)
+
+	// (POST /tag)
+	PostTag(ctx context.Context, request PostTagRequestObject) (PostTagResponseObject, error)
+
+	// (DELETE /tag/{UUID})
+	DeleteTagUUID(ctx context.Context, request DeleteTagUUIDRequestObject) (DeleteTagUUIDResponseObject, error)
+
+	// (PATCH /tag/{UUID})
+	PatchTagUUID(ctx context.Context, request PatchTagUUIDRequestObject) (PatchTagUUIDResponseObject, error)
+
+	// (GET /user)
+	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// PostCompany operation middleware
+func (sh *strictHandler) PostCompany(ctx echo.Context) error {
+	var request PostCompanyRequestObject
+
+	var body PostCompanyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompany(ctx.Request().Context(), request.(PostCompanyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompany")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyResponseObject); ok {
+		return validResponse.VisitPostCompanyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCompanyUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteCompanyUUIDRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUID operation middleware
+func (sh *strictHandler) GetCompanyUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUID(ctx.Request().Context(), request.(GetCompanyUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDDeals operation middleware
+func (sh *strictHandler) GetCompanyUUIDDeals(ctx echo.Context, uUID Uuid, params GetCompanyUUIDDealsParams) error {
+	var request GetCompanyUUIDDealsRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDDeals(ctx.Request().Context(), request.(GetCompanyUUIDDealsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDDeals")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDDealsResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDDealsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCompanyUUIDDeals operation middleware
+func (sh *strictHandler) PostCompanyUUIDDeals(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDDealsRequestObject
+
+	request.UUID = uUID
+
+	var body PostCompanyUUIDDealsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDDeals(ctx.Request().Context(), request.(PostCompanyUUIDDealsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDDeals")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDDealsResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDDealsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDDealsForecast operation middleware
+func (sh *strictHandler) GetCompanyUUIDDealsForecast(ctx echo.Context, uUID Uuid, params GetCompanyUUIDDealsForecastParams) error {
+	var request GetCompanyUUIDDealsForecastRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDDealsForecast(ctx.Request().Context(), request.(GetCompanyUUIDDealsForecastRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDDealsForecast")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDDealsForecastResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDDealsForecastResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDDealsPipelines operation middleware
+func (sh *strictHandler) GetCompanyUUIDDealsPipelines(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDDealsPipelinesRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDDealsPipelines(ctx.Request().Context(), request.(GetCompanyUUIDDealsPipelinesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDDealsPipelines")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDDealsPipelinesResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDDealsPipelinesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCompanyUUIDDealsPipelines operation middleware
+func (sh *strictHandler) PostCompanyUUIDDealsPipelines(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDDealsPipelinesRequestObject
+
+	request.UUID = uUID
+
+	var body PostCompanyUUIDDealsPipelinesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDDealsPipelines(ctx.Request().Context(), request.(PostCompanyUUIDDealsPipelinesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDDealsPipelines")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDDealsPipelinesResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDDealsPipelinesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCompanyUUIDDealsPipelinesEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDDealsPipelinesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDDealsPipelinesEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDDealsPipelinesEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDDealsPipelinesEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDDealsPipelinesEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDDealsPipelinesEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDDealsPipelinesEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutCompanyUUIDDealsPipelinesEntityUUID operation middleware
+func (sh *strictHandler) PutCompanyUUIDDealsPipelinesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutCompanyUUIDDealsPipelinesEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PutCompanyUUIDDealsPipelinesEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PatchProjectUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDJSONRequestBody
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCompanyUUIDDealsPipelinesEntityUUID(ctx.Request().Context(), request.(PutCompanyUUIDDealsPipelinesEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCompanyUUIDDealsPipelinesEntityUUID")
+	}
 
-type PatchProjectUUIDResponseObject interface {
-	VisitPatchProjectUUIDResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type PatchProjectUUID200Response struct {
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutCompanyUUIDDealsPipelinesEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutCompanyUUIDDealsPipelinesEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response PatchProjectUUID200Response) VisitPatchProjectUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+// DeleteCompanyUUIDDealsEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDDealsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDDealsEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDDealsEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDDealsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDDealsEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDDealsEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDDealsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetProjectUUIDCatalogRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// GetCompanyUUIDDealsEntityUUID operation middleware
+func (sh *strictHandler) GetCompanyUUIDDealsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetCompanyUUIDDealsEntityUUIDRequestObject
 
-type GetProjectUUIDCatalogResponseObject interface {
-	VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type GetProjectUUIDCatalog200JSONResponse struct {
-	Count int                     `json:"count"`
-	Items []ProjectCatalogDataDTO `json:"items"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDDealsEntityUUID(ctx.Request().Context(), request.(GetCompanyUUIDDealsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDDealsEntityUUID")
+	}
 
-func (response GetProjectUUIDCatalog200JSONResponse) VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDDealsEntityUUIDResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDDealsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostProjectUUIDCatalogRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostProjectUUIDCatalogJSONRequestBody
-}
+// PutCompanyUUIDDealsEntityUUID operation middleware
+func (sh *strictHandler) PutCompanyUUIDDealsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutCompanyUUIDDealsEntityUUIDRequestObject
 
-type PostProjectUUIDCatalogResponseObject interface {
-	VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type PostProjectUUIDCatalog200JSONResponse struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-}
+	var body PutCompanyUUIDDealsEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response PostProjectUUIDCatalog200JSONResponse) VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCompanyUUIDDealsEntityUUID(ctx.Request().Context(), request.(PutCompanyUUIDDealsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCompanyUUIDDealsEntityUUID")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type GetProjectUUIDCatalogEntityNameRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityName EntityName `json:"entityName"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutCompanyUUIDDealsEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutCompanyUUIDDealsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetProjectUUIDCatalogEntityNameResponseObject interface {
-	VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error
-}
+// GetCompanyUUIDDealsEntityUUIDActivity operation middleware
+func (sh *strictHandler) GetCompanyUUIDDealsEntityUUIDActivity(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetCompanyUUIDDealsEntityUUIDActivityParams) error {
+	var request GetCompanyUUIDDealsEntityUUIDActivityRequestObject
 
-type GetProjectUUIDCatalogEntityName200JSONResponse struct {
-	Count int                     `json:"count"`
-	Items []ProjectCatalogDataDTO `json:"items"`
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.Params = params
 
-func (response GetProjectUUIDCatalogEntityName200JSONResponse) VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDDealsEntityUUIDActivity(ctx.Request().Context(), request.(GetCompanyUUIDDealsEntityUUIDActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDDealsEntityUUIDActivity")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type DeleteProjectUUIDCatalogEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDDealsEntityUUIDActivityResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDDealsEntityUUIDActivityResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteProjectUUIDCatalogEntityUUIDResponseObject interface {
-	VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error
-}
+// PutCompanyUUIDDealsEntityUUIDStage operation middleware
+func (sh *strictHandler) PutCompanyUUIDDealsEntityUUIDStage(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutCompanyUUIDDealsEntityUUIDStageRequestObject
 
-type DeleteProjectUUIDCatalogEntityUUID200Response struct {
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-func (response DeleteProjectUUIDCatalogEntityUUID200Response) VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	var body PutCompanyUUIDDealsEntityUUIDStageJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCompanyUUIDDealsEntityUUIDStage(ctx.Request().Context(), request.(PutCompanyUUIDDealsEntityUUIDStageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCompanyUUIDDealsEntityUUIDStage")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutCompanyUUIDDealsEntityUUIDStageResponseObject); ok {
+		return validResponse.VisitPutCompanyUUIDDealsEntityUUIDStageResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchProjectUUIDDescriptionRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDDescriptionJSONRequestBody
-}
+// GetCompanyUUIDFields operation middleware
+func (sh *strictHandler) GetCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDFieldsRequestObject
 
-type PatchProjectUUIDDescriptionResponseObject interface {
-	VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type PatchProjectUUIDDescription200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDFields(ctx.Request().Context(), request.(GetCompanyUUIDFieldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDFields")
+	}
 
-func (response PatchProjectUUIDDescription200Response) VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDFieldsResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDFieldsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type DeleteProjectUUIDFieldEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+// PostCompanyUUIDFields operation middleware
+func (sh *strictHandler) PostCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDFieldsRequestObject
 
-type DeleteProjectUUIDFieldEntityUUIDResponseObject interface {
-	VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type DeleteProjectUUIDFieldEntityUUID200Response struct {
-}
+	var body PostCompanyUUIDFieldsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response DeleteProjectUUIDFieldEntityUUID200Response) VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDFields(ctx.Request().Context(), request.(PostCompanyUUIDFieldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDFields")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDFieldsResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDFieldsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PostProjectUUIDFieldEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PostProjectUUIDFieldEntityUUIDJSONRequestBody
+// DeleteCompanyUUIDFieldsEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDFieldsEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDFieldsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDFieldsEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDFieldsEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostProjectUUIDFieldEntityUUIDResponseObject interface {
-	VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
-}
+// PutCompanyUUIDFieldsEntityUUID operation middleware
+func (sh *strictHandler) PutCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutCompanyUUIDFieldsEntityUUIDRequestObject
 
-type PostProjectUUIDFieldEntityUUID200Response struct {
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-func (response PostProjectUUIDFieldEntityUUID200Response) VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	var body PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PatchProjectUUIDGraphRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDGraphJSONRequestBody
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(PutCompanyUUIDFieldsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCompanyUUIDFieldsEntityUUID")
+	}
 
-type PatchProjectUUIDGraphResponseObject interface {
-	VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutCompanyUUIDFieldsEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PatchProjectUUIDGraph200JSONResponse map[string]interface{}
+// GetCompanyUUIDGroup operation middleware
+func (sh *strictHandler) GetCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDGroupRequestObject
 
-func (response PatchProjectUUIDGraph200JSONResponse) VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.UUID = uUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDGroup(ctx.Request().Context(), request.(GetCompanyUUIDGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDGroup")
+	}
 
-type PatchProjectUUIDNameRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDNameJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PatchProjectUUIDNameResponseObject interface {
-	VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDGroupResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PatchProjectUUIDName200Response struct {
-}
+// PostCompanyUUIDGroup operation middleware
+func (sh *strictHandler) PostCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDGroupRequestObject
 
-func (response PatchProjectUUIDName200Response) VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.UUID = uUID
 
-type PatchProjectUUIDOptionsRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDOptionsJSONRequestBody
-}
+	var body PostCompanyUUIDGroupJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PatchProjectUUIDOptionsResponseObject interface {
-	VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDGroup(ctx.Request().Context(), request.(PostCompanyUUIDGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDGroup")
+	}
 
-type PatchProjectUUIDOptions200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PatchProjectUUIDOptions200Response) VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDGroupResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetProjectUUIDStatusRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
-
-type GetProjectUUIDStatusResponseObject interface {
-	VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error
-}
+// DeleteCompanyUUIDGroupEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDGroupEntityUUIDRequestObject
 
-type GetProjectUUIDStatus200JSONResponse struct {
-	Count int                `json:"count"`
-	Items []ProjectStatusDTO `json:"items"`
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-func (response GetProjectUUIDStatus200JSONResponse) VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDGroupEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDGroupEntityUUID")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type PostProjectUUIDStatusRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostProjectUUIDStatusJSONRequestBody
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDGroupEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostProjectUUIDStatusResponseObject interface {
-	VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error
-}
+// PatchCompanyUUIDGroupEntityUUID operation middleware
+func (sh *strictHandler) PatchCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchCompanyUUIDGroupEntityUUIDRequestObject
 
-type PostProjectUUIDStatus200JSONResponse UUIDResponse
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-func (response PostProjectUUIDStatus200JSONResponse) VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var body PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDGroupEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDGroupEntityUUID")
+	}
 
-type DeleteProjectUUIDStatusEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+	response, err := handler(ctx, request)
 
-type DeleteProjectUUIDStatusEntityUUIDResponseObject interface {
-	VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDGroupEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteProjectUUIDStatusEntityUUID200Response struct {
-}
+// PatchCompanyUUIDName operation middleware
+func (sh *strictHandler) PatchCompanyUUIDName(ctx echo.Context, uUID Uuid) error {
+	var request PatchCompanyUUIDNameRequestObject
 
-func (response DeleteProjectUUIDStatusEntityUUID200Response) VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.UUID = uUID
 
-type PatchProjectUUIDStatusEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchProjectUUIDStatusEntityUUIDJSONRequestBody
-}
+	var body PatchCompanyUUIDNameJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PatchProjectUUIDStatusEntityUUIDResponseObject interface {
-	VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDName(ctx.Request().Context(), request.(PatchCompanyUUIDNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDName")
+	}
 
-type PatchProjectUUIDStatusEntityUUID200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PatchProjectUUIDStatusEntityUUID200Response) VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDNameResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PostProjectUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostProjectUUIDUserJSONRequestBody
-}
+// GetCompanyUUIDPriorities operation middleware
+func (sh *strictHandler) GetCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDPrioritiesRequestObject
 
-type PostProjectUUIDUserResponseObject interface {
-	VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDPriorities(ctx.Request().Context(), request.(GetCompanyUUIDPrioritiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDPriorities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDPrioritiesResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostProjectUUIDUser200JSONResponse UUIDResponse
-
-func (response PostProjectUUIDUser200JSONResponse) VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostCompanyUUIDPriorities operation middleware
+func (sh *strictHandler) PostCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDPrioritiesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.UUID = uUID
 
-type DeleteProjectUUIDUserUserUUIDRequestObject struct {
-	UUID     Uuid     `json:"UUID"`
-	UserUUID UserUUID `json:"userUUID"`
-}
+	var body PostCompanyUUIDPrioritiesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type DeleteProjectUUIDUserUserUUIDResponseObject interface {
-	VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDPriorities(ctx.Request().Context(), request.(PostCompanyUUIDPrioritiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDPriorities")
+	}
 
-type DeleteProjectUUIDUserUserUUID200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response DeleteProjectUUIDUserUserUUID200Response) VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDPrioritiesResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetTagRequestObject struct {
-	Params GetTagParams
-}
+// DeleteCompanyUUIDPrioritiesEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject
 
-type GetTagResponseObject interface {
-	VisitGetTagResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type GetTag200JSONResponse struct {
-	Count int      `json:"count"`
-	Items []TagDTO `json:"items"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDPrioritiesEntityUUID")
+	}
 
-func (response GetTag200JSONResponse) VisitGetTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostTagRequestObject struct {
-	Body *PostTagJSONRequestBody
-}
+// PatchCompanyUUIDPrioritiesEntityUUID operation middleware
+func (sh *strictHandler) PatchCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject
 
-type PostTagResponseObject interface {
-	VisitPostTagResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type PostTag200JSONResponse UUIDResponse
+	var body PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response PostTag200JSONResponse) VisitPostTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDPrioritiesEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDPrioritiesEntityUUID")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type DeleteTagUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteTagUUIDResponseObject interface {
-	VisitDeleteTagUUIDResponse(w http.ResponseWriter) error
-}
+// GetCompanyUUIDProjectCatalogEntityName operation middleware
+func (sh *strictHandler) GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
+	var request GetCompanyUUIDProjectCatalogEntityNameRequestObject
 
-type DeleteTagUUID200Response struct {
-}
+	request.UUID = uUID
+	request.EntityName = entityName
 
-func (response DeleteTagUUID200Response) VisitDeleteTagUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDProjectCatalogEntityName(ctx.Request().Context(), request.(GetCompanyUUIDProjectCatalogEntityNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDProjectCatalogEntityName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDProjectCatalogEntityNameResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDProjectCatalogEntityNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchTagUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchTagUUIDJSONRequestBody
-}
+// GetCompanyUUIDSms operation middleware
+func (sh *strictHandler) GetCompanyUUIDSms(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsParams) error {
+	var request GetCompanyUUIDSmsRequestObject
 
-type PatchTagUUIDResponseObject interface {
-	VisitPatchTagUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.Params = params
 
-type PatchTagUUID200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDSms(ctx.Request().Context(), request.(GetCompanyUUIDSmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDSms")
+	}
 
-func (response PatchTagUUID200Response) VisitPatchTagUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDSmsResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDSmsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetUserRequestObject struct {
-	Body *GetUserJSONRequestBody
-}
+// GetCompanyUUIDSmsCampaigns operation middleware
+func (sh *strictHandler) GetCompanyUUIDSmsCampaigns(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsCampaignsParams) error {
+	var request GetCompanyUUIDSmsCampaignsRequestObject
 
-type GetUserResponseObject interface {
-	VisitGetUserResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.Params = params
 
-type GetUser200JSONResponse struct {
-	Count int       `json:"count"`
-	Items []UserDTO `json:"items"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDSmsCampaigns(ctx.Request().Context(), request.(GetCompanyUUIDSmsCampaignsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDSmsCampaigns")
+	}
 
-func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDSmsCampaignsResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDSmsCampaignsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-
-	// (POST /company)
-	PostCompany(ctx context.Context, request PostCompanyRequestObject) (PostCompanyResponseObject, error)
-
-	// (DELETE /company/{UUID})
-	DeleteCompanyUUID(ctx context.Context, request DeleteCompanyUUIDRequestObject) (DeleteCompanyUUIDResponseObject, error)
+// PostCompanyUUIDSmsCampaigns operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsCampaigns(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDSmsCampaignsRequestObject
 
-	// (GET /company/{UUID})
-	GetCompanyUUID(ctx context.Context, request GetCompanyUUIDRequestObject) (GetCompanyUUIDResponseObject, error)
+	request.UUID = uUID
 
-	// (GET /company/{UUID}/fields)
-	GetCompanyUUIDFields(ctx context.Context, request GetCompanyUUIDFieldsRequestObject) (GetCompanyUUIDFieldsResponseObject, error)
+	var body PostCompanyUUIDSmsCampaignsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (POST /company/{UUID}/fields)
-	PostCompanyUUIDFields(ctx context.Context, request PostCompanyUUIDFieldsRequestObject) (PostCompanyUUIDFieldsResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsCampaigns(ctx.Request().Context(), request.(PostCompanyUUIDSmsCampaignsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsCampaigns")
+	}
 
-	// (DELETE /company/{UUID}/fields/{entityUUID})
-	DeleteCompanyUUIDFieldsEntityUUID(ctx context.Context, request DeleteCompanyUUIDFieldsEntityUUIDRequestObject) (DeleteCompanyUUIDFieldsEntityUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (PUT /company/{UUID}/fields/{entityUUID})
-	PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request PutCompanyUUIDFieldsEntityUUIDRequestObject) (PutCompanyUUIDFieldsEntityUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsCampaignsResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsCampaignsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (GET /company/{UUID}/group)
-	GetCompanyUUIDGroup(ctx context.Context, request GetCompanyUUIDGroupRequestObject) (GetCompanyUUIDGroupResponseObject, error)
+// GetCompanyUUIDSmsCampaignsEntityUUID operation middleware
+func (sh *strictHandler) GetCompanyUUIDSmsCampaignsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetCompanyUUIDSmsCampaignsEntityUUIDRequestObject
 
-	// (POST /company/{UUID}/group)
-	PostCompanyUUIDGroup(ctx context.Context, request PostCompanyUUIDGroupRequestObject) (PostCompanyUUIDGroupResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	// (DELETE /company/{UUID}/group/{entityUUID})
-	DeleteCompanyUUIDGroupEntityUUID(ctx context.Context, request DeleteCompanyUUIDGroupEntityUUIDRequestObject) (DeleteCompanyUUIDGroupEntityUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDSmsCampaignsEntityUUID(ctx.Request().Context(), request.(GetCompanyUUIDSmsCampaignsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDSmsCampaignsEntityUUID")
+	}
 
-	// (PATCH /company/{UUID}/group/{entityUUID})
-	PatchCompanyUUIDGroupEntityUUID(ctx context.Context, request PatchCompanyUUIDGroupEntityUUIDRequestObject) (PatchCompanyUUIDGroupEntityUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (PATCH /company/{UUID}/name)
-	PatchCompanyUUIDName(ctx context.Context, request PatchCompanyUUIDNameRequestObject) (PatchCompanyUUIDNameResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDSmsCampaignsEntityUUIDResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDSmsCampaignsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (GET /company/{UUID}/priorities)
-	GetCompanyUUIDPriorities(ctx context.Context, request GetCompanyUUIDPrioritiesRequestObject) (GetCompanyUUIDPrioritiesResponseObject, error)
+// PostCompanyUUIDSmsCampaignsEntityUUIDCancel operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsCampaignsEntityUUIDCancel(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostCompanyUUIDSmsCampaignsEntityUUIDCancelRequestObject
 
-	// (POST /company/{UUID}/priorities)
-	PostCompanyUUIDPriorities(ctx context.Context, request PostCompanyUUIDPrioritiesRequestObject) (PostCompanyUUIDPrioritiesResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	// (DELETE /company/{UUID}/priorities/{entityUUID})
-	DeleteCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject) (DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsCampaignsEntityUUIDCancel(ctx.Request().Context(), request.(PostCompanyUUIDSmsCampaignsEntityUUIDCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsCampaignsEntityUUIDCancel")
+	}
 
-	// (PATCH /company/{UUID}/priorities/{entityUUID})
-	PatchCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject) (PatchCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /company/{UUID}/project/catalog/{entityName})
-	GetCompanyUUIDProjectCatalogEntityName(ctx context.Context, request GetCompanyUUIDProjectCatalogEntityNameRequestObject) (GetCompanyUUIDProjectCatalogEntityNameResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsCampaignsEntityUUIDCancelResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsCampaignsEntityUUIDCancelResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (GET /company/{UUID}/sms)
-	GetCompanyUUIDSms(ctx context.Context, request GetCompanyUUIDSmsRequestObject) (GetCompanyUUIDSmsResponseObject, error)
+// GetCompanyUUIDSmsCampaignsEntityUUIDCost operation middleware
+func (sh *strictHandler) GetCompanyUUIDSmsCampaignsEntityUUIDCost(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetCompanyUUIDSmsCampaignsEntityUUIDCostRequestObject
 
-	// (POST /company/{UUID}/sms/cost)
-	PostCompanyUUIDSmsCost(ctx context.Context, request PostCompanyUUIDSmsCostRequestObject) (PostCompanyUUIDSmsCostResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	// (POST /company/{UUID}/sms/options)
-	PostCompanyUUIDSmsOptions(ctx context.Context, request PostCompanyUUIDSmsOptionsRequestObject) (PostCompanyUUIDSmsOptionsResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDSmsCampaignsEntityUUIDCost(ctx.Request().Context(), request.(GetCompanyUUIDSmsCampaignsEntityUUIDCostRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDSmsCampaignsEntityUUIDCost")
+	}
 
-	// (POST /company/{UUID}/sms/send)
-	PostCompanyUUIDSmsSend(ctx context.Context, request PostCompanyUUIDSmsSendRequestObject) (PostCompanyUUIDSmsSendResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /company/{UUID}/user)
-	PostCompanyUUIDUser(ctx context.Context, request PostCompanyUUIDUserRequestObject) (PostCompanyUUIDUserResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDSmsCampaignsEntityUUIDCostResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDSmsCampaignsEntityUUIDCostResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (DELETE /company/{UUID}/user/{userUUID})
-	DeleteCompanyUUIDUserUserUUID(ctx context.Context, request DeleteCompanyUUIDUserUserUUIDRequestObject) (DeleteCompanyUUIDUserUserUUIDResponseObject, error)
+// PostCompanyUUIDSmsCampaignsEntityUUIDCsv operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsCampaignsEntityUUIDCsv(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostCompanyUUIDSmsCampaignsEntityUUIDCsvRequestObject
 
-	// (POST /federation)
-	PostFederation(ctx context.Context, request PostFederationRequestObject) (PostFederationResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	// (DELETE /federation/{UUID})
-	DeleteFederationUUID(ctx context.Context, request DeleteFederationUUIDRequestObject) (DeleteFederationUUIDResponseObject, error)
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
 
-	// (GET /federation/{UUID})
-	GetFederationUUID(ctx context.Context, request GetFederationUUIDRequestObject) (GetFederationUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsCampaignsEntityUUIDCsv(ctx.Request().Context(), request.(PostCompanyUUIDSmsCampaignsEntityUUIDCsvRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsCampaignsEntityUUIDCsv")
+	}
 
-	// (GET /federation/{UUID}/agent)
-	GetFederationUUIDAgent(ctx context.Context, request GetFederationUUIDAgentRequestObject) (GetFederationUUIDAgentResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /federation/{UUID}/agent)
-	PostFederationUUIDAgent(ctx context.Context, request PostFederationUUIDAgentRequestObject) (PostFederationUUIDAgentResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsCampaignsEntityUUIDCsvResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsCampaignsEntityUUIDCsvResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (DELETE /federation/{UUID}/agent/{entityUUID})
-	DeleteFederationUUIDAgentEntityUUID(ctx context.Context, request DeleteFederationUUIDAgentEntityUUIDRequestObject) (DeleteFederationUUIDAgentEntityUUIDResponseObject, error)
+// GetCompanyUUIDSmsCampaignsEntityUUIDRecipients operation middleware
+func (sh *strictHandler) GetCompanyUUIDSmsCampaignsEntityUUIDRecipients(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsParams) error {
+	var request GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsRequestObject
 
-	// (PATCH /federation/{UUID}/agent/{entityUUID})
-	PatchFederationUUIDAgentEntityUUID(ctx context.Context, request PatchFederationUUIDAgentEntityUUIDRequestObject) (PatchFederationUUIDAgentEntityUUIDResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.Params = params
 
-	// (GET /federation/{UUID}/invite)
-	GetFederationUUIDInvite(ctx context.Context, request GetFederationUUIDInviteRequestObject) (GetFederationUUIDInviteResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDSmsCampaignsEntityUUIDRecipients(ctx.Request().Context(), request.(GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDSmsCampaignsEntityUUIDRecipients")
+	}
 
-	// (POST /federation/{UUID}/invite)
-	PostFederationUUIDInvite(ctx context.Context, request PostFederationUUIDInviteRequestObject) (PostFederationUUIDInviteResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (DELETE /federation/{UUID}/invite/{entityUUID})
-	DeleteFederationUUIDInviteEntityUUID(ctx context.Context, request DeleteFederationUUIDInviteEntityUUIDRequestObject) (DeleteFederationUUIDInviteEntityUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDSmsCampaignsEntityUUIDRecipientsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (PATCH /federation/{UUID}/name)
-	PatchFederationUUIDName(ctx context.Context, request PatchFederationUUIDNameRequestObject) (PatchFederationUUIDNameResponseObject, error)
+// PostCompanyUUIDSmsCampaignsEntityUUIDStart operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsCampaignsEntityUUIDStart(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostCompanyUUIDSmsCampaignsEntityUUIDStartRequestObject
 
-	// (GET /federation/{UUID}/project)
-	GetFederationUUIDProject(ctx context.Context, request GetFederationUUIDProjectRequestObject) (GetFederationUUIDProjectResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	// (POST /federation/{UUID}/user)
-	PostFederationUUIDUser(ctx context.Context, request PostFederationUUIDUserRequestObject) (PostFederationUUIDUserResponseObject, error)
+	var body PostCompanyUUIDSmsCampaignsEntityUUIDStartJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (DELETE /federation/{UUID}/user/{userUUID})
-	DeleteFederationUUIDUserUserUUID(ctx context.Context, request DeleteFederationUUIDUserUserUUIDRequestObject) (DeleteFederationUUIDUserUserUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsCampaignsEntityUUIDStart(ctx.Request().Context(), request.(PostCompanyUUIDSmsCampaignsEntityUUIDStartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsCampaignsEntityUUIDStart")
+	}
 
-	// (DELETE /group/{UUID}/user)
-	DeleteGroupUUIDUser(ctx context.Context, request DeleteGroupUUIDUserRequestObject) (DeleteGroupUUIDUserResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /group/{UUID}/user)
-	GetGroupUUIDUser(ctx context.Context, request GetGroupUUIDUserRequestObject) (GetGroupUUIDUserResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsCampaignsEntityUUIDStartResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsCampaignsEntityUUIDStartResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /group/{UUID}/user)
-	PostGroupUUIDUser(ctx context.Context, request PostGroupUUIDUserRequestObject) (PostGroupUUIDUserResponseObject, error)
-	// Delete user by id
-	// (DELETE /legal_entities)
-	DeleteLegalEntities(ctx context.Context, request DeleteLegalEntitiesRequestObject) (DeleteLegalEntitiesResponseObject, error)
-	// Get All Bank Accounts
-	// (GET /legal_entities)
-	GetLegalEntities(ctx context.Context, request GetLegalEntitiesRequestObject) (GetLegalEntitiesResponseObject, error)
-	// Change users data
-	// (PATCH /legal_entities)
-	PatchLegalEntities(ctx context.Context, request PatchLegalEntitiesRequestObject) (PatchLegalEntitiesResponseObject, error)
-	// Create new Bank Account
-	// (POST /legal_entities)
-	PostLegalEntities(ctx context.Context, request PostLegalEntitiesRequestObject) (PostLegalEntitiesResponseObject, error)
+// PostCompanyUUIDSmsCost operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsCost(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDSmsCostRequestObject
 
-	// (POST /permissions)
-	PostPermissions(ctx context.Context, request PostPermissionsRequestObject) (PostPermissionsResponseObject, error)
+	request.UUID = uUID
 
-	// (DELETE /permissions/{UUID})
-	DeletePermissionsUUID(ctx context.Context, request DeletePermissionsUUIDRequestObject) (DeletePermissionsUUIDResponseObject, error)
+	var body PostCompanyUUIDSmsCostJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (GET /permissions/{UUID})
-	GetPermissionsUUID(ctx context.Context, request GetPermissionsUUIDRequestObject) (GetPermissionsUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsCost(ctx.Request().Context(), request.(PostCompanyUUIDSmsCostRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsCost")
+	}
 
-	// (POST /profile/survey)
-	PostProfileSurvey(ctx context.Context, request PostProfileSurveyRequestObject) (PostProfileSurveyResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (DELETE /profile/survey/{UUID})
-	DeleteProfileSurveyUUID(ctx context.Context, request DeleteProfileSurveyUUIDRequestObject) (DeleteProfileSurveyUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsCostResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsCostResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (GET /profile/survey/{UUID})
-	GetProfileSurveyUUID(ctx context.Context, request GetProfileSurveyUUIDRequestObject) (GetProfileSurveyUUIDResponseObject, error)
+// PostCompanyUUIDSmsOptions operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsOptions(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDSmsOptionsRequestObject
 
-	// (POST /project)
-	PostProject(ctx context.Context, request PostProjectRequestObject) (PostProjectResponseObject, error)
+	request.UUID = uUID
 
-	// (DELETE /project/{UUID})
-	DeleteProjectUUID(ctx context.Context, request DeleteProjectUUIDRequestObject) (DeleteProjectUUIDResponseObject, error)
+	var body PostCompanyUUIDSmsOptionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (GET /project/{UUID})
-	GetProjectUUID(ctx context.Context, request GetProjectUUIDRequestObject) (GetProjectUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsOptions(ctx.Request().Context(), request.(PostCompanyUUIDSmsOptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsOptions")
+	}
 
-	// (PATCH /project/{UUID})
-	PatchProjectUUID(ctx context.Context, request PatchProjectUUIDRequestObject) (PatchProjectUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /project/{UUID}/catalog)
-	GetProjectUUIDCatalog(ctx context.Context, request GetProjectUUIDCatalogRequestObject) (GetProjectUUIDCatalogResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsOptionsResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsOptionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /project/{UUID}/catalog)
-	PostProjectUUIDCatalog(ctx context.Context, request PostProjectUUIDCatalogRequestObject) (PostProjectUUIDCatalogResponseObject, error)
+// PostCompanyUUIDSmsSend operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsSend(ctx echo.Context, uUID Uuid, params PostCompanyUUIDSmsSendParams) error {
+	var request PostCompanyUUIDSmsSendRequestObject
 
-	// (GET /project/{UUID}/catalog/{entityName})
-	GetProjectUUIDCatalogEntityName(ctx context.Context, request GetProjectUUIDCatalogEntityNameRequestObject) (GetProjectUUIDCatalogEntityNameResponseObject, error)
+	request.UUID = uUID
+	request.Params = params
 
-	// (DELETE /project/{UUID}/catalog/{entityUUID})
-	DeleteProjectUUIDCatalogEntityUUID(ctx context.Context, request DeleteProjectUUIDCatalogEntityUUIDRequestObject) (DeleteProjectUUIDCatalogEntityUUIDResponseObject, error)
+	var body PostCompanyUUIDSmsSendJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (PATCH /project/{UUID}/description)
-	PatchProjectUUIDDescription(ctx context.Context, request PatchProjectUUIDDescriptionRequestObject) (PatchProjectUUIDDescriptionResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsSend(ctx.Request().Context(), request.(PostCompanyUUIDSmsSendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsSend")
+	}
 
-	// (DELETE /project/{UUID}/field/{entityUUID})
-	DeleteProjectUUIDFieldEntityUUID(ctx context.Context, request DeleteProjectUUIDFieldEntityUUIDRequestObject) (DeleteProjectUUIDFieldEntityUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /project/{UUID}/field/{entityUUID})
-	PostProjectUUIDFieldEntityUUID(ctx context.Context, request PostProjectUUIDFieldEntityUUIDRequestObject) (PostProjectUUIDFieldEntityUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsSendResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsSendResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (PATCH /project/{UUID}/graph)
-	PatchProjectUUIDGraph(ctx context.Context, request PatchProjectUUIDGraphRequestObject) (PatchProjectUUIDGraphResponseObject, error)
+// GetCompanyUUIDSmsTemplates operation middleware
+func (sh *strictHandler) GetCompanyUUIDSmsTemplates(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDSmsTemplatesRequestObject
 
-	// (PATCH /project/{UUID}/name)
-	PatchProjectUUIDName(ctx context.Context, request PatchProjectUUIDNameRequestObject) (PatchProjectUUIDNameResponseObject, error)
+	request.UUID = uUID
 
-	// (PATCH /project/{UUID}/options)
-	PatchProjectUUIDOptions(ctx context.Context, request PatchProjectUUIDOptionsRequestObject) (PatchProjectUUIDOptionsResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDSmsTemplates(ctx.Request().Context(), request.(GetCompanyUUIDSmsTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDSmsTemplates")
+	}
 
-	// (GET /project/{UUID}/status)
-	GetProjectUUIDStatus(ctx context.Context, request GetProjectUUIDStatusRequestObject) (GetProjectUUIDStatusResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /project/{UUID}/status)
-	PostProjectUUIDStatus(ctx context.Context, request PostProjectUUIDStatusRequestObject) (PostProjectUUIDStatusResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDSmsTemplatesResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDSmsTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (DELETE /project/{UUID}/status/{entityUUID})
-	DeleteProjectUUIDStatusEntityUUID(ctx context.Context, request DeleteProjectUUIDStatusEntityUUIDRequestObject) (DeleteProjectUUIDStatusEntityUUIDResponseObject, error)
+// PostCompanyUUIDSmsTemplates operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsTemplates(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDSmsTemplatesRequestObject
 
-	// (PATCH /project/{UUID}/status/{entityUUID})
-	PatchProjectUUIDStatusEntityUUID(ctx context.Context, request PatchProjectUUIDStatusEntityUUIDRequestObject) (PatchProjectUUIDStatusEntityUUIDResponseObject, error)
+	request.UUID = uUID
 
-	// (POST /project/{UUID}/user)
-	PostProjectUUIDUser(ctx context.Context, request PostProjectUUIDUserRequestObject) (PostProjectUUIDUserResponseObject, error)
+	var body PostCompanyUUIDSmsTemplatesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (DELETE /project/{UUID}/user/{userUUID})
-	DeleteProjectUUIDUserUserUUID(ctx context.Context, request DeleteProjectUUIDUserUserUUIDRequestObject) (DeleteProjectUUIDUserUserUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsTemplates(ctx.Request().Context(), request.(PostCompanyUUIDSmsTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsTemplates")
+	}
 
-	// (GET /tag)
-	GetTag(ctx context.Context, request GetTagRequestObject) (GetTagResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /tag)
-	PostTag(ctx context.Context, request PostTagRequestObject) (PostTagResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsTemplatesResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (DELETE /tag/{UUID})
-	DeleteTagUUID(ctx context.Context, request DeleteTagUUIDRequestObject) (DeleteTagUUIDResponseObject, error)
+// DeleteCompanyUUIDSmsTemplatesEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDSmsTemplatesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDSmsTemplatesEntityUUIDRequestObject
 
-	// (PATCH /tag/{UUID})
-	PatchTagUUID(ctx context.Context, request PatchTagUUIDRequestObject) (PatchTagUUIDResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	// (GET /user)
-	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDSmsTemplatesEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDSmsTemplatesEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDSmsTemplatesEntityUUID")
+	}
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	response, err := handler(ctx, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDSmsTemplatesEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDSmsTemplatesEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+// PutCompanyUUIDSmsTemplatesEntityUUID operation middleware
+func (sh *strictHandler) PutCompanyUUIDSmsTemplatesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutCompanyUUIDSmsTemplatesEntityUUIDRequestObject
 
-// PostCompany operation middleware
-func (sh *strictHandler) PostCompany(ctx echo.Context) error {
-	var request PostCompanyRequestObject
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	var body PostCompanyJSONRequestBody
+	var body PutCompanyUUIDSmsTemplatesEntityUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompany(ctx.Request().Context(), request.(PostCompanyRequestObject))
+		return sh.ssi.PutCompanyUUIDSmsTemplatesEntityUUID(ctx.Request().Context(), request.(PutCompanyUUIDSmsTemplatesEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompany")
+		handler = middleware(handler, "PutCompanyUUIDSmsTemplatesEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyResponseObject); ok {
-		return validResponse.VisitPostCompanyResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutCompanyUUIDSmsTemplatesEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutCompanyUUIDSmsTemplatesEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteCompanyUUIDRequestObject
+// PostCompanyUUIDUser operation middleware
+func (sh *strictHandler) PostCompanyUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDUserRequestObject
 
 	request.UUID = uUID
 
+	var body PostCompanyUUIDUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDRequestObject))
+		return sh.ssi.PostCompanyUUIDUser(ctx.Request().Context(), request.(PostCompanyUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUID")
+		handler = middleware(handler, "PostCompanyUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostCompanyUUIDUserResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUID operation middleware
-func (sh *strictHandler) GetCompanyUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDRequestObject
+// DeleteCompanyUUIDUserUserUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
+	var request DeleteCompanyUUIDUserUserUUIDRequestObject
 
 	request.UUID = uUID
+	request.UserUUID = userUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUID(ctx.Request().Context(), request.(GetCompanyUUIDRequestObject))
+		return sh.ssi.DeleteCompanyUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDUserUserUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUID")
+		handler = middleware(handler, "DeleteCompanyUUIDUserUserUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteCompanyUUIDUserUserUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDUserUserUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDFields operation middleware
-func (sh *strictHandler) GetCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDFieldsRequestObject
+// PostFederation operation middleware
+func (sh *strictHandler) PostFederation(ctx echo.Context) error {
+	var request PostFederationRequestObject
 
-	request.UUID = uUID
+	var body PostFederationJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDFields(ctx.Request().Context(), request.(GetCompanyUUIDFieldsRequestObject))
+		return sh.ssi.PostFederation(ctx.Request().Context(), request.(PostFederationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDFields")
+		handler = middleware(handler, "PostFederation")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDFieldsResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDFieldsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationResponseObject); ok {
+		return validResponse.VisitPostFederationResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDFields operation middleware
-func (sh *strictHandler) PostCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDFieldsRequestObject
+// DeleteFederationUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteFederationUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PostCompanyUUIDFieldsJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFederationUUID(ctx.Request().Context(), request.(DeleteFederationUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFederationUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(DeleteFederationUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// GetFederationUUID operation middleware
+func (sh *strictHandler) GetFederationUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDRequestObject
+
+	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDFields(ctx.Request().Context(), request.(PostCompanyUUIDFieldsRequestObject))
+		return sh.ssi.GetFederationUUID(ctx.Request().Context(), request.(GetFederationUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDFields")
+		handler = middleware(handler, "GetFederationUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDFieldsResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDFieldsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDFieldsEntityUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteCompanyUUIDFieldsEntityUUIDRequestObject
+// GetFederationUUIDAgent operation middleware
+func (sh *strictHandler) GetFederationUUIDAgent(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentParams) error {
+	var request GetFederationUUIDAgentRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDFieldsEntityUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDAgent(ctx.Request().Context(), request.(GetFederationUUIDAgentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDFieldsEntityUUID")
+		handler = middleware(handler, "GetFederationUUIDAgent")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDFieldsEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutCompanyUUIDFieldsEntityUUID operation middleware
-func (sh *strictHandler) PutCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PutCompanyUUIDFieldsEntityUUIDRequestObject
+// PostFederationUUIDAgent operation middleware
+func (sh *strictHandler) PostFederationUUIDAgent(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDAgentRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
-	var body PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
+	var body PostFederationUUIDAgentJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(PutCompanyUUIDFieldsEntityUUIDRequestObject))
+		return sh.ssi.PostFederationUUIDAgent(ctx.Request().Context(), request.(PostFederationUUIDAgentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutCompanyUUIDFieldsEntityUUID")
+		handler = middleware(handler, "PostFederationUUIDAgent")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutCompanyUUIDFieldsEntityUUIDResponseObject); ok {
-		return validResponse.VisitPutCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDAgentResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDAgentResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDGroup operation middleware
-func (sh *strictHandler) GetCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDGroupRequestObject
+// GetFederationUUIDAgentDuplicates operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentDuplicates(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentDuplicatesParams) error {
+	var request GetFederationUUIDAgentDuplicatesRequestObject
 
 	request.UUID = uUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDGroup(ctx.Request().Context(), request.(GetCompanyUUIDGroupRequestObject))
+		return sh.ssi.GetFederationUUIDAgentDuplicates(ctx.Request().Context(), request.(GetFederationUUIDAgentDuplicatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDGroup")
+		handler = middleware(handler, "GetFederationUUIDAgentDuplicates")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDGroupResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentDuplicatesResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentDuplicatesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDGroup operation middleware
-func (sh *strictHandler) PostCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDGroupRequestObject
+// DeleteFederationUUIDAgentEntityUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteFederationUUIDAgentEntityUUIDRequestObject
 
 	request.UUID = uUID
-
-	var body PostCompanyUUIDGroupJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDGroup(ctx.Request().Context(), request.(PostCompanyUUIDGroupRequestObject))
+		return sh.ssi.DeleteFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDAgentEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDGroup")
+		handler = middleware(handler, "DeleteFederationUUIDAgentEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDGroupResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDAgentEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDAgentEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDGroupEntityUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteCompanyUUIDGroupEntityUUIDRequestObject
-
+// GetFederationUUIDAgentEntityUUID operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetFederationUUIDAgentEntityUUIDRequestObject
+
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDGroupEntityUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(GetFederationUUIDAgentEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDGroupEntityUUID")
+		handler = middleware(handler, "GetFederationUUIDAgentEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDGroupEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentEntityUUIDResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCompanyUUIDGroupEntityUUID operation middleware
-func (sh *strictHandler) PatchCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchCompanyUUIDGroupEntityUUIDRequestObject
+// PatchFederationUUIDAgentEntityUUID operation middleware
+func (sh *strictHandler) PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchFederationUUIDAgentEntityUUIDRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
 
-	var body PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
+	var body PatchFederationUUIDAgentEntityUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDGroupEntityUUIDRequestObject))
+		return sh.ssi.PatchFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(PatchFederationUUIDAgentEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDGroupEntityUUID")
+		handler = middleware(handler, "PatchFederationUUIDAgentEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDGroupEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchFederationUUIDAgentEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchFederationUUIDAgentEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCompanyUUIDName operation middleware
-func (sh *strictHandler) PatchCompanyUUIDName(ctx echo.Context, uUID Uuid) error {
-	var request PatchCompanyUUIDNameRequestObject
+// GetFederationUUIDAgentEntityUUIDActivity operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentEntityUUIDActivity(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDActivityParams) error {
+	var request GetFederationUUIDAgentEntityUUIDActivityRequestObject
 
 	request.UUID = uUID
-
-	var body PatchCompanyUUIDNameJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDName(ctx.Request().Context(), request.(PatchCompanyUUIDNameRequestObject))
+		return sh.ssi.GetFederationUUIDAgentEntityUUIDActivity(ctx.Request().Context(), request.(GetFederationUUIDAgentEntityUUIDActivityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDName")
+		handler = middleware(handler, "GetFederationUUIDAgentEntityUUIDActivity")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDNameResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentEntityUUIDActivityResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentEntityUUIDActivityResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDPriorities operation middleware
-func (sh *strictHandler) GetCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDPrioritiesRequestObject
+// GetFederationUUIDAgentEntityUUIDComments operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentEntityUUIDComments(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDCommentsParams) error {
+	var request GetFederationUUIDAgentEntityUUIDCommentsRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDPriorities(ctx.Request().Context(), request.(GetCompanyUUIDPrioritiesRequestObject))
+		return sh.ssi.GetFederationUUIDAgentEntityUUIDComments(ctx.Request().Context(), request.(GetFederationUUIDAgentEntityUUIDCommentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDPriorities")
+		handler = middleware(handler, "GetFederationUUIDAgentEntityUUIDComments")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDPrioritiesResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentEntityUUIDCommentsResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentEntityUUIDCommentsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDPriorities operation middleware
-func (sh *strictHandler) PostCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDPrioritiesRequestObject
+// PostFederationUUIDAgentEntityUUIDComments operation middleware
+func (sh *strictHandler) PostFederationUUIDAgentEntityUUIDComments(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostFederationUUIDAgentEntityUUIDCommentsRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	var body PostCompanyUUIDPrioritiesJSONRequestBody
+	var body PostFederationUUIDAgentEntityUUIDCommentsJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDPriorities(ctx.Request().Context(), request.(PostCompanyUUIDPrioritiesRequestObject))
+		return sh.ssi.PostFederationUUIDAgentEntityUUIDComments(ctx.Request().Context(), request.(PostFederationUUIDAgentEntityUUIDCommentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDPriorities")
+		handler = middleware(handler, "PostFederationUUIDAgentEntityUUIDComments")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDPrioritiesResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDAgentEntityUUIDCommentsResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDAgentEntityUUIDCommentsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDPrioritiesEntityUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject
+// DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, commentUUID openapi_types.UUID) error {
+	var request DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
+	request.CommentUUID = commentUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject))
+		return sh.ssi.DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID(ctx.Request().Context(), request.(DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDPrioritiesEntityUUID")
+		handler = middleware(handler, "DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCompanyUUIDPrioritiesEntityUUID operation middleware
-func (sh *strictHandler) PatchCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject
+// GetFederationUUIDAgentEntityUUIDDuplicates operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentEntityUUIDDuplicates(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDDuplicatesParams) error {
+	var request GetFederationUUIDAgentEntityUUIDDuplicatesRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
-
-	var body PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDPrioritiesEntityUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDAgentEntityUUIDDuplicates(ctx.Request().Context(), request.(GetFederationUUIDAgentEntityUUIDDuplicatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDPrioritiesEntityUUID")
+		handler = middleware(handler, "GetFederationUUIDAgentEntityUUIDDuplicates")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentEntityUUIDDuplicatesResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentEntityUUIDDuplicatesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDProjectCatalogEntityName operation middleware
-func (sh *strictHandler) GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
-	var request GetCompanyUUIDProjectCatalogEntityNameRequestObject
+// GetFederationUUIDAgentEntityUUIDFiles operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentEntityUUIDFiles(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetFederationUUIDAgentEntityUUIDFilesRequestObject
 
 	request.UUID = uUID
-	request.EntityName = entityName
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDProjectCatalogEntityName(ctx.Request().Context(), request.(GetCompanyUUIDProjectCatalogEntityNameRequestObject))
+		return sh.ssi.GetFederationUUIDAgentEntityUUIDFiles(ctx.Request().Context(), request.(GetFederationUUIDAgentEntityUUIDFilesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDProjectCatalogEntityName")
+		handler = middleware(handler, "GetFederationUUIDAgentEntityUUIDFiles")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDProjectCatalogEntityNameResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDProjectCatalogEntityNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentEntityUUIDFilesResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentEntityUUIDFilesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDSms operation middleware
-func (sh *strictHandler) GetCompanyUUIDSms(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsParams) error {
-	var request GetCompanyUUIDSmsRequestObject
+// PostFederationUUIDAgentEntityUUIDFiles operation middleware
+func (sh *strictHandler) PostFederationUUIDAgentEntityUUIDFiles(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostFederationUUIDAgentEntityUUIDFilesRequestObject
 
 	request.UUID = uUID
-	request.Params = params
+	request.EntityUUID = entityUUID
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDSms(ctx.Request().Context(), request.(GetCompanyUUIDSmsRequestObject))
+		return sh.ssi.PostFederationUUIDAgentEntityUUIDFiles(ctx.Request().Context(), request.(PostFederationUUIDAgentEntityUUIDFilesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDSms")
+		handler = middleware(handler, "PostFederationUUIDAgentEntityUUIDFiles")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDSmsResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDSmsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDAgentEntityUUIDFilesResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDAgentEntityUUIDFilesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDSmsCost operation middleware
-func (sh *strictHandler) PostCompanyUUIDSmsCost(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDSmsCostRequestObject
+// DeleteFederationUUIDAgentEntityUUIDFilesFileUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDAgentEntityUUIDFilesFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error {
+	var request DeleteFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject
 
 	request.UUID = uUID
-
-	var body PostCompanyUUIDSmsCostJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityUUID = entityUUID
+	request.FileUUID = fileUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDSmsCost(ctx.Request().Context(), request.(PostCompanyUUIDSmsCostRequestObject))
+		return sh.ssi.DeleteFederationUUIDAgentEntityUUIDFilesFileUUID(ctx.Request().Context(), request.(DeleteFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDSmsCost")
+		handler = middleware(handler, "DeleteFederationUUIDAgentEntityUUIDFilesFileUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDSmsCostResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDSmsCostResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDAgentEntityUUIDFilesFileUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDAgentEntityUUIDFilesFileUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDSmsOptions operation middleware
-func (sh *strictHandler) PostCompanyUUIDSmsOptions(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDSmsOptionsRequestObject
+// GetFederationUUIDAgentEntityUUIDFilesFileUUID operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentEntityUUIDFilesFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error {
+	var request GetFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject
 
 	request.UUID = uUID
-
-	var body PostCompanyUUIDSmsOptionsJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityUUID = entityUUID
+	request.FileUUID = fileUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDSmsOptions(ctx.Request().Context(), request.(PostCompanyUUIDSmsOptionsRequestObject))
+		return sh.ssi.GetFederationUUIDAgentEntityUUIDFilesFileUUID(ctx.Request().Context(), request.(GetFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDSmsOptions")
+		handler = middleware(handler, "GetFederationUUIDAgentEntityUUIDFilesFileUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDSmsOptionsResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDSmsOptionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentEntityUUIDFilesFileUUIDResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentEntityUUIDFilesFileUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDSmsSend operation middleware
-func (sh *strictHandler) PostCompanyUUIDSmsSend(ctx echo.Context, uUID Uuid, params PostCompanyUUIDSmsSendParams) error {
-	var request PostCompanyUUIDSmsSendRequestObject
+// PutFederationUUIDAgentEntityUUIDLegalEntities operation middleware
+func (sh *strictHandler) PutFederationUUIDAgentEntityUUIDLegalEntities(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutFederationUUIDAgentEntityUUIDLegalEntitiesRequestObject
 
 	request.UUID = uUID
-	request.Params = params
+	request.EntityUUID = entityUUID
 
-	var body PostCompanyUUIDSmsSendJSONRequestBody
+	var body PutFederationUUIDAgentEntityUUIDLegalEntitiesJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDSmsSend(ctx.Request().Context(), request.(PostCompanyUUIDSmsSendRequestObject))
+		return sh.ssi.PutFederationUUIDAgentEntityUUIDLegalEntities(ctx.Request().Context(), request.(PutFederationUUIDAgentEntityUUIDLegalEntitiesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDSmsSend")
+		handler = middleware(handler, "PutFederationUUIDAgentEntityUUIDLegalEntities")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDSmsSendResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDSmsSendResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutFederationUUIDAgentEntityUUIDLegalEntitiesResponseObject); ok {
+		return validResponse.VisitPutFederationUUIDAgentEntityUUIDLegalEntitiesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDUser operation middleware
-func (sh *strictHandler) PostCompanyUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDUserRequestObject
+// PostFederationUUIDAgentEntityUUIDMerge operation middleware
+func (sh *strictHandler) PostFederationUUIDAgentEntityUUIDMerge(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostFederationUUIDAgentEntityUUIDMergeRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	var body PostCompanyUUIDUserJSONRequestBody
+	var body PostFederationUUIDAgentEntityUUIDMergeJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDUser(ctx.Request().Context(), request.(PostCompanyUUIDUserRequestObject))
+		return sh.ssi.PostFederationUUIDAgentEntityUUIDMerge(ctx.Request().Context(), request.(PostFederationUUIDAgentEntityUUIDMergeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDUser")
+		handler = middleware(handler, "PostFederationUUIDAgentEntityUUIDMerge")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDUserResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDAgentEntityUUIDMergeResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDAgentEntityUUIDMergeResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDUserUserUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
-	var request DeleteCompanyUUIDUserUserUUIDRequestObject
+// PutFederationUUIDAgentEntityUUIDTasks operation middleware
+func (sh *strictHandler) PutFederationUUIDAgentEntityUUIDTasks(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutFederationUUIDAgentEntityUUIDTasksRequestObject
 
 	request.UUID = uUID
-	request.UserUUID = userUUID
+	request.EntityUUID = entityUUID
+
+	var body PutFederationUUIDAgentEntityUUIDTasksJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDUserUserUUIDRequestObject))
+		return sh.ssi.PutFederationUUIDAgentEntityUUIDTasks(ctx.Request().Context(), request.(PutFederationUUIDAgentEntityUUIDTasksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDUserUserUUID")
+		handler = middleware(handler, "PutFederationUUIDAgentEntityUUIDTasks")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDUserUserUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDUserUserUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutFederationUUIDAgentEntityUUIDTasksResponseObject); ok {
+		return validResponse.VisitPutFederationUUIDAgentEntityUUIDTasksResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederation operation middleware
-func (sh *strictHandler) PostFederation(ctx echo.Context) error {
-	var request PostFederationRequestObject
+// GetFederationUUIDAgentEntityUUIDTimeline operation middleware
+func (sh *strictHandler) GetFederationUUIDAgentEntityUUIDTimeline(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDAgentEntityUUIDTimelineParams) error {
+	var request GetFederationUUIDAgentEntityUUIDTimelineRequestObject
 
-	var body PostFederationJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederation(ctx.Request().Context(), request.(PostFederationRequestObject))
+		return sh.ssi.GetFederationUUIDAgentEntityUUIDTimeline(ctx.Request().Context(), request.(GetFederationUUIDAgentEntityUUIDTimelineRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederation")
+		handler = middleware(handler, "GetFederationUUIDAgentEntityUUIDTimeline")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationResponseObject); ok {
-		return validResponse.VisitPostFederationResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentEntityUUIDTimelineResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentEntityUUIDTimelineResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteFederationUUIDRequestObject
+// GetFederationUUIDEmailBranding operation middleware
+func (sh *strictHandler) GetFederationUUIDEmailBranding(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDEmailBrandingRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUID(ctx.Request().Context(), request.(DeleteFederationUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDEmailBranding(ctx.Request().Context(), request.(GetFederationUUIDEmailBrandingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUID")
+		handler = middleware(handler, "GetFederationUUIDEmailBranding")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDEmailBrandingResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDEmailBrandingResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUID operation middleware
-func (sh *strictHandler) GetFederationUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetFederationUUIDRequestObject
+// PutFederationUUIDEmailBranding operation middleware
+func (sh *strictHandler) PutFederationUUIDEmailBranding(ctx echo.Context, uUID Uuid) error {
+	var request PutFederationUUIDEmailBrandingRequestObject
 
 	request.UUID = uUID
 
+	var body PutFederationUUIDEmailBrandingJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUID(ctx.Request().Context(), request.(GetFederationUUIDRequestObject))
+		return sh.ssi.PutFederationUUIDEmailBranding(ctx.Request().Context(), request.(PutFederationUUIDEmailBrandingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUID")
+		handler = middleware(handler, "PutFederationUUIDEmailBranding")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutFederationUUIDEmailBrandingResponseObject); ok {
+		return validResponse.VisitPutFederationUUIDEmailBrandingResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUIDAgent operation middleware
-func (sh *strictHandler) GetFederationUUIDAgent(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentParams) error {
-	var request GetFederationUUIDAgentRequestObject
+// GetFederationUUIDEmailTemplates operation middleware
+func (sh *strictHandler) GetFederationUUIDEmailTemplates(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDEmailTemplatesRequestObject
 
 	request.UUID = uUID
-	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUIDAgent(ctx.Request().Context(), request.(GetFederationUUIDAgentRequestObject))
+		return sh.ssi.GetFederationUUIDEmailTemplates(ctx.Request().Context(), request.(GetFederationUUIDEmailTemplatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUIDAgent")
+		handler = middleware(handler, "GetFederationUUIDEmailTemplates")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDAgentResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDAgentResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDEmailTemplatesResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDEmailTemplatesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederationUUIDAgent operation middleware
-func (sh *strictHandler) PostFederationUUIDAgent(ctx echo.Context, uUID Uuid) error {
-	var request PostFederationUUIDAgentRequestObject
+// DeleteFederationUUIDEmailTemplatesEntityName operation middleware
+func (sh *strictHandler) DeleteFederationUUIDEmailTemplatesEntityName(ctx echo.Context, uUID Uuid, entityName EntityName, params DeleteFederationUUIDEmailTemplatesEntityNameParams) error {
+	var request DeleteFederationUUIDEmailTemplatesEntityNameRequestObject
 
 	request.UUID = uUID
-
-	var body PostFederationUUIDAgentJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityName = entityName
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederationUUIDAgent(ctx.Request().Context(), request.(PostFederationUUIDAgentRequestObject))
+		return sh.ssi.DeleteFederationUUIDEmailTemplatesEntityName(ctx.Request().Context(), request.(DeleteFederationUUIDEmailTemplatesEntityNameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederationUUIDAgent")
+		handler = middleware(handler, "DeleteFederationUUIDEmailTemplatesEntityName")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationUUIDAgentResponseObject); ok {
-		return validResponse.VisitPostFederationUUIDAgentResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDEmailTemplatesEntityNameResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDEmailTemplatesEntityNameResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUIDAgentEntityUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteFederationUUIDAgentEntityUUIDRequestObject
+// PutFederationUUIDEmailTemplatesEntityName operation middleware
+func (sh *strictHandler) PutFederationUUIDEmailTemplatesEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
+	var request PutFederationUUIDEmailTemplatesEntityNameRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.EntityName = entityName
+
+	var body PutFederationUUIDEmailTemplatesEntityNameJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDAgentEntityUUIDRequestObject))
+		return sh.ssi.PutFederationUUIDEmailTemplatesEntityName(ctx.Request().Context(), request.(PutFederationUUIDEmailTemplatesEntityNameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUIDAgentEntityUUID")
+		handler = middleware(handler, "PutFederationUUIDEmailTemplatesEntityName")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDAgentEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDAgentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutFederationUUIDEmailTemplatesEntityNameResponseObject); ok {
+		return validResponse.VisitPutFederationUUIDEmailTemplatesEntityNameResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchFederationUUIDAgentEntityUUID operation middleware
-func (sh *strictHandler) PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchFederationUUIDAgentEntityUUIDRequestObject
+// PostFederationUUIDEmailTemplatesEntityNamePreview operation middleware
+func (sh *strictHandler) PostFederationUUIDEmailTemplatesEntityNamePreview(ctx echo.Context, uUID Uuid, entityName EntityName) error {
+	var request PostFederationUUIDEmailTemplatesEntityNamePreviewRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.EntityName = entityName
 
-	var body PatchFederationUUIDAgentEntityUUIDJSONRequestBody
+	var body PostFederationUUIDEmailTemplatesEntityNamePreviewJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(PatchFederationUUIDAgentEntityUUIDRequestObject))
+		return sh.ssi.PostFederationUUIDEmailTemplatesEntityNamePreview(ctx.Request().Context(), request.(PostFederationUUIDEmailTemplatesEntityNamePreviewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchFederationUUIDAgentEntityUUID")
+		handler = middleware(handler, "PostFederationUUIDEmailTemplatesEntityNamePreview")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchFederationUUIDAgentEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchFederationUUIDAgentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDEmailTemplatesEntityNamePreviewResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDEmailTemplatesEntityNamePreviewResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -5581,6 +9787,31 @@ func (sh *strictHandler) PatchProjectUUIDDescription(ctx echo.Context, uUID Uuid
 	return nil
 }
 
+// GetProjectUUIDEmail operation middleware
+func (sh *strictHandler) GetProjectUUIDEmail(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDEmailRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDEmail(ctx.Request().Context(), request.(GetProjectUUIDEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDEmailResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDEmailResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteProjectUUIDFieldEntityUUID operation middleware
 func (sh *strictHandler) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
 	var request DeleteProjectUUIDFieldEntityUUIDRequestObject
@@ -30,11 +30,23 @@ type ActivityDTO = dto.ActivityDTO
 // CommentDTO defines model for CommentDTO.
 type CommentDTO = dto.CommentDTO
 
+// CommentRevisionDTO defines model for CommentRevisionDTO.
+type CommentRevisionDTO = dto.CommentRevisionDTO
+
+// FileVersionDTO defines model for FileVersionDTO.
+type FileVersionDTO = dto.FileVersionDTO
+
 // NameRequest defines model for NameRequest.
 type NameRequest struct {
 	Name string `json:"name" validate:"trim,name,min=0,max=100"`
 }
 
+// SearchResultDTO defines model for SearchResultDTO.
+type SearchResultDTO = dto.SearchResultDTO
+
+// SmsDTO defines model for SmsDTO.
+type SmsDTO = dto.SmsDTO
+
 // StatusRequest defines model for StatusRequest.
 type StatusRequest struct {
 	Comment string `json:"comment" validate:"trim,min=0,max=300"`
@@ -79,6 +91,9 @@ type TaskPutRequest struct {
 // UploadDTO defines model for UploadDTO.
 type UploadDTO = dto.UploadDTO
 
+// UploadSessionDTO defines model for UploadSessionDTO.
+type UploadSessionDTO = dto.UploadSessionDTO
+
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
@@ -91,6 +106,16 @@ type FileUUID = openapi_types.UUID
 // Uuid defines model for uuid.
 type Uuid = openapi_types.UUID
 
+// GetSearchParams defines parameters for GetSearch.
+type GetSearchParams struct {
+	Q              string              `form:"q" json:"q" validate:"trim,min=2,max=200"`
+	FederationUuid *openapi_types.UUID `form:"federation_uuid,omitempty" json:"federation_uuid,omitempty"`
+	ProjectUuid    *openapi_types.UUID `form:"project_uuid,omitempty" json:"project_uuid,omitempty"`
+	Types          *[]string           `form:"types,omitempty" json:"types,omitempty" validate:"omitempty,dive,oneof=task comment file catalog"`
+	Offset         *int                `form:"offset,omitempty" json:"offset,omitempty" validate:"min=0,max=1000"`
+	Limit          *int                `form:"limit,omitempty" json:"limit,omitempty" validate:"min=1,max=200"`
+}
+
 // GetTaskParams defines parameters for GetTask.
 type GetTaskParams struct {
 	Offset         *int               `form:"offset,omitempty" json:"offset,omitempty"`
@@ -104,10 +129,11 @@ type GetTaskParams struct {
 	Tags           *[]string          `form:"tags,omitempty" json:"tags,omitempty"`
 	Path           *string            `form:"path,omitempty" json:"path,omitempty"`
 	Name           *string            `form:"name,omitempty" json:"name,omitempty"`
-	Fields         *string            `form:"fields,omitempty" json:"fields,omitempty"`
-	Order          *string            `form:"order,omitempty" json:"order,omitempty"`
-	By             *string            `form:"by,omitempty" json:"by,omitempty"`
-	Format         *string            `form:"format,omitempty" json:"format,omitempty"`
+	// Fields JSON object of field filters by hash, e.g. {"hash": "value"}. Numeric and money fields take a range {"from": 1000, "to": 5000, "currency": "RUB"}, bounds are inclusive, money bounds in minor units
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+	Order  *string `form:"order,omitempty" json:"order,omitempty"`
+	By     *string `form:"by,omitempty" json:"by,omitempty"`
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
 }
 
 // GetTaskUUIDActivityParams defines parameters for GetTaskUUIDActivity.
@@ -144,6 +170,16 @@ type PatchTaskUUIDProjectJSONBody struct {
 	Uuid    openapi_types.UUID `json:"uuid" validate:"uuid"`
 }
 
+// PostTaskUUIDSmsJSONBody defines parameters for PostTaskUUIDSms.
+type PostTaskUUIDSmsJSONBody struct {
+	// Phone Recipient, by default taken from template phone_field or first phone-like task field
+	Phone        *string             `json:"phone,omitempty"`
+	TemplateUuid *openapi_types.UUID `json:"template_uuid,omitempty"`
+
+	// Text Used when template_uuid is empty, supports {{task.*}} placeholders
+	Text *string `json:"text,omitempty" validate:"omitempty,max=1000"`
+}
+
 // PatchTaskUUIDTeamJSONBody defines parameters for PatchTaskUUIDTeam.
 type PatchTaskUUIDTeamJSONBody struct {
 	CoworkersBy   *[]string `json:"coworkers_by,omitempty" validate:"omitempty,dive,email"`
@@ -158,11 +194,42 @@ type PatchTaskUUIDUploadMultipartBody struct {
 	File *openapi_types.File `json:"file,omitempty"`
 }
 
+// DeleteTaskUUIDUploadEntityUUIDParams defines parameters for DeleteTaskUUIDUploadEntityUUID.
+type DeleteTaskUUIDUploadEntityUUIDParams struct {
+	LatestVersion *bool `form:"latest_version,omitempty" json:"latest_version,omitempty"`
+}
+
+// GetTaskUUIDUploadEntityUUIDParams defines parameters for GetTaskUUIDUploadEntityUUID.
+type GetTaskUUIDUploadEntityUUIDParams struct {
+	Version *int `form:"version,omitempty" json:"version,omitempty" validate:"omitempty,min=1"`
+}
+
 // PostTaskUUIDUploadEntityUUIDRenameJSONBody defines parameters for PostTaskUUIDUploadEntityUUIDRename.
 type PostTaskUUIDUploadEntityUUIDRenameJSONBody struct {
 	Name string `json:"name" validate:"trim,min=1,max=50"`
 }
 
+// PatchTaskUUIDUploadEntityUUIDVersionsMultipartBody defines parameters for PatchTaskUUIDUploadEntityUUIDVersions.
+type PatchTaskUUIDUploadEntityUUIDVersionsMultipartBody struct {
+	File *openapi_types.File `json:"file,omitempty"`
+}
+
+// PostTaskUUIDUploadsJSONBody defines parameters for PostTaskUUIDUploads.
+type PostTaskUUIDUploadsJSONBody struct {
+	// CommentUuid Attach the file to this comment instead of the task
+	CommentUuid *openapi_types.UUID `json:"comment_uuid,omitempty"`
+
+	// Mime Declared type, the stored one is detected from the content
+	Mime *string `json:"mime,omitempty"`
+	Name string  `json:"name" validate:"trim,min=1,max=50"`
+
+	// Size File size in bytes
+	Size int64 `json:"size" validate:"min=1"`
+}
+
+// PatchTaskUUIDUploadEntityUUIDVersionsMultipartRequestBody defines body for PatchTaskUUIDUploadEntityUUIDVersions for multipart/form-data ContentType.
+type PatchTaskUUIDUploadEntityUUIDVersionsMultipartRequestBody PatchTaskUUIDUploadEntityUUIDVersionsMultipartBody
+
 // PostTaskJSONRequestBody defines body for PostTask for application/json ContentType.
 type PostTaskJSONRequestBody = TaskCreateRequest
 
@@ -184,6 +251,9 @@ type PatchTaskUUIDParentJSONRequestBody PatchTaskUUIDParentJSONBody
 // PatchTaskUUIDProjectJSONRequestBody defines body for PatchTaskUUIDProject for application/json ContentType.
 type PatchTaskUUIDProjectJSONRequestBody PatchTaskUUIDProjectJSONBody
 
+// PostTaskUUIDSmsJSONRequestBody defines body for PostTaskUUIDSms for application/json ContentType.
+type PostTaskUUIDSmsJSONRequestBody PostTaskUUIDSmsJSONBody
+
 // PatchTaskUUIDStatusJSONRequestBody defines body for PatchTaskUUIDStatus for application/json ContentType.
 type PatchTaskUUIDStatusJSONRequestBody = StatusRequest
 
@@ -196,9 +266,15 @@ type PatchTaskUUIDUploadMultipartRequestBody PatchTaskUUIDUploadMultipartBody
 // PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody defines body for PostTaskUUIDUploadEntityUUIDRename for application/json ContentType.
 type PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody PostTaskUUIDUploadEntityUUIDRenameJSONBody
 
+// PostTaskUUIDUploadsJSONRequestBody defines body for PostTaskUUIDUploads for application/json ContentType.
+type PostTaskUUIDUploadsJSONRequestBody PostTaskUUIDUploadsJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /search)
+	GetSearch(ctx echo.Context, params GetSearchParams) error
+
 	// (GET /task)
 	GetTask(ctx echo.Context, params GetTaskParams) error
 
@@ -232,12 +308,21 @@ type ServerInterface interface {
 	// (DELETE /task/{UUID}/comment/{entityUUID}/file/{fileUUID})
 	DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error
 
+	// (PATCH /task/{UUID}/comment/{entityUUID}/hide)
+	PatchTaskUUIDCommentEntityUUIDHide(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /task/{UUID}/comment/{entityUUID}/history)
+	GetTaskUUIDCommentEntityUUIDHistory(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (PATCH /task/{UUID}/comment/{entityUUID}/like)
 	PatchTaskUUIDCommentEntityUUIDLike(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
 	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
 	PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (PATCH /task/{UUID}/comment/{entityUUID}/restore)
+	PatchTaskUUIDCommentEntityUUIDRestore(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (PATCH /task/{UUID}/name)
 	PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error
 
@@ -247,6 +332,9 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/project)
 	PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error
 
+	// (POST /task/{UUID}/sms)
+	PostTaskUUIDSms(ctx echo.Context, uUID Uuid) error
+
 	// (PATCH /task/{UUID}/status)
 	PatchTaskUUIDStatus(ctx echo.Context, uUID Uuid) error
 
@@ -263,13 +351,34 @@ type ServerInterface interface {
 	PatchTaskUUIDUpload(ctx echo.Context, uUID Uuid) error
 
 	// (DELETE /task/{UUID}/upload/{entityUUID})
-	DeleteTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+	DeleteTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params DeleteTaskUUIDUploadEntityUUIDParams) error
 
 	// (GET /task/{UUID}/upload/{entityUUID})
-	GetTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+	GetTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetTaskUUIDUploadEntityUUIDParams) error
+
+	// (GET /task/{UUID}/upload/{entityUUID}/preview)
+	GetTaskUUIDUploadEntityUUIDPreview(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
 	// (POST /task/{UUID}/upload/{entityUUID}/rename)
 	PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /task/{UUID}/upload/{entityUUID}/versions)
+	GetTaskUUIDUploadEntityUUIDVersions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PATCH /task/{UUID}/upload/{entityUUID}/versions)
+	PatchTaskUUIDUploadEntityUUIDVersions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/uploads)
+	PostTaskUUIDUploads(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /task/{UUID}/uploads/{entityUUID})
+	DeleteTaskUUIDUploadsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /task/{UUID}/uploads/{entityUUID})
+	GetTaskUUIDUploadsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/uploads/{entityUUID}/complete)
+	PostTaskUUIDUploadsEntityUUIDComplete(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -277,6 +386,60 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSearch(ctx echo.Context) error {
+	var err error
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSearchParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "federation_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "federation_uuid", ctx.QueryParams(), &params.FederationUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federation_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "project_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "types" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "types", ctx.QueryParams(), &params.Types)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter types: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSearch(ctx, params)
+	return err
+}
+
 // GetTask converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTask(ctx echo.Context) error {
 	var err error
@@ -616,6 +779,58 @@ func (w *ServerInterfaceWrapper) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx
 	return err
 }
 
+// PatchTaskUUIDCommentEntityUUIDHide converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDHide(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDCommentEntityUUIDHide(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetTaskUUIDCommentEntityUUIDHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDCommentEntityUUIDHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDCommentEntityUUIDHistory(ctx, uUID, entityUUID)
+	return err
+}
+
 // PatchTaskUUIDCommentEntityUUIDLike converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDLike(ctx echo.Context) error {
 	var err error
@@ -668,6 +883,32 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Cont
 	return err
 }
 
+// PatchTaskUUIDCommentEntityUUIDRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDCommentEntityUUIDRestore(ctx, uUID, entityUUID)
+	return err
+}
+
 // PatchTaskUUIDName converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchTaskUUIDName(ctx echo.Context) error {
 	var err error
@@ -722,6 +963,24 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDProject(ctx echo.Context) error {
 	return err
 }
 
+// PostTaskUUIDSms converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDSms(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDSms(ctx, uUID)
+	return err
+}
+
 // PatchTaskUUIDStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchTaskUUIDStatus(ctx echo.Context) error {
 	var err error
@@ -841,8 +1100,17 @@ func (w *ServerInterfaceWrapper) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTaskUUIDUploadEntityUUIDParams
+	// ------------- Optional query parameter "latest_version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "latest_version", ctx.QueryParams(), &params.LatestVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter latest_version: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeleteTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID, params)
 	return err
 }
 
@@ -867,8 +1135,43 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUID(ctx echo.Context) e
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDUploadEntityUUIDParams
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID, params)
+	return err
+}
+
+// GetTaskUUIDUploadEntityUUIDPreview converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUIDPreview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetTaskUUIDUploadEntityUUIDPreview(ctx, uUID, entityUUID)
 	return err
 }
 
@@ -898,62 +1201,243 @@ func (w *ServerInterfaceWrapper) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Con
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// GetTaskUUIDUploadEntityUUIDVersions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUIDVersions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
-	router.GET(baseURL+"/task", wrapper.GetTask)
-	router.POST(baseURL+"/task", wrapper.PostTask)
-	router.DELETE(baseURL+"/task/:UUID", wrapper.DeleteTaskUUID)
-	router.GET(baseURL+"/task/:UUID", wrapper.GetTaskUUID)
-	router.PUT(baseURL+"/task/:UUID", wrapper.PutTaskUUID)
-	router.GET(baseURL+"/task/:UUID/activity", wrapper.GetTaskUUIDActivity)
-	router.GET(baseURL+"/task/:UUID/comment", wrapper.GetTaskUUIDComment)
-	router.POST(baseURL+"/task/:UUID/comment", wrapper.PostTaskUUIDComment)
-	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.DeleteTaskUUIDCommentEntityUUID)
-	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.PatchTaskUUIDCommentEntityUUID)
-	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID/file/:fileUUID", wrapper.DeleteTaskUUIDCommentEntityUUIDFileFileUUID)
-	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/like", wrapper.PatchTaskUUIDCommentEntityUUIDLike)
-	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/pin", wrapper.PatchTaskUUIDCommentEntityUUIDPin)
-	router.PATCH(baseURL+"/task/:UUID/name", wrapper.PatchTaskUUIDName)
-	router.PATCH(baseURL+"/task/:UUID/parent", wrapper.PatchTaskUUIDParent)
-	router.PATCH(baseURL+"/task/:UUID/project", wrapper.PatchTaskUUIDProject)
-	router.PATCH(baseURL+"/task/:UUID/status", wrapper.PatchTaskUUIDStatus)
-	router.DELETE(baseURL+"/task/:UUID/stop/:entityUUID", wrapper.DeleteTaskUUIDStopEntityUUID)
-	router.PATCH(baseURL+"/task/:UUID/team", wrapper.PatchTaskUUIDTeam)
-	router.GET(baseURL+"/task/:UUID/upload", wrapper.GetTaskUUIDUpload)
-	router.PATCH(baseURL+"/task/:UUID/upload", wrapper.PatchTaskUUIDUpload)
-	router.DELETE(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.DeleteTaskUUIDUploadEntityUUID)
-	router.GET(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.GetTaskUUIDUploadEntityUUID)
-	router.POST(baseURL+"/task/:UUID/upload/:entityUUID/rename", wrapper.PostTaskUUIDUploadEntityUUIDRename)
+	ctx.Set(BearerAuthScopes, []string{})
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDUploadEntityUUIDVersions(ctx, uUID, entityUUID)
+	return err
 }
 
-type GetTaskRequestObject struct {
+// PatchTaskUUIDUploadEntityUUIDVersions converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDUploadEntityUUIDVersions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDUploadEntityUUIDVersions(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostTaskUUIDUploads converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDUploads(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDUploads(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDUploadsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDUploadsEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDUploadsEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetTaskUUIDUploadsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDUploadsEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDUploadsEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostTaskUUIDUploadsEntityUUIDComplete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDUploadsEntityUUIDComplete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDUploadsEntityUUIDComplete(ctx, uUID, entityUUID)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/search", wrapper.GetSearch)
+	router.GET(baseURL+"/task", wrapper.GetTask)
+	router.POST(baseURL+"/task", wrapper.PostTask)
+	router.DELETE(baseURL+"/task/:UUID", wrapper.DeleteTaskUUID)
+	router.GET(baseURL+"/task/:UUID", wrapper.GetTaskUUID)
+	router.PUT(baseURL+"/task/:UUID", wrapper.PutTaskUUID)
+	router.GET(baseURL+"/task/:UUID/activity", wrapper.GetTaskUUIDActivity)
+	router.GET(baseURL+"/task/:UUID/comment", wrapper.GetTaskUUIDComment)
+	router.POST(baseURL+"/task/:UUID/comment", wrapper.PostTaskUUIDComment)
+	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.DeleteTaskUUIDCommentEntityUUID)
+	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.PatchTaskUUIDCommentEntityUUID)
+	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID/file/:fileUUID", wrapper.DeleteTaskUUIDCommentEntityUUIDFileFileUUID)
+	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/hide", wrapper.PatchTaskUUIDCommentEntityUUIDHide)
+	router.GET(baseURL+"/task/:UUID/comment/:entityUUID/history", wrapper.GetTaskUUIDCommentEntityUUIDHistory)
+	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/like", wrapper.PatchTaskUUIDCommentEntityUUIDLike)
+	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/pin", wrapper.PatchTaskUUIDCommentEntityUUIDPin)
+	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/restore", wrapper.PatchTaskUUIDCommentEntityUUIDRestore)
+	router.PATCH(baseURL+"/task/:UUID/name", wrapper.PatchTaskUUIDName)
+	router.PATCH(baseURL+"/task/:UUID/parent", wrapper.PatchTaskUUIDParent)
+	router.PATCH(baseURL+"/task/:UUID/project", wrapper.PatchTaskUUIDProject)
+	router.POST(baseURL+"/task/:UUID/sms", wrapper.PostTaskUUIDSms)
+	router.PATCH(baseURL+"/task/:UUID/status", wrapper.PatchTaskUUIDStatus)
+	router.DELETE(baseURL+"/task/:UUID/stop/:entityUUID", wrapper.DeleteTaskUUIDStopEntityUUID)
+	router.PATCH(baseURL+"/task/:UUID/team", wrapper.PatchTaskUUIDTeam)
+	router.GET(baseURL+"/task/:UUID/upload", wrapper.GetTaskUUIDUpload)
+	router.PATCH(baseURL+"/task/:UUID/upload", wrapper.PatchTaskUUIDUpload)
+	router.DELETE(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.DeleteTaskUUIDUploadEntityUUID)
+	router.GET(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.GetTaskUUIDUploadEntityUUID)
+	router.GET(baseURL+"/task/:UUID/upload/:entityUUID/preview", wrapper.GetTaskUUIDUploadEntityUUIDPreview)
+	router.POST(baseURL+"/task/:UUID/upload/:entityUUID/rename", wrapper.PostTaskUUIDUploadEntityUUIDRename)
+	router.GET(baseURL+"/task/:UUID/upload/:entityUUID/versions", wrapper.GetTaskUUIDUploadEntityUUIDVersions)
+	router.PATCH(baseURL+"/task/:UUID/upload/:entityUUID/versions", wrapper.PatchTaskUUIDUploadEntityUUIDVersions)
+	router.POST(baseURL+"/task/:UUID/uploads", wrapper.PostTaskUUIDUploads)
+	router.DELETE(baseURL+"/task/:UUID/uploads/:entityUUID", wrapper.DeleteTaskUUIDUploadsEntityUUID)
+	router.GET(baseURL+"/task/:UUID/uploads/:entityUUID", wrapper.GetTaskUUIDUploadsEntityUUID)
+	router.POST(baseURL+"/task/:UUID/uploads/:entityUUID/complete", wrapper.PostTaskUUIDUploadsEntityUUIDComplete)
+
+}
+
+type GetSearchRequestObject struct {
+	Params GetSearchParams
+}
+
+type GetSearchResponseObject interface {
+	VisitGetSearchResponse(w http.ResponseWriter) error
+}
+
+type GetSearch200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []SearchResultDTO `json:"items"`
+	Total int64             `json:"total"`
+}
+
+func (response GetSearch200JSONResponse) VisitGetSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskRequestObject struct {
 	Params GetTaskParams
 }
 
@@ -1213,6 +1697,44 @@ func (response DeleteTaskUUIDCommentEntityUUIDFileFileUUID200Response) VisitDele
 	return nil
 }
 
+type PatchTaskUUIDCommentEntityUUIDHideRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type PatchTaskUUIDCommentEntityUUIDHideResponseObject interface {
+	VisitPatchTaskUUIDCommentEntityUUIDHideResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDCommentEntityUUIDHide200Response struct {
+}
+
+func (response PatchTaskUUIDCommentEntityUUIDHide200Response) VisitPatchTaskUUIDCommentEntityUUIDHideResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTaskUUIDCommentEntityUUIDHistoryRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetTaskUUIDCommentEntityUUIDHistoryResponseObject interface {
+	VisitGetTaskUUIDCommentEntityUUIDHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDCommentEntityUUIDHistory200JSONResponse struct {
+	Count int                  `json:"count"`
+	Items []CommentRevisionDTO `json:"items"`
+}
+
+func (response GetTaskUUIDCommentEntityUUIDHistory200JSONResponse) VisitGetTaskUUIDCommentEntityUUIDHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchTaskUUIDCommentEntityUUIDLikeRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
@@ -1251,6 +1773,23 @@ func (response PatchTaskUUIDCommentEntityUUIDPin200Response) VisitPatchTaskUUIDC
 	return nil
 }
 
+type PatchTaskUUIDCommentEntityUUIDRestoreRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type PatchTaskUUIDCommentEntityUUIDRestoreResponseObject interface {
+	VisitPatchTaskUUIDCommentEntityUUIDRestoreResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDCommentEntityUUIDRestore200Response struct {
+}
+
+func (response PatchTaskUUIDCommentEntityUUIDRestore200Response) VisitPatchTaskUUIDCommentEntityUUIDRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
 type PatchTaskUUIDNameRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchTaskUUIDNameJSONRequestBody
@@ -1318,6 +1857,24 @@ func (response PatchTaskUUIDProject200Response) VisitPatchTaskUUIDProjectRespons
 	return nil
 }
 
+type PostTaskUUIDSmsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDSmsJSONRequestBody
+}
+
+type PostTaskUUIDSmsResponseObject interface {
+	VisitPostTaskUUIDSmsResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDSms200JSONResponse SmsDTO
+
+func (response PostTaskUUIDSms200JSONResponse) VisitPostTaskUUIDSmsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchTaskUUIDStatusRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchTaskUUIDStatusJSONRequestBody
@@ -1421,6 +1978,7 @@ func (response PatchTaskUUIDUpload200JSONResponse) VisitPatchTaskUUIDUploadRespo
 type DeleteTaskUUIDUploadEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     DeleteTaskUUIDUploadEntityUUIDParams
 }
 
 type DeleteTaskUUIDUploadEntityUUIDResponseObject interface {
@@ -1438,6 +1996,7 @@ func (response DeleteTaskUUIDUploadEntityUUID200Response) VisitDeleteTaskUUIDUpl
 type GetTaskUUIDUploadEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetTaskUUIDUploadEntityUUIDParams
 }
 
 type GetTaskUUIDUploadEntityUUIDResponseObject interface {
@@ -1458,6 +2017,29 @@ func (response GetTaskUUIDUploadEntityUUID302Response) VisitGetTaskUUIDUploadEnt
 	return nil
 }
 
+type GetTaskUUIDUploadEntityUUIDPreviewRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetTaskUUIDUploadEntityUUIDPreviewResponseObject interface {
+	VisitGetTaskUUIDUploadEntityUUIDPreviewResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDUploadEntityUUIDPreview302ResponseHeaders struct {
+	Location string
+}
+
+type GetTaskUUIDUploadEntityUUIDPreview302Response struct {
+	Headers GetTaskUUIDUploadEntityUUIDPreview302ResponseHeaders
+}
+
+func (response GetTaskUUIDUploadEntityUUIDPreview302Response) VisitGetTaskUUIDUploadEntityUUIDPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
 type PostTaskUUIDUploadEntityUUIDRenameRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
@@ -1476,9 +2058,123 @@ func (response PostTaskUUIDUploadEntityUUIDRename200Response) VisitPostTaskUUIDU
 	return nil
 }
 
+type GetTaskUUIDUploadEntityUUIDVersionsRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetTaskUUIDUploadEntityUUIDVersionsResponseObject interface {
+	VisitGetTaskUUIDUploadEntityUUIDVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDUploadEntityUUIDVersions200JSONResponse struct {
+	Count int              `json:"count"`
+	Items []FileVersionDTO `json:"items"`
+}
+
+func (response GetTaskUUIDUploadEntityUUIDVersions200JSONResponse) VisitGetTaskUUIDUploadEntityUUIDVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDUploadEntityUUIDVersionsRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *multipart.Reader
+}
+
+type PatchTaskUUIDUploadEntityUUIDVersionsResponseObject interface {
+	VisitPatchTaskUUIDUploadEntityUUIDVersionsResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDUploadEntityUUIDVersions200JSONResponse UploadDTO
+
+func (response PatchTaskUUIDUploadEntityUUIDVersions200JSONResponse) VisitPatchTaskUUIDUploadEntityUUIDVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDUploadsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDUploadsJSONRequestBody
+}
+
+type PostTaskUUIDUploadsResponseObject interface {
+	VisitPostTaskUUIDUploadsResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDUploads200JSONResponse UploadSessionDTO
+
+func (response PostTaskUUIDUploads200JSONResponse) VisitPostTaskUUIDUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDUploadsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDUploadsEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDUploadsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDUploadsEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDUploadsEntityUUID200Response) VisitDeleteTaskUUIDUploadsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTaskUUIDUploadsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetTaskUUIDUploadsEntityUUIDResponseObject interface {
+	VisitGetTaskUUIDUploadsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDUploadsEntityUUID200JSONResponse UploadSessionDTO
+
+func (response GetTaskUUIDUploadsEntityUUID200JSONResponse) VisitGetTaskUUIDUploadsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDUploadsEntityUUIDCompleteRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type PostTaskUUIDUploadsEntityUUIDCompleteResponseObject interface {
+	VisitPostTaskUUIDUploadsEntityUUIDCompleteResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDUploadsEntityUUIDComplete200JSONResponse UploadDTO
+
+func (response PostTaskUUIDUploadsEntityUUIDComplete200JSONResponse) VisitPostTaskUUIDUploadsEntityUUIDCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
+	// (GET /search)
+	GetSearch(ctx context.Context, request GetSearchRequestObject) (GetSearchResponseObject, error)
+
 	// (GET /task)
 	GetTask(ctx context.Context, request GetTaskRequestObject) (GetTaskResponseObject, error)
 
@@ -1512,12 +2208,21 @@ type StrictServerInterface interface {
 	// (DELETE /task/{UUID}/comment/{entityUUID}/file/{fileUUID})
 	DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx context.Context, request DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject) (DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject, error)
 
+	// (PATCH /task/{UUID}/comment/{entityUUID}/hide)
+	PatchTaskUUIDCommentEntityUUIDHide(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDHideRequestObject) (PatchTaskUUIDCommentEntityUUIDHideResponseObject, error)
+
+	// (GET /task/{UUID}/comment/{entityUUID}/history)
+	GetTaskUUIDCommentEntityUUIDHistory(ctx context.Context, request GetTaskUUIDCommentEntityUUIDHistoryRequestObject) (GetTaskUUIDCommentEntityUUIDHistoryResponseObject, error)
+
 	// (PATCH /task/{UUID}/comment/{entityUUID}/like)
 	PatchTaskUUIDCommentEntityUUIDLike(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDLikeRequestObject) (PatchTaskUUIDCommentEntityUUIDLikeResponseObject, error)
 
 	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
 	PatchTaskUUIDCommentEntityUUIDPin(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDPinRequestObject) (PatchTaskUUIDCommentEntityUUIDPinResponseObject, error)
 
+	// (PATCH /task/{UUID}/comment/{entityUUID}/restore)
+	PatchTaskUUIDCommentEntityUUIDRestore(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDRestoreRequestObject) (PatchTaskUUIDCommentEntityUUIDRestoreResponseObject, error)
+
 	// (PATCH /task/{UUID}/name)
 	PatchTaskUUIDName(ctx context.Context, request PatchTaskUUIDNameRequestObject) (PatchTaskUUIDNameResponseObject, error)
 
@@ -1527,6 +2232,9 @@ type StrictServerInterface interface {
 	// (PATCH /task/{UUID}/project)
 	PatchTaskUUIDProject(ctx context.Context, request PatchTaskUUIDProjectRequestObject) (PatchTaskUUIDProjectResponseObject, error)
 
+	// (POST /task/{UUID}/sms)
+	PostTaskUUIDSms(ctx context.Context, request PostTaskUUIDSmsRequestObject) (PostTaskUUIDSmsResponseObject, error)
+
 	// (PATCH /task/{UUID}/status)
 	PatchTaskUUIDStatus(ctx context.Context, request PatchTaskUUIDStatusRequestObject) (PatchTaskUUIDStatusResponseObject, error)
 
@@ -1548,8 +2256,29 @@ type StrictServerInterface interface {
 	// (GET /task/{UUID}/upload/{entityUUID})
 	GetTaskUUIDUploadEntityUUID(ctx context.Context, request GetTaskUUIDUploadEntityUUIDRequestObject) (GetTaskUUIDUploadEntityUUIDResponseObject, error)
 
+	// (GET /task/{UUID}/upload/{entityUUID}/preview)
+	GetTaskUUIDUploadEntityUUIDPreview(ctx context.Context, request GetTaskUUIDUploadEntityUUIDPreviewRequestObject) (GetTaskUUIDUploadEntityUUIDPreviewResponseObject, error)
+
 	// (POST /task/{UUID}/upload/{entityUUID}/rename)
 	PostTaskUUIDUploadEntityUUIDRename(ctx context.Context, request PostTaskUUIDUploadEntityUUIDRenameRequestObject) (PostTaskUUIDUploadEntityUUIDRenameResponseObject, error)
+
+	// (GET /task/{UUID}/upload/{entityUUID}/versions)
+	GetTaskUUIDUploadEntityUUIDVersions(ctx context.Context, request GetTaskUUIDUploadEntityUUIDVersionsRequestObject) (GetTaskUUIDUploadEntityUUIDVersionsResponseObject, error)
+
+	// (PATCH /task/{UUID}/upload/{entityUUID}/versions)
+	PatchTaskUUIDUploadEntityUUIDVersions(ctx context.Context, request PatchTaskUUIDUploadEntityUUIDVersionsRequestObject) (PatchTaskUUIDUploadEntityUUIDVersionsResponseObject, error)
+
+	// (POST /task/{UUID}/uploads)
+	PostTaskUUIDUploads(ctx context.Context, request PostTaskUUIDUploadsRequestObject) (PostTaskUUIDUploadsResponseObject, error)
+
+	// (DELETE /task/{UUID}/uploads/{entityUUID})
+	DeleteTaskUUIDUploadsEntityUUID(ctx context.Context, request DeleteTaskUUIDUploadsEntityUUIDRequestObject) (DeleteTaskUUIDUploadsEntityUUIDResponseObject, error)
+
+	// (GET /task/{UUID}/uploads/{entityUUID})
+	GetTaskUUIDUploadsEntityUUID(ctx context.Context, request GetTaskUUIDUploadsEntityUUIDRequestObject) (GetTaskUUIDUploadsEntityUUIDResponseObject, error)
+
+	// (POST /task/{UUID}/uploads/{entityUUID}/complete)
+	PostTaskUUIDUploadsEntityUUIDComplete(ctx context.Context, request PostTaskUUIDUploadsEntityUUIDCompleteRequestObject) (PostTaskUUIDUploadsEntityUUIDCompleteResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -1564,6 +2293,31 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// GetSearch operation middleware
+func (sh *strictHandler) GetSearch(ctx echo.Context, params GetSearchParams) error {
+	var request GetSearchRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSearch(ctx.Request().Context(), request.(GetSearchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSearch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSearchResponseObject); ok {
+		return validResponse.VisitGetSearchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetTask operation middleware
 func (sh *strictHandler) GetTask(ctx echo.Context, params GetTaskParams) error {
 	var request GetTaskRequestObject
@@ -1866,6 +2620,58 @@ func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Co
 	return nil
 }
 
+// PatchTaskUUIDCommentEntityUUIDHide operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDHide(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDHideRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDCommentEntityUUIDHide(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDHideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDHide")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDHideResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDHideResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUIDCommentEntityUUIDHistory operation middleware
+func (sh *strictHandler) GetTaskUUIDCommentEntityUUIDHistory(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetTaskUUIDCommentEntityUUIDHistoryRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDCommentEntityUUIDHistory(ctx.Request().Context(), request.(GetTaskUUIDCommentEntityUUIDHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDCommentEntityUUIDHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDCommentEntityUUIDHistoryResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDCommentEntityUUIDHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchTaskUUIDCommentEntityUUIDLike operation middleware
 func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDLike(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
 	var request PatchTaskUUIDCommentEntityUUIDLikeRequestObject
@@ -1918,6 +2724,32 @@ func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUI
 	return nil
 }
 
+// PatchTaskUUIDCommentEntityUUIDRestore operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDRestore(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDRestoreRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDCommentEntityUUIDRestore(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDRestoreResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchTaskUUIDName operation middleware
 func (sh *strictHandler) PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error {
 	var request PatchTaskUUIDNameRequestObject
@@ -2011,6 +2843,37 @@ func (sh *strictHandler) PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error
 	return nil
 }
 
+// PostTaskUUIDSms operation middleware
+func (sh *strictHandler) PostTaskUUIDSms(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDSmsRequestObject
+
+	request.UUID = uUID
+
+	var body PostTaskUUIDSmsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDSms(ctx.Request().Context(), request.(PostTaskUUIDSmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDSms")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDSmsResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDSmsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchTaskUUIDStatus operation middleware
 func (sh *strictHandler) PatchTaskUUIDStatus(ctx echo.Context, uUID Uuid) error {
 	var request PatchTaskUUIDStatusRequestObject
@@ -2156,11 +3019,12 @@ func (sh *strictHandler) PatchTaskUUIDUpload(ctx echo.Context, uUID Uuid) error
 }
 
 // DeleteTaskUUIDUploadEntityUUID operation middleware
-func (sh *strictHandler) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+func (sh *strictHandler) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params DeleteTaskUUIDUploadEntityUUIDParams) error {
 	var request DeleteTaskUUIDUploadEntityUUIDRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.DeleteTaskUUIDUploadEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDUploadEntityUUIDRequestObject))
@@ -2182,11 +3046,12 @@ func (sh *strictHandler) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context, uUID U
 }
 
 // GetTaskUUIDUploadEntityUUID operation middleware
-func (sh *strictHandler) GetTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+func (sh *strictHandler) GetTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetTaskUUIDUploadEntityUUIDParams) error {
 	var request GetTaskUUIDUploadEntityUUIDRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetTaskUUIDUploadEntityUUID(ctx.Request().Context(), request.(GetTaskUUIDUploadEntityUUIDRequestObject))
@@ -2207,6 +3072,32 @@ func (sh *strictHandler) GetTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid
 	return nil
 }
 
+// GetTaskUUIDUploadEntityUUIDPreview operation middleware
+func (sh *strictHandler) GetTaskUUIDUploadEntityUUIDPreview(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetTaskUUIDUploadEntityUUIDPreviewRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDUploadEntityUUIDPreview(ctx.Request().Context(), request.(GetTaskUUIDUploadEntityUUIDPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDUploadEntityUUIDPreview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDUploadEntityUUIDPreviewResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDUploadEntityUUIDPreviewResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostTaskUUIDUploadEntityUUIDRename operation middleware
 func (sh *strictHandler) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
 	var request PostTaskUUIDUploadEntityUUIDRenameRequestObject
@@ -2238,3 +3129,170 @@ func (sh *strictHandler) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uU
 	}
 	return nil
 }
+
+// GetTaskUUIDUploadEntityUUIDVersions operation middleware
+func (sh *strictHandler) GetTaskUUIDUploadEntityUUIDVersions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetTaskUUIDUploadEntityUUIDVersionsRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDUploadEntityUUIDVersions(ctx.Request().Context(), request.(GetTaskUUIDUploadEntityUUIDVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDUploadEntityUUIDVersions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDUploadEntityUUIDVersionsResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDUploadEntityUUIDVersionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchTaskUUIDUploadEntityUUIDVersions operation middleware
+func (sh *strictHandler) PatchTaskUUIDUploadEntityUUIDVersions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDUploadEntityUUIDVersionsRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDUploadEntityUUIDVersions(ctx.Request().Context(), request.(PatchTaskUUIDUploadEntityUUIDVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDUploadEntityUUIDVersions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDUploadEntityUUIDVersionsResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDUploadEntityUUIDVersionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTaskUUIDUploads operation middleware
+func (sh *strictHandler) PostTaskUUIDUploads(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDUploadsRequestObject
+
+	request.UUID = uUID
+
+	var body PostTaskUUIDUploadsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDUploads(ctx.Request().Context(), request.(PostTaskUUIDUploadsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDUploads")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDUploadsResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDUploadsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTaskUUIDUploadsEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDUploadsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDUploadsEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUIDUploadsEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDUploadsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUIDUploadsEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDUploadsEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDUploadsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUIDUploadsEntityUUID operation middleware
+func (sh *strictHandler) GetTaskUUIDUploadsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetTaskUUIDUploadsEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDUploadsEntityUUID(ctx.Request().Context(), request.(GetTaskUUIDUploadsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDUploadsEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDUploadsEntityUUIDResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDUploadsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTaskUUIDUploadsEntityUUIDComplete operation middleware
+func (sh *strictHandler) PostTaskUUIDUploadsEntityUUIDComplete(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostTaskUUIDUploadsEntityUUIDCompleteRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDUploadsEntityUUIDComplete(ctx.Request().Context(), request.(PostTaskUUIDUploadsEntityUUIDCompleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDUploadsEntityUUIDComplete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDUploadsEntityUUIDCompleteResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDUploadsEntityUUIDCompleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -3,12 +3,18 @@ package web
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"os"
 
+	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/jwt"
 	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
 	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
 )
 
 func (a *Web) DeleteFederationUUIDAgentEntityUUID(ctx context.Context, request oapi.DeleteFederationUUIDAgentEntityUUIDRequestObject) (oapi.DeleteFederationUUIDAgentEntityUUIDResponseObject, error) {
@@ -35,6 +41,8 @@ func (a *Web) GetFederationUUIDAgent(ctx context.Context, request oapi.GetFedera
 		Offset:         request.Params.Offset,
 		Limit:          request.Params.Limit,
 		FederationUUID: request.UUID,
+		Phone:          request.Params.Phone,
+		Email:          request.Params.Email,
 	}
 
 	dms, total, err := a.app.AgentsService.Get(ctx, filter)
@@ -127,3 +135,418 @@ func (a *Web) PostFederationUUIDAgent(ctx context.Context, request oapi.PostFede
 		Uuid: dm.UUID,
 	}, nil
 }
+
+func (a *Web) GetFederationUUIDAgentEntityUUID(ctx context.Context, request oapi.GetFederationUUIDAgentEntityUUIDRequestObject) (oapi.GetFederationUUIDAgentEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDAgentEntityUUID200JSONResponse(dto.NewAgentDTO(dm)), nil
+}
+
+func (a *Web) PutFederationUUIDAgentEntityUUIDLegalEntities(ctx context.Context, request oapi.PutFederationUUIDAgentEntityUUIDLegalEntitiesRequestObject) (oapi.PutFederationUUIDAgentEntityUUIDLegalEntitiesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.AgentsService.SetLegalEntities(ctx, dm, lo.Map(request.Body.Items, func(item oapi.AgentLegalEntityRequest, _ int) domain.AgentLegalEntity {
+		return domain.AgentLegalEntity{
+			Name:    item.Name,
+			INN:     item.Inn,
+			KPP:     lo.FromPtr(item.Kpp),
+			OGRN:    lo.FromPtr(item.Ogrn),
+			Address: lo.FromPtr(item.Address),
+		}
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err = a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutFederationUUIDAgentEntityUUIDLegalEntities200JSONResponse(dto.NewAgentDTO(dm)), nil
+}
+
+func (a *Web) PutFederationUUIDAgentEntityUUIDTasks(ctx context.Context, request oapi.PutFederationUUIDAgentEntityUUIDTasksRequestObject) (oapi.PutFederationUUIDAgentEntityUUIDTasksResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.AgentsService.SetTasks(ctx, dm, request.Body.TaskUuids)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err = a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutFederationUUIDAgentEntityUUIDTasks200JSONResponse(dto.NewAgentDTO(dm)), nil
+}
+
+func (a *Web) GetFederationUUIDAgentEntityUUIDComments(ctx context.Context, request oapi.GetFederationUUIDAgentEntityUUIDCommentsRequestObject) (oapi.GetFederationUUIDAgentEntityUUIDCommentsResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, total, err := a.app.AgentsService.GetComments(ctx, request.EntityUUID, lo.FromPtr(request.Params.Offset), lo.FromPtrOr(request.Params.Limit, 20))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDAgentEntityUUIDComments200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.AgentComment, _ int) dto.AgentCommentDTO {
+			return dto.NewAgentCommentDTO(item)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) PostFederationUUIDAgentEntityUUIDComments(ctx context.Context, request oapi.PostFederationUUIDAgentEntityUUIDCommentsRequestObject) (oapi.PostFederationUUIDAgentEntityUUIDCommentsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm := &domain.AgentComment{
+		UUID:          uuid.New(),
+		AgentUUID:     request.EntityUUID,
+		CreatedBy:     claims.Email,
+		CreatedByUUID: claims.UUID,
+		Text:          request.Body.Text,
+	}
+
+	err = a.app.AgentsService.CreateComment(ctx, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostFederationUUIDAgentEntityUUIDComments200JSONResponse(dto.NewAgentCommentDTO(*dm)), nil
+}
+
+func (a *Web) DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID(ctx context.Context, request oapi.DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDRequestObject) (oapi.DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.AgentsService.DeleteComment(ctx, request.EntityUUID, request.CommentUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteFederationUUIDAgentEntityUUIDCommentsCommentUUID200Response{}, nil
+}
+
+func (a *Web) GetFederationUUIDAgentEntityUUIDFiles(ctx context.Context, request oapi.GetFederationUUIDAgentEntityUUIDFilesRequestObject) (oapi.GetFederationUUIDAgentEntityUUIDFilesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := a.app.S3PrivateService.GetAgentFiles(request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDAgentEntityUUIDFiles200JSONResponse{
+		Count: len(files),
+		Items: lo.Map(files, func(item domain.File, _ int) dto.UploadDTO {
+			res := dto.NewUploadDTO(item.UUID, item.Name, item.Ext, item.Size, item.URL)
+			res.Mime = item.Mime
+			res.Width = item.Width
+			res.Height = item.Height
+			res.Pages = item.Pages
+			res.Version = item.Version
+			res.ScanStatus = item.ScanStatus
+
+			return res
+		}),
+	}, nil
+}
+
+func (a *Web) PostFederationUUIDAgentEntityUUIDFiles(ctx context.Context, request oapi.PostFederationUUIDAgentEntityUUIDFilesRequestObject) (oapi.PostFederationUUIDAgentEntityUUIDFilesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := request.Body.NextPart()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("file is required: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	storeFilePath := "/tmp/" + helpers.FakeString(10) + "-" + file.FileName()
+	dst, err := os.Create(storeFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(storeFilePath)
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, file); err != nil {
+		return nil, err
+	}
+
+	uploaded, err := a.app.S3PrivateService.UploadAgentFile(dm.FederationUUID, dm.UUID, file.FileName(), storeFilePath, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.NewUploadDTO(uploaded.UUID, uploaded.Name, uploaded.Ext, uploaded.Size, a.app.S3PrivateService.AgentFileURL(dm.FederationUUID, dm.UUID, uploaded.UUID))
+	res.Version = uploaded.Version
+	res.ScanStatus = uploaded.ScanStatus
+
+	return oapi.PostFederationUUIDAgentEntityUUIDFiles200JSONResponse(res), nil
+}
+
+func (a *Web) GetFederationUUIDAgentEntityUUIDFilesFileUUID(ctx context.Context, request oapi.GetFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject) (oapi.GetFederationUUIDAgentEntityUUIDFilesFileUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.agentFile(ctx, request.UUID, request.EntityUUID, request.FileUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := a.app.S3PrivateService.PresignedURLFromFile(request.FileUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDAgentEntityUUIDFilesFileUUID302Response{
+		Headers: oapi.GetFederationUUIDAgentEntityUUIDFilesFileUUID302ResponseHeaders{
+			Location: url,
+		},
+	}, nil
+}
+
+func (a *Web) DeleteFederationUUIDAgentEntityUUIDFilesFileUUID(ctx context.Context, request oapi.DeleteFederationUUIDAgentEntityUUIDFilesFileUUIDRequestObject) (oapi.DeleteFederationUUIDAgentEntityUUIDFilesFileUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.agentFile(ctx, request.UUID, request.EntityUUID, request.FileUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.S3PrivateService.Delete(request.FileUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteFederationUUIDAgentEntityUUIDFilesFileUUID200Response{}, nil
+}
+
+func (a *Web) GetFederationUUIDAgentEntityUUIDTimeline(ctx context.Context, request oapi.GetFederationUUIDAgentEntityUUIDTimelineRequestObject) (oapi.GetFederationUUIDAgentEntityUUIDTimelineResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, total, err := a.app.AgentTimeline(ctx, dm, lo.FromPtr(request.Params.Offset), lo.FromPtrOr(request.Params.Limit, 20))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDAgentEntityUUIDTimeline200JSONResponse{
+		Count: len(items),
+		Items: lo.Map(items, func(item domain.AgentTimelineItem, _ int) dto.AgentTimelineItemDTO {
+			return dto.NewAgentTimelineItemDTO(item)
+		}),
+		Total: total,
+	}, nil
+}
+
+// agentFile проверяет, что файл загружен в карточку агента федерации
+func (a *Web) agentFile(ctx context.Context, federationUUID, agentUUID, fileUUID uuid.UUID) error {
+	_, err := a.app.AgentsService.GetByUUID(ctx, federationUUID, agentUUID)
+	if err != nil {
+		return err
+	}
+
+	files, err := a.app.S3PrivateService.GetAgentFiles(federationUUID, agentUUID)
+	if err != nil {
+		return err
+	}
+
+	if !lo.ContainsBy(files, func(f domain.File) bool { return f.UUID == fileUUID }) {
+		return dto.NotFoundErr("файл не найден")
+	}
+
+	return nil
+}
+
+func (a *Web) GetFederationUUIDAgentDuplicates(ctx context.Context, request oapi.GetFederationUUIDAgentDuplicatesRequestObject) (oapi.GetFederationUUIDAgentDuplicatesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.AgentsService.AllDuplicates(ctx, request.UUID, request.Params.CompanyUuid, lo.FromPtrOr(request.Params.MinScore, domain.DuplicateMinScore))
+	if err != nil {
+		return nil, err
+	}
+
+	items := lo.Subset(dms, 0, uint(lo.FromPtrOr(request.Params.Limit, 100)))
+
+	return oapi.GetFederationUUIDAgentDuplicates200JSONResponse{
+		Count: len(items),
+		Items: lo.Map(items, func(item domain.AgentDuplicate, _ int) dto.AgentDuplicateDTO {
+			return dto.NewAgentDuplicateDTO(item)
+		}),
+		Total: len(dms),
+	}, nil
+}
+
+func (a *Web) GetFederationUUIDAgentEntityUUIDDuplicates(ctx context.Context, request oapi.GetFederationUUIDAgentEntityUUIDDuplicatesRequestObject) (oapi.GetFederationUUIDAgentEntityUUIDDuplicatesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.AgentsService.Duplicates(ctx, dm, lo.FromPtrOr(request.Params.MinScore, domain.DuplicateMinScore))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDAgentEntityUUIDDuplicates200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.AgentDuplicate, _ int) dto.AgentDuplicateDTO {
+			return dto.NewAgentDuplicateDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostFederationUUIDAgentEntityUUIDMerge(ctx context.Context, request oapi.PostFederationUUIDAgentEntityUUIDMergeRequestObject) (oapi.PostFederationUUIDAgentEntityUUIDMergeResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	survivor, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicate, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.Body.DuplicateUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = a.app.AgentsService.Merge(ctx, domain.Creator{
+		UUID:  claims.UUID,
+		Email: claims.Email,
+	}, survivor, duplicate)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostFederationUUIDAgentEntityUUIDMerge200JSONResponse(dto.NewAgentDTO(dm)), nil
+}
+
+func (a *Web) GetFederationUUIDAgentEntityUUIDActivity(ctx context.Context, request oapi.GetFederationUUIDAgentEntityUUIDActivityRequestObject) (oapi.GetFederationUUIDAgentEntityUUIDActivityResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.app.AgentsService.GetByUUID(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := lo.FromPtr(request.Params.Offset)
+	limit := lo.FromPtrOr(request.Params.Limit, 20)
+
+	dms, total, err := a.app.AgentsService.GetActivities(ctx, request.EntityUUID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDAgentEntityUUIDActivity200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Activity, _ int) dto.ActivityDTO {
+			createdBy, f := a.app.DictionaryService.FindUser(item.CreatedBy.Email)
+
+			if !f {
+				logrus.Errorf("activity created by not found: %s", item.CreatedBy.Email)
+			}
+
+			return *dto.NewActivityDTO(item, lo.FromPtr(createdBy))
+		}),
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,146 @@
+package web
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/s3"
+	echo "github.com/labstack/echo/v4"
+)
+
+func initBlobRoutes(a *Web, e *echo.Echo) {
+	// Файлы локального хранилища (CDN_BACKEND=fs) отдаются по подписанным ссылкам, выданным FSStore.
+	// У публичного и приватного хранилищ свои папки и секреты, файл отдается из того, чьей подписи верит ссылка
+	e.GET(s3.BlobRoute+"/:bucket/*", func(c echo.Context) error {
+		stores := a.fsStores()
+		if len(stores) == 0 {
+			return c.NoContent(http.StatusNotFound)
+		}
+
+		bucket := c.Param("bucket")
+		object := c.Param("*")
+		if c.Request().URL.RawPath != "" {
+			var err error
+			if object, err = url.PathUnescape(object); err != nil {
+				return c.NoContent(http.StatusBadRequest)
+			}
+		}
+
+		var store *s3.FSStore
+		var name string
+		var err error
+		for _, store = range stores {
+			if name, err = store.Verify(bucket, object, c.QueryParams()); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return c.String(http.StatusForbidden, err.Error())
+		}
+
+		p, err := store.Path(bucket, object)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return c.NoContent(http.StatusNotFound)
+			}
+			return err
+		}
+		defer f.Close()
+
+		stat, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		if stat.IsDir() {
+			return c.NoContent(http.StatusNotFound)
+		}
+
+		if name == "" {
+			name = path.Base(object)
+		}
+
+		disposition := "attachment"
+		if helpers.FileInline(object) {
+			disposition = "inline"
+		}
+
+		h := c.Response().Header()
+		h.Set(echo.HeaderContentDisposition, mime.FormatMediaType(disposition, map[string]string{"filename": name}))
+		h.Set(echo.HeaderXContentTypeOptions, "nosniff")
+		h.Set(echo.HeaderContentSecurityPolicy, "sandbox")
+		http.ServeContent(c.Response(), c.Request(), path.Base(object), stat.ModTime(), f)
+
+		return nil
+	})
+
+	// Части составной загрузки приватного хранилища, ссылки выдает FSStore.PresignPart. В ответ, как у S3, приходит ETag части.
+	e.PUT(s3.BlobUploadRoute+"/:upload/:part", func(c echo.Context) error {
+		store, ok := a.app.S3PrivateService.Store().(*s3.FSStore)
+		if !ok {
+			return c.NoContent(http.StatusNotFound)
+		}
+
+		uploadID := c.Param("upload")
+		number, err := strconv.Atoi(c.Param("part"))
+		if err != nil || number < 1 || number > s3.UploadMaxParts {
+			return c.NoContent(http.StatusBadRequest)
+		}
+
+		err = store.VerifyPart(uploadID, number, c.QueryParams())
+		if err != nil {
+			return c.String(http.StatusForbidden, err.Error())
+		}
+
+		// общий BodyLimit эти запросы пропускает, размер части ограничивается здесь
+		limit := a.app.S3PrivateService.MaxPartSize()
+		if c.Request().ContentLength > limit {
+			return c.NoContent(http.StatusRequestEntityTooLarge)
+		}
+
+		body := http.MaxBytesReader(c.Response(), c.Request().Body, limit)
+
+		etag, err := store.PutPart(uploadID, number, body)
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return c.NoContent(http.StatusRequestEntityTooLarge)
+			}
+			return err
+		}
+
+		c.Response().Header().Set("ETag", strconv.Quote(etag))
+
+		return c.NoContent(http.StatusOK)
+	})
+}
+
+// blobRequest - запрос к файлам локального хранилища, тела таких запросов и ответов не читаются в память целиком
+func blobRequest(c echo.Context) bool {
+	p := c.Request().URL.Path
+
+	return strings.HasPrefix(p, s3.BlobRoute+"/") || strings.HasPrefix(p, s3.BlobUploadRoute+"/")
+}
+
+func (a *Web) fsStores() (stores []*s3.FSStore) {
+	if store, ok := a.app.S3PrivateService.Store().(*s3.FSStore); ok {
+		stores = append(stores, store)
+	}
+
+	if store, ok := a.app.S3Service.Store().(*s3.FSStore); ok {
+		stores = append(stores, store)
+	}
+
+	return stores
+}
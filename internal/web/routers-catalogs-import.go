@@ -0,0 +1,253 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/catalogs"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ocatalog"
+	"github.com/samber/lo"
+)
+
+const catalogImportMaxSize = 20 << 20
+
+func (a *Web) PostCatalogUUIDImport(ctx context.Context, request oapi.PostCatalogUUIDImportRequestObject) (oapi.PostCatalogUUIDImportResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	catalog, err := a.app.CatalogService.GetCatalog(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := request.Body.ReadForm(1000000)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = form.RemoveAll() }()
+
+	if len(form.File["file"]) == 0 {
+		return nil, errors.New("file is required")
+	}
+
+	fh := form.File["file"][0]
+	if fh.Size > catalogImportMaxSize {
+		return nil, fmt.Errorf("файл больше %d МБ", catalogImportMaxSize>>20)
+	}
+
+	format, err := catalogs.ImportFormat(fh.Filename)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := catalogs.ImportOptions{}
+
+	if v := form.Value["mapping"]; len(v) > 0 && v[0] != "" {
+		if err := json.Unmarshal([]byte(v[0]), &opts.Mapping); err != nil {
+			return nil, fmt.Errorf("mapping: %w", err)
+		}
+	}
+
+	if v := form.Value["lookup"]; len(v) > 0 && v[0] != "" {
+		if err := json.Unmarshal([]byte(v[0]), &opts.Lookup); err != nil {
+			return nil, fmt.Errorf("lookup: %w", err)
+		}
+	}
+
+	if v := form.Value["dry_run"]; len(v) > 0 {
+		opts.DryRun = v[0] == "true" || v[0] == "1"
+	}
+
+	file, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	table, err := catalogs.ParseImport(format, io.LimitReader(file, catalogImportMaxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := a.app.CatalogService.ImportData(catalog, table, opts, domain.Creator{
+		UUID:  claims.UUID,
+		Email: claims.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCatalogUUIDImport200JSONResponse{
+		DryRun:  report.DryRun,
+		Total:   report.Total,
+		Valid:   report.Valid,
+		Created: report.Created,
+		Columns: report.Columns,
+		Ignored: lo.Ternary(report.Ignored == nil, []string{}, report.Ignored),
+		Errors: lo.Map(report.Errors, func(e catalogs.ImportError, _ int) dto.CatalogImportErrorDTO {
+			return dto.CatalogImportErrorDTO{
+				Row:    e.Row,
+				Column: e.Column,
+				Error:  e.Error,
+			}
+		}),
+	}, nil
+}
+
+func (a *Web) GetCatalogUUIDExport(ctx context.Context, request oapi.GetCatalogUUIDExportRequestObject) (oapi.GetCatalogUUIDExportResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	catalog, err := a.app.CatalogService.GetCatalog(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	filterDto, err := dto.NewFilterDTO(request.Params.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	search := dto.CatalogSearchDTO{
+		CatalogUUID: request.UUID,
+		Fields:      filterDto,
+
+		Order: request.Params.Order,
+		By:    request.Params.By,
+	}
+
+	err = search.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	dmns, err := a.app.CatalogService.ExportData(search)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := lo.Map(dmns, func(dm domain.CatalogData, _ int) dto.CatalogDataExportDTO {
+		return newCatalogDataExportDTO(catalog.Fields, dm)
+	})
+
+	buf := &bytes.Buffer{}
+	contentType := "application/octet-stream"
+
+	switch request.Params.Format {
+	case catalogs.FormatXLSX:
+		f, err := toExcel(dtos, "")
+		if err != nil {
+			return nil, err
+		}
+
+		buf, err = f.WriteToBuffer()
+		if err != nil {
+			return nil, err
+		}
+	case catalogs.FormatCSV:
+		err = toCSV(buf, dtos)
+		if err != nil {
+			return nil, err
+		}
+
+		contentType = "text/csv; charset=utf-8"
+	default:
+		items := lo.Map(dmns, func(dm domain.CatalogData, _ int) map[string]interface{} {
+			mp := map[string]interface{}{}
+			for _, field := range catalog.Fields {
+				if v, ok := dm.Fields[field.Hash]; ok {
+					mp[field.Hash] = v
+				}
+			}
+
+			mp["uuid"] = dm.UUID
+			mp["created_at"] = dm.CreatedAt
+			mp["updated_at"] = dm.UpdatedAt
+
+			return mp
+		})
+
+		err = json.NewEncoder(buf).Encode(items)
+		if err != nil {
+			return nil, err
+		}
+
+		contentType = "application/json"
+	}
+
+	contentDisposition := fmt.Sprintf("attachment; filename=\"%s.%s\";", helpers.Scientific(catalog.Name), request.Params.Format)
+
+	return oapi.GetCatalogUUIDExport200ApplicationoctetStreamResponse{
+		Body:          buf,
+		ContentLength: int64(buf.Len()),
+
+		Headers: oapi.GetCatalogUUIDExport200ResponseHeaders{
+			CacheControl:       "no-cache",
+			ContentType:        contentType,
+			ContentDisposition: contentDisposition,
+		},
+	}, nil
+}
+
+func newCatalogDataExportDTO(fields []domain.CatalogFiled, dm domain.CatalogData) dto.CatalogDataExportDTO {
+	return dto.CatalogDataExportDTO{
+		UUID: dm.UUID,
+		Fields: lo.Map(fields, func(field domain.CatalogFiled, _ int) dto.XLSXColumn {
+			value := dm.Fields[field.Hash]
+			if field.DataType == domain.Money && value != nil {
+				if m, err := domain.NewMoney(value); err == nil {
+					value = m
+				}
+			}
+
+			return dto.XLSXColumn{
+				Name:  field.Name,
+				Value: value,
+			}
+		}),
+		CreatedAt: dm.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt: dm.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// toCSV пишет строки через parseStruct, как toExcel, с BOM чтобы Excel понял utf-8
+func toCSV[T any](w io.Writer, dtos []T) error {
+	_, err := io.WriteString(w, "\ufeff")
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+
+	for idx, item := range dtos {
+		row, names := parseStruct(item, make([]interface{}, 0), "", []string{})
+
+		if idx == 0 {
+			if err := cw.Write(names); err != nil {
+				return err
+			}
+		}
+
+		err = cw.Write(lo.Map(row, func(v interface{}, _ int) string { return fmt.Sprintf("%v", v) }))
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
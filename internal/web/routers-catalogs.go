@@ -119,6 +119,7 @@ func (a *Web) GetCatalogUUID(ctx context.Context, request oapi.GetCatalogUUIDReq
 				Hash:     item.Hash,
 				DataType: int(item.DataType),
 				DataDesc: item.FieldTypeDesc(),
+				Options:  item.Options,
 			}
 		}),
 
@@ -186,6 +187,21 @@ func (a *Web) PostCatalogUUIDFields(ctx context.Context, request oapi.PostCatalo
 
 	pf := domain.NewCatalogFiled(request.Body.Name, "", request.Body.DataType, request.Body.DataUuid, request.UUID, claims.Email)
 
+	err := pf.SetOnDelete(lo.FromPtr(request.Body.OnDelete))
+	if err != nil {
+		return nil, err
+	}
+
+	err = pf.SetFormula(lo.FromPtr(request.Body.Formula))
+	if err != nil {
+		return nil, err
+	}
+
+	err = pf.SetOptions(lo.FromPtr(request.Body.Options))
+	if err != nil {
+		return nil, err
+	}
+
 	dt, err := a.app.CatalogService.CreateCatalogField(pf)
 	if err != nil {
 		return nil, err
@@ -223,7 +239,7 @@ func (a *Web) PostCatalogUUIDFieldsNamed(ctx context.Context, request oapi.PostC
 }
 
 func (a *Web) PutCatalogUUIDFieldsEntityUUID(ctx context.Context, request oapi.PutCatalogUUIDFieldsEntityUUIDRequestObject) (oapi.PutCatalogUUIDFieldsEntityUUIDResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
@@ -232,9 +248,15 @@ func (a *Web) PutCatalogUUIDFieldsEntityUUID(ctx context.Context, request oapi.P
 		CatalogUUID: request.UUID,
 		UUID:        request.EntityUUID,
 		Name:        request.Body.Name,
+		OnDelete:    lo.FromPtr(request.Body.OnDelete),
+		Formula:     lo.FromPtr(request.Body.Formula),
+		Options:     lo.FromPtr(request.Body.Options),
 	}
 
-	err := a.app.CatalogService.PutCatalogField(pf)
+	err := a.app.CatalogService.PutCatalogField(pf, domain.Creator{
+		UUID:  claims.UUID,
+		Email: claims.Email,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -261,6 +283,9 @@ func (a *Web) GetCatalogUUIDFields(ctx context.Context, request oapi.GetCatalogU
 			DataType:        int(item.DataType),
 			DataCatalogUUID: item.DataCatalogUUID,
 			DataDesc:        item.FieldTypeDesc(),
+			OnDelete:        item.OnDelete,
+			Formula:         item.Formula,
+			Options:         item.Options,
 		}
 	})
 
@@ -420,3 +445,175 @@ func (a *Web) GetCatalogUUIDData(ctx context.Context, request oapi.GetCatalogUUI
 		},
 	}, nil
 }
+
+func (a *Web) PutCatalogUUIDDataEntityUUID(ctx context.Context, request oapi.PutCatalogUUIDDataEntityUUIDRequestObject) (oapi.PutCatalogUUIDDataEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.app.CatalogService.PutData(request.UUID, request.EntityUUID, request.Body.Fields, domain.Creator{
+		UUID:  claims.UUID,
+		Email: claims.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutCatalogUUIDDataEntityUUID200JSONResponse{
+		Uuid: request.EntityUUID,
+	}, nil
+}
+
+func (a *Web) PatchCatalogUUIDDataEntityUUID(ctx context.Context, request oapi.PatchCatalogUUIDDataEntityUUIDRequestObject) (oapi.PatchCatalogUUIDDataEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.app.CatalogService.PatchData(request.UUID, request.EntityUUID, request.Body.Fields, domain.Creator{
+		UUID:  claims.UUID,
+		Email: claims.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchCatalogUUIDDataEntityUUID200JSONResponse{
+		Uuid: request.EntityUUID,
+	}, nil
+}
+
+func (a *Web) DeleteCatalogUUIDDataEntityUUID(ctx context.Context, request oapi.DeleteCatalogUUIDDataEntityUUIDRequestObject) (oapi.DeleteCatalogUUIDDataEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.CatalogService.DeleteData(request.UUID, request.EntityUUID, domain.Creator{
+		UUID:  claims.UUID,
+		Email: claims.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteCatalogUUIDDataEntityUUID200Response{}, nil
+}
+
+func (a *Web) GetCatalogUUIDDataEntityUUIDHistory(ctx context.Context, request oapi.GetCatalogUUIDDataEntityUUIDHistoryRequestObject) (oapi.GetCatalogUUIDDataEntityUUIDHistoryResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	history, err := a.app.CatalogService.GetDataHistory(request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCatalogUUIDDataEntityUUIDHistory200JSONResponse{
+		Count: len(history),
+		Items: lo.Map(history, func(item domain.CatalogDataHistory, _ int) dto.CatalogDataHistoryDTO {
+			return dto.NewCatalogDataHistoryDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) GetCatalogUUIDDataEntityUUIDUsages(ctx context.Context, request oapi.GetCatalogUUIDDataEntityUUIDUsagesRequestObject) (oapi.GetCatalogUUIDDataEntityUUIDUsagesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	usages, err := a.app.CatalogService.GetDataUsages(request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := a.app.TaskService.GetTasksByEntity(ctx, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCatalogUUIDDataEntityUUIDUsages200JSONResponse{
+		Catalogs: lo.Map(usages, func(u catalogs.DataUsage, _ int) dto.CatalogDataUsageDTO {
+			return dto.CatalogDataUsageDTO{
+				UUID:        u.DataUUID,
+				CatalogUUID: u.CatalogUUID,
+				CatalogName: u.CatalogName,
+				FieldHash:   u.Field.Hash,
+				FieldName:   u.Field.Name,
+				OnDelete:    u.Field.OnDelete,
+			}
+		}),
+		Tasks: lo.Map(tasks, func(t domain.Task, _ int) dto.TaskEntityUsageDTO {
+			return dto.TaskEntityUsageDTO{
+				UUID:        t.UUID,
+				Name:        t.Name,
+				ProjectUUID: t.ProjectUUID,
+				Fields:      lo.Ternary(t.TaskEntities[request.EntityUUID] == nil, []string{}, t.TaskEntities[request.EntityUUID]),
+			}
+		}),
+	}, nil
+}
+
+func (a *Web) GetCatalogUUIDDataDuplicates(ctx context.Context, request oapi.GetCatalogUUIDDataDuplicatesRequestObject) (oapi.GetCatalogUUIDDataDuplicatesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.CatalogService.AllDataDuplicates(request.UUID, lo.FromPtrOr(request.Params.MinScore, domain.DuplicateMinScore))
+	if err != nil {
+		return nil, err
+	}
+
+	items := lo.Subset(dms, 0, uint(lo.FromPtrOr(request.Params.Limit, 100)))
+
+	return oapi.GetCatalogUUIDDataDuplicates200JSONResponse{
+		Count: len(items),
+		Items: lo.Map(items, func(item domain.CatalogDataDuplicate, _ int) dto.CatalogDataDuplicateDTO {
+			return dto.NewCatalogDataDuplicateDTO(item)
+		}),
+		Total: len(dms),
+	}, nil
+}
+
+func (a *Web) GetCatalogUUIDDataEntityUUIDDuplicates(ctx context.Context, request oapi.GetCatalogUUIDDataEntityUUIDDuplicatesRequestObject) (oapi.GetCatalogUUIDDataEntityUUIDDuplicatesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.CatalogService.DataDuplicates(request.UUID, request.EntityUUID, lo.FromPtrOr(request.Params.MinScore, domain.DuplicateMinScore))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCatalogUUIDDataEntityUUIDDuplicates200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.CatalogDataDuplicate, _ int) dto.CatalogDataDuplicateDTO {
+			return dto.NewCatalogDataDuplicateDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostCatalogUUIDDataEntityUUIDMerge(ctx context.Context, request oapi.PostCatalogUUIDDataEntityUUIDMergeRequestObject) (oapi.PostCatalogUUIDDataEntityUUIDMergeResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.app.CatalogService.MergeData(request.UUID, request.EntityUUID, request.Body.DuplicateUuid, domain.Creator{
+		UUID:  claims.UUID,
+		Email: claims.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCatalogUUIDDataEntityUUIDMerge200JSONResponse{
+		Uuid: request.EntityUUID,
+	}, nil
+}
@@ -0,0 +1,364 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+func (a *Web) GetCompanyUUIDDealsPipelines(ctx context.Context, request oapi.GetCompanyUUIDDealsPipelinesRequestObject) (oapi.GetCompanyUUIDDealsPipelinesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.DealsService.GetPipelines(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDDealsPipelines200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.DealPipeline, _ int) dto.DealPipelineDTO {
+			return dto.NewDealPipelineDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostCompanyUUIDDealsPipelines(ctx context.Context, request oapi.PostCompanyUUIDDealsPipelinesRequestObject) (oapi.PostCompanyUUIDDealsPipelinesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	cmpny, f := a.app.DictionaryService.FindCompany(request.UUID)
+	if !f {
+		return nil, errors.New("company not found")
+	}
+
+	dm := &domain.DealPipeline{
+		UUID:           uuid.New(),
+		FederationUUID: cmpny.FederationUUID,
+		CompanyUUID:    cmpny.UUID,
+		CreatedBy:      claims.Email,
+		CreatedByUUID:  claims.UUID,
+	}
+	setDealPipeline(dm, *request.Body)
+
+	err := a.app.DealsService.CreatePipeline(ctx, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := a.app.DealsService.GetPipeline(ctx, cmpny.UUID, dm.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDDealsPipelines200JSONResponse(dto.NewDealPipelineDTO(pipeline)), nil
+}
+
+func (a *Web) PutCompanyUUIDDealsPipelinesEntityUUID(ctx context.Context, request oapi.PutCompanyUUIDDealsPipelinesEntityUUIDRequestObject) (oapi.PutCompanyUUIDDealsPipelinesEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.DealsService.GetPipeline(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+	setDealPipeline(&dm, *request.Body)
+
+	err = a.app.DealsService.UpdatePipeline(ctx, &dm)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := a.app.DealsService.GetPipeline(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutCompanyUUIDDealsPipelinesEntityUUID200JSONResponse(dto.NewDealPipelineDTO(pipeline)), nil
+}
+
+func (a *Web) DeleteCompanyUUIDDealsPipelinesEntityUUID(ctx context.Context, request oapi.DeleteCompanyUUIDDealsPipelinesEntityUUIDRequestObject) (oapi.DeleteCompanyUUIDDealsPipelinesEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.DealsService.DeletePipeline(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteCompanyUUIDDealsPipelinesEntityUUID200Response{}, nil
+}
+
+func (a *Web) GetCompanyUUIDDeals(ctx context.Context, request oapi.GetCompanyUUIDDealsRequestObject) (oapi.GetCompanyUUIDDealsResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, total, err := a.app.DealsService.GetDeals(ctx, domain.DealFilter{
+		CompanyUUID:  request.UUID,
+		PipelineUUID: request.Params.PipelineUuid,
+		StageUUID:    request.Params.StageUuid,
+		AgentUUID:    request.Params.AgentUuid,
+		TaskUUID:     request.Params.TaskUuid,
+		Status:       request.Params.Status,
+		Name:         request.Params.Name,
+		Offset:       lo.FromPtr(request.Params.Offset),
+		Limit:        lo.FromPtrOr(request.Params.Limit, 20),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDDeals200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Deal, _ int) dto.DealDTO {
+			return dto.NewDealDTO(item)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) PostCompanyUUIDDeals(ctx context.Context, request oapi.PostCompanyUUIDDealsRequestObject) (oapi.PostCompanyUUIDDealsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	cmpny, f := a.app.DictionaryService.FindCompany(request.UUID)
+	if !f {
+		return nil, errors.New("company not found")
+	}
+
+	if request.Body.PipelineUuid == nil {
+		return nil, errors.New("pipeline_uuid is required")
+	}
+
+	dm := &domain.Deal{
+		UUID:           uuid.New(),
+		FederationUUID: cmpny.FederationUUID,
+		CompanyUUID:    cmpny.UUID,
+		CreatedBy:      claims.Email,
+		CreatedByUUID:  claims.UUID,
+		PipelineUUID:   *request.Body.PipelineUuid,
+		StageUUID:      lo.FromPtr(request.Body.StageUuid),
+	}
+	setDeal(dm, *request.Body)
+
+	err := a.app.DealsService.CreateDeal(ctx, domain.Creator{
+		UUID:  claims.UUID,
+		Email: claims.Email,
+	}, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	deal, err := a.app.DealsService.GetDeal(ctx, cmpny.UUID, dm.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDDeals200JSONResponse(dto.NewDealDTO(deal)), nil
+}
+
+func (a *Web) GetCompanyUUIDDealsForecast(ctx context.Context, request oapi.GetCompanyUUIDDealsForecastRequestObject) (oapi.GetCompanyUUIDDealsForecastResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	var from, to *time.Time
+	if request.Params.From != nil {
+		from = &request.Params.From.Time
+	}
+	if request.Params.To != nil {
+		to = &request.Params.To.Time
+	}
+
+	dms, err := a.app.DealsService.Forecast(ctx, request.UUID, request.Params.PipelineUuid, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelines, err := a.app.DealsService.GetPipelines(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	stages := map[uuid.UUID]string{}
+	for _, pipeline := range pipelines {
+		for _, stage := range pipeline.Stages {
+			stages[stage.UUID] = stage.Name
+		}
+	}
+
+	return oapi.GetCompanyUUIDDealsForecast200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.DealForecast, _ int) dto.DealForecastDTO {
+			return dto.DealForecastDTO{
+				StageUUID: item.StageUUID,
+				Stage:     stages[item.StageUUID],
+				Month:     item.Month,
+				Currency:  item.Currency,
+				Count:     item.Count,
+				Amount:    item.Amount,
+				Weighted:  item.Weighted,
+			}
+		}),
+	}, nil
+}
+
+func (a *Web) GetCompanyUUIDDealsEntityUUID(ctx context.Context, request oapi.GetCompanyUUIDDealsEntityUUIDRequestObject) (oapi.GetCompanyUUIDDealsEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.DealsService.GetDeal(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDDealsEntityUUID200JSONResponse(dto.NewDealDTO(dm)), nil
+}
+
+func (a *Web) PutCompanyUUIDDealsEntityUUID(ctx context.Context, request oapi.PutCompanyUUIDDealsEntityUUIDRequestObject) (oapi.PutCompanyUUIDDealsEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.DealsService.GetDeal(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+	setDeal(&dm, *request.Body)
+
+	err = a.app.DealsService.UpdateDeal(ctx, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	deal, err := a.app.DealsService.GetDeal(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutCompanyUUIDDealsEntityUUID200JSONResponse(dto.NewDealDTO(deal)), nil
+}
+
+func (a *Web) DeleteCompanyUUIDDealsEntityUUID(ctx context.Context, request oapi.DeleteCompanyUUIDDealsEntityUUIDRequestObject) (oapi.DeleteCompanyUUIDDealsEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.DealsService.DeleteDeal(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteCompanyUUIDDealsEntityUUID200Response{}, nil
+}
+
+func (a *Web) PutCompanyUUIDDealsEntityUUIDStage(ctx context.Context, request oapi.PutCompanyUUIDDealsEntityUUIDStageRequestObject) (oapi.PutCompanyUUIDDealsEntityUUIDStageResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.DealsService.GetDeal(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err = a.app.DealsService.MoveDeal(ctx, domain.Creator{
+		UUID:  claims.UUID,
+		Email: claims.Email,
+	}, dm, request.Body.PipelineUuid, request.Body.StageUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutCompanyUUIDDealsEntityUUIDStage200JSONResponse(dto.NewDealDTO(dm)), nil
+}
+
+func (a *Web) GetCompanyUUIDDealsEntityUUIDActivity(ctx context.Context, request oapi.GetCompanyUUIDDealsEntityUUIDActivityRequestObject) (oapi.GetCompanyUUIDDealsEntityUUIDActivityResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.app.DealsService.GetDeal(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := lo.FromPtr(request.Params.Offset)
+	limit := lo.FromPtr(request.Params.Limit)
+
+	dms, total, err := a.app.DealsService.GetActivities(ctx, request.EntityUUID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDDealsEntityUUIDActivity200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Activity, _ int) dto.ActivityDTO {
+			createdBy, f := a.app.DictionaryService.FindUser(item.CreatedBy.Email)
+
+			if !f {
+				logrus.Errorf("activity created by not found: %s", item.CreatedBy.Email)
+			}
+
+			return *dto.NewActivityDTO(item, lo.FromPtr(createdBy))
+		}),
+		Total: total,
+	}, nil
+}
+
+func setDealPipeline(dm *domain.DealPipeline, body oapi.DealPipelineRequest) {
+	dm.Name = body.Name
+	dm.Sort = lo.FromPtr(body.Sort)
+	dm.Stages = lo.Map(body.Stages, func(item oapi.DealStageRequest, _ int) domain.DealStage {
+		return domain.DealStage{
+			UUID:        lo.FromPtr(item.Uuid),
+			Name:        item.Name,
+			Color:       lo.FromPtr(item.Color),
+			Probability: item.Probability,
+			Status:      lo.FromPtr(item.Status),
+		}
+	})
+}
+
+// setDeal переносит в сделку поля запроса, кроме воронки и этапа
+func setDeal(dm *domain.Deal, body oapi.DealRequest) {
+	dm.Name = body.Name
+	dm.Description = lo.FromPtr(body.Description)
+	dm.Tags = lo.FromPtr(body.Tags)
+	dm.Priority = lo.FromPtr(body.Priority)
+	dm.Amount = domain.MoneyValue{
+		Amount:   lo.FromPtr(body.Amount),
+		Currency: lo.FromPtrOr(body.Currency, "RUB"),
+	}
+	dm.Probability = body.Probability
+	dm.AgentUUID = body.AgentUuid
+	dm.TaskUUIDs = lo.FromPtr(body.TaskUuids)
+	dm.CloseAt = body.CloseAt
+}
@@ -2,12 +2,15 @@ package web
 
 import (
 	"context"
+	"errors"
 
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/jwt"
 	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
 )
 
 func (a *Web) PostCompanyUUIDFields(ctx context.Context, request oapi.PostCompanyUUIDFieldsRequestObject) (oapi.PostCompanyUUIDFieldsResponseObject, error) {
@@ -24,8 +27,27 @@ func (a *Web) PostCompanyUUIDFields(ctx context.Context, request oapi.PostCompan
 		Icon:        request.Body.Icon,
 	}
 
+	err := pf.SetFormula(lo.FromPtr(request.Body.Formula))
+	if err != nil {
+		return nil, err
+	}
+
+	err = pf.SetOptions(lo.FromPtr(request.Body.Options))
+	if err != nil {
+		return nil, err
+	}
+
+	err = pf.SetOnDelete(lo.FromPtr(request.Body.OnDelete))
+	if err != nil {
+		return nil, err
+	}
+
 	dt, err := a.app.FederationService.CreateCompanyField(pf)
 	if err != nil {
+		if errors.Is(err, federation.ErrInvalidFormula) {
+			return nil, err
+		}
+
 		return nil, ErrInvalidAuthHeader
 	}
 
@@ -51,6 +73,9 @@ func (a *Web) PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request oapi.P
 		Description:        request.Body.Description,
 		Icon:               request.Body.Icon,
 		RequiredOnStatuses: request.Body.RequiredOnStatuses,
+		Formula:            lo.FromPtr(request.Body.Formula),
+		Options:            lo.FromPtr(request.Body.Options),
+		OnDelete:           lo.FromPtr(request.Body.OnDelete),
 	}
 
 	err := a.app.FederationService.PutCompanyField(pf)
@@ -81,6 +106,9 @@ func (a *Web) GetCompanyUUIDFields(ctx context.Context, request oapi.GetCompanyU
 			Icon:         item.Icon,
 			DataType:     int(item.DataType),
 			DataDesc:     item.FieldTypeDesc(),
+			Formula:      item.Formula,
+			Options:      item.Options,
+			OnDelete:     item.OnDelete,
 			ProjectsUUID: item.ProjectUUID,
 
 			TasksTotal:        item.TasksTotal,
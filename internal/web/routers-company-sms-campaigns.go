@@ -0,0 +1,252 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	"github.com/krisch/crm-backend/internal/sms"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetCompanyUUIDSmsCampaigns(ctx context.Context, request oapi.GetCompanyUUIDSmsCampaignsRequestObject) (oapi.GetCompanyUUIDSmsCampaignsResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	offset := lo.FromPtr(request.Params.Offset)
+	limit := lo.FromPtrOr(request.Params.Limit, 20)
+
+	dms, total, err := a.app.SMSService.GetCampaigns(ctx, request.UUID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDSmsCampaigns200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.SmsCampaign, _ int) dto.SmsCampaignDTO {
+			return dto.NewSmsCampaignDTO(item)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) PostCompanyUUIDSmsCampaigns(ctx context.Context, request oapi.PostCompanyUUIDSmsCampaignsRequestObject) (oapi.PostCompanyUUIDSmsCampaignsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	cmpny, f := a.app.DictionaryService.FindCompany(request.UUID)
+	if !f {
+		return nil, errors.New("company not found")
+	}
+
+	opts, err := a.app.SmsOptions(cmpny.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := a.app.SmsCampaignRecipients(ctx, cmpny.FederationUUID, cmpny.UUID, request.Body.Source, request.Body.CatalogUuid, lo.FromPtr(request.Body.PhoneField))
+	if err != nil {
+		return nil, err
+	}
+
+	cmp := &domain.SmsCampaign{
+		UUID:           uuid.New(),
+		FederationUUID: cmpny.FederationUUID,
+		CompanyUUID:    cmpny.UUID,
+		CreatedBy:      claims.Email,
+		CreatedByUUID:  claims.UUID,
+		Name:           request.Body.Name,
+		Template:       request.Body.Template,
+		Source:         request.Body.Source,
+	}
+
+	if request.Body.Source == sms.SourceCatalog {
+		cmp.SourceUUID = request.Body.CatalogUuid
+	}
+
+	err = a.app.SMSService.CreateCampaign(ctx, opts, cmp, recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.SMSService.GetCampaign(ctx, cmp.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDSmsCampaigns200JSONResponse(dto.NewSmsCampaignDTO(dm)), nil
+}
+
+func (a *Web) GetCompanyUUIDSmsCampaignsEntityUUID(ctx context.Context, request oapi.GetCompanyUUIDSmsCampaignsEntityUUIDRequestObject) (oapi.GetCompanyUUIDSmsCampaignsEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.smsCampaign(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDSmsCampaignsEntityUUID200JSONResponse(dto.NewSmsCampaignDTO(dm)), nil
+}
+
+func (a *Web) PostCompanyUUIDSmsCampaignsEntityUUIDCancel(ctx context.Context, request oapi.PostCompanyUUIDSmsCampaignsEntityUUIDCancelRequestObject) (oapi.PostCompanyUUIDSmsCampaignsEntityUUIDCancelResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.smsCampaign(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.SMSService.CancelCampaign(ctx, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDSmsCampaignsEntityUUIDCancel200Response{}, nil
+}
+
+func (a *Web) GetCompanyUUIDSmsCampaignsEntityUUIDCost(ctx context.Context, request oapi.GetCompanyUUIDSmsCampaignsEntityUUIDCostRequestObject) (oapi.GetCompanyUUIDSmsCampaignsEntityUUIDCostResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.smsCampaign(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := a.app.SmsOptions(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := a.app.SMSService.CampaignCost(ctx, opts, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	mp, err := helpers.StructToMap(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDSmsCampaignsEntityUUIDCost200JSONResponse(mp), nil
+}
+
+func (a *Web) PostCompanyUUIDSmsCampaignsEntityUUIDCsv(ctx context.Context, request oapi.PostCompanyUUIDSmsCampaignsEntityUUIDCsvRequestObject) (oapi.PostCompanyUUIDSmsCampaignsEntityUUIDCsvResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.smsCampaign(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := request.Body.NextPart()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("file is required: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	recipients, err := sms.ParseRecipientsCSV(io.LimitReader(file, 10<<20))
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := a.app.SmsOptions(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := a.app.SMSService.AddRecipients(ctx, opts, request.EntityUUID, recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDSmsCampaignsEntityUUIDCsv200JSONResponse{
+		Added: added,
+	}, nil
+}
+
+func (a *Web) GetCompanyUUIDSmsCampaignsEntityUUIDRecipients(ctx context.Context, request oapi.GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsRequestObject) (oapi.GetCompanyUUIDSmsCampaignsEntityUUIDRecipientsResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.smsCampaign(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := lo.FromPtr(request.Params.Offset)
+	limit := lo.FromPtrOr(request.Params.Limit, 50)
+
+	dms, total, err := a.app.SMSService.GetRecipients(ctx, request.EntityUUID, request.Params.State, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDSmsCampaignsEntityUUIDRecipients200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.SmsRecipient, _ int) dto.SmsRecipientDTO {
+			return dto.NewSmsRecipientDTO(item)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) PostCompanyUUIDSmsCampaignsEntityUUIDStart(ctx context.Context, request oapi.PostCompanyUUIDSmsCampaignsEntityUUIDStartRequestObject) (oapi.PostCompanyUUIDSmsCampaignsEntityUUIDStartResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.smsCampaign(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.SMSService.StartCampaign(ctx, request.EntityUUID, request.Body.SendAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDSmsCampaignsEntityUUIDStart200Response{}, nil
+}
+
+// smsCampaign возвращает рассылку, если она принадлежит компании
+func (a *Web) smsCampaign(ctx context.Context, companyUUID, uid uuid.UUID) (domain.SmsCampaign, error) {
+	dm, err := a.app.SMSService.GetCampaign(ctx, uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.CompanyUUID != companyUUID {
+		return dm, dto.NotFoundErr("рассылка не найдена")
+	}
+
+	return dm, nil
+}
@@ -0,0 +1,131 @@
+package web
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetCompanyUUIDSmsTemplates(ctx context.Context, request oapi.GetCompanyUUIDSmsTemplatesRequestObject) (oapi.GetCompanyUUIDSmsTemplatesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.SMSService.GetTemplates(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDSmsTemplates200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.SmsTemplate, _ int) dto.SmsTemplateDTO {
+			return dto.NewSmsTemplateDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostCompanyUUIDSmsTemplates(ctx context.Context, request oapi.PostCompanyUUIDSmsTemplatesRequestObject) (oapi.PostCompanyUUIDSmsTemplatesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	cmpny, f := a.app.DictionaryService.FindCompany(request.UUID)
+	if !f {
+		return nil, errors.New("company not found")
+	}
+
+	tpl := &domain.SmsTemplate{
+		FederationUUID: cmpny.FederationUUID,
+		CompanyUUID:    cmpny.UUID,
+		CreatedBy:      claims.Email,
+		CreatedByUUID:  claims.UUID,
+	}
+	smsTemplateFromRequest(tpl, request.Body)
+
+	err := a.app.SMSService.CreateTemplate(ctx, tpl)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.SMSService.GetTemplate(ctx, tpl.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDSmsTemplates200JSONResponse(dto.NewSmsTemplateDTO(dm)), nil
+}
+
+func (a *Web) PutCompanyUUIDSmsTemplatesEntityUUID(ctx context.Context, request oapi.PutCompanyUUIDSmsTemplatesEntityUUIDRequestObject) (oapi.PutCompanyUUIDSmsTemplatesEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.smsTemplate(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	smsTemplateFromRequest(&dm, request.Body)
+
+	err = a.app.SMSService.UpdateTemplate(ctx, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err = a.app.SMSService.GetTemplate(ctx, dm.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutCompanyUUIDSmsTemplatesEntityUUID200JSONResponse(dto.NewSmsTemplateDTO(dm)), nil
+}
+
+func (a *Web) DeleteCompanyUUIDSmsTemplatesEntityUUID(ctx context.Context, request oapi.DeleteCompanyUUIDSmsTemplatesEntityUUIDRequestObject) (oapi.DeleteCompanyUUIDSmsTemplatesEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, err := a.smsTemplate(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.SMSService.DeleteTemplate(ctx, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteCompanyUUIDSmsTemplatesEntityUUID200Response{}, nil
+}
+
+func smsTemplateFromRequest(tpl *domain.SmsTemplate, body *oapi.SmsTemplateRequest) {
+	tpl.Name = body.Name
+	tpl.Text = body.Text
+	tpl.PhoneField = lo.FromPtr(body.PhoneField)
+	tpl.ProjectUUID = body.ProjectUuid
+	tpl.OnStatus = body.OnStatus
+}
+
+// smsTemplate возвращает шаблон sms, если он принадлежит компании
+func (a *Web) smsTemplate(ctx context.Context, companyUUID, uid uuid.UUID) (domain.SmsTemplate, error) {
+	dm, err := a.app.SMSService.GetTemplate(ctx, uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.CompanyUUID != companyUUID {
+		return dm, dto.NotFoundErr("шаблон sms не найден")
+	}
+
+	return dm, nil
+}
@@ -4,7 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/company"
@@ -12,7 +17,9 @@ import (
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/sms"
 	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	echo "github.com/labstack/echo/v4"
 	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
 )
 
 // PostCompanySmsCost implements oapi.StrictServerInterface.
@@ -22,7 +29,7 @@ func (a *Web) PostCompanyUUIDSmsCost(ctx context.Context, request oapi.PostCompa
 		return nil, ErrInvalidAuthHeader
 	}
 
-	smsOptions, err := a.app.CompanyService.GetSmsOptions(request.UUID)
+	opts, err := a.app.SmsOptions(request.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -30,10 +37,10 @@ func (a *Web) PostCompanyUUIDSmsCost(ctx context.Context, request oapi.PostCompa
 	s := &domain.Sms{
 		To:   fmt.Sprint(request.Body.Phone),
 		Text: request.Body.Text,
-		From: smsOptions.From,
+		From: opts.From,
 	}
 
-	rsp, err := a.app.SMSService.SmsCost(smsOptions.API, s)
+	rsp, err := a.app.SMSService.Cost(ctx, opts, s)
 	if err != nil {
 		return nil, err
 	}
@@ -52,15 +59,40 @@ func (a *Web) PostCompanyUUIDSmsOptions(ctx context.Context, request oapi.PostCo
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CompanyService.CreateSmsOptions(request.UUID, company.SmsOptions{
-		API:  request.Body.Api,
-		From: request.Body.From,
-	})
+	so := company.SmsOptions{
+		Provider: lo.FromPtr(request.Body.Provider),
+		API:      request.Body.Api,
+		From:     request.Body.From,
+		Params:   lo.FromPtr(request.Body.Params),
+
+		RateLimit: lo.FromPtr(request.Body.RateLimit),
+	}
+
+	// неизвестный провайдер или неполные параметры не сохраняем, иначе ошибка всплывет только при отправке
+	_, err := a.app.SMSService.Provider(sms.Options{Provider: so.Provider, API: so.API, From: so.From, Params: so.Params})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CompanyService.CreateSmsOptions(request.UUID, so)
 	if err != nil {
 		return nil, err
 	}
 
-	return oapi.PostCompanyUUIDSmsOptions200Response{}, nil
+	return oapi.PostCompanyUUIDSmsOptions200JSONResponse{
+		CallbackUrl: a.smsCallbackURL(request.UUID, lo.Ternary(so.Provider == "", sms.ProviderSmsRu, so.Provider)),
+	}, nil
+}
+
+// smsCallbackURL - адрес отчетов о доставке, который компания указывает у провайдера.
+// Пустой, пока не задан SMS_CALLBACK_SECRET: без него отчеты не принимаются
+func (a *Web) smsCallbackURL(companyUUID uuid.UUID, provider string) string {
+	if a.Options.SMS_CALLBACK_SECRET == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/sms/callback/%s/%s?token=%s", strings.TrimRight(a.Options.URL_BACKEND, "/"), companyUUID, provider,
+		sms.CallbackToken(a.Options.SMS_CALLBACK_SECRET, companyUUID, provider))
 }
 
 var MockSms = "mock_sms"
@@ -71,55 +103,30 @@ func (a *Web) PostCompanyUUIDSmsSend(ctx context.Context, request oapi.PostCompa
 		return nil, ErrInvalidAuthHeader
 	}
 
-	mockSms := false
-	if request.Params.MockSms != nil && *request.Params.MockSms == "true" {
-		mockSms = true
-	}
-
 	cmpny, f := a.app.DictionaryService.FindCompany(request.UUID)
 	if !f {
 		return nil, errors.New("company not found")
 	}
 
-	smsOptions, err := a.app.CompanyService.GetSmsOptions(cmpny.UUID)
+	opts, err := a.app.SmsOptions(cmpny.UUID)
 	if err != nil {
 		return nil, err
 	}
 
-	s := sms.NewCompanySms(fmt.Sprint(request.Body.Phone), request.Body.Text, smsOptions.From, claims.UUID, claims.Email, cmpny)
-
-	mp := make(map[string]interface{})
+	if request.Params.MockSms != nil && *request.Params.MockSms == "true" {
+		opts.Provider = sms.ProviderFake
+	}
 
-	if !mockSms {
-		rsp, err := a.app.SMSService.SmsSend(smsOptions.API, s)
-		if err != nil {
-			return nil, err
-		}
-		mp, err = helpers.StructToMap(rsp)
-		if err != nil {
-			return nil, err
-		}
+	s := sms.NewCompanySms(fmt.Sprint(request.Body.Phone), request.Body.Text, opts.From, claims.UUID, claims.Email, cmpny)
 
-		err = a.app.SMSService.StoreSms(s)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		err := a.app.SMSService.StoreSms(s)
-		if err != nil {
-			return nil, err
-		}
+	rsp, err := a.app.SMSService.Send(ctx, opts, s)
+	if err != nil {
+		return nil, err
+	}
 
-		mp["balance"] = "0"
-		mp["callbacks"] = nil
-		mp["cost"] = 0
-		mp["count"] = 0
-		mp["id"] = []string{"202419-1000003"}
-		mp["limit"] = 0
-		mp["limit_sent"] = 0
-		mp["senders"] = nil
-		mp["status"] = 100
-		mp["stoplist"] = nil
+	mp, err := helpers.StructToMap(rsp)
+	if err != nil {
+		return nil, err
 	}
 
 	return oapi.PostCompanyUUIDSmsSend200JSONResponse(mp), nil
@@ -132,10 +139,12 @@ func (a *Web) GetCompanyUUIDSms(ctx context.Context, request oapi.GetCompanyUUID
 	}
 
 	filter := dto.SmsFilterDTO{
-		Offset:  request.Params.Offset,
-		Limit:   request.Params.Limit,
-		IsMy:    request.Params.IsMy,
-		MyEmail: &claims.Email,
+		CompanyUUID: &request.UUID,
+		Offset:      request.Params.Offset,
+		Limit:       request.Params.Limit,
+		IsMy:        request.Params.IsMy,
+		State:       request.Params.State,
+		MyEmail:     &claims.Email,
 	}
 
 	dms, total, err := a.app.SMSService.GetSms(ctx, filter)
@@ -153,7 +162,11 @@ func (a *Web) GetCompanyUUIDSms(ctx context.Context, request oapi.GetCompanyUUID
 				UserUUID:       item.CreatedByUUID,
 				Phone:          item.To,
 				Text:           item.Text,
-				Status:         "sent",
+				Status:         item.Status,
+				StatusCode:     item.StatusCode,
+				ErrorCode:      item.ErrorCode,
+				StatusAt:       item.StatusAt,
+				TaskUUID:       item.TaskUUID,
 				CreatedAt:      item.CreatedAt,
 				UpdatedAt:      item.UpdatedAt,
 			}
@@ -161,3 +174,56 @@ func (a *Web) GetCompanyUUIDSms(ctx context.Context, request oapi.GetCompanyUUID
 		Total: total,
 	}, nil
 }
+
+func initSmsCallbackRoutes(a *Web, e *echo.Echo) {
+	// Отчеты о доставке. sms.ru шлет поля data[], остальные провайдеры - id и status (код sms.ru).
+	// В параметре token должна прийти подпись компании и провайдера из адреса (см. smsCallbackURL),
+	// без SMS_CALLBACK_SECRET отчеты не принимаются. Статусы обновляются только у сообщений этой компании.
+	e.POST("/sms/callback/:company/:provider", func(c echo.Context) error {
+		companyUUID, err := uuid.Parse(c.Param("company"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "wrong company")
+		}
+
+		provider := c.Param("provider")
+
+		if !sms.CheckCallbackToken(a.Options.SMS_CALLBACK_SECRET, companyUUID, provider, c.QueryParam("token")) {
+			return c.String(http.StatusForbidden, "forbidden")
+		}
+
+		var reports []sms.Report
+
+		if provider == sms.ProviderSmsRu {
+			form, err := c.FormParams()
+			if err != nil {
+				return err
+			}
+
+			var data []string
+			for key, values := range form {
+				if strings.HasPrefix(key, "data[") {
+					data = append(data, values...)
+				}
+			}
+
+			reports = sms.ParseSmsRuCallback(data)
+		} else {
+			code, err := strconv.Atoi(c.FormValue("status"))
+			if err != nil {
+				return c.String(http.StatusBadRequest, "wrong status")
+			}
+
+			reports = append(reports, sms.Report{ID: c.FormValue("id"), Code: code, At: time.Now()})
+		}
+
+		n, err := a.app.SMSService.ApplyReports(c.Request().Context(), companyUUID, provider, reports)
+		if err != nil {
+			return err
+		}
+
+		logrus.WithField("provider", provider).Debugf("sms callback: %d reports, %d updated", len(reports), n)
+
+		// sms.ru ждет в ответ 100, иначе повторяет отправку
+		return c.String(http.StatusOK, "100")
+	})
+}
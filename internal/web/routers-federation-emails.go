@@ -0,0 +1,158 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetFederationUUIDEmailTemplates(ctx context.Context, request oapi.GetFederationUUIDEmailTemplatesRequestObject) (oapi.GetFederationUUIDEmailTemplatesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.EmailTemplates.List(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDEmailTemplates200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.EmailTemplate, _ int) dto.EmailTemplateDTO {
+			return dto.NewEmailTemplateDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PutFederationUUIDEmailTemplatesEntityName(ctx context.Context, request oapi.PutFederationUUIDEmailTemplatesEntityNameRequestObject) (oapi.PutFederationUUIDEmailTemplatesEntityNameResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, f := a.app.DictionaryService.FindFederation(request.UUID)
+	if !f {
+		return nil, dto.NotFoundErr("федерация не найдена")
+	}
+
+	err := a.app.GateService.FederationPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.EmailTemplates.Save(domain.EmailTemplate{
+		FederationUUID: request.UUID,
+		Name:           request.EntityName,
+		Locale:         request.Body.Locale,
+		Subject:        request.Body.Subject,
+		Body:           request.Body.Body,
+		UpdatedBy:      claims.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.EmailTemplates.Template(request.UUID, request.EntityName, request.Body.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutFederationUUIDEmailTemplatesEntityName200JSONResponse(dto.NewEmailTemplateDTO(dm)), nil
+}
+
+func (a *Web) DeleteFederationUUIDEmailTemplatesEntityName(ctx context.Context, request oapi.DeleteFederationUUIDEmailTemplatesEntityNameRequestObject) (oapi.DeleteFederationUUIDEmailTemplatesEntityNameResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.FederationPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.EmailTemplates.Reset(request.UUID, request.EntityName, request.Params.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteFederationUUIDEmailTemplatesEntityName200Response{}, nil
+}
+
+func (a *Web) PostFederationUUIDEmailTemplatesEntityNamePreview(ctx context.Context, request oapi.PostFederationUUIDEmailTemplatesEntityNamePreviewRequestObject) (oapi.PostFederationUUIDEmailTemplatesEntityNamePreviewResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	msg, err := a.app.EmailTemplates.Preview(request.UUID, domain.EmailTemplate{
+		Name:    request.EntityName,
+		Locale:  request.Body.Locale,
+		Subject: lo.FromPtr(request.Body.Subject),
+		Body:    lo.FromPtr(request.Body.Body),
+	}, lo.FromPtr(request.Body.Data))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostFederationUUIDEmailTemplatesEntityNamePreview200JSONResponse{
+		Subject: msg.GetSubject(),
+		Html:    msg.GetBody(),
+	}, nil
+}
+
+func (a *Web) GetFederationUUIDEmailBranding(ctx context.Context, request oapi.GetFederationUUIDEmailBrandingRequestObject) (oapi.GetFederationUUIDEmailBrandingResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.EmailTemplates.Branding(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDEmailBranding200JSONResponse(dto.NewEmailBrandingDTO(dm)), nil
+}
+
+func (a *Web) PutFederationUUIDEmailBranding(ctx context.Context, request oapi.PutFederationUUIDEmailBrandingRequestObject) (oapi.PutFederationUUIDEmailBrandingResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, f := a.app.DictionaryService.FindFederation(request.UUID)
+	if !f {
+		return nil, dto.NotFoundErr("федерация не найдена")
+	}
+
+	err := a.app.GateService.FederationPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.EmailTemplates.SaveBranding(domain.EmailBranding{
+		FederationUUID:  request.UUID,
+		LogoURL:         lo.FromPtr(request.Body.LogoUrl),
+		PrimaryColor:    lo.FromPtr(request.Body.PrimaryColor),
+		BackgroundColor: lo.FromPtr(request.Body.BackgroundColor),
+		Footer:          lo.FromPtr(request.Body.Footer),
+		Locale:          lo.FromPtr(request.Body.Locale),
+		UpdatedBy:       claims.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.EmailTemplates.Branding(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutFederationUUIDEmailBranding200JSONResponse(dto.NewEmailBrandingDTO(dm)), nil
+}
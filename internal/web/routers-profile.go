@@ -9,6 +9,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/emails"
@@ -73,7 +74,7 @@ func (a *Web) PostProfile(_ context.Context, request oapi.PostProfileRequestObje
 
 	logrus.Debugf("[uuid:%s][code:%s] PostProfileRegister: user created", uid, code)
 
-	message, err := emails.NewConfirmationMessage(code)
+	message, err := a.app.EmailTemplates.Render(uuid.Nil, "", emails.TemplateConfirmation, emails.CodeData{Code: code})
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +166,7 @@ func (a *Web) PostProfileValidateSend(_ context.Context, request oapi.PostProfil
 		return nil, err
 	}
 
-	message, err := emails.NewConfirmationMessage(code)
+	message, err := a.app.EmailTemplates.Render(uuid.Nil, "", emails.TemplateConfirmation, emails.CodeData{Code: code})
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +194,7 @@ func (a *Web) PostProfileValidateSimpleSend(ctx context.Context, request oapi.Po
 		return nil, err
 	}
 
-	message, err := emails.NewConfirmationMessage(code)
+	message, err := a.app.EmailTemplates.Render(uuid.Nil, "", emails.TemplateConfirmation, emails.CodeData{Code: code})
 	if err != nil {
 		return nil, err
 	}
@@ -613,7 +614,7 @@ func (a *Web) PostProfileResetSend(_ context.Context, request oapi.PostProfileRe
 		return nil, err
 	}
 
-	message, err := emails.NewConfirmationMessage(code)
+	message, err := a.app.EmailTemplates.Render(uuid.Nil, "", emails.TemplateReset, emails.CodeData{Code: code})
 	if err != nil {
 		return nil, err
 	}
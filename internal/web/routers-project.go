@@ -162,6 +162,8 @@ func (a *Web) GetProjectUUID(ctx context.Context, request oapi.GetProjectUUIDReq
 				DataType:           int(item.DataType),
 				RequiredOnStatuses: item.RequiredOnStatuses,
 				Style:              item.Style,
+				Rules:              item.Rules,
+				Options:            item.Options,
 				DataDesc:           item.FieldTypeDesc(),
 			}
 		}),
@@ -386,6 +388,26 @@ func (a *Web) PatchProjectUUIDOptions(ctx context.Context, request oapi.PatchPro
 	return oapi.PatchProjectUUIDOptions200Response{}, nil
 }
 
+func (a *Web) GetProjectUUIDEmail(ctx context.Context, request oapi.GetProjectUUIDEmailRequestObject) (oapi.GetProjectUUIDEmailResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if !a.app.InboundService.Enabled() {
+		return nil, errors.New("прием почты выключен")
+	}
+
+	project, found := a.app.DictionaryService.FindProject(request.UUID)
+	if !found {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	return oapi.GetProjectUUIDEmail200JSONResponse{
+		Email: a.app.InboundService.Addresses.ProjectAddress(project.UUID),
+	}, nil
+}
+
 func (a *Web) PatchProjectUUID(ctx context.Context, request oapi.PatchProjectUUIDRequestObject) (oapi.PatchProjectUUIDResponseObject, error) {
 	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
@@ -614,7 +636,7 @@ func (a *Web) PostProjectUUIDFieldEntityUUID(ctx context.Context, request oapi.P
 		return nil, dto.NotFoundErr("проект не найден")
 	}
 
-	err := a.app.FederationService.AddProjectField(request.UUID, project.CompanyUUID, request.EntityUUID, request.Body.RequiredOnStatuses, request.Body.Style)
+	err := a.app.FederationService.AddProjectField(request.UUID, project.CompanyUUID, request.EntityUUID, request.Body.RequiredOnStatuses, request.Body.Style, lo.FromPtr(request.Body.Rules))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,41 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetSearch(ctx context.Context, request oapi.GetSearchRequestObject) (oapi.GetSearchResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	query := domain.SearchQuery{
+		Query:          request.Params.Q,
+		FederationUUID: request.Params.FederationUuid,
+		ProjectUUID:    request.Params.ProjectUuid,
+		Types:          lo.FromPtr(request.Params.Types),
+		Offset:         helpers.If(request.Params.Offset == nil, 0, lo.FromPtr(request.Params.Offset)),
+		Limit:          helpers.If(request.Params.Limit == nil, 20, lo.FromPtr(request.Params.Limit)),
+	}
+
+	hits, total, err := a.app.SearchService.Search(ctx, claims.UUID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetSearch200JSONResponse{
+		Count: len(hits),
+		Total: total,
+		Items: lo.Map(hits, func(item domain.SearchHit, _ int) dto.SearchResultDTO {
+			return dto.NewSearchResultDTO(item)
+		}),
+	}, nil
+}
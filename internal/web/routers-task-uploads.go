@@ -0,0 +1,156 @@
+package web
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	"github.com/krisch/crm-backend/internal/s3"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+// PostTaskUUIDUploads implements oapi.StrictServerInterface.
+func (a *Web) PostTaskUUIDUploads(ctx context.Context, request oapi.PostTaskUUIDUploadsRequestObject) (oapi.PostTaskUUIDUploadsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	var commentUUID *uuid.UUID
+	if request.Body.CommentUuid != nil {
+		comment, err := a.app.CommentService.GetComment(ctx, *request.Body.CommentUuid)
+		if err != nil {
+			return nil, err
+		}
+
+		if comment.TaskUUID != task.UUID {
+			return nil, dto.NotFoundErr("комментарий не найден")
+		}
+
+		commentUUID = &comment.UUID
+	}
+
+	state, err := a.app.S3PrivateService.CreateUpload(s3.UploadRequest{
+		FederationUUID: task.FederationUUID,
+		TaskUUID:       task.UUID,
+		CommentUUID:    commentUUID,
+
+		Name:     request.Body.Name,
+		Size:     request.Body.Size,
+		MimeType: lo.FromPtr(request.Body.Mime),
+
+		UserUUID: claims.UUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDUploads200JSONResponse(newUploadSessionDTO(state)), nil
+}
+
+// GetTaskUUIDUploadsEntityUUID implements oapi.StrictServerInterface.
+func (a *Web) GetTaskUUIDUploadsEntityUUID(ctx context.Context, request oapi.GetTaskUUIDUploadsEntityUUIDRequestObject) (oapi.GetTaskUUIDUploadsEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	state, err := a.app.S3PrivateService.GetUpload(request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDUploadsEntityUUID200JSONResponse(newUploadSessionDTO(state)), nil
+}
+
+// DeleteTaskUUIDUploadsEntityUUID implements oapi.StrictServerInterface.
+func (a *Web) DeleteTaskUUIDUploadsEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDUploadsEntityUUIDRequestObject) (oapi.DeleteTaskUUIDUploadsEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.S3PrivateService.AbortUpload(request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTaskUUIDUploadsEntityUUID200Response{}, nil
+}
+
+// PostTaskUUIDUploadsEntityUUIDComplete implements oapi.StrictServerInterface.
+func (a *Web) PostTaskUUIDUploadsEntityUUIDComplete(ctx context.Context, request oapi.PostTaskUUIDUploadsEntityUUIDCompleteRequestObject) (oapi.PostTaskUUIDUploadsEntityUUIDCompleteResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := a.app.S3PrivateService.CompleteUpload(task.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := a.app.S3PrivateService.DownloadURL(file)
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.TaskService.ResetCache(task.UUID)
+
+	notify := lo.Filter(task.People, func(email string, _ int) bool {
+		return email != claims.Email
+	})
+
+	err = a.app.TaskService.TaskWasUpdatedOrCreated(task.UUID, notify)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.NewUploadDTO(file.UUID, file.Name, file.Ext, file.Size, url)
+	res.Mime = file.MimeType
+	res.Width = file.ImgWidth
+	res.Height = file.ImgHeight
+	res.Version = file.Version
+	res.ScanStatus = file.ScanStatus
+
+	return oapi.PostTaskUUIDUploadsEntityUUIDComplete200JSONResponse(res), nil
+}
+
+func newUploadSessionDTO(state s3.UploadState) dto.UploadSessionDTO {
+	session := state.Session
+
+	return dto.UploadSessionDTO{
+		UUID:       session.UUID,
+		Name:       session.Name,
+		Size:       session.Size,
+		Mime:       session.MimeType,
+		PartSize:   session.PartSize,
+		PartsCount: session.PartsCount,
+		Status:     session.Status,
+		Error:      session.Error,
+		FileUUID:   session.FileUUID,
+		ExpiresAt:  session.ExpiresAt,
+
+		Parts: lo.Map(state.Parts, func(p s3.UploadPart, _ int) dto.UploadPartDTO {
+			return dto.UploadPartDTO{
+				Number:   p.Number,
+				Size:     p.Size,
+				Uploaded: p.Uploaded,
+				ETag:     p.ETag,
+				URL:      p.URL,
+			}
+		}),
+	}
+}
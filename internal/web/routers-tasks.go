@@ -109,7 +109,7 @@ func (a *Web) PatchTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.P
 
 			os.Remove(storeFilePath)
 
-			url, err := a.app.S3PrivateService.PresignedURL(fileDTO.Name, fileDTO.ObjectName)
+			url, err := a.app.S3PrivateService.DownloadURL(fileDTO)
 			if err != nil {
 				return nil, err
 			}
@@ -474,7 +474,7 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 	}, nil
 }
 
-func toExcel(dtos []dto.TaskDTOs, storeToDisk string) (f *excelize.File, err error) {
+func toExcel[T any](dtos []T, storeToDisk string) (f *excelize.File, err error) {
 	f = excelize.NewFile()
 	defer func() {
 		if err := f.Close(); err != nil {
@@ -492,6 +492,8 @@ func toExcel(dtos []dto.TaskDTOs, storeToDisk string) (f *excelize.File, err err
 		logrus.Error(err)
 	}
 
+	moneyStyles := map[string]int{}
+
 	maxHeaderLevels := 0
 	for idx, dto := range dtos {
 
@@ -591,8 +593,16 @@ func toExcel(dtos []dto.TaskDTOs, storeToDisk string) (f *excelize.File, err err
 			return f, err
 		}
 
+		err = setMoneyCells(f, sheet, moneyStyles, idx+maxHeaderLevels+2, row)
+		if err != nil {
+			return f, err
+		}
+
 		for i, r := range row {
-			column := string(rune(65 + i))
+			column, err := excelize.ColumnNumberToName(i + 1)
+			if err != nil {
+				return f, err
+			}
 			strLen := float64(len(fmt.Sprintf("%v", r)))
 			if maxMp[column] < strLen {
 				maxMp[column] = math.Min(strLen, 100)
@@ -607,7 +617,16 @@ func toExcel(dtos []dto.TaskDTOs, storeToDisk string) (f *excelize.File, err err
 		}
 	}
 
-	err = f.AutoFilter(sheet, fmt.Sprintf("A1:%s1", string(rune(64+max))), []excelize.AutoFilterOptions{})
+	if max == 0 {
+		return f, nil
+	}
+
+	lastColumn, err := excelize.ColumnNumberToName(max)
+	if err != nil {
+		return f, err
+	}
+
+	err = f.AutoFilter(sheet, fmt.Sprintf("A1:%s1", lastColumn), []excelize.AutoFilterOptions{})
 	if err != nil {
 		return f, err
 	}
@@ -621,6 +640,53 @@ func toExcel(dtos []dto.TaskDTOs, storeToDisk string) (f *excelize.File, err err
 	return f, err
 }
 
+// setMoneyCells записывает суммы строки числами с форматом валюты, чтобы в Excel работали сумма
+// и сортировка. Стили кешируются по валюте в styles
+func setMoneyCells(f *excelize.File, sheet string, styles map[string]int, rowNum int, row []interface{}) error {
+	for i, v := range row {
+		m, ok := v.(domain.MoneyValue)
+		if !ok {
+			continue
+		}
+
+		style, ok := styles[m.Currency]
+		if !ok {
+			exp, _ := domain.CurrencyExponent(m.Currency)
+
+			numFmt := "#,##0"
+			if exp > 0 {
+				numFmt += "." + strings.Repeat("0", exp)
+			}
+			numFmt += fmt.Sprintf(` "%s"`, m.Currency)
+
+			var err error
+			style, err = f.NewStyle(&excelize.Style{CustomNumFmt: &numFmt})
+			if err != nil {
+				return err
+			}
+
+			styles[m.Currency] = style
+		}
+
+		cell, err := excelize.CoordinatesToCellName(i+1, rowNum)
+		if err != nil {
+			return err
+		}
+
+		err = f.SetCellFloat(sheet, cell, m.Major(), -1, 64)
+		if err != nil {
+			return err
+		}
+
+		err = f.SetCellStyle(sheet, cell, cell, style)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func parseStruct(dt interface{}, rows []interface{}, level string, names []string) ([]interface{}, []string) {
 	elemType := reflect.TypeOf(dt)
 	elemValue := reflect.ValueOf(dt)
@@ -634,6 +700,16 @@ func parseStruct(dt interface{}, rows []interface{}, level string, names []strin
 		}
 
 		value := elemValue.Field(j).Interface()
+
+		if columns, ok := value.([]dto.XLSXColumn); ok {
+			for _, c := range columns {
+				rows = append(rows, xlsxValue(c.Value))
+				names = append(names, strings.TrimLeft(level+"."+c.Name, "."))
+			}
+
+			continue
+		}
+
 		kind := reflect.ValueOf(value).Kind()
 		if kind.String() == "struct" {
 			columnLocale := field.Tag.Get("ru")
@@ -667,6 +743,20 @@ func parseStruct(dt interface{}, rows []interface{}, level string, names []strin
 	return rows, names
 }
 
+// xlsxValue приводит значение динамической колонки к ячейке, списки склеиваются через запятую
+func xlsxValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		return strings.Join(lo.Map(val, func(item interface{}, _ int) string { return fmt.Sprintf("%v", item) }), ", ")
+	case []string:
+		return strings.Join(val, ", ")
+	}
+
+	return v
+}
+
 func (a *Web) PatchTaskUUIDParent(ctx context.Context, request oapi.PatchTaskUUIDParentRequestObject) (oapi.PatchTaskUUIDParentResponseObject, error) {
 	_, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
@@ -746,6 +836,46 @@ func (a *Web) PatchTaskUUIDStatus(ctx context.Context, request oapi.PatchTaskUUI
 	}, err
 }
 
+func (a *Web) PostTaskUUIDSms(ctx context.Context, request oapi.PostTaskUUIDSmsRequestObject) (oapi.PostTaskUUIDSmsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	var tpl *domain.SmsTemplate
+
+	if request.Body.TemplateUuid != nil {
+		dm, err := a.smsTemplate(ctx, task.CompanyUUID, *request.Body.TemplateUuid)
+		if err != nil {
+			return nil, err
+		}
+		tpl = &dm
+	}
+
+	s, err := a.app.SendTaskSms(ctx, domain.NewCreatorFromUser(&claims), task, tpl, lo.FromPtr(request.Body.Text), lo.FromPtr(request.Body.Phone))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDSms200JSONResponse(dto.SmsDTO{
+		UUID:           s.UUID,
+		FederationUUID: s.FederationUUID,
+		CompanyUUID:    s.CompanyUUID,
+		UserUUID:       s.CreatedByUUID,
+		Phone:          s.To,
+		Text:           s.Text,
+		Status:         s.Status,
+		StatusCode:     s.StatusCode,
+		StatusAt:       s.StatusAt,
+		TaskUUID:       s.TaskUUID,
+	}), nil
+}
+
 func (a *Web) DeleteTaskUUIDStopEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDStopEntityUUIDRequestObject) (oapi.DeleteTaskUUIDStopEntityUUIDResponseObject, error) {
 	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
@@ -834,7 +964,7 @@ func (a *Web) PostTaskUUIDComment(ctx context.Context, request oapi.PostTaskUUID
 
 			os.Remove(storeFilePath)
 
-			url, err := a.app.S3PrivateService.PresignedURL(fileDTO.Name, fileDTO.ObjectName)
+			url, err := a.app.S3PrivateService.DownloadURL(fileDTO)
 			if err != nil {
 				return nil, err
 			}
@@ -914,7 +1044,7 @@ func (a *Web) DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CommentService.DeleteComment(ctx, request.UUID, request.EntityUUID, &claims.UUID)
+	err := a.app.TaskService.DeleteComment(ctx, request.UUID, request.EntityUUID, domain.NewCreatorFromUser(&claims))
 	if err != nil {
 		return nil, err
 	}
@@ -922,6 +1052,97 @@ func (a *Web) DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.
 	return oapi.DeleteTaskUUIDCommentEntityUUID200Response{}, nil
 }
 
+func (a *Web) GetTaskUUIDCommentEntityUUIDHistory(ctx context.Context, request oapi.GetTaskUUIDCommentEntityUUIDHistoryRequestObject) (oapi.GetTaskUUIDCommentEntityUUIDHistoryResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	comment, err := a.app.CommentService.GetComment(ctx, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.TaskUUID != request.UUID {
+		return nil, dto.NotFoundErr("комментарий не найден")
+	}
+
+	// текст скрытого комментария и его правок видят только модераторы
+	if comment.IsHidden() {
+		err = a.commentModerator(ctx, request.UUID, claims.UUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dms, err := a.app.CommentService.GetCommentHistory(ctx, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := []dto.CommentRevisionDTO{}
+	for _, dm := range dms {
+		dtos = append(dtos, dto.NewCommentRevisionDTO(dm, a.app.DictionaryService))
+	}
+
+	return oapi.GetTaskUUIDCommentEntityUUIDHistory200JSONResponse{
+		Count: len(dtos),
+		Items: dtos,
+	}, nil
+}
+
+func (a *Web) PatchTaskUUIDCommentEntityUUIDHide(ctx context.Context, request oapi.PatchTaskUUIDCommentEntityUUIDHideRequestObject) (oapi.PatchTaskUUIDCommentEntityUUIDHideResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.commentModerator(ctx, request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.HideComment(ctx, request.UUID, request.EntityUUID, domain.NewCreatorFromUser(&claims))
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.TaskService.ResetCache(request.UUID)
+
+	return oapi.PatchTaskUUIDCommentEntityUUIDHide200Response{}, nil
+}
+
+func (a *Web) PatchTaskUUIDCommentEntityUUIDRestore(ctx context.Context, request oapi.PatchTaskUUIDCommentEntityUUIDRestoreRequestObject) (oapi.PatchTaskUUIDCommentEntityUUIDRestoreResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.commentModerator(ctx, request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.RestoreComment(ctx, request.UUID, request.EntityUUID, domain.NewCreatorFromUser(&claims))
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.TaskService.ResetCache(request.UUID)
+
+	return oapi.PatchTaskUUIDCommentEntityUUIDRestore200Response{}, nil
+}
+
+// commentModerator проверяет, что пользователь может модерировать комментарии задачи
+func (a *Web) commentModerator(ctx context.Context, taskUUID, userUUID uuid.UUID) error {
+	task, err := a.app.TaskService.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return err
+	}
+
+	return a.app.GateService.ProjectAdmin(task.ProjectUUID, userUUID)
+}
+
 // Web struct should implement the missing method from otask.StrictServerInterface.
 func (a *Web) GetTaskUUIDComment(_ context.Context, request oapi.GetTaskUUIDCommentRequestObject) (oapi.GetTaskUUIDCommentResponseObject, error) {
 	dms, err := a.app.CommentService.GetTaskComments(request.UUID, true, true)
@@ -1005,7 +1226,7 @@ func (a *Web) PatchTaskUUIDUpload(ctx context.Context, request oapi.PatchTaskUUI
 		return nil, err
 	}
 
-	url, err := a.app.S3PrivateService.PresignedURL(fileDTO.Name, fileDTO.ObjectName)
+	url, err := a.app.S3PrivateService.DownloadURL(fileDTO)
 	if err != nil {
 		return nil, err
 	}
@@ -1022,7 +1243,11 @@ func (a *Web) PatchTaskUUIDUpload(ctx context.Context, request oapi.PatchTaskUUI
 		return nil, err
 	}
 
-	return oapi.PatchTaskUUIDUpload200JSONResponse(dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)), nil
+	res := dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)
+	res.Version = fileDTO.Version
+	res.ScanStatus = fileDTO.ScanStatus
+
+	return oapi.PatchTaskUUIDUpload200JSONResponse(res), nil
 }
 
 func (a *Web) DeleteTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDUploadEntityUUIDRequestObject) (oapi.DeleteTaskUUIDUploadEntityUUIDResponseObject, error) {
@@ -1031,7 +1256,9 @@ func (a *Web) DeleteTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.D
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.TaskService.DeleteTaskFile(domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID)
+	latestVersion := request.Params.LatestVersion != nil && *request.Params.LatestVersion
+
+	err := a.app.TaskService.DeleteTaskFile(domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID, latestVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -1062,7 +1289,14 @@ func (a *Web) GetTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.GetT
 		return nil, ErrInvalidAuthHeader
 	}
 
-	url, err := a.app.S3PrivateService.PresignedURLFromFile(request.EntityUUID)
+	var url string
+	var err error
+
+	if request.Params.Version != nil {
+		url, err = a.app.S3PrivateService.PresignedURLFromFileVersion(request.UUID, request.EntityUUID, *request.Params.Version)
+	} else {
+		url, err = a.app.S3PrivateService.PresignedURLFromFile(request.EntityUUID)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1074,6 +1308,105 @@ func (a *Web) GetTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.GetT
 	}, nil
 }
 
+func (a *Web) GetTaskUUIDUploadEntityUUIDVersions(ctx context.Context, request oapi.GetTaskUUIDUploadEntityUUIDVersionsRequestObject) (oapi.GetTaskUUIDUploadEntityUUIDVersionsResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.S3PrivateService.GetFileVersions(request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := []dto.FileVersionDTO{}
+	for _, dm := range dms {
+		dtos = append(dtos, dto.NewFileVersionDTO(dm, a.app.DictionaryService))
+	}
+
+	return oapi.GetTaskUUIDUploadEntityUUIDVersions200JSONResponse{
+		Count: len(dtos),
+		Items: dtos,
+	}, nil
+}
+
+func (a *Web) PatchTaskUUIDUploadEntityUUIDVersions(ctx context.Context, request oapi.PatchTaskUUIDUploadEntityUUIDVersionsRequestObject) (oapi.PatchTaskUUIDUploadEntityUUIDVersionsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	file, err := request.Body.NextPart()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("file is required: %w", err)
+	}
+	defer file.Close()
+
+	storeFilePath := "/tmp/" + helpers.FakeString(10) + "-" + file.FileName()
+	dst, err := os.Create(storeFilePath)
+	if err != nil {
+		logrus.Errorf("error creating file: %s", err)
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, file); err != nil {
+		logrus.Error(err)
+	}
+
+	defer os.Remove(storeFilePath)
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	fileDTO, err := a.app.S3PrivateService.UploadTaskFileVersion(task.FederationUUID, task.UUID, request.EntityUUID, file.FileName(), storeFilePath, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := a.app.S3PrivateService.DownloadURL(fileDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	// @todo: mv upload to service
+	a.app.TaskService.ResetCache(request.UUID)
+
+	notify := lo.Filter(task.People, func(email string, _ int) bool {
+		return email != claims.Email
+	})
+
+	err = a.app.TaskService.TaskWasUpdatedOrCreated(request.UUID, notify)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)
+	res.Version = fileDTO.Version
+	res.ScanStatus = fileDTO.ScanStatus
+
+	return oapi.PatchTaskUUIDUploadEntityUUIDVersions200JSONResponse(res), nil
+}
+
+func (a *Web) GetTaskUUIDUploadEntityUUIDPreview(ctx context.Context, request oapi.GetTaskUUIDUploadEntityUUIDPreviewRequestObject) (oapi.GetTaskUUIDUploadEntityUUIDPreviewResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	url, err := a.app.S3PrivateService.PresignedPreviewURL(request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDUploadEntityUUIDPreview302Response{
+		Headers: oapi.GetTaskUUIDUploadEntityUUIDPreview302ResponseHeaders{
+			Location: url,
+		},
+	}, nil
+}
+
 func (a *Web) GetTaskUUIDUpload(ctx context.Context, request oapi.GetTaskUUIDUploadRequestObject) (oapi.GetTaskUUIDUploadResponseObject, error) {
 	_, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
@@ -1100,6 +1433,14 @@ func (a *Web) GetTaskUUIDUpload(ctx context.Context, request oapi.GetTaskUUIDUpl
 			EXT:  item.Ext,
 			Size: item.Size,
 			URL:  item.URL,
+
+			Mime:       item.Mime,
+			Width:      item.Width,
+			Height:     item.Height,
+			Pages:      item.Pages,
+			PreviewURL: item.PreviewURL,
+			ScanStatus: item.ScanStatus,
+			Version:    item.Version,
 		}
 	})
 
@@ -27,6 +27,7 @@ import (
 	"github.com/krisch/crm-backend/internal/app"
 	"github.com/krisch/crm-backend/internal/configs"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/pkg/redis"
 
 	validator "github.com/go-playground/validator/v10"
@@ -145,7 +146,11 @@ func (a *Web) Init() *echo.Echo {
 	}
 
 	e.Use(middleware.Recover())
-	e.Use(middleware.BodyLimit("150M"))
+	// части загрузки ограничены MaxPartSize в самом обработчике
+	e.Use(middleware.BodyLimitWithConfig(middleware.BodyLimitConfig{
+		Skipper: blobRequest,
+		Limit:   "150M",
+	}))
 	e.Use(middleware.BodyLimitWithConfig(middleware.BodyLimitConfig{
 		Skipper: func(c echo.Context) bool {
 			if strings.Contains(c.Request().RequestURI, "/comment") {
@@ -156,13 +161,20 @@ func (a *Web) Init() *echo.Echo {
 				return true
 			}
 
+			if strings.HasPrefix(c.Request().RequestURI, s3.BlobUploadRoute+"/") {
+				return true
+			}
+
 			return false
 		},
 		Limit: "2M",
 	}))
 
 	e.Pre(middleware.RemoveTrailingSlash())
-	e.Use(middleware.BodyDump(LogMiddleware(a.app)))
+	e.Use(middleware.BodyDumpWithConfig(middleware.BodyDumpConfig{
+		Skipper: blobRequest,
+		Handler: LogMiddleware(a.app),
+	}))
 
 	if a.Options.GZIP > 0 {
 		e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
@@ -188,6 +200,27 @@ func (a *Web) Init() *echo.Echo {
 			return
 		}
 
+		var forbiddenErr dto.ForbiddenError
+		if errors.As(err, &forbiddenErr) {
+			//nolint
+			c.JSON(http.StatusForbidden, RequestError{
+				StatusCode: http.StatusForbidden,
+				Message:    err.Error(),
+			})
+			return
+		}
+
+		var fieldsErr dto.FieldsError
+		if errors.As(err, &fieldsErr) {
+			//nolint
+			c.JSON(http.StatusBadRequest, FieldsValidationError{
+				StatusCode: http.StatusBadRequest,
+				Message:    err.Error(),
+				Fields:     fieldsErr.Fields,
+			})
+			return
+		}
+
 		if errors.Is(err, ErrUnauthorized) {
 			//nolint
 			c.JSON(http.StatusUnauthorized, RequestError{
@@ -300,6 +333,8 @@ func (a *Web) Init() *echo.Echo {
 	initOpenAPITaskRouters(a, e)
 	initOpenAPIReminderRouters(a, e)
 	initOpenAPIcatalogRouters(a, e)
+	initSmsCallbackRoutes(a, e)
+	initBlobRoutes(a, e)
 
 	// Special routes
 	e.File("/openapi.yaml", "./openapi.yaml", middleware.CORSWithConfig(middleware.CORSConfig{
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS comment_revisions;
+
+ALTER TABLE
+    "public"."comments" DROP COLUMN "deleted_by",
+    DROP COLUMN "hidden_at",
+    DROP COLUMN "hidden_by";
@@ -0,0 +1,20 @@
+ALTER TABLE
+    "public"."comments"
+ADD
+    COLUMN "deleted_by" varchar(200),
+ADD
+    COLUMN "hidden_at" timestamptz,
+ADD
+    COLUMN "hidden_by" varchar(200);
+
+CREATE TABLE comment_revisions (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "comment_uuid" uuid NOT NULL,
+    "task_uuid" uuid NOT NULL,
+    "comment" text NOT NULL DEFAULT '' :: text,
+    "people" jsonb NOT NULL DEFAULT '{}' :: jsonb,
+    "edited_by" varchar(200) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX comment_revisions_comment_uuid ON comment_revisions (comment_uuid, created_at DESC);
@@ -0,0 +1,5 @@
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "description_html";
+
+ALTER TABLE
+    "public"."comments" DROP COLUMN "comment_html";
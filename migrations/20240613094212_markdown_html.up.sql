@@ -0,0 +1,9 @@
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "description_html" text NOT NULL DEFAULT '';
+
+ALTER TABLE
+    "public"."comments"
+ADD
+    COLUMN "comment_html" text NOT NULL DEFAULT '';
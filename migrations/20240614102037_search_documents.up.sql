@@ -0,0 +1,70 @@
+CREATE TABLE search_documents (
+    "entity_uuid" uuid NOT NULL PRIMARY KEY,
+    "entity_type" varchar(20) NOT NULL,
+    "task_uuid" uuid,
+    "federation_uuid" uuid NOT NULL,
+    "company_uuid" uuid NOT NULL,
+    "project_uuid" uuid,
+    "title" text NOT NULL DEFAULT '',
+    "body" text NOT NULL DEFAULT '',
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "tsv" tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', title), 'A') || setweight(to_tsvector('english', title), 'A') || setweight(to_tsvector('russian', body), 'B') || setweight(to_tsvector('english', body), 'B')
+    ) STORED
+);
+
+CREATE INDEX search_documents_tsv ON search_documents USING GIN (tsv);
+
+CREATE INDEX search_documents_company ON search_documents (company_uuid, entity_type);
+
+CREATE INDEX search_documents_task ON search_documents (task_uuid);
+
+-- tasks
+INSERT INTO
+    search_documents (entity_uuid, entity_type, task_uuid, federation_uuid, company_uuid, project_uuid, title, body, updated_at)
+SELECT
+    t.uuid, 'task', t.uuid, t.federation_uuid, t.company_uuid, t.project_uuid, t.name, t.description, t.updated_at
+FROM
+    tasks t
+WHERE
+    t.deleted_at IS NULL;
+
+-- comments
+INSERT INTO
+    search_documents (entity_uuid, entity_type, task_uuid, federation_uuid, company_uuid, project_uuid, title, body, updated_at)
+SELECT
+    c.uuid, 'comment', t.uuid, t.federation_uuid, t.company_uuid, t.project_uuid, t.name, c.comment, c.updated_at
+FROM
+    comments c
+    JOIN tasks t ON t.uuid = c.task_uuid
+WHERE
+    c.deleted_at IS NULL
+    AND c.hidden_at IS NULL
+    AND t.deleted_at IS NULL;
+
+-- task and comment files
+INSERT INTO
+    search_documents (entity_uuid, entity_type, task_uuid, federation_uuid, company_uuid, project_uuid, title, body, updated_at)
+SELECT
+    f.uuid, 'file', t.uuid, t.federation_uuid, t.company_uuid, t.project_uuid, f.name, '', f.created_at
+FROM
+    files f
+    LEFT JOIN comments c ON f.type = 'comment' AND c.uuid = f.type_uuid
+    JOIN tasks t ON t.uuid = CASE WHEN f.type = 'task' THEN f.type_uuid ELSE c.task_uuid END
+WHERE
+    f.type IN ('task', 'comment')
+    AND f.deleted_at IS NULL
+    AND t.deleted_at IS NULL;
+
+-- catalog data
+INSERT INTO
+    search_documents (entity_uuid, entity_type, federation_uuid, company_uuid, title, body, updated_at)
+SELECT
+    cd.uuid, 'catalog', cd.federation_uuid, cd.company_uuid, ct.name || ' #' || COALESCE(cd.id :: text, ''), COALESCE((SELECT string_agg(value, ' ') FROM jsonb_each_text(cd.fields)), ''), cd.updated_at
+FROM
+    catalog_data cd
+    JOIN catalogs ct ON ct.uuid = cd.catalog_uuid
+WHERE
+    cd.deleted_at IS NULL
+    AND ct.deleted_at IS NULL
+ON CONFLICT (entity_uuid) DO NOTHING;
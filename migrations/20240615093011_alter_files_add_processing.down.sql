@@ -0,0 +1,6 @@
+ALTER TABLE
+    "public"."files" DROP COLUMN "text_content",
+    DROP COLUMN "page_count",
+    DROP COLUMN "preview_object_name",
+    DROP COLUMN "process_error",
+    DROP COLUMN "processed_at";
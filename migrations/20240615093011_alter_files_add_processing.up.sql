@@ -0,0 +1,12 @@
+ALTER TABLE
+    "public"."files"
+ADD
+    COLUMN "text_content" text NOT NULL DEFAULT '',
+ADD
+    COLUMN "page_count" int NOT NULL DEFAULT 0,
+ADD
+    COLUMN "preview_object_name" character varying(250) NOT NULL DEFAULT '',
+ADD
+    COLUMN "process_error" text NOT NULL DEFAULT '',
+ADD
+    COLUMN "processed_at" timestamptz DEFAULT NULL;
@@ -0,0 +1,4 @@
+DROP TABLE file_versions;
+
+ALTER TABLE
+    "public"."files" DROP COLUMN "version";
@@ -0,0 +1,53 @@
+ALTER TABLE
+    "public"."files"
+ADD
+    COLUMN "version" int NOT NULL DEFAULT 1;
+
+CREATE TABLE file_versions (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "file_uuid" uuid NOT NULL,
+    "version" int NOT NULL,
+    "name" varchar(50) NOT NULL DEFAULT '',
+    "object_name" varchar(250) NOT NULL DEFAULT '',
+    "size" bigint NOT NULL DEFAULT 0,
+    "ext" varchar(10) NOT NULL DEFAULT '',
+    "mime_type" varchar(250) NOT NULL DEFAULT '',
+    "img_width" int NOT NULL DEFAULT 0,
+    "img_height" int NOT NULL DEFAULT 0,
+    "created_by" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE UNIQUE INDEX file_versions_file_uuid_version ON file_versions (file_uuid, version);
+
+INSERT INTO
+    file_versions (
+        file_uuid,
+        version,
+        name,
+        object_name,
+        size,
+        ext,
+        mime_type,
+        img_width,
+        img_height,
+        created_by,
+        created_at
+    )
+SELECT
+    uuid,
+    1,
+    name,
+    object_name,
+    size,
+    ext,
+    mime_type,
+    img_width,
+    img_height,
+    created_by,
+    created_at
+FROM
+    files
+WHERE
+    deleted_at IS NULL;
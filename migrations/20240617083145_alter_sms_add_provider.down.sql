@@ -0,0 +1,5 @@
+DROP INDEX sms_provider_id;
+
+ALTER TABLE
+    "public"."sms" DROP COLUMN "provider",
+    DROP COLUMN "provider_id";
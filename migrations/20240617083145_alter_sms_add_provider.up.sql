@@ -0,0 +1,8 @@
+ALTER TABLE
+    "public"."sms"
+ADD
+    COLUMN "provider" varchar(50) NOT NULL DEFAULT '',
+ADD
+    COLUMN "provider_id" varchar(100) NOT NULL DEFAULT '';
+
+CREATE INDEX sms_provider_id ON sms (provider, provider_id);
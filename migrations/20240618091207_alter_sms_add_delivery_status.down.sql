@@ -0,0 +1,6 @@
+DROP INDEX sms_status;
+
+ALTER TABLE
+    "public"."sms" DROP COLUMN "status_code",
+    DROP COLUMN "error_code",
+    DROP COLUMN "status_at";
@@ -0,0 +1,12 @@
+ALTER TABLE
+    "public"."sms"
+ADD
+    COLUMN "status_code" int NOT NULL DEFAULT 0,
+ADD
+    COLUMN "error_code" int NOT NULL DEFAULT 0,
+ADD
+    COLUMN "status_at" timestamptz;
+
+UPDATE sms SET status = 'sent' WHERE coalesce(status, '') = '';
+
+CREATE INDEX sms_status ON sms (status, status_at);
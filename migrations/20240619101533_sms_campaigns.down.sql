@@ -0,0 +1,5 @@
+DROP INDEX sms_company_created_at;
+
+DROP TABLE sms_campaign_recipients;
+
+DROP TABLE sms_campaigns;
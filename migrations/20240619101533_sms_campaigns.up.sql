@@ -0,0 +1,43 @@
+CREATE TABLE sms_campaigns (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL REFERENCES federations(uuid) ON DELETE CASCADE,
+    "company_uuid" uuid NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    "name" varchar(100) NOT NULL DEFAULT '',
+    "template" text NOT NULL DEFAULT '',
+    "source" varchar(20) NOT NULL DEFAULT '',
+    "source_uuid" uuid,
+    "status" varchar(20) NOT NULL DEFAULT 'draft',
+    "send_at" timestamptz,
+    "started_at" timestamptz,
+    "finished_at" timestamptz,
+    "created_by" varchar(100) NOT NULL DEFAULT '',
+    "created_by_uuid" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX sms_campaigns_company_uuid ON sms_campaigns (company_uuid);
+
+CREATE INDEX sms_campaigns_status ON sms_campaigns (status, send_at);
+
+CREATE TABLE sms_campaign_recipients (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "campaign_uuid" uuid NOT NULL REFERENCES sms_campaigns(uuid) ON DELETE CASCADE,
+    "phone" varchar(20) NOT NULL DEFAULT '',
+    "name" varchar(255) NOT NULL DEFAULT '',
+    "vars" jsonb NOT NULL DEFAULT '{}' :: jsonb,
+    "text" text NOT NULL DEFAULT '',
+    "status" varchar(20) NOT NULL DEFAULT 'pending',
+    "error" text NOT NULL DEFAULT '',
+    "cost" float NOT NULL DEFAULT 0,
+    "sms_uuid" uuid,
+    "sent_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX sms_campaign_recipients_phone ON sms_campaign_recipients (campaign_uuid, phone);
+
+CREATE INDEX sms_campaign_recipients_status ON sms_campaign_recipients (campaign_uuid, status);
+
+CREATE INDEX sms_company_created_at ON sms (company_uuid, created_at);
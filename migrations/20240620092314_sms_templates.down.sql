@@ -0,0 +1,7 @@
+DROP INDEX sms_task_uuid;
+
+ALTER TABLE
+    "public"."sms" DROP COLUMN "task_uuid",
+    DROP COLUMN "template_uuid";
+
+DROP TABLE sms_templates;
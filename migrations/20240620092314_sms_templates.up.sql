@@ -0,0 +1,28 @@
+CREATE TABLE sms_templates (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL REFERENCES federations(uuid) ON DELETE CASCADE,
+    "company_uuid" uuid NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    "name" varchar(100) NOT NULL DEFAULT '',
+    "text" text NOT NULL DEFAULT '',
+    "phone_field" varchar(100) NOT NULL DEFAULT '',
+    "project_uuid" uuid,
+    "on_status" int,
+    "created_by" varchar(100) NOT NULL DEFAULT '',
+    "created_by_uuid" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX sms_templates_company_uuid ON sms_templates (company_uuid);
+
+CREATE INDEX sms_templates_on_status ON sms_templates (company_uuid, on_status) WHERE on_status IS NOT NULL;
+
+ALTER TABLE
+    "public"."sms"
+ADD
+    COLUMN "task_uuid" uuid,
+ADD
+    COLUMN "template_uuid" uuid;
+
+CREATE INDEX sms_task_uuid ON sms (task_uuid);
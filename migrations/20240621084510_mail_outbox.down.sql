@@ -0,0 +1,15 @@
+DROP INDEX mails_outbox_uuid;
+
+ALTER TABLE
+    "public"."mails" DROP COLUMN "outbox_uuid",
+    DROP COLUMN "transport",
+    DROP COLUMN "attempt",
+    DROP COLUMN "status",
+    DROP COLUMN "error";
+
+ALTER TABLE
+    "public"."mails"
+ALTER COLUMN
+    "to" TYPE varchar(100) USING left("to", 100);
+
+DROP TABLE mail_outbox;
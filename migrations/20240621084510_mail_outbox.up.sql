@@ -0,0 +1,36 @@
+CREATE TABLE mail_outbox (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "from" varchar(100) NOT NULL DEFAULT '',
+    "to" text[] NOT NULL DEFAULT '{}',
+    "subject" varchar(200) NOT NULL DEFAULT '',
+    "body" text NOT NULL DEFAULT '',
+    "status" varchar(20) NOT NULL DEFAULT 'pending',
+    "attempts" int NOT NULL DEFAULT 0,
+    "next_attempt_at" timestamptz NOT NULL DEFAULT now(),
+    "last_error" text NOT NULL DEFAULT '',
+    "sent_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX mail_outbox_status ON mail_outbox (status, next_attempt_at);
+
+ALTER TABLE
+    "public"."mails"
+ALTER COLUMN
+    "to" TYPE text;
+
+ALTER TABLE
+    "public"."mails"
+ADD
+    COLUMN "outbox_uuid" uuid,
+ADD
+    COLUMN "transport" varchar(20) NOT NULL DEFAULT '',
+ADD
+    COLUMN "attempt" int NOT NULL DEFAULT 0,
+ADD
+    COLUMN "status" varchar(20) NOT NULL DEFAULT '',
+ADD
+    COLUMN "error" text NOT NULL DEFAULT '';
+
+CREATE INDEX mails_outbox_uuid ON mails (outbox_uuid);
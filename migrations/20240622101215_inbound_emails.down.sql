@@ -0,0 +1,6 @@
+DROP INDEX inbound_emails_message_id;
+
+DROP TABLE inbound_emails;
+
+ALTER TABLE
+    "public"."mail_outbox" DROP COLUMN "reply_to";
@@ -0,0 +1,24 @@
+CREATE TABLE inbound_emails (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "message_id" varchar(250) NOT NULL DEFAULT '',
+    "from" varchar(100) NOT NULL DEFAULT '',
+    "to" varchar(250) NOT NULL DEFAULT '',
+    "subject" varchar(250) NOT NULL DEFAULT '',
+    "kind" varchar(20) NOT NULL DEFAULT '',
+    "project_uuid" uuid,
+    "task_uuid" uuid,
+    "comment_uuid" uuid,
+    "user_email" varchar(100) NOT NULL DEFAULT '',
+    "agent_uuid" uuid,
+    "attachments" int NOT NULL DEFAULT 0,
+    "status" varchar(20) NOT NULL DEFAULT '',
+    "error" text NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX inbound_emails_message_id ON inbound_emails (message_id);
+
+ALTER TABLE
+    "public"."mail_outbox"
+ADD
+    COLUMN "reply_to" varchar(250) NOT NULL DEFAULT '';
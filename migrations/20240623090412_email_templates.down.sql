@@ -0,0 +1,3 @@
+DROP TABLE email_brandings;
+
+DROP TABLE email_templates;
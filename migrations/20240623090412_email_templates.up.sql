@@ -0,0 +1,21 @@
+CREATE TABLE email_templates (
+    "federation_uuid" uuid NOT NULL,
+    "name" varchar(50) NOT NULL,
+    "locale" varchar(10) NOT NULL,
+    "subject" varchar(200) NOT NULL DEFAULT '',
+    "body" text NOT NULL DEFAULT '',
+    "updated_by" varchar(100) NOT NULL DEFAULT '',
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("federation_uuid", "name", "locale")
+);
+
+CREATE TABLE email_brandings (
+    "federation_uuid" uuid NOT NULL PRIMARY KEY,
+    "logo_url" varchar(500) NOT NULL DEFAULT '',
+    "primary_color" varchar(20) NOT NULL DEFAULT '',
+    "background_color" varchar(20) NOT NULL DEFAULT '',
+    "footer" text NOT NULL DEFAULT '',
+    "locale" varchar(10) NOT NULL DEFAULT '',
+    "updated_by" varchar(100) NOT NULL DEFAULT '',
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
@@ -0,0 +1,23 @@
+CREATE TABLE upload_sessions (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL,
+    "task_uuid" uuid NOT NULL,
+    "comment_uuid" uuid DEFAULT NULL,
+    "name" varchar(50) NOT NULL,
+    "size" bigint NOT NULL,
+    "mime_type" varchar(250) NOT NULL DEFAULT '',
+    "part_size" bigint NOT NULL,
+    "parts_count" int NOT NULL,
+    "bucket_name" varchar(200) NOT NULL DEFAULT '',
+    "object_name" varchar(250) NOT NULL,
+    "upload_id" varchar(1024) NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'active',
+    "error" text NOT NULL DEFAULT '',
+    "file_uuid" uuid DEFAULT NULL,
+    "created_by" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "expires_at" timestamptz NOT NULL,
+    "completed_at" timestamptz DEFAULT NULL
+);
+
+CREATE INDEX upload_sessions_status_expires_at_idx ON upload_sessions (status, expires_at);
@@ -0,0 +1,8 @@
+DROP INDEX files_scan_status_idx;
+
+ALTER TABLE file_versions DROP COLUMN "scan_result";
+ALTER TABLE file_versions DROP COLUMN "scan_status";
+
+ALTER TABLE files DROP COLUMN "scanned_at";
+ALTER TABLE files DROP COLUMN "scan_result";
+ALTER TABLE files DROP COLUMN "scan_status";
@@ -0,0 +1,10 @@
+ALTER TABLE files ADD COLUMN "scan_status" varchar(20) NOT NULL DEFAULT 'clean';
+ALTER TABLE files ALTER COLUMN "scan_status" SET DEFAULT 'pending';
+ALTER TABLE files ADD COLUMN "scan_result" varchar(250) NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN "scanned_at" timestamptz DEFAULT NULL;
+
+ALTER TABLE file_versions ADD COLUMN "scan_status" varchar(20) NOT NULL DEFAULT 'clean';
+ALTER TABLE file_versions ALTER COLUMN "scan_status" SET DEFAULT 'pending';
+ALTER TABLE file_versions ADD COLUMN "scan_result" varchar(250) NOT NULL DEFAULT '';
+
+CREATE INDEX files_scan_status_idx ON files (scan_status) WHERE scan_status = 'pending';
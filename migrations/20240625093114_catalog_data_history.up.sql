@@ -0,0 +1,13 @@
+CREATE TABLE catalog_data_history (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "data_uuid" uuid NOT NULL,
+    "catalog_uuid" uuid NOT NULL,
+    "action" varchar(10) NOT NULL,
+    "fields" jsonb NOT NULL DEFAULT '{}',
+    "changes" jsonb NOT NULL DEFAULT '{}',
+    "created_by" varchar(100) NOT NULL DEFAULT '',
+    "created_by_uuid" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX catalog_data_history_data_uuid_idx ON catalog_data_history (data_uuid, created_at);
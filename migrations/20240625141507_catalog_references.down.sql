@@ -0,0 +1,3 @@
+DROP INDEX catalog_data_entities_idx;
+
+ALTER TABLE catalog_fields DROP COLUMN "on_delete";
@@ -0,0 +1,3 @@
+ALTER TABLE catalog_fields ADD COLUMN "on_delete" varchar(10) NOT NULL DEFAULT 'block';
+
+CREATE INDEX catalog_data_entities_idx ON catalog_data USING gin (entities jsonb_path_ops);
@@ -0,0 +1,3 @@
+ALTER TABLE catalog_fields DROP COLUMN "formula";
+
+ALTER TABLE company_fields DROP COLUMN "formula";
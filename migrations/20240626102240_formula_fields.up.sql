@@ -0,0 +1,3 @@
+ALTER TABLE company_fields ADD COLUMN "formula" text NOT NULL DEFAULT '';
+
+ALTER TABLE catalog_fields ADD COLUMN "formula" text NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE project_fields DROP COLUMN "rules";
@@ -0,0 +1 @@
+ALTER TABLE project_fields ADD COLUMN "rules" jsonb NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+ALTER TABLE company_fields DROP COLUMN "options";
+ALTER TABLE catalog_fields DROP COLUMN "options";
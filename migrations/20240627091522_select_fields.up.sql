@@ -0,0 +1,2 @@
+ALTER TABLE company_fields ADD COLUMN "options" jsonb NOT NULL DEFAULT '[]';
+ALTER TABLE catalog_fields ADD COLUMN "options" jsonb NOT NULL DEFAULT '[]';
@@ -0,0 +1,24 @@
+DROP TABLE deal_tasks;
+
+DROP INDEX deals_agent_uuid;
+
+DROP INDEX deals_pipeline;
+
+ALTER TABLE deals
+    DROP COLUMN "created_by_uuid",
+    DROP COLUMN "pipeline_uuid",
+    DROP COLUMN "stage_uuid",
+    DROP COLUMN "amount",
+    DROP COLUMN "currency",
+    DROP COLUMN "probability",
+    DROP COLUMN "agent_uuid",
+    DROP COLUMN "close_at",
+    DROP COLUMN "stage_changed_at";
+
+ALTER TABLE deals ALTER COLUMN "tags" DROP DEFAULT;
+ALTER TABLE deals ALTER COLUMN "tags" TYPE text USING "tags"::text;
+ALTER TABLE deals ALTER COLUMN "tags" SET DEFAULT '{}';
+
+DROP TABLE deal_stages;
+
+DROP TABLE deal_pipelines;
@@ -0,0 +1,57 @@
+CREATE TABLE deal_pipelines (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL REFERENCES federations(uuid) ON DELETE CASCADE,
+    "company_uuid" uuid NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    "name" varchar(100) NOT NULL DEFAULT '',
+    "sort" int NOT NULL DEFAULT 0,
+    "created_by" varchar(100) NOT NULL DEFAULT '',
+    "created_by_uuid" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX deal_pipelines_company_uuid ON deal_pipelines (company_uuid);
+
+CREATE TABLE deal_stages (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "pipeline_uuid" uuid NOT NULL REFERENCES deal_pipelines(uuid) ON DELETE CASCADE,
+    "name" varchar(100) NOT NULL DEFAULT '',
+    "color" varchar(7) NOT NULL DEFAULT '',
+    "probability" int NOT NULL DEFAULT 0,
+    "status" int NOT NULL DEFAULT 0,
+    "sort" int NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX deal_stages_pipeline_uuid ON deal_stages (pipeline_uuid, sort);
+
+ALTER TABLE deals ALTER COLUMN "tags" DROP DEFAULT;
+ALTER TABLE deals ALTER COLUMN "tags" TYPE text[] USING "tags"::text[];
+ALTER TABLE deals ALTER COLUMN "tags" SET DEFAULT '{}';
+
+ALTER TABLE deals
+    ADD COLUMN "created_by_uuid" uuid,
+    ADD COLUMN "pipeline_uuid" uuid,
+    ADD COLUMN "stage_uuid" uuid,
+    ADD COLUMN "amount" int8 NOT NULL DEFAULT 0,
+    ADD COLUMN "currency" varchar(3) NOT NULL DEFAULT 'RUB',
+    ADD COLUMN "probability" int,
+    ADD COLUMN "agent_uuid" uuid,
+    ADD COLUMN "close_at" timestamptz,
+    ADD COLUMN "stage_changed_at" timestamptz NOT NULL DEFAULT now();
+
+CREATE INDEX deals_pipeline ON deals (company_uuid, pipeline_uuid, stage_uuid);
+
+CREATE INDEX deals_agent_uuid ON deals (agent_uuid);
+
+CREATE TABLE deal_tasks (
+    "deal_uuid" uuid NOT NULL,
+    "task_uuid" uuid NOT NULL,
+    "company_uuid" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("deal_uuid", "task_uuid")
+);
+
+CREATE INDEX deal_tasks_task_uuid ON deal_tasks (task_uuid);
@@ -0,0 +1,7 @@
+DROP INDEX sms_to;
+
+DROP TABLE agent_comments;
+
+DROP TABLE agent_tasks;
+
+DROP TABLE agent_legal_entities;
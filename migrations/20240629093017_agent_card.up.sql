@@ -0,0 +1,38 @@
+CREATE TABLE agent_legal_entities (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "agent_uuid" uuid NOT NULL REFERENCES agents(uuid) ON DELETE CASCADE,
+    "name" varchar(250) NOT NULL DEFAULT '',
+    "inn" varchar(12) NOT NULL DEFAULT '',
+    "kpp" varchar(9) NOT NULL DEFAULT '',
+    "ogrn" varchar(15) NOT NULL DEFAULT '',
+    "address" varchar(500) NOT NULL DEFAULT '',
+    "sort" int NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX agent_legal_entities_agent_uuid ON agent_legal_entities (agent_uuid);
+
+CREATE INDEX agent_legal_entities_inn ON agent_legal_entities (inn);
+
+CREATE TABLE agent_tasks (
+    "agent_uuid" uuid NOT NULL REFERENCES agents(uuid) ON DELETE CASCADE,
+    "task_uuid" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("agent_uuid", "task_uuid")
+);
+
+CREATE INDEX agent_tasks_task_uuid ON agent_tasks (task_uuid);
+
+CREATE TABLE agent_comments (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "agent_uuid" uuid NOT NULL REFERENCES agents(uuid) ON DELETE CASCADE,
+    "created_by" varchar(100) NOT NULL DEFAULT '',
+    "created_by_uuid" uuid NOT NULL,
+    "text" text NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX agent_comments_agent_uuid ON agent_comments (agent_uuid, created_at);
+
+CREATE INDEX sms_to ON sms ("to");
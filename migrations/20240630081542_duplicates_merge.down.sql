@@ -0,0 +1 @@
+ALTER TABLE catalog_data_history DROP COLUMN merged_uuid;
@@ -0,0 +1 @@
+ALTER TABLE catalog_data_history ADD COLUMN "merged_uuid" uuid;
@@ -0,0 +1,3 @@
+DROP INDEX sms_provider_ids;
+
+ALTER TABLE sms DROP COLUMN provider_ids;
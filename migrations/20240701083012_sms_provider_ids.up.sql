@@ -0,0 +1,8 @@
+ALTER TABLE
+    "public"."sms"
+ADD
+    COLUMN "provider_ids" text[] NOT NULL DEFAULT '{}';
+
+UPDATE sms SET provider_ids = ARRAY[provider_id] WHERE provider_id <> '';
+
+CREATE INDEX sms_provider_ids ON sms USING gin (provider_ids);
@@ -0,0 +1 @@
+ALTER TABLE sms_campaign_recipients DROP COLUMN claimed_at;
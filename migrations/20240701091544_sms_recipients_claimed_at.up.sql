@@ -0,0 +1,4 @@
+ALTER TABLE
+    "public"."sms_campaign_recipients"
+ADD
+    COLUMN "claimed_at" timestamptz;
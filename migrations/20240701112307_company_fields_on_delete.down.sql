@@ -0,0 +1 @@
+ALTER TABLE company_fields DROP COLUMN "on_delete";
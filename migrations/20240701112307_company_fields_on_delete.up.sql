@@ -0,0 +1 @@
+ALTER TABLE company_fields ADD COLUMN "on_delete" varchar(10) NOT NULL DEFAULT 'block';
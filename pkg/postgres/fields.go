@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RenameFieldOptions переименовывает варианты поля select/multi_select hash в jsonb-колонке fields
+// строк query, renames - старое название -> новое. multi - поле multi_select, там хранится массив вариантов
+func RenameFieldOptions(query *gorm.DB, hash string, multi bool, renames map[string]string) error {
+	mp, err := json.Marshal(renames)
+	if err != nil {
+		return err
+	}
+
+	var fields clause.Expr
+	if multi {
+		query = query.
+			Where("jsonb_typeof(fields->?) = 'array'", hash).
+			Where("jsonb_exists_any(fields->?, array[?]::text[])", hash, lo.Keys(renames))
+		fields = gorm.Expr("jsonb_set(fields, array[?]::text[], (select jsonb_agg(coalesce(?::jsonb->>e, e) order by i) from jsonb_array_elements_text(fields->?) with ordinality as x(e, i)))", hash, string(mp), hash)
+	} else {
+		query = query.
+			Where("jsonb_exists(?::jsonb, fields->>?)", string(mp), hash)
+		fields = gorm.Expr("jsonb_set(fields, array[?]::text[], to_jsonb(?::jsonb->>(fields->>?)))", hash, string(mp), hash)
+	}
+
+	return query.Updates(map[string]interface{}{
+		"fields":     fields,
+		"updated_at": gorm.Expr("now()"),
+	}).Error
+}
+
+// WhereFieldRange - условие фильтра по диапазону значений поля name в jsonb-колонке fields:
+// для чисел сравнивается само значение, для money - amount, currency отбирает валюту money
+func WhereFieldRange(query *gorm.DB, name string, from, to *float64, currency string) *gorm.DB {
+	value := "CASE jsonb_typeof(fields->?) WHEN 'number' THEN (fields->>?)::numeric WHEN 'object' THEN (fields->?->>'amount')::numeric END"
+
+	if from != nil {
+		query = query.Where(value+" >= ?", name, name, name, *from)
+	}
+
+	if to != nil {
+		query = query.Where(value+" <= ?", name, name, name, *to)
+	}
+
+	if currency != "" {
+		query = query.Where("fields->?->>'currency' = ?", name, currency)
+	}
+
+	return query
+}